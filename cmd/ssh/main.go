@@ -17,7 +17,9 @@ import (
 	"github.com/charmbracelet/wish/bubbletea"
 	"github.com/charmbracelet/wish/logging"
 	"github.com/taldoflemis/nume/configs"
+	"github.com/taldoflemis/nume/internal/precision"
 	"github.com/taldoflemis/nume/internal/tui/models"
+	"github.com/taldoflemis/nume/internal/usecases"
 )
 
 func gracefulShutdown(
@@ -57,7 +59,7 @@ func main() {
 		AddSource: true,
 	})
 
-	logger := slog.New(slogHandler)
+	logger := slog.New(models.NewContextHandler(slogHandler))
 	slog.SetDefault(logger)
 
 	cfg, err := configs.LoadConfig()
@@ -66,11 +68,35 @@ func main() {
 		return
 	}
 
+	usecases.SetLogFormat(usecases.LogFormat{
+		Precision:   cfg.Logger.FloatPrecision,
+		MaxElements: cfg.Logger.MaxLoggedElements,
+	})
+
+	models.SetMaxRenderWidth(cfg.TUI.MaxRenderWidth)
+	models.SetMaxFixedPartitions(cfg.Integration.MaxPartitions)
+
+	matrices := make([]models.NamedMatrix, len(cfg.TUI.Matrices))
+	for i, matrix := range cfg.TUI.Matrices {
+		matrices[i] = models.NamedMatrix{Name: matrix.Name, Values: matrix.Rows}
+	}
+	if err := models.RegisterMatrices(matrices...); err != nil {
+		slog.Error("failed to register the configured matrices", slog.Any("error", err))
+		return
+	}
+
 	s, err := wish.NewServer(
 		wish.WithAddress(net.JoinHostPort(cfg.SSH.Host, strconv.Itoa(cfg.SSH.Port))),
 		wish.WithHostKeyPath(cfg.SSH.HostKeyPath),
 		wish.WithMiddleware(
-			bubbletea.Middleware(teaHandler),
+			bubbletea.Middleware(teaHandler(
+				models.WelcomeTiming{
+					AnimationDelay:  time.Duration(cfg.TUI.AnimationDelayInMilliseconds) * time.Millisecond,
+					TransitionDelay: time.Duration(cfg.TUI.TransitionDelayInMilliseconds) * time.Millisecond,
+				},
+				models.Branding{Title: cfg.TUI.Title, WelcomeText: cfg.TUI.WelcomeText},
+				precision.Profile(cfg.Numerics.Profile),
+			)),
 			activeterm.Middleware(),
 			logging.StructuredMiddleware(),
 		),
@@ -98,15 +124,21 @@ func main() {
 	slog.Info("SSH server down")
 }
 
-func teaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
-	// This should never fail, as we are using the activeterm middleware.
-	pty, _, _ := s.Pty()
-
-	renderer := bubbletea.MakeRenderer(s)
-	opts := bubbletea.MakeOptions(s)
-	opts = append(opts, tea.WithAltScreen())
-
-	theme := models.ThemeCatppuccin(renderer)
-	m := models.NewWelcomeModel(theme, pty.Term, renderer.ColorProfile().Name(), s.User())
-	return m, opts
+func teaHandler(
+	timing models.WelcomeTiming,
+	branding models.Branding,
+	precisionProfile precision.Profile,
+) bubbletea.Handler {
+	return func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+		// This should never fail, as we are using the activeterm middleware.
+		pty, _, _ := s.Pty()
+
+		renderer := bubbletea.MakeRenderer(s)
+		opts := bubbletea.MakeOptions(s)
+		opts = append(opts, tea.WithAltScreen())
+
+		theme := models.ThemeCatppuccin(renderer)
+		m := models.NewWelcomeModel(theme, pty.Term, renderer.ColorProfile().Name(), s.User(), timing, branding, precisionProfile).Remote()
+		return m, opts
+	}
 }
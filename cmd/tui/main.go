@@ -1,45 +1,108 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"log/slog"
 	"os"
+	"os/signal"
 	"os/user"
+	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/taldoflemis/nume/configs"
+	"github.com/taldoflemis/nume/internal/logfile"
+	"github.com/taldoflemis/nume/internal/precision"
 	"github.com/taldoflemis/nume/internal/tui/models"
+	"github.com/taldoflemis/nume/internal/usecases"
 )
 
 func main() {
-	// Start with the welcome screen
-	renderer := lipgloss.DefaultRenderer()
+	// os.Exit skips deferred calls, so run returns the exit code once the log
+	// file is closed
+	os.Exit(run())
+}
+
+func run() int {
+	cfg, err := configs.LoadConfig()
+	if err != nil {
+		log.Printf("Error loading config: %v", err)
+		return 1
+	}
 
-	file, err := os.OpenFile("nume.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	file, err := logfile.Open(cfg.Logger.FilePath, cfg.Logger.TruncateOnStart)
 	if err != nil {
-		log.Fatalf("Error opening log file: %v", err)
+		log.Printf("Error opening log file: %v", err)
+		return 1
 	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("Error closing log file: %v", err)
+		}
+	}()
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Logger.Level)); err != nil {
+		log.Printf("Error parsing log level: %v", err)
+		return 1
+	}
+
 	hander := slog.NewJSONHandler(file, &slog.HandlerOptions{
-		Level: slog.LevelDebug,
+		Level: level,
 	})
 
-	slog.SetDefault(slog.New(hander))
+	slog.SetDefault(slog.New(models.NewContextHandler(hander)))
+
+	usecases.SetLogFormat(usecases.LogFormat{
+		Precision:   cfg.Logger.FloatPrecision,
+		MaxElements: cfg.Logger.MaxLoggedElements,
+	})
 
+	models.SetMaxRenderWidth(cfg.TUI.MaxRenderWidth)
+	models.SetMaxFixedPartitions(cfg.Integration.MaxPartitions)
+
+	matrices := make([]models.NamedMatrix, len(cfg.TUI.Matrices))
+	for i, matrix := range cfg.TUI.Matrices {
+		matrices[i] = models.NamedMatrix{Name: matrix.Name, Values: matrix.Rows}
+	}
+	if err := models.RegisterMatrices(matrices...); err != nil {
+		log.Printf("Error registering the configured matrices: %v", err)
+		return 1
+	}
+
+	// Start with the welcome screen
+	renderer := lipgloss.DefaultRenderer()
 	theme := models.ThemeCatppuccin(renderer)
 
 	currentUser, err := user.Current()
 	if err != nil {
 		fmt.Println("Error getting current user:", err)
-		return
+		return 1
+	}
+
+	timing := models.WelcomeTiming{
+		AnimationDelay:  time.Duration(cfg.TUI.AnimationDelayInMilliseconds) * time.Millisecond,
+		TransitionDelay: time.Duration(cfg.TUI.TransitionDelayInMilliseconds) * time.Millisecond,
 	}
+	branding := models.Branding{Title: cfg.TUI.Title, WelcomeText: cfg.TUI.WelcomeText}
 
-	m := models.NewWelcomeModel(theme, "TERM", renderer.ColorProfile().Name(), currentUser.Username)
-	// m := models.NewMainModel(theme)
+	m := models.NewWelcomeModel(theme, "TERM", renderer.ColorProfile().Name(), currentUser.Username, timing, branding, precision.Profile(cfg.Numerics.Profile))
+	// m := models.NewMainModel(theme, models.NewSession(currentUser.Username))
 
-	p := tea.NewProgram(m, tea.WithAltScreen())
+	// A hang up or termination stops the program like quitting does, so the
+	// log file is still closed
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGHUP, syscall.SIGTERM)
+	defer stop()
+
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx))
 	if _, err := p.Run(); err != nil {
+		slog.ErrorContext(ctx, "Program stopped", slog.Any("error", err))
 		log.Printf("Error running program: %v", err)
-		os.Exit(1)
+		return 1
 	}
+
+	return 0
 }
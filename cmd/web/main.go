@@ -13,6 +13,7 @@ import (
 
 	"github.com/taldoflemis/nume/configs"
 	"github.com/taldoflemis/nume/internal/server"
+	"github.com/taldoflemis/nume/internal/usecases"
 )
 
 func gracefulShutdown(
@@ -63,6 +64,11 @@ func main() {
 		return
 	}
 
+	usecases.SetLogFormat(usecases.LogFormat{
+		Precision:   cfg.Logger.FloatPrecision,
+		MaxElements: cfg.Logger.MaxLoggedElements,
+	})
+
 	echoServer := server.NewServer(*cfg)
 	echoServer.SetDefaultMiddlewares()
 
@@ -27,6 +27,22 @@ type SSHCfg struct {
 	HostKeyPath string `mapstructure:"host-key-path" validate:"required"`
 }
 
+// RateLimitCfg is a per client IP token bucket refilled at RequestsPerSecond
+// and holding up to Burst requests, a zero rate disables the limiter
+type RateLimitCfg struct {
+	RequestsPerSecond float64 `mapstructure:"requests-per-second" validate:"gte=0"`
+	Burst             int     `mapstructure:"burst"               validate:"gte=0"`
+	ExpiresInSeconds  int     `mapstructure:"expires-in-seconds"  validate:"gte=0"`
+}
+
+// RequestLimitsCfg bounds the payloads of the API, MaxBodyBytes is rejected
+// with a 413 and the others with a 400, zero disables a limit
+type RequestLimitsCfg struct {
+	MaxBodyBytes        int64 `mapstructure:"max-body-bytes"        validate:"gte=0"`
+	MaxMatrixDimension  int   `mapstructure:"max-matrix-dimension"  validate:"gte=0"`
+	MaxExpressionLength int   `mapstructure:"max-expression-length" validate:"gte=0"`
+}
+
 type HTTPCfg struct {
 	Port                     int     `mapstructure:"port"                        validate:"required,min=1,max=65535"`
 	APIPrefix                string  `mapstructure:"api-prefix"                  validate:"required"`
@@ -36,6 +52,13 @@ type HTTPCfg struct {
 	ReadTimeoutInSeconds     int     `mapstructure:"read-timeout-in-seconds"     validate:"required,gt=10,lt=600"`
 	WriteTimeoutInSeconds    int     `mapstructure:"write-timeout-in-seconds"    validate:"required,gt=10,lt=600"`
 	IdleTimeoutInSeconds     int     `mapstructure:"idle-timeout-in-seconds"     validate:"required,gt=10,lt=600"`
+
+	// EvaluationTimeoutInMilliseconds bounds each evaluation of a user
+	// expression, zero disables the bound and its per evaluation overhead
+	EvaluationTimeoutInMilliseconds int `mapstructure:"evaluation-timeout-in-milliseconds" validate:"gte=0"`
+
+	RateLimit RateLimitCfg     `mapstructure:"rate-limit"`
+	Limits    RequestLimitsCfg `mapstructure:"limits"`
 }
 
 type AppCfg struct {
@@ -44,9 +67,57 @@ type AppCfg struct {
 	Environment string `mapstructure:"environment" validate:"required,oneof=develop prod local"`
 }
 
+// LoggerCfg also bounds how matrices and vectors are logged, FloatPrecision is
+// in significant digits and MaxLoggedElements is per row, column or vector.
+// FilePath is the log file of the terminal interface, nume.log when empty,
+// emptied on start when TruncateOnStart is set
 type LoggerCfg struct {
-	Level      string `mapstructure:"level"       validate:"required,oneof=DEBUG INFO WARN ERROR"`
-	EnableJSON bool   `mapstructure:"enable-json"`
+	Level             string `mapstructure:"level"               validate:"required,oneof=DEBUG INFO WARN ERROR"`
+	EnableJSON        bool   `mapstructure:"enable-json"`
+	FilePath          string `mapstructure:"file-path"`
+	TruncateOnStart   bool   `mapstructure:"truncate-on-start"`
+	FloatPrecision    int    `mapstructure:"float-precision"     validate:"gte=0,lte=17"`
+	MaxLoggedElements int    `mapstructure:"max-logged-elements" validate:"gte=0"`
+}
+
+// MatrixCfg is a named matrix offered in the eigen tab after the builtin ones
+type MatrixCfg struct {
+	Name string      `mapstructure:"name" validate:"required"`
+	Rows [][]float64 `mapstructure:"rows" validate:"required,min=1"`
+}
+
+// TUICfg tunes the terminal interface, a zero delay disables the pause
+type TUICfg struct {
+	AnimationDelayInMilliseconds  int `mapstructure:"animation-delay-in-milliseconds"  validate:"gte=0,lte=2000"`
+	TransitionDelayInMilliseconds int `mapstructure:"transition-delay-in-milliseconds" validate:"gte=0,lte=10000"`
+
+	// Title heads the main screen and WelcomeText is typed on the welcome
+	// screen, so deployments can rebrand the interface
+	Title       string `mapstructure:"title"        validate:"required"`
+	WelcomeText string `mapstructure:"welcome-text" validate:"required"`
+
+	// MaxRenderWidth caps the word wrap of the tab content, zero follows the
+	// width of the terminal
+	MaxRenderWidth int `mapstructure:"max-render-width" validate:"gte=0"`
+
+	Matrices []MatrixCfg `mapstructure:"matrices" validate:"dive"`
+}
+
+// IntegrationCfg caps the partitions of a uniform integration grid, requests
+// above it are integrated adaptively within the cap, zero disables the cap.
+// DoubleIntegralWorkers spreads the rows of a double integral over goroutines,
+// summed in a fixed order when DoubleIntegralDeterministic is set, one or
+// zero summing them sequentially
+type IntegrationCfg struct {
+	MaxPartitions               uint64 `mapstructure:"max-partitions"                validate:"gte=0"`
+	DoubleIntegralWorkers       int    `mapstructure:"double-integral-workers"       validate:"gte=0"`
+	DoubleIntegralDeterministic bool   `mapstructure:"double-integral-deterministic"`
+}
+
+// NumericsCfg picks the precision profile setting the default epsilon,
+// iterations, partitions and quadrature order of every numerical method
+type NumericsCfg struct {
+	Profile string `mapstructure:"profile" validate:"required,oneof=fast balanced accurate"`
 }
 
 type Config struct {
@@ -54,6 +125,10 @@ type Config struct {
 	HTTP   HTTPCfg   `mapstructure:"http"   validate:"required"`
 	App    AppCfg    `mapstructure:"app"    validate:"required"`
 	Logger LoggerCfg `mapstructure:"logger" validate:"required"`
+	TUI    TUICfg    `mapstructure:"tui"`
+
+	Integration IntegrationCfg `mapstructure:"integration"`
+	Numerics    NumericsCfg    `mapstructure:"numerics"`
 }
 
 func LoadConfig() (*Config, error) {
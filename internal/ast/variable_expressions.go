@@ -4,3 +4,11 @@ type SingleVariableExpressionNode struct {
 	VariableIdentifier string
 	Expression         string
 }
+
+// DualVariableExpressionNode is an expression of two variables, evaluated
+// with FirstVariableIdentifier bound to the first argument.
+type DualVariableExpressionNode struct {
+	FirstVariableIdentifier  string
+	SecondVariableIdentifier string
+	Expression               string
+}
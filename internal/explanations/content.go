@@ -0,0 +1,316 @@
+package explanations
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+// Tab is a calculator whose sections are explained.
+type Tab string
+
+const (
+	TabDerivative   Tab = "derivative"
+	TabIntegral     Tab = "integral"
+	TabEigen        Tab = "eigen"
+	TabLinearSystem Tab = "linear-system"
+)
+
+// Section is a section of a tab, the same name standing for the analogous
+// section of every tab.
+type Section string
+
+const (
+	SectionArguments       Section = "arguments"
+	SectionMethod          Section = "method"
+	SectionErrorOrder      Section = "error-order"
+	SectionDerivativeOrder Section = "derivative-order"
+	SectionPhilosophy      Section = "philosophy"
+	SectionMode            Section = "mode"
+	SectionMatrixSelection Section = "matrix-selection"
+	SectionMatrixEditor    Section = "matrix-editor"
+	SectionVector          Section = "vector"
+)
+
+// Lookup returns the explanation of section in tab, its defaults being those
+// of profile, false when there is none, as for the sections whose content
+// depends on the state of the tab.
+func Lookup(tab Tab, section Section, profile precision.Profile) (Explanation, bool) {
+	explanation, ok := content(profile)[tab][section]
+	return explanation, ok
+}
+
+// profileDefault describes value as the default of profile.
+func profileDefault[T float64 | uint64](profile precision.Profile, value T) string {
+	formatted := strconv.FormatFloat(float64(value), 'g', -1, 64)
+	return fmt.Sprintf("%s with the %s profile", strings.Replace(formatted, "e-0", "e-", 1), profile)
+}
+
+// content returns the explanations of the sections whose content does not
+// depend on the selection, with the defaults of profile.
+func content(profile precision.Profile) map[Tab]map[Section]Explanation {
+	defaults := profile.Defaults()
+
+	return map[Tab]map[Section]Explanation{
+		TabDerivative: {
+			SectionErrorOrder: {
+				Title:           "Error Order",
+				Overview:        "Choose the degree of the error for the approximation.",
+				ParametersTitle: "Available Orders",
+				Parameters: []Parameter{
+					{Name: "Linear (degree 1)", Description: "O(h)"},
+					{Name: "Quadratic (degree 2)", Description: "O(h²)"},
+					{Name: "Cubic (degree 3)", Description: "O(h³)"},
+					{Name: "Quartic (degree 4)", Description: "O(h⁴)"},
+				},
+				Tips: []string{"Use ↑/↓ arrows to select the approximation degree."},
+			},
+			SectionDerivativeOrder: {
+				Title:           "Derivative Order",
+				Overview:        "Select the order of derivative to calculate.",
+				Formula:         "f'(x)   = df/dx\nf''(x)  = d²f/dx²\nf'''(x) = d³f/dx³",
+				ParametersTitle: "Available Orders",
+				Parameters: []Parameter{
+					{Name: "First derivative", Description: "f'(x), the rate of change"},
+					{Name: "Second derivative", Description: "f''(x), the concavity and acceleration"},
+					{Name: "Third derivative", Description: "f'''(x), the rate of change of acceleration"},
+				},
+				Tips: []string{"Use ↑/↓ arrows to select the derivative order."},
+			},
+			SectionPhilosophy: {
+				Title:           "Philosophy",
+				Overview:        "Choose the finite difference method for numerical differentiation.",
+				Formula:         "forward:  (f(x+h) - f(x)) / h\nbackward: (f(x) - f(x-h)) / h\ncentral:  (f(x+h) - f(x-h)) / 2h",
+				ParametersTitle: "Available Methods",
+				Parameters: []Parameter{
+					{
+						Name:        "Forward Difference",
+						Description: "Uses f(x+h) - f(x)",
+						Details:     []string{"Good for left boundary points", "First-order accurate: O(h)"},
+					},
+					{
+						Name:        "Backward Difference",
+						Description: "Uses f(x) - f(x-h)",
+						Details:     []string{"Good for right boundary points", "First-order accurate: O(h)"},
+					},
+					{
+						Name:        "Central Difference",
+						Description: "Uses f(x+h) - f(x-h)",
+						Details:     []string{"Most accurate for interior points", "Second-order accurate: O(h²)"},
+					},
+				},
+				Tips: []string{
+					"Use ↑/↓ arrows to select the difference method.",
+					"**Recommended**: Central difference for most applications.",
+				},
+			},
+			SectionArguments: {
+				Title:    "Arguments",
+				Overview: "Configure the numerical calculation parameters.",
+				Parameters: []Parameter{
+					{
+						Name:        "Delta (h)",
+						Description: "The step size for finite difference calculation.",
+						Details: []string{
+							"Smaller values: More accurate but prone to numerical errors",
+							"Larger values: Less accurate but more stable",
+							"Typical range: 1e-6 to 1e-2",
+						},
+						Default: "0.001",
+					},
+					{
+						Name:        "Test Point",
+						Description: "The x-coordinate where the derivative is evaluated.",
+						Details: []string{
+							"Choose based on your function's domain",
+							"Avoid singularities (e.g., x=0 for 1/x)",
+						},
+						Default: "1.0",
+					},
+				},
+				Tips: []string{"Use ←/→ arrows to switch between input fields."},
+			},
+		},
+		TabIntegral: {
+			SectionMode: {
+				Title:           "Mode",
+				Overview:        "Choose how the partitions of the interval are picked.",
+				Formula:         "T(n) = h/2 · [f(x₀) + 2f(x₁) + … + 2f(xₙ₋₁) + f(xₙ)]",
+				ParametersTitle: "Available Modes",
+				Parameters: []Parameter{
+					{
+						Name: "Accurate",
+						Description: "Composite trapezoidal rule on 1, 2, 4, ... partitions until the Richardson " +
+							"estimate |T(n) - T(n/2)| / 3 of the error falls below the tolerance. A reliable " +
+							"answer without picking a method or a partition count. A precision ladder shows the " +
+							"actual error on each grid shrinking to the tolerance.",
+					},
+					{
+						Name: "Fixed partitions",
+						Description: "Composite trapezoidal rule on the given number of partitions, error O(h²). " +
+							"The result previews the area of each partition, showing where most of the integral lies.",
+					},
+				},
+				Tips: []string{"Use ↑/↓ arrows to select the mode."},
+			},
+			SectionArguments: {
+				Title: "Arguments",
+				Parameters: []Parameter{
+					{Name: "Left and Right", Description: "The integration interval [a, b].", Default: "[0, 1]"},
+					{Name: "Tolerance (accurate mode)", Description: "Largest accepted estimate of the absolute error.", Default: profileDefault(profile, defaults.IntegralTolerance)},
+					{Name: "Partitions (fixed mode)", Description: "Number of equal partitions of the interval.", Default: profileDefault(profile, defaults.Partitions)},
+					{Name: "Reference", Description: "Optional exact value of the integral, the result then shows its absolute and relative error."},
+				},
+				Tips: []string{"Use ↑/↓ arrows to switch between input fields."},
+			},
+		},
+		TabEigen: {
+			SectionMethod: {
+				Title:           "Power Method Selection",
+				Overview:        "Choose the eigenvalue calculation method.",
+				Formula:         "xₖ₊₁ = A·xₖ / ‖A·xₖ‖",
+				ParametersTitle: "Available Methods",
+				Parameters: []Parameter{
+					{Name: "Regular Power Method", Description: "Finds the largest eigenvalue"},
+					{Name: "Inverse Power Method", Description: "Finds the smallest eigenvalue"},
+					{Name: "Farthest Eigenvalue Power", Description: "Finds eigenvalue farthest from given value"},
+					{Name: "Nearest Eigenvalue Power", Description: "Finds eigenvalue nearest to given value"},
+				},
+				Tips: []string{
+					"Use ↑/↓ arrows to select a power method.",
+					"Press **n** to cycle the norm the eigenvector is scaled to: L2 for unit length, L1 for absolute values summing to 1 or max for a largest element of 1.",
+				},
+			},
+			SectionMatrixSelection: {
+				Title:           "Matrix Selection",
+				Overview:        "Choose a predefined matrix for eigenvalue calculation.",
+				ParametersTitle: "Available Matrices",
+				Parameters: []Parameter{
+					{Name: "2x2 Simple", Description: "Small symmetric matrix"},
+					{Name: "3x3 Simple", Description: "Tridiagonal symmetric matrix"},
+					{Name: "4x4 Simple", Description: "Larger tridiagonal matrix"},
+					{Name: "5x5 Real", Description: "Large pentadiagonal matrix"},
+				},
+				Tips: []string{
+					"Use ↑/↓ arrows to select a matrix, it is loaded into the matrix editor.",
+					"Press **t** to transpose the matrix, which keeps its eigenvalues, or ***** to scale it by the scale factor, which scales them too.",
+					"The Gershgorin discs below the matrix hold every eigenvalue, a quick check of the result.",
+				},
+			},
+			SectionMatrixEditor: {
+				Title:           "Matrix Editor",
+				Overview:        "Edit the matrix used by the power methods cell by cell.",
+				ParametersTitle: "Controls",
+				Parameters: []Parameter{
+					{Name: "↑/↓/←/→", Description: "Move between cells"},
+					{Name: "0-9 . - + E", Description: "Type into the selected cell"},
+					{Name: "Backspace", Description: "Delete the last character, **Del** clears the cell"},
+					{Name: "] / [", Description: "Add / remove a row"},
+					{Name: "} / {", Description: "Add / remove a column"},
+				},
+				Tips: []string{
+					"Empty cells are read as zero and invalid cells are highlighted.",
+					"The power methods require a square matrix.",
+				},
+			},
+			SectionArguments: {
+				Title:    "Arguments",
+				Overview: "Configure the power method parameters.",
+				Parameters: []Parameter{
+					{
+						Name:        "Initial Vector",
+						Description: "Starting eigenvector guess (comma-separated values).",
+						Details: []string{
+							"Must have same dimension as matrix",
+							"Cannot be zero vector",
+							"**Format**: 1.0,1.0 or 1,0,1",
+						},
+						Default: "1.0,1.0",
+					},
+					{
+						Name:        "Epsilon (ε)",
+						Description: "Convergence tolerance for the algorithm.",
+						Details:     []string{"Smaller values: More precise but slower", "Typical range: 1e-10 to 1e-3"},
+						Default:     profileDefault(profile, defaults.Epsilon),
+					},
+					{
+						Name:        "Max Iterations",
+						Description: "Maximum number of iterations before stopping.",
+						Details:     []string{"Higher values: More chances to converge", "Typical range: 50 to 1000"},
+						Default:     profileDefault(profile, defaults.MaxIterations),
+					},
+					{
+						Name:        "K Eigenvalue (Shift Value)",
+						Description: "Shift value for nearest/farthest eigenvalue methods.",
+						Details: []string{
+							`Used only with "Nearest" and "Farthest" power methods`,
+							"For nearest: finds eigenvalue closest to this value",
+							"For farthest: finds eigenvalue farthest from this value",
+						},
+						Default: "0.0",
+					},
+					{
+						Name:        "Scale Factor",
+						Description: "Factor the matrix is multiplied by when pressing *.",
+						Default:     "2",
+					},
+				},
+				Tips: []string{"Use ←/→ arrows to switch between input fields."},
+			},
+		},
+		TabLinearSystem: {
+			SectionMethod: {
+				Title:           "Method Selection",
+				Overview:        "Choose how to solve the linear system Ax = b.",
+				ParametersTitle: "Available Methods",
+				Parameters: []Parameter{
+					{Name: "LU Decomposition", Description: "Direct method, factors PA = LU with partial pivoting"},
+					{Name: "Gaussian Elimination", Description: "Direct method, eliminates below each pivot of [A | b] and back substitutes"},
+					{Name: "Cholesky", Description: "Direct method for symmetric positive definite A, factors A = LLᵀ"},
+					{Name: "Jacobi", Description: "Iterative, updates every component from the previous iterate"},
+					{Name: "Gauss-Seidel", Description: "Iterative, uses each updated component right away"},
+					{Name: "SOR", Description: "Iterative, Gauss-Seidel moving each component by ω times its update"},
+				},
+				Tips: []string{
+					"Iterative methods converge for diagonally dominant matrices.",
+					"Use ↑/↓ arrows to select a method.",
+				},
+			},
+			SectionMatrixEditor: {
+				Title:           "Matrix A",
+				Overview:        "Edit the coefficient matrix of the system cell by cell.",
+				ParametersTitle: "Controls",
+				Parameters: []Parameter{
+					{Name: "↑/↓/←/→", Description: "Move between cells"},
+					{Name: "0-9 . - + E", Description: "Type into the selected cell"},
+					{Name: "backspace/del", Description: "Delete a character or clear the cell"},
+					{Name: "] / [", Description: "Add or remove a row"},
+					{Name: "} / {", Description: "Add or remove a column"},
+				},
+				Tips: []string{"The matrix must be square."},
+			},
+			SectionVector: {
+				Title:           "Vector b",
+				Overview:        "Edit the right-hand side of the system, a single column with as many rows as A.",
+				ParametersTitle: "Controls",
+				Parameters: []Parameter{
+					{Name: "↑/↓", Description: "Move between entries"},
+					{Name: "0-9 . - + E", Description: "Type into the selected entry"},
+					{Name: "] / [", Description: "Add or remove an entry"},
+				},
+			},
+			SectionArguments: {
+				Title:    "Arguments",
+				Overview: "Configure the iterative methods, ignored by the direct ones.",
+				Parameters: []Parameter{
+					{Name: "Tolerance", Description: "Stops once the relative change between iterates is below it.", Default: profileDefault(profile, defaults.Epsilon)},
+					{Name: "Max Iterations", Description: "Upper bound on the number of iterations.", Default: profileDefault(profile, defaults.MaxIterations)},
+					{Name: "Relaxation ω", Description: "Factor of SOR, strictly between 0 and 2, where 1 is Gauss-Seidel.", Default: "1.25"},
+				},
+				Tips: []string{"Use ↑/↓ arrows to switch between input fields."},
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package explanations
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// Explanation describes a section of a calculator as data, so the TUI and
+// the API render the same content.
+type Explanation struct {
+	Title    string `json:"title"`
+	Overview string `json:"overview,omitempty"`
+	// Formula is shown verbatim in a code block, empty when the section has
+	// none
+	Formula string `json:"formula,omitempty"`
+	// ParametersTitle heads the parameters, "Parameters" when empty
+	ParametersTitle string      `json:"parametersTitle,omitempty"`
+	Parameters      []Parameter `json:"parameters,omitempty"`
+	Tips            []string    `json:"tips,omitempty"`
+}
+
+// Parameter is an option, input or control of a section.
+type Parameter struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Details     []string `json:"details,omitempty"`
+	Default     string   `json:"default,omitempty"`
+}
+
+// Markdown renders the explanation, every section being left out when it
+// has no content.
+func (e Explanation) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n", e.Title)
+
+	if e.Overview != "" {
+		fmt.Fprintf(&b, "\n%s\n", e.Overview)
+	}
+
+	if e.Formula != "" {
+		fmt.Fprintf(&b, "\n## Formula\n\n```\n%s\n```\n", e.Formula)
+	}
+
+	if len(e.Parameters) > 0 {
+		fmt.Fprintf(&b, "\n## %s\n\n", cmp.Or(e.ParametersTitle, "Parameters"))
+		for _, parameter := range e.Parameters {
+			b.WriteString(parameter.markdown())
+		}
+	}
+
+	if len(e.Tips) > 0 {
+		b.WriteString("\n## Tips\n\n")
+		for _, tip := range e.Tips {
+			fmt.Fprintf(&b, "- %s\n", tip)
+		}
+	}
+
+	return b.String()
+}
+
+// markdown renders the parameter as a list item, its details and default
+// nested under it.
+func (p Parameter) markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "- **%s**", p.Name)
+	if p.Description != "" {
+		fmt.Fprintf(&b, ": %s", p.Description)
+	}
+	b.WriteString("\n")
+
+	for _, detail := range p.Details {
+		fmt.Fprintf(&b, "  - %s\n", detail)
+	}
+
+	if p.Default != "" {
+		fmt.Fprintf(&b, "  - **Default**: %s\n", p.Default)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,197 @@
+package explanations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+func TestExplanationMarkdown(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		explanation Explanation
+		expected    string
+	}{
+		{
+			name:        "TitleOnly",
+			explanation: Explanation{Title: "Calculate"},
+			expected:    "# Calculate\n",
+		},
+		{
+			name: "AllSections",
+			explanation: Explanation{
+				Title:    "Philosophy",
+				Overview: "Choose the finite difference method.",
+				Formula:  "f'(x) ≈ (f(x+h) - f(x-h)) / 2h",
+				Parameters: []Parameter{
+					{Name: "Central", Description: "Uses f(x+h) - f(x-h)", Details: []string{"Error O(h²)"}},
+					{Name: "Delta (h)", Default: "0.001"},
+				},
+				Tips: []string{"Use ↑/↓ arrows to select a method."},
+			},
+			expected: "# Philosophy\n" +
+				"\nChoose the finite difference method.\n" +
+				"\n## Formula\n\n```\nf'(x) ≈ (f(x+h) - f(x-h)) / 2h\n```\n" +
+				"\n## Parameters\n\n" +
+				"- **Central**: Uses f(x+h) - f(x-h)\n" +
+				"  - Error O(h²)\n" +
+				"- **Delta (h)**\n" +
+				"  - **Default**: 0.001\n" +
+				"\n## Tips\n\n" +
+				"- Use ↑/↓ arrows to select a method.\n",
+		},
+		{
+			name: "NamedParameters",
+			explanation: Explanation{
+				Title:           "Matrix Editor",
+				ParametersTitle: "Controls",
+				Parameters:      []Parameter{{Name: "↑/↓/←/→", Description: "Move between cells"}},
+			},
+			expected: "# Matrix Editor\n" +
+				"\n## Controls\n\n" +
+				"- **↑/↓/←/→**: Move between cells\n",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			markdown := test.explanation.Markdown()
+
+			// Assert
+			assert.Equal(t, test.expected, markdown)
+		})
+	}
+}
+
+func TestExplanationJSONOmitsEmptySections(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	explanation := Explanation{Title: "Mode", Tips: []string{"Use ↑/↓ arrows to select the mode."}}
+
+	// Act
+	encoded, err := json.Marshal(explanation)
+
+	// Assert
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title": "Mode", "tips": ["Use ↑/↓ arrows to select the mode."]}`, string(encoded))
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		tab           Tab
+		section       Section
+		expectedTitle string
+		expectedFound bool
+	}{
+		{
+			name:          "DerivativePhilosophy",
+			tab:           TabDerivative,
+			section:       SectionPhilosophy,
+			expectedTitle: "Philosophy",
+			expectedFound: true,
+		},
+		{
+			name:          "SharedSectionName",
+			tab:           TabLinearSystem,
+			section:       SectionMatrixEditor,
+			expectedTitle: "Matrix A",
+			expectedFound: true,
+		},
+		{
+			name:    "SectionOfAnotherTab",
+			tab:     TabIntegral,
+			section: SectionPhilosophy,
+		},
+		{
+			name:    "UnknownTab",
+			tab:     Tab("interpolation"),
+			section: SectionArguments,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			explanation, found := Lookup(test.tab, test.section, precision.Balanced)
+
+			// Assert
+			assert.Equal(t, test.expectedFound, found)
+			assert.Equal(t, test.expectedTitle, explanation.Title)
+		})
+	}
+}
+
+func TestLookupRendersTheProfileDefaults(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		tab      Tab
+		profile  precision.Profile
+		expected map[string]string
+	}{
+		{
+			name:    "IntegralBalanced",
+			tab:     TabIntegral,
+			profile: precision.Balanced,
+			expected: map[string]string{
+				"Tolerance (accurate mode)": "1e-8 with the balanced profile",
+				"Partitions (fixed mode)":   "16 with the balanced profile",
+			},
+		},
+		{
+			name:    "EigenAccurate",
+			tab:     TabEigen,
+			profile: precision.Accurate,
+			expected: map[string]string{
+				"Epsilon (ε)":    "1e-10 with the accurate profile",
+				"Max Iterations": "1000 with the accurate profile",
+			},
+		},
+		{
+			name:    "LinearSystemFast",
+			tab:     TabLinearSystem,
+			profile: precision.Fast,
+			expected: map[string]string{
+				"Tolerance":      "0.0001 with the fast profile",
+				"Max Iterations": "50 with the fast profile",
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			explanation, found := Lookup(test.tab, SectionArguments, test.profile)
+
+			// Assert
+			require.True(t, found)
+			defaults := make(map[string]string)
+			for _, parameter := range explanation.Parameters {
+				if _, ok := test.expected[parameter.Name]; ok {
+					defaults[parameter.Name] = parameter.Default
+				}
+			}
+			assert.Equal(t, test.expected, defaults)
+		})
+	}
+}
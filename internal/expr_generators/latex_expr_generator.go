@@ -0,0 +1,115 @@
+package exprgenerators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+
+	"github.com/taldoflemis/nume/internal/ast"
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/interfaces"
+	"github.com/taldoflemis/nume/internal/latex"
+)
+
+// LatexExpressionGenerator parses the expression as LaTeX and evaluates its
+// tree in pure Go, unlike ExprTKExpressionGenerator it needs no CGO.
+type LatexExpressionGenerator struct {
+	parser interfaces.LatexParser
+}
+
+var (
+	//nolint:revive
+	_ (interfaces.EvaluableExpressionGenerator)    = (*LatexExpressionGenerator)(nil)
+	_ (interfaces.DualVariableExpressionGenerator) = (*LatexExpressionGenerator)(nil)
+)
+
+var ErrUnexpectedVariables = errors.New("expression uses variables other than its declared ones")
+
+func NewLatexExpressionGenerator(parser interfaces.LatexParser) *LatexExpressionGenerator {
+	return &LatexExpressionGenerator{
+		parser: parser,
+	}
+}
+
+func (g *LatexExpressionGenerator) GenerateSingleVariableExpression(
+	ctx context.Context,
+	node *ast.SingleVariableExpressionNode,
+) (expressions.SingleVariableExpr, error) {
+	parsed, err := g.parser.ParseExpression(ctx, node.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := *parsed
+
+	// Evaluate once so unknown variables are reported up front instead of
+	// silently turning every evaluation into NaN
+	_, err = latex.Evaluate(tree, latex.Environment{node.VariableIdentifier: 0})
+	if errors.Is(err, latex.ErrUndefinedVariable) || errors.Is(err, latex.ErrUnsupportedNode) {
+		slog.ErrorContext(
+			ctx,
+			"failed to compile expression",
+			slog.Any("err", err),
+		)
+		return nil, err
+	}
+
+	return func(f float64) float64 {
+		value, err := latex.Evaluate(tree, latex.Environment{node.VariableIdentifier: f})
+		if err != nil {
+			return math.NaN()
+		}
+		return value
+	}, nil
+}
+
+// GenerateDualVariableExpression compiles an expression of the two declared
+// variables. Any other free variable is rejected up front, wherever it is in
+// the tree, as it would make every evaluation NaN.
+func (g *LatexExpressionGenerator) GenerateDualVariableExpression(
+	ctx context.Context,
+	node *ast.DualVariableExpressionNode,
+) (expressions.DualVariableExpr, error) {
+	parsed, err := g.parser.ParseExpression(ctx, node.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := *parsed
+
+	declared := []string{node.FirstVariableIdentifier, node.SecondVariableIdentifier}
+	var unexpected []string
+	for _, variable := range latex.FreeVariables(tree) {
+		if !slices.Contains(declared, variable) {
+			unexpected = append(unexpected, variable)
+		}
+	}
+	if len(unexpected) > 0 {
+		err := fmt.Errorf("%w: %v, expected only %v", ErrUnexpectedVariables, unexpected, declared)
+		slog.ErrorContext(ctx, "failed to compile expression", slog.Any("err", err))
+		return nil, err
+	}
+
+	_, err = latex.Evaluate(tree, latex.Environment{
+		node.FirstVariableIdentifier:  0,
+		node.SecondVariableIdentifier: 0,
+	})
+	if errors.Is(err, latex.ErrUnsupportedNode) {
+		slog.ErrorContext(ctx, "failed to compile expression", slog.Any("err", err))
+		return nil, err
+	}
+
+	return func(first, second float64) float64 {
+		value, err := latex.Evaluate(tree, latex.Environment{
+			node.FirstVariableIdentifier:  first,
+			node.SecondVariableIdentifier: second,
+		})
+		if err != nil {
+			return math.NaN()
+		}
+		return value
+	}, nil
+}
@@ -0,0 +1,151 @@
+package expressions
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrInvalidSampling = errors.New("sampling needs a non-empty range and at least two samples")
+
+// boundaryRefinements is the number of bisections used to locate the edge of
+// the domain between two samples, enough to reach float64 resolution
+const boundaryRefinements = 64
+
+// Interval is a closed range [Left, Right] where an expression is finite.
+type Interval struct {
+	Left  float64
+	Right float64
+}
+
+// DetectDomain probes expr over [left, right] and returns the sub-intervals
+// where it evaluates to finite values, so singular points like x = 0 for 1/x
+// or x ≤ 0 for ln(x) can be avoided when sampling. Edges between a finite and
+// a non-finite sample are located by bisection, and sign changes that grow
+// without bound, i.e. poles falling between samples, split the interval.
+func DetectDomain(expr SingleVariableExpr, left, right float64, samples int) ([]Interval, error) {
+	if samples < 2 || !(left < right) {
+		return nil, ErrInvalidSampling
+	}
+
+	xs := make([]float64, samples)
+	ys := make([]float64, samples)
+	for i := range xs {
+		xs[i] = left + (right-left)*float64(i)/float64(samples-1)
+		ys[i] = expr(xs[i])
+	}
+
+	var intervals []Interval
+	var current *Interval
+
+	for i := range xs {
+		finite := isFinite(ys[i])
+
+		switch {
+		case finite && current == nil:
+			start := xs[i]
+			if i > 0 {
+				start = refineBoundary(expr, xs[i-1], xs[i])
+			}
+			current = &Interval{Left: start, Right: xs[i]}
+		case finite:
+			if poleLeft, poleRight, ok := findPole(expr, xs[i-1], xs[i], ys[i-1], ys[i]); ok {
+				current.Right = poleLeft
+				intervals = append(intervals, *current)
+				current = &Interval{Left: poleRight}
+			}
+			current.Right = xs[i]
+		case current != nil:
+			current.Right = refineBoundary(expr, xs[i-1], xs[i])
+			intervals = append(intervals, *current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		intervals = append(intervals, *current)
+	}
+
+	return intervals, nil
+}
+
+// refineBoundary bisects between a and b, where exactly one of them is finite,
+// returning the last finite point found.
+func refineBoundary(expr SingleVariableExpr, a, b float64) float64 {
+	finite, infinite := a, b
+	if !isFinite(expr(a)) {
+		finite, infinite = b, a
+	}
+
+	for range boundaryRefinements {
+		mid := finite + (infinite-finite)/2
+		if mid == finite || mid == infinite {
+			break
+		}
+
+		if isFinite(expr(mid)) {
+			finite = mid
+		} else {
+			infinite = mid
+		}
+	}
+
+	return finite
+}
+
+// findPole looks for a pole between two finite samples with opposite signs,
+// bisecting on the sign change. A root shrinks |f| while a pole makes it grow
+// past both samples, in which case the finite points closest to the pole on
+// each side are returned.
+func findPole(expr SingleVariableExpr, a, b, fa, fb float64) (float64, float64, bool) {
+	if math.Signbit(fa) == math.Signbit(fb) {
+		return 0, 0, false
+	}
+
+	bound := max(math.Abs(fa), math.Abs(fb))
+
+	for range boundaryRefinements {
+		mid := a + (b-a)/2
+		if mid == a || mid == b {
+			break
+		}
+
+		fm := expr(mid)
+		if !isFinite(fm) {
+			// Landed on the singularity itself
+			return refineBoundary(expr, a, mid), refineBoundary(expr, mid, b), true
+		}
+
+		if math.Signbit(fm) == math.Signbit(fa) {
+			a, fa = mid, fm
+		} else {
+			b, fb = mid, fm
+		}
+	}
+
+	if min(math.Abs(fa), math.Abs(fb)) > bound {
+		return a, b, true
+	}
+
+	return 0, 0, false
+}
+
+// SafeEval evaluates expr at x, reporting false instead of a value when the
+// result is not finite or the evaluation panics, e.g. at a singularity.
+func SafeEval(expr SingleVariableExpr, x float64) (value float64, ok bool) {
+	defer func() {
+		if recover() != nil {
+			value, ok = 0, false
+		}
+	}()
+
+	value = expr(x)
+	if !isFinite(value) {
+		return 0, false
+	}
+
+	return value, true
+}
+
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
@@ -0,0 +1,168 @@
+package expressions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectDomain(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		expr     SingleVariableExpr
+		left     float64
+		right    float64
+		samples  int
+		expected []Interval
+	}{
+		{
+			name:     "1/x with a sample on the pole",
+			expr:     func(x float64) float64 { return 1 / x },
+			left:     -1,
+			right:    1,
+			samples:  101,
+			expected: []Interval{{Left: -1, Right: 0}, {Left: 0, Right: 1}},
+		},
+		{
+			name:     "1/x with the pole between samples",
+			expr:     func(x float64) float64 { return 1 / x },
+			left:     -1,
+			right:    2,
+			samples:  10,
+			expected: []Interval{{Left: -1, Right: 0}, {Left: 0, Right: 2}},
+		},
+		{
+			name:     "ln(x) excludes the non-positive numbers",
+			expr:     math.Log,
+			left:     -2,
+			right:    3,
+			samples:  50,
+			expected: []Interval{{Left: 0, Right: 3}},
+		},
+		{
+			name:     "√(1-x²) is defined on [-1, 1] only",
+			expr:     func(x float64) float64 { return math.Sqrt(1 - x*x) },
+			left:     -3,
+			right:    3,
+			samples:  20,
+			expected: []Interval{{Left: -1, Right: 1}},
+		},
+		{
+			name:     "Roots do not split the domain",
+			expr:     math.Sin,
+			left:     -4,
+			right:    4,
+			samples:  33,
+			expected: []Interval{{Left: -4, Right: 4}},
+		},
+		{
+			name:     "Nowhere defined",
+			expr:     func(x float64) float64 { return math.Log(-1 - x*x) },
+			left:     -1,
+			right:    1,
+			samples:  10,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			intervals, err := DetectDomain(test.expr, test.left, test.right, test.samples)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, intervals, len(test.expected))
+			for i, expected := range test.expected {
+				assert.InDelta(t, expected.Left, intervals[i].Left, 1e-9, "left edge of interval %d", i)
+				assert.InDelta(t, expected.Right, intervals[i].Right, 1e-9, "right edge of interval %d", i)
+				assert.False(t, math.IsInf(test.expr(intervals[i].Left), 0) || math.IsNaN(test.expr(intervals[i].Left)))
+				assert.False(t, math.IsInf(test.expr(intervals[i].Right), 0) || math.IsNaN(test.expr(intervals[i].Right)))
+			}
+		})
+	}
+}
+
+func TestDetectDomainInvalidSampling(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name    string
+		left    float64
+		right   float64
+		samples int
+	}{
+		{name: "Single sample", left: 0, right: 1, samples: 1},
+		{name: "Empty range", left: 1, right: 1, samples: 10},
+		{name: "Reversed range", left: 1, right: 0, samples: 10},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := DetectDomain(math.Exp, test.left, test.right, test.samples)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidSampling)
+		})
+	}
+}
+
+func TestSafeEval(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		expr          SingleVariableExpr
+		x             float64
+		expectedValue float64
+		expectedOk    bool
+	}{
+		{
+			name:          "Finite",
+			expr:          func(x float64) float64 { return 1 / x },
+			x:             2,
+			expectedValue: 0.5,
+			expectedOk:    true,
+		},
+		{
+			name: "Pole",
+			expr: func(x float64) float64 { return 1 / x },
+			x:    0,
+		},
+		{
+			name: "Outside the domain",
+			expr: math.Log,
+			x:    -1,
+		},
+		{
+			name: "Panic",
+			expr: func(float64) float64 { panic("boom") },
+			x:    1,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			value, ok := SafeEval(test.expr, test.x)
+
+			// Assert
+			assert.Equal(t, test.expectedOk, ok)
+			assert.InDelta(t, test.expectedValue, value, 1e-12)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package expressions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+var ErrEvaluationTimeout = errors.New("expression evaluation timed out")
+
+// EvaluationGuard bounds every evaluation of an expression by a timeout, so a
+// pathologically slow expression fails its request instead of hanging it.
+//
+// Each guarded call runs the expression in its own goroutine and waits on a
+// timer, about a microsecond of overhead per evaluation, which is noticeable
+// only for cheap expressions evaluated millions of times. Go can't stop a
+// goroutine, so a timed out evaluation keeps running in the background until
+// it returns. Once an evaluation failed the guarded expression returns NaN
+// without evaluating, letting the numerical method wind down quickly.
+type EvaluationGuard struct {
+	timeout time.Duration
+
+	mu  sync.Mutex
+	err error
+}
+
+func NewEvaluationGuard(timeout time.Duration) *EvaluationGuard {
+	return &EvaluationGuard{timeout: timeout}
+}
+
+// Guard wraps expr so each evaluation gives up after the timeout, or when ctx
+// is done, returning NaN and recording the error reported by Err. A panicking
+// evaluation returns NaN too, like SafeEval.
+func (g *EvaluationGuard) Guard(ctx context.Context, expr SingleVariableExpr) SingleVariableExpr {
+	return func(x float64) float64 {
+		return g.evaluate(ctx, func() float64 { return expr(x) }, func() string {
+			return fmt.Sprintf("x = %g", x)
+		})
+	}
+}
+
+// GuardDual is Guard for an expression of two variables.
+func (g *EvaluationGuard) GuardDual(ctx context.Context, expr DualVariableExpr) DualVariableExpr {
+	return func(x, y float64) float64 {
+		return g.evaluate(ctx, func() float64 { return expr(x, y) }, func() string {
+			return fmt.Sprintf("(%g, %g)", x, y)
+		})
+	}
+}
+
+// evaluate runs eval under the guard, point describing where it was
+// evaluated only when it times out.
+func (g *EvaluationGuard) evaluate(ctx context.Context, eval func() float64, point func() string) float64 {
+	if g.Err() != nil {
+		return math.NaN()
+	}
+
+	// Buffered, so an abandoned evaluation can still send and exit
+	values := make(chan float64, 1)
+	go func() {
+		defer func() {
+			if recover() != nil {
+				values <- math.NaN()
+			}
+		}()
+		values <- eval()
+	}()
+
+	timer := time.NewTimer(g.timeout)
+	defer timer.Stop()
+
+	select {
+	case value := <-values:
+		return value
+	case <-timer.C:
+		g.fail(fmt.Errorf("%w after %s at %s", ErrEvaluationTimeout, g.timeout, point()))
+	case <-ctx.Done():
+		g.fail(ctx.Err())
+	}
+
+	return math.NaN()
+}
+
+// Err returns why an evaluation was abandoned, nil when none was.
+func (g *EvaluationGuard) Err() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.err
+}
+
+// fail records the first error, later ones follow from it.
+func (g *EvaluationGuard) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.err == nil {
+		g.err = err
+	}
+}
@@ -0,0 +1,100 @@
+package expressions
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func slowExpression(delay time.Duration) SingleVariableExpr {
+	return func(x float64) float64 {
+		time.Sleep(delay)
+		return x
+	}
+}
+
+func TestEvaluationGuardPassesFastEvaluations(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	guard := NewEvaluationGuard(time.Second)
+	expr := guard.Guard(context.Background(), func(x float64) float64 { return x * x })
+
+	// Act
+	value := expr(3)
+
+	// Assert
+	assert.InDelta(t, 9.0, value, 0)
+	assert.NoError(t, guard.Err())
+}
+
+func TestEvaluationGuardTimesOutSlowEvaluations(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	guard := NewEvaluationGuard(10 * time.Millisecond)
+	expr := guard.Guard(context.Background(), slowExpression(time.Second))
+
+	// Act
+	start := time.Now()
+	first := expr(1)
+	second := expr(2)
+	elapsed := time.Since(start)
+
+	// Assert
+	assert.True(t, math.IsNaN(first))
+	assert.True(t, math.IsNaN(second), "later evaluations give up right away")
+	assert.Less(t, elapsed, 500*time.Millisecond)
+	require.ErrorIs(t, guard.Err(), ErrEvaluationTimeout)
+	assert.ErrorContains(t, guard.Err(), "x = 1")
+}
+
+func TestEvaluationGuardStopsWithTheContext(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	guard := NewEvaluationGuard(time.Minute)
+	expr := guard.Guard(ctx, slowExpression(time.Second))
+
+	// Act
+	value := expr(1)
+
+	// Assert
+	assert.True(t, math.IsNaN(value))
+	assert.ErrorIs(t, guard.Err(), context.Canceled)
+}
+
+func TestEvaluationGuardRecoversPanics(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	guard := NewEvaluationGuard(time.Second)
+	expr := guard.Guard(context.Background(), func(float64) float64 { panic("boom") })
+
+	// Act
+	value := expr(1)
+
+	// Assert
+	assert.True(t, math.IsNaN(value))
+	assert.NoError(t, guard.Err())
+}
+
+func TestEvaluationGuardTimesOutSlowDualEvaluations(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	guard := NewEvaluationGuard(10 * time.Millisecond)
+	expr := guard.GuardDual(context.Background(), func(x, y float64) float64 {
+		time.Sleep(time.Second)
+		return x * y
+	})
+
+	// Act
+	value := expr(1, 2)
+
+	// Assert
+	assert.True(t, math.IsNaN(value))
+	require.ErrorIs(t, guard.Err(), ErrEvaluationTimeout)
+	assert.ErrorContains(t, guard.Err(), "(1, 2)")
+}
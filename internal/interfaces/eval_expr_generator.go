@@ -13,3 +13,10 @@ type EvaluableExpressionGenerator interface {
 		node *ast.SingleVariableExpressionNode,
 	) (expressions.SingleVariableExpr, error)
 }
+
+type DualVariableExpressionGenerator interface {
+	GenerateDualVariableExpression(
+		ctx context.Context,
+		node *ast.DualVariableExpressionNode,
+	) (expressions.DualVariableExpr, error)
+}
@@ -14,9 +14,14 @@ var (
 	_ ExpressionNode = (*BinaryExpressionNode)(nil)
 	_ ExpressionNode = (*UnaryExpressionNode)(nil)
 	_ ExpressionNode = (*SquareRootExpressionNode)(nil)
+	_ ExpressionNode = (*AbsoluteValueExpressionNode)(nil)
+	_ ExpressionNode = (*FunctionExpressionNode)(nil)
 	_ ExpressionNode = (*NumberExpression)(nil)
 	_ ExpressionNode = (*VariableExpressionNode)(nil)
 	_ ExpressionNode = (*VariableExpressionNode)(nil)
+	_ ExpressionNode = (*ComparisonExpressionNode)(nil)
+	_ ExpressionNode = (*PiecewiseExpressionNode)(nil)
+	_ ExpressionNode = (*LogicalExpressionNode)(nil)
 )
 
 const (
@@ -97,6 +102,44 @@ func (s *SquareRootExpressionNode) String() string {
 // expression implements ExpressionNode.
 func (s *SquareRootExpressionNode) expression() {}
 
+// AbsoluteValueExpressionNode is |SubExpression|, written \left| ... \right|.
+type AbsoluteValueExpressionNode struct {
+	SubExpression ExpressionNode
+}
+
+// String implements ExpressionNode.
+func (a *AbsoluteValueExpressionNode) String() string {
+	return "|" + a.SubExpression.String() + "|"
+}
+
+// expression implements ExpressionNode.
+func (a *AbsoluteValueExpressionNode) expression() {}
+
+type Function string
+
+const (
+	SineFunction        Function = "sin"
+	CosineFunction      Function = "cos"
+	TangentFunction     Function = "tan"
+	ExponentialFunction Function = "exp"
+	LogarithmFunction   Function = "ln"
+)
+
+// FunctionExpressionNode applies an elementary function to Argument, written
+// \sin{...}, \cos{...}, \tan{...}, \exp{...} or \ln{...}.
+type FunctionExpressionNode struct {
+	Function string
+	Argument ExpressionNode
+}
+
+// String implements ExpressionNode.
+func (f *FunctionExpressionNode) String() string {
+	return escapedBackslash + f.Function + "{" + f.Argument.String() + "}"
+}
+
+// expression implements ExpressionNode.
+func (f *FunctionExpressionNode) expression() {}
+
 type NumberExpression struct {
 	Value float64
 }
@@ -120,3 +163,106 @@ func (v *VariableExpressionNode) String() string {
 
 // expression implements ExpressionNode.
 func (v *VariableExpressionNode) expression() {}
+
+type ComparisonOperator string
+
+const (
+	LessOperator         ComparisonOperator = "<"
+	LessEqualOperator    ComparisonOperator = "<="
+	GreaterOperator      ComparisonOperator = ">"
+	GreaterEqualOperator ComparisonOperator = ">="
+	EqualOperator        ComparisonOperator = "="
+)
+
+// ComparisonExpressionNode compares two expressions, evaluating to 1 when the
+// comparison holds and 0 otherwise.
+type ComparisonExpressionNode struct {
+	LHS      ExpressionNode
+	Operator string
+	RHS      ExpressionNode
+}
+
+// String implements ExpressionNode.
+func (c *ComparisonExpressionNode) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(c.LHS.String())
+	out.WriteString(" " + c.Operator + " ")
+	out.WriteString(c.RHS.String())
+	out.WriteString(")")
+
+	return out.String()
+}
+
+// expression implements ExpressionNode.
+func (c *ComparisonExpressionNode) expression() {}
+
+type LogicalOperator string
+
+const (
+	AndOperator LogicalOperator = escapedBackslash + "land"
+	OrOperator  LogicalOperator = escapedBackslash + "lor"
+)
+
+// LogicalExpressionNode combines two boolean expressions, evaluating to 1
+// when the combination holds and 0 otherwise.
+type LogicalExpressionNode struct {
+	LHS      ExpressionNode
+	Operator string
+	RHS      ExpressionNode
+}
+
+// String implements ExpressionNode.
+func (l *LogicalExpressionNode) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(l.LHS.String())
+	out.WriteString(" " + l.Operator + " ")
+	out.WriteString(l.RHS.String())
+	out.WriteString(")")
+
+	return out.String()
+}
+
+// expression implements ExpressionNode.
+func (l *LogicalExpressionNode) expression() {}
+
+// PiecewiseCase is a single branch of a piecewise function. A nil Condition
+// means the branch is the "otherwise" fallback.
+type PiecewiseCase struct {
+	Value     ExpressionNode
+	Condition ExpressionNode
+}
+
+// PiecewiseExpressionNode evaluates to the value of the first case whose
+// condition holds, mirroring LaTeX's cases environment.
+type PiecewiseExpressionNode struct {
+	Cases []PiecewiseCase
+}
+
+// String implements ExpressionNode.
+func (p *PiecewiseExpressionNode) String() string {
+	var out bytes.Buffer
+
+	out.WriteString(escapedBackslash + "begin{cases}")
+	for i, c := range p.Cases {
+		if i > 0 {
+			out.WriteString(" " + escapedBackslash + escapedBackslash)
+		}
+
+		out.WriteString(" " + c.Value.String() + " & ")
+		if c.Condition == nil {
+			out.WriteString(escapedBackslash + "text{otherwise}")
+		} else {
+			out.WriteString(c.Condition.String())
+		}
+	}
+	out.WriteString(" " + escapedBackslash + "end{cases}")
+
+	return out.String()
+}
+
+// expression implements ExpressionNode.
+func (p *PiecewiseExpressionNode) expression() {}
@@ -0,0 +1,403 @@
+package latex
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrNotDifferentiable = errors.New("expression is not differentiable")
+
+// Differentiate returns the symbolic derivative of node with respect to
+// variable, simplified so vanishing terms and constant sub-expressions fold
+// away.
+func Differentiate(node ExpressionNode, variable string) (ExpressionNode, error) {
+	derivative, err := differentiate(node, variable)
+	if err != nil {
+		return nil, err
+	}
+
+	return Simplify(derivative), nil
+}
+
+func differentiate(node ExpressionNode, variable string) (ExpressionNode, error) {
+	switch n := node.(type) {
+	case *NumberExpression:
+		return number(0), nil
+	case *VariableExpressionNode:
+		if n.Identifier == variable {
+			return number(1), nil
+		}
+		return number(0), nil
+	case *UnaryExpressionNode:
+		derivative, err := differentiate(n.SubExpression, variable)
+		if err != nil {
+			return nil, err
+		}
+		return &UnaryExpressionNode{Operator: n.Operator, SubExpression: derivative}, nil
+	case *BinaryExpressionNode:
+		return differentiateBinary(n, variable)
+	case *SquareRootExpressionNode:
+		return differentiateSquareRoot(n, variable)
+	case *AbsoluteValueExpressionNode:
+		return differentiateAbsoluteValue(n, variable)
+	case *FunctionExpressionNode:
+		return differentiateFunction(n, variable)
+	case *PiecewiseExpressionNode:
+		return differentiatePiecewise(n, variable)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrNotDifferentiable, node)
+	}
+}
+
+func differentiateBinary(n *BinaryExpressionNode, variable string) (ExpressionNode, error) {
+	lhs, err := differentiate(n.LHS, variable)
+	if err != nil {
+		return nil, err
+	}
+
+	rhs, err := differentiate(n.RHS, variable)
+	if err != nil {
+		return nil, err
+	}
+
+	switch Operator(n.Operator) {
+	case PlusOperator, MinusOperator:
+		return binary(lhs, Operator(n.Operator), rhs), nil
+	case MulOperator:
+		// (uv)' = u'v + uv'
+		return binary(
+			binary(lhs, MulOperator, n.RHS),
+			PlusOperator,
+			binary(n.LHS, MulOperator, rhs),
+		), nil
+	case DivOperator:
+		if !dependsOn(n.RHS, variable) {
+			return binary(lhs, DivOperator, n.RHS), nil
+		}
+
+		// (u/v)' = (u'v - uv') / v²
+		return binary(
+			binary(
+				binary(lhs, MulOperator, n.RHS),
+				MinusOperator,
+				binary(n.LHS, MulOperator, rhs),
+			),
+			DivOperator,
+			binary(n.RHS, PowerOperator, number(2)),
+		), nil
+	case PowerOperator:
+		return differentiatePower(n, lhs, rhs, variable)
+	default:
+		return nil, fmt.Errorf("%w: binary %q", ErrUnknownOperator, n.Operator)
+	}
+}
+
+// differentiatePower handles u^c with a constant exponent and a^v with a
+// positive constant base. A variable raised to a variable needs a logarithm,
+// which the tree cannot represent.
+func differentiatePower(
+	n *BinaryExpressionNode,
+	lhs, rhs ExpressionNode,
+	variable string,
+) (ExpressionNode, error) {
+	if !dependsOn(n.RHS, variable) {
+		// (u^c)' = c u^(c-1) u'
+		return binary(
+			binary(n.RHS, MulOperator, binary(n.LHS, PowerOperator, binary(n.RHS, MinusOperator, number(1)))),
+			MulOperator,
+			lhs,
+		), nil
+	}
+
+	base, err := Evaluate(n.LHS, Environment{})
+	if err != nil || base <= 0 {
+		return nil, fmt.Errorf("%w: %s raised to a variable exponent", ErrNotDifferentiable, n.LHS)
+	}
+
+	// (a^v)' = a^v ln(a) v'
+	return binary(binary(n, MulOperator, number(math.Log(base))), MulOperator, rhs), nil
+}
+
+// differentiateSquareRoot treats the n-th root of u as u^(1/n), writing the
+// derivative back with roots: u' / (n (ⁿ√u)^(n-1)).
+func differentiateSquareRoot(n *SquareRootExpressionNode, variable string) (ExpressionNode, error) {
+	if dependsOn(n.Index, variable) {
+		return nil, fmt.Errorf("%w: root index depends on %s", ErrNotDifferentiable, variable)
+	}
+
+	radicand, err := differentiate(n.Radicand, variable)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary(
+		radicand,
+		DivOperator,
+		binary(n.Index, MulOperator, binary(n, PowerOperator, binary(n.Index, MinusOperator, number(1)))),
+	), nil
+}
+
+// differentiateAbsoluteValue writes |u|' as u u' / |u|, the sign of u times
+// u', undefined where u vanishes.
+func differentiateAbsoluteValue(n *AbsoluteValueExpressionNode, variable string) (ExpressionNode, error) {
+	sub, err := differentiate(n.SubExpression, variable)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary(binary(n.SubExpression, MulOperator, sub), DivOperator, n), nil
+}
+
+// differentiateFunction applies the chain rule, f(u)' = f'(u) u'.
+func differentiateFunction(n *FunctionExpressionNode, variable string) (ExpressionNode, error) {
+	argument, err := differentiate(n.Argument, variable)
+	if err != nil {
+		return nil, err
+	}
+
+	var outer ExpressionNode
+	switch Function(n.Function) {
+	case SineFunction:
+		outer = function(CosineFunction, n.Argument)
+	case CosineFunction:
+		outer = &UnaryExpressionNode{Operator: string(MinusOperator), SubExpression: function(SineFunction, n.Argument)}
+	case TangentFunction:
+		outer = binary(number(1), DivOperator, binary(function(CosineFunction, n.Argument), PowerOperator, number(2)))
+	case ExponentialFunction:
+		outer = n
+	case LogarithmFunction:
+		outer = binary(number(1), DivOperator, n.Argument)
+	default:
+		return nil, fmt.Errorf("%w: function %q", ErrUnknownFunction, n.Function)
+	}
+
+	return binary(outer, MulOperator, argument), nil
+}
+
+// differentiatePiecewise differentiates every branch, keeping the conditions.
+// The result is only meaningful away from the branch boundaries.
+func differentiatePiecewise(n *PiecewiseExpressionNode, variable string) (ExpressionNode, error) {
+	cases := make([]PiecewiseCase, 0, len(n.Cases))
+
+	for _, c := range n.Cases {
+		value, err := differentiate(c.Value, variable)
+		if err != nil {
+			return nil, err
+		}
+
+		cases = append(cases, PiecewiseCase{Value: value, Condition: c.Condition})
+	}
+
+	return &PiecewiseExpressionNode{Cases: cases}, nil
+}
+
+// dependsOn reports whether variable appears anywhere in node.
+func dependsOn(node ExpressionNode, variable string) bool {
+	switch n := node.(type) {
+	case *VariableExpressionNode:
+		return n.Identifier == variable
+	case *UnaryExpressionNode:
+		return dependsOn(n.SubExpression, variable)
+	case *BinaryExpressionNode:
+		return dependsOn(n.LHS, variable) || dependsOn(n.RHS, variable)
+	case *SquareRootExpressionNode:
+		return dependsOn(n.Index, variable) || dependsOn(n.Radicand, variable)
+	case *AbsoluteValueExpressionNode:
+		return dependsOn(n.SubExpression, variable)
+	case *FunctionExpressionNode:
+		return dependsOn(n.Argument, variable)
+	case *ComparisonExpressionNode:
+		return dependsOn(n.LHS, variable) || dependsOn(n.RHS, variable)
+	case *LogicalExpressionNode:
+		return dependsOn(n.LHS, variable) || dependsOn(n.RHS, variable)
+	case *PiecewiseExpressionNode:
+		for _, c := range n.Cases {
+			if dependsOn(c.Value, variable) || (c.Condition != nil && dependsOn(c.Condition, variable)) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// Simplify folds constant sub-expressions and removes identities such as
+// x + 0, 1x and x^1, leaving the tree otherwise untouched.
+func Simplify(node ExpressionNode) ExpressionNode {
+	switch n := node.(type) {
+	case *UnaryExpressionNode:
+		return simplifyUnary(n)
+	case *BinaryExpressionNode:
+		return simplifyBinary(n)
+	case *SquareRootExpressionNode:
+		root := &SquareRootExpressionNode{Index: Simplify(n.Index), Radicand: Simplify(n.Radicand)}
+		if isNumber(root.Index) && isNumber(root.Radicand) {
+			if value, err := Evaluate(root, Environment{}); err == nil {
+				return number(value)
+			}
+		}
+		return root
+	case *AbsoluteValueExpressionNode:
+		sub := Simplify(n.SubExpression)
+		if constant, ok := sub.(*NumberExpression); ok {
+			return number(math.Abs(constant.Value))
+		}
+		return &AbsoluteValueExpressionNode{SubExpression: sub}
+	case *FunctionExpressionNode:
+		applied := function(Function(n.Function), Simplify(n.Argument))
+		if isNumber(applied.Argument) {
+			if value, err := Evaluate(applied, Environment{}); err == nil {
+				return number(value)
+			}
+		}
+		return applied
+	case *PiecewiseExpressionNode:
+		cases := make([]PiecewiseCase, 0, len(n.Cases))
+		for _, c := range n.Cases {
+			simplified := PiecewiseCase{Value: Simplify(c.Value), Condition: c.Condition}
+			if c.Condition != nil {
+				simplified.Condition = Simplify(c.Condition)
+			}
+			cases = append(cases, simplified)
+		}
+		return &PiecewiseExpressionNode{Cases: cases}
+	default:
+		return node
+	}
+}
+
+func simplifyUnary(n *UnaryExpressionNode) ExpressionNode {
+	sub := Simplify(n.SubExpression)
+
+	if Operator(n.Operator) != MinusOperator {
+		return sub
+	}
+
+	switch s := sub.(type) {
+	case *NumberExpression:
+		return number(-s.Value)
+	case *UnaryExpressionNode:
+		if Operator(s.Operator) == MinusOperator {
+			return s.SubExpression
+		}
+	}
+
+	return &UnaryExpressionNode{Operator: n.Operator, SubExpression: sub}
+}
+
+func simplifyBinary(n *BinaryExpressionNode) ExpressionNode {
+	lhs, rhs := Simplify(n.LHS), Simplify(n.RHS)
+	operator := Operator(n.Operator)
+
+	if isNumber(lhs) && isNumber(rhs) {
+		if value, err := Evaluate(binary(lhs, operator, rhs), Environment{}); err == nil {
+			return number(value)
+		}
+	}
+
+	switch operator {
+	case PlusOperator:
+		switch {
+		case isConstant(lhs, 0):
+			return rhs
+		case isConstant(rhs, 0):
+			return lhs
+		case isNegation(rhs):
+			return binary(lhs, MinusOperator, rhs.(*UnaryExpressionNode).SubExpression)
+		case isNegativeNumber(rhs):
+			return binary(lhs, MinusOperator, number(-rhs.(*NumberExpression).Value))
+		}
+	case MinusOperator:
+		switch {
+		case isConstant(rhs, 0):
+			return lhs
+		case isConstant(lhs, 0):
+			return simplifyUnary(&UnaryExpressionNode{Operator: string(MinusOperator), SubExpression: rhs})
+		case isNegation(rhs):
+			return binary(lhs, PlusOperator, rhs.(*UnaryExpressionNode).SubExpression)
+		case isNegativeNumber(rhs):
+			return binary(lhs, PlusOperator, number(-rhs.(*NumberExpression).Value))
+		}
+	case MulOperator:
+		return simplifyProduct(lhs, rhs)
+	case DivOperator:
+		switch {
+		case isConstant(lhs, 0):
+			return number(0)
+		case isConstant(rhs, 1):
+			return lhs
+		}
+	case PowerOperator:
+		switch {
+		case isConstant(rhs, 0):
+			return number(1)
+		case isConstant(rhs, 1):
+			return lhs
+		}
+	}
+
+	return binary(lhs, operator, rhs)
+}
+
+// simplifyProduct drops unit and zero factors and moves constant factors to
+// the left, merging them, so products read as 4x^3 instead of x^3 * 2 * 2.
+func simplifyProduct(lhs, rhs ExpressionNode) ExpressionNode {
+	if isNumber(rhs) && !isNumber(lhs) {
+		lhs, rhs = rhs, lhs
+	}
+
+	switch {
+	case isConstant(lhs, 0), isConstant(rhs, 0):
+		return number(0)
+	case isConstant(lhs, 1):
+		return rhs
+	case isConstant(rhs, 1):
+		return lhs
+	case isConstant(lhs, -1):
+		return simplifyUnary(&UnaryExpressionNode{Operator: string(MinusOperator), SubExpression: rhs})
+	}
+
+	if constant, ok := lhs.(*NumberExpression); ok {
+		if product, ok := rhs.(*BinaryExpressionNode); ok && Operator(product.Operator) == MulOperator {
+			if inner, ok := product.LHS.(*NumberExpression); ok {
+				return simplifyProduct(number(constant.Value*inner.Value), product.RHS)
+			}
+		}
+	}
+
+	return binary(lhs, MulOperator, rhs)
+}
+
+func binary(lhs ExpressionNode, operator Operator, rhs ExpressionNode) *BinaryExpressionNode {
+	return &BinaryExpressionNode{LHS: lhs, Operator: string(operator), RHS: rhs}
+}
+
+func function(f Function, argument ExpressionNode) *FunctionExpressionNode {
+	return &FunctionExpressionNode{Function: string(f), Argument: argument}
+}
+
+func number(value float64) *NumberExpression {
+	return &NumberExpression{Value: value}
+}
+
+func isNumber(node ExpressionNode) bool {
+	_, ok := node.(*NumberExpression)
+	return ok
+}
+
+func isConstant(node ExpressionNode, value float64) bool {
+	n, ok := node.(*NumberExpression)
+	return ok && n.Value == value
+}
+
+func isNegation(node ExpressionNode) bool {
+	n, ok := node.(*UnaryExpressionNode)
+	return ok && Operator(n.Operator) == MinusOperator
+}
+
+func isNegativeNumber(node ExpressionNode) bool {
+	n, ok := node.(*NumberExpression)
+	return ok && n.Value < 0
+}
@@ -0,0 +1,224 @@
+package latex
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func x() ExpressionNode {
+	return &VariableExpressionNode{Identifier: "x"}
+}
+
+func TestDifferentiate(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name      string
+		node      ExpressionNode
+		expected  string
+		reference func(float64) float64
+	}{
+		{
+			name:      "Constant",
+			node:      number(42),
+			expected:  "0",
+			reference: func(float64) float64 { return 0 },
+		},
+		{
+			name: "Polynomial",
+			// x^4 - 2x^2 + 5x - 1
+			node: binary(
+				binary(
+					binary(binary(x(), PowerOperator, number(4)), MinusOperator, binary(number(2), MulOperator, binary(x(), PowerOperator, number(2)))),
+					PlusOperator,
+					binary(number(5), MulOperator, x()),
+				),
+				MinusOperator,
+				number(1),
+			),
+			expected:  "4x^{3} - 4x + 5",
+			reference: func(v float64) float64 { return 4*v*v*v - 4*v + 5 },
+		},
+		{
+			name:      "Exponential",
+			node:      binary(number(math.E), PowerOperator, binary(number(3), MulOperator, x())),
+			expected:  "3e^{3x}",
+			reference: func(v float64) float64 { return 3 * math.Exp(3*v) },
+		},
+		{
+			name:      "Quotient",
+			node:      binary(number(1), DivOperator, x()),
+			expected:  `\frac{-1}{x^{2}}`,
+			reference: func(v float64) float64 { return -1 / (v * v) },
+		},
+		{
+			name:      "Sine chain rule",
+			node:      function(SineFunction, binary(number(2), MulOperator, x())),
+			expected:  `2\cos\left(2x\right)`,
+			reference: func(v float64) float64 { return 2 * math.Cos(2*v) },
+		},
+		{
+			name:      "Logarithm",
+			node:      function(LogarithmFunction, x()),
+			expected:  `\frac{1}{x}`,
+			reference: func(v float64) float64 { return 1 / v },
+		},
+		{
+			name:      "Square root",
+			node:      &SquareRootExpressionNode{Index: number(2), Radicand: x()},
+			expected:  `\frac{1}{2\sqrt{x}}`,
+			reference: func(v float64) float64 { return 0.5 / math.Sqrt(v) },
+		},
+		{
+			name:      "Absolute value",
+			node:      &AbsoluteValueExpressionNode{SubExpression: binary(x(), MinusOperator, number(3))},
+			expected:  `\frac{x - 3}{\left|x - 3\right|}`,
+			reference: func(float64) float64 { return -1 },
+		},
+		{
+			name:      "Negated product",
+			node:      &UnaryExpressionNode{Operator: "-", SubExpression: binary(x(), MulOperator, binary(x(), PlusOperator, number(1)))},
+			expected:  `-\left(x + 1 + x\right)`,
+			reference: func(v float64) float64 { return -(2*v + 1) },
+		},
+		{
+			name:      "Other variables are constants",
+			node:      binary(&VariableExpressionNode{Identifier: "y"}, MulOperator, x()),
+			expected:  "y",
+			reference: func(float64) float64 { return 2 },
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			derivative, err := Differentiate(test.node, "x")
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, Format(derivative))
+			for _, point := range []float64{0.5, 1, 2} {
+				value, err := Evaluate(derivative, Environment{"x": point, "y": 2})
+				require.NoError(t, err)
+				assert.InDelta(t, test.reference(point), value, 1e-9, "at x = %v", point)
+			}
+		})
+	}
+}
+
+func TestDifferentiatePiecewiseKeepsConditions(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	// |x| written as cases
+	absolute := &PiecewiseExpressionNode{
+		Cases: []PiecewiseCase{
+			{
+				Value:     x(),
+				Condition: &ComparisonExpressionNode{LHS: x(), Operator: string(GreaterEqualOperator), RHS: number(0)},
+			},
+			{Value: &UnaryExpressionNode{Operator: "-", SubExpression: x()}},
+		},
+	}
+
+	// Act
+	derivative, err := Differentiate(absolute, "x")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `\begin{cases} 1 & x \geq 0 \\ -1 & \text{otherwise} \end{cases}`, Format(derivative))
+}
+
+func TestDifferentiateUnsupported(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name string
+		node ExpressionNode
+	}{
+		{
+			name: "Variable base and exponent",
+			node: binary(x(), PowerOperator, x()),
+		},
+		{
+			name: "Negative base with variable exponent",
+			node: binary(number(-2), PowerOperator, x()),
+		},
+		{
+			name: "Comparison",
+			node: &ComparisonExpressionNode{LHS: x(), Operator: string(LessOperator), RHS: number(1)},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := Differentiate(test.node, "x")
+
+			// Assert
+			assert.ErrorIs(t, err, ErrNotDifferentiable)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		node     ExpressionNode
+		expected string
+	}{
+		{
+			name:     "Subtraction keeps grouping",
+			node:     binary(x(), MinusOperator, binary(x(), PlusOperator, number(1))),
+			expected: `x - \left(x + 1\right)`,
+		},
+		{
+			name:     "Power of a sum",
+			node:     binary(binary(x(), PlusOperator, number(1)), PowerOperator, number(2)),
+			expected: `\left(x + 1\right)^{2}`,
+		},
+		{
+			name:     "Product of numbers",
+			node:     binary(number(2), MulOperator, number(3)),
+			expected: `2 \cdot 3`,
+		},
+		{
+			name:     "Cube root",
+			node:     &SquareRootExpressionNode{Index: number(3), Radicand: x()},
+			expected: `\sqrt[3]{x}`,
+		},
+		{
+			name:     "Absolute value",
+			node:     binary(&AbsoluteValueExpressionNode{SubExpression: binary(x(), PlusOperator, number(1))}, PowerOperator, number(2)),
+			expected: `\left|x + 1\right|^{2}`,
+		},
+		{
+			name:     "Constants",
+			node:     binary(number(math.Pi), MulOperator, x()),
+			expected: `\pi x`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			formatted := Format(test.node)
+
+			// Assert
+			assert.Equal(t, test.expected, formatted)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package latex
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrUndefinedVariable = errors.New("undefined variable")
+	ErrUnknownOperator   = errors.New("unknown operator")
+	ErrNoMatchingCase    = errors.New("no piecewise case matches")
+	ErrUnsupportedNode   = errors.New("unsupported expression node")
+	ErrUnknownFunction   = errors.New("unknown function")
+)
+
+// Environment binds variable identifiers to their values during evaluation.
+type Environment map[string]float64
+
+// Evaluate walks the expression tree computing its numeric value, looking up
+// variables in env.
+func Evaluate(node ExpressionNode, env Environment) (float64, error) {
+	switch n := node.(type) {
+	case *NumberExpression:
+		return n.Value, nil
+	case *VariableExpressionNode:
+		value, ok := env[n.Identifier]
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", ErrUndefinedVariable, n.Identifier)
+		}
+		return value, nil
+	case *UnaryExpressionNode:
+		return evaluateUnary(n, env)
+	case *BinaryExpressionNode:
+		return evaluateBinary(n, env)
+	case *SquareRootExpressionNode:
+		return evaluateSquareRoot(n, env)
+	case *AbsoluteValueExpressionNode:
+		value, err := Evaluate(n.SubExpression, env)
+		if err != nil {
+			return 0, err
+		}
+		return math.Abs(value), nil
+	case *FunctionExpressionNode:
+		return evaluateFunction(n, env)
+	case *PiecewiseExpressionNode:
+		return evaluatePiecewise(n, env)
+	case *ComparisonExpressionNode, *LogicalExpressionNode:
+		holds, err := EvaluateBool(node, env)
+		if err != nil {
+			return 0, err
+		}
+		return boolToFloat(holds), nil
+	default:
+		return 0, fmt.Errorf("%w: %T", ErrUnsupportedNode, node)
+	}
+}
+
+func evaluateUnary(n *UnaryExpressionNode, env Environment) (float64, error) {
+	value, err := Evaluate(n.SubExpression, env)
+	if err != nil {
+		return 0, err
+	}
+
+	switch Operator(n.Operator) {
+	case PlusOperator:
+		return value, nil
+	case MinusOperator:
+		return -value, nil
+	default:
+		return 0, fmt.Errorf("%w: unary %q", ErrUnknownOperator, n.Operator)
+	}
+}
+
+func evaluateBinary(n *BinaryExpressionNode, env Environment) (float64, error) {
+	lhs, err := Evaluate(n.LHS, env)
+	if err != nil {
+		return 0, err
+	}
+
+	rhs, err := Evaluate(n.RHS, env)
+	if err != nil {
+		return 0, err
+	}
+
+	switch Operator(n.Operator) {
+	case PlusOperator:
+		return lhs + rhs, nil
+	case MinusOperator:
+		return lhs - rhs, nil
+	case MulOperator:
+		return lhs * rhs, nil
+	case DivOperator:
+		return lhs / rhs, nil
+	case PowerOperator:
+		return math.Pow(lhs, rhs), nil
+	default:
+		return 0, fmt.Errorf("%w: binary %q", ErrUnknownOperator, n.Operator)
+	}
+}
+
+func evaluateSquareRoot(n *SquareRootExpressionNode, env Environment) (float64, error) {
+	index, err := Evaluate(n.Index, env)
+	if err != nil {
+		return 0, err
+	}
+
+	radicand, err := Evaluate(n.Radicand, env)
+	if err != nil {
+		return 0, err
+	}
+
+	if index == 2 {
+		return math.Sqrt(radicand), nil
+	}
+
+	// Odd roots of negative numbers are real, math.Pow would return NaN
+	if radicand < 0 && math.Mod(index, 2) == 1 {
+		return -math.Pow(-radicand, 1/index), nil
+	}
+
+	return math.Pow(radicand, 1/index), nil
+}
+
+func evaluateFunction(n *FunctionExpressionNode, env Environment) (float64, error) {
+	argument, err := Evaluate(n.Argument, env)
+	if err != nil {
+		return 0, err
+	}
+
+	switch Function(n.Function) {
+	case SineFunction:
+		return math.Sin(argument), nil
+	case CosineFunction:
+		return math.Cos(argument), nil
+	case TangentFunction:
+		return math.Tan(argument), nil
+	case ExponentialFunction:
+		return math.Exp(argument), nil
+	case LogarithmFunction:
+		return math.Log(argument), nil
+	default:
+		return 0, fmt.Errorf("%w: function %q", ErrUnknownFunction, n.Function)
+	}
+}
+
+func evaluatePiecewise(n *PiecewiseExpressionNode, env Environment) (float64, error) {
+	for _, c := range n.Cases {
+		if c.Condition != nil {
+			holds, err := EvaluateBool(c.Condition, env)
+			if err != nil {
+				return 0, err
+			}
+
+			if !holds {
+				continue
+			}
+		}
+
+		return Evaluate(c.Value, env)
+	}
+
+	return 0, ErrNoMatchingCase
+}
+
+// EvaluateBool evaluates node as a predicate. Comparisons and logical
+// operators are evaluated directly, any other expression holds when its value
+// is non-zero.
+func EvaluateBool(node ExpressionNode, env Environment) (bool, error) {
+	switch n := node.(type) {
+	case *ComparisonExpressionNode:
+		return evaluateComparison(n, env)
+	case *LogicalExpressionNode:
+		return evaluateLogical(n, env)
+	default:
+		value, err := Evaluate(node, env)
+		if err != nil {
+			return false, err
+		}
+		return value != 0, nil
+	}
+}
+
+func evaluateComparison(n *ComparisonExpressionNode, env Environment) (bool, error) {
+	lhs, err := Evaluate(n.LHS, env)
+	if err != nil {
+		return false, err
+	}
+
+	rhs, err := Evaluate(n.RHS, env)
+	if err != nil {
+		return false, err
+	}
+
+	switch ComparisonOperator(n.Operator) {
+	case LessOperator:
+		return lhs < rhs, nil
+	case LessEqualOperator:
+		return lhs <= rhs, nil
+	case GreaterOperator:
+		return lhs > rhs, nil
+	case GreaterEqualOperator:
+		return lhs >= rhs, nil
+	case EqualOperator:
+		return lhs == rhs, nil
+	default:
+		return false, fmt.Errorf("%w: comparison %q", ErrUnknownOperator, n.Operator)
+	}
+}
+
+func evaluateLogical(n *LogicalExpressionNode, env Environment) (bool, error) {
+	lhs, err := EvaluateBool(n.LHS, env)
+	if err != nil {
+		return false, err
+	}
+
+	// Short-circuit so the right side may rely on the left one, e.g. guarding
+	// against a division by zero
+	switch LogicalOperator(n.Operator) {
+	case AndOperator:
+		if !lhs {
+			return false, nil
+		}
+	case OrOperator:
+		if lhs {
+			return true, nil
+		}
+	default:
+		return false, fmt.Errorf("%w: logical %q", ErrUnknownOperator, n.Operator)
+	}
+
+	return EvaluateBool(n.RHS, env)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
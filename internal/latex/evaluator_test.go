@@ -0,0 +1,145 @@
+package latex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluatePiecewise(t *testing.T) {
+	t.Parallel()
+
+	// Indicator of the unit interval, the kind of integrand used by the
+	// double integral tests
+	indicator := &PiecewiseExpressionNode{
+		Cases: []PiecewiseCase{
+			{
+				Value: &NumberExpression{Value: 1},
+				Condition: &ComparisonExpressionNode{
+					LHS:      &VariableExpressionNode{Identifier: "x"},
+					Operator: string(LessEqualOperator),
+					RHS:      &NumberExpression{Value: 1},
+				},
+			},
+			{
+				Value: &NumberExpression{Value: 0},
+			},
+		},
+	}
+
+	tt := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{name: "Inside", x: 0.5, expected: 1},
+		{name: "Boundary", x: 1, expected: 1},
+		{name: "Outside", x: 1.5, expected: 0},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := Evaluate(indicator, Environment{"x": test.x})
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, result, 1e-12)
+		})
+	}
+}
+
+func TestEvaluatePiecewiseWithoutMatchingCase(t *testing.T) {
+	t.Parallel()
+
+	node := &PiecewiseExpressionNode{
+		Cases: []PiecewiseCase{
+			{
+				Value: &NumberExpression{Value: 1},
+				Condition: &ComparisonExpressionNode{
+					LHS:      &VariableExpressionNode{Identifier: "x"},
+					Operator: string(LessOperator),
+					RHS:      &NumberExpression{Value: 0},
+				},
+			},
+		},
+	}
+
+	_, err := Evaluate(node, Environment{"x": 1})
+	assert.ErrorIs(t, err, ErrNoMatchingCase)
+}
+
+func TestEvaluateUndefinedVariable(t *testing.T) {
+	t.Parallel()
+
+	_, err := Evaluate(&VariableExpressionNode{Identifier: "y"}, Environment{"x": 1})
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+}
+
+func TestEvaluateLogicalShortCircuits(t *testing.T) {
+	t.Parallel()
+
+	// y is undefined, so evaluating the right side would fail
+	node := &LogicalExpressionNode{
+		LHS: &ComparisonExpressionNode{
+			LHS:      &VariableExpressionNode{Identifier: "x"},
+			Operator: string(LessOperator),
+			RHS:      &NumberExpression{Value: 0},
+		},
+		Operator: string(AndOperator),
+		RHS:      &VariableExpressionNode{Identifier: "y"},
+	}
+
+	holds, err := EvaluateBool(node, Environment{"x": 1})
+	require.NoError(t, err)
+	assert.False(t, holds)
+
+	_, err = EvaluateBool(node, Environment{"x": -1})
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+}
+
+func TestEvaluateChainedUnary(t *testing.T) {
+	t.Parallel()
+
+	negate := func(node ExpressionNode) ExpressionNode {
+		return &UnaryExpressionNode{Operator: string(MinusOperator), SubExpression: node}
+	}
+	plus := func(node ExpressionNode) ExpressionNode {
+		return &UnaryExpressionNode{Operator: string(PlusOperator), SubExpression: node}
+	}
+
+	tt := []struct {
+		name     string
+		node     ExpressionNode
+		expected float64
+	}{
+		{name: "Double negation", node: negate(negate(x())), expected: 3},
+		{name: "Triple negation", node: negate(negate(negate(x()))), expected: -3},
+		{name: "Unary plus", node: plus(x()), expected: 3},
+		{name: "Negated unary plus", node: negate(plus(x())), expected: -3},
+		{name: "Unary plus of a negation", node: plus(negate(x())), expected: -3},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			value, err := Evaluate(test.node, Environment{"x": 3})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+			assert.Equal(t, test.expected, mustEvaluate(t, Simplify(test.node)))
+		})
+	}
+}
+
+func mustEvaluate(t *testing.T, node ExpressionNode) float64 {
+	t.Helper()
+
+	value, err := Evaluate(node, Environment{"x": 3})
+	require.NoError(t, err)
+	return value
+}
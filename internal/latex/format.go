@@ -0,0 +1,159 @@
+package latex
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Binding strength of each kind of node, used to decide where Format needs
+// parentheses.
+const (
+	logicalPrecedence = iota
+	comparisonPrecedence
+	additivePrecedence
+	multiplicativePrecedence
+	unaryPrecedence
+	powerPrecedence
+	atomPrecedence
+)
+
+// Format pretty-prints node as LaTeX, only adding the parentheses needed to
+// keep its structure, unlike String which brackets every operation.
+func Format(node ExpressionNode) string {
+	formatted, _ := format(node)
+	return formatted
+}
+
+func format(node ExpressionNode) (string, int) {
+	switch n := node.(type) {
+	case *NumberExpression:
+		return formatNumber(n.Value)
+	case *VariableExpressionNode:
+		return n.Identifier, atomPrecedence
+	case *UnaryExpressionNode:
+		return n.Operator + wrap(n.SubExpression, unaryPrecedence), unaryPrecedence
+	case *BinaryExpressionNode:
+		return formatBinary(n)
+	case *SquareRootExpressionNode:
+		radicand, _ := format(n.Radicand)
+		if isConstant(n.Index, 2) {
+			return escapedBackslash + "sqrt{" + radicand + "}", atomPrecedence
+		}
+		index, _ := format(n.Index)
+		return escapedBackslash + "sqrt[" + index + "]{" + radicand + "}", atomPrecedence
+	case *AbsoluteValueExpressionNode:
+		sub, _ := format(n.SubExpression)
+		return escapedBackslash + "left|" + sub + escapedBackslash + "right|", atomPrecedence
+	case *FunctionExpressionNode:
+		argument, _ := format(n.Argument)
+		return escapedBackslash + n.Function + escapedBackslash + "left(" + argument + escapedBackslash + "right)", atomPrecedence
+	case *ComparisonExpressionNode:
+		return wrap(n.LHS, additivePrecedence) + " " + comparisonSymbol(n.Operator) + " " +
+			wrap(n.RHS, additivePrecedence), comparisonPrecedence
+	case *LogicalExpressionNode:
+		return wrap(n.LHS, comparisonPrecedence) + " " + n.Operator + " " +
+			wrap(n.RHS, comparisonPrecedence), logicalPrecedence
+	case *PiecewiseExpressionNode:
+		return formatPiecewise(n), atomPrecedence
+	default:
+		return node.String(), atomPrecedence
+	}
+}
+
+func formatBinary(n *BinaryExpressionNode) (string, int) {
+	switch Operator(n.Operator) {
+	case PlusOperator:
+		return wrap(n.LHS, additivePrecedence) + " + " + wrap(n.RHS, additivePrecedence), additivePrecedence
+	case MinusOperator:
+		// a - (b + c) must keep its parentheses
+		return wrap(n.LHS, additivePrecedence) + " - " + wrap(n.RHS, multiplicativePrecedence), additivePrecedence
+	case MulOperator:
+		lhs := wrap(n.LHS, multiplicativePrecedence)
+		rhs := wrap(n.RHS, powerPrecedence)
+		// Juxtapose a leading coefficient, 4x^{3} instead of 4 \cdot x^{3}
+		if isNumber(n.LHS) && !startsWithDigit(rhs) {
+			if endsWithLetter(lhs) {
+				return lhs + " " + rhs, multiplicativePrecedence
+			}
+			return lhs + rhs, multiplicativePrecedence
+		}
+		return lhs + " " + escapedBackslash + "cdot " + rhs, multiplicativePrecedence
+	case DivOperator:
+		lhs, _ := format(n.LHS)
+		rhs, _ := format(n.RHS)
+		return escapedBackslash + "frac{" + lhs + "}{" + rhs + "}", atomPrecedence
+	case PowerOperator:
+		exponent, _ := format(n.RHS)
+		return wrap(n.LHS, atomPrecedence) + "^{" + exponent + "}", powerPrecedence
+	default:
+		return n.String(), atomPrecedence
+	}
+}
+
+func formatPiecewise(n *PiecewiseExpressionNode) string {
+	var out strings.Builder
+
+	out.WriteString(escapedBackslash + "begin{cases} ")
+	for i, c := range n.Cases {
+		if i > 0 {
+			out.WriteString(" " + escapedBackslash + escapedBackslash + " ")
+		}
+
+		value, _ := format(c.Value)
+		out.WriteString(value + " & ")
+		if c.Condition == nil {
+			out.WriteString(escapedBackslash + "text{otherwise}")
+		} else {
+			condition, _ := format(c.Condition)
+			out.WriteString(condition)
+		}
+	}
+	out.WriteString(" " + escapedBackslash + "end{cases}")
+
+	return out.String()
+}
+
+// wrap formats node, parenthesizing it when it binds looser than minimum.
+func wrap(node ExpressionNode, minimum int) string {
+	formatted, precedence := format(node)
+	if precedence < minimum {
+		return escapedBackslash + "left(" + formatted + escapedBackslash + "right)"
+	}
+	return formatted
+}
+
+func formatNumber(value float64) (string, int) {
+	switch value {
+	case math.E:
+		return "e", atomPrecedence
+	case math.Pi:
+		return escapedBackslash + "pi", atomPrecedence
+	}
+
+	formatted := strconv.FormatFloat(value, 'g', -1, 64)
+	if value < 0 {
+		return formatted, unaryPrecedence
+	}
+	return formatted, atomPrecedence
+}
+
+func comparisonSymbol(operator string) string {
+	switch ComparisonOperator(operator) {
+	case LessEqualOperator:
+		return escapedBackslash + "leq"
+	case GreaterEqualOperator:
+		return escapedBackslash + "geq"
+	default:
+		return operator
+	}
+}
+
+func startsWithDigit(s string) bool {
+	return s != "" && (s[0] >= '0' && s[0] <= '9' || s[0] == '.')
+}
+
+func endsWithLetter(s string) bool {
+	last := s[len(s)-1]
+	return last >= 'a' && last <= 'z' || last >= 'A' && last <= 'Z'
+}
@@ -0,0 +1,48 @@
+package latex
+
+import (
+	"maps"
+	"slices"
+)
+
+// FreeVariables returns the identifiers of the variables in node, sorted and
+// without duplicates. Unlike evaluating the tree, it also finds variables in
+// piecewise cases that a given environment would never reach.
+func FreeVariables(node ExpressionNode) []string {
+	variables := make(map[string]bool)
+	collectVariables(node, variables)
+
+	return slices.Sorted(maps.Keys(variables))
+}
+
+func collectVariables(node ExpressionNode, variables map[string]bool) {
+	switch n := node.(type) {
+	case *VariableExpressionNode:
+		variables[n.Identifier] = true
+	case *UnaryExpressionNode:
+		collectVariables(n.SubExpression, variables)
+	case *BinaryExpressionNode:
+		collectVariables(n.LHS, variables)
+		collectVariables(n.RHS, variables)
+	case *SquareRootExpressionNode:
+		collectVariables(n.Index, variables)
+		collectVariables(n.Radicand, variables)
+	case *AbsoluteValueExpressionNode:
+		collectVariables(n.SubExpression, variables)
+	case *FunctionExpressionNode:
+		collectVariables(n.Argument, variables)
+	case *ComparisonExpressionNode:
+		collectVariables(n.LHS, variables)
+		collectVariables(n.RHS, variables)
+	case *LogicalExpressionNode:
+		collectVariables(n.LHS, variables)
+		collectVariables(n.RHS, variables)
+	case *PiecewiseExpressionNode:
+		for _, c := range n.Cases {
+			collectVariables(c.Value, variables)
+			if c.Condition != nil {
+				collectVariables(c.Condition, variables)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package latex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeVariables(t *testing.T) {
+	t.Parallel()
+
+	x := &VariableExpressionNode{Identifier: "x"}
+	y := &VariableExpressionNode{Identifier: "y"}
+
+	tt := []struct {
+		name     string
+		node     ExpressionNode
+		expected []string
+	}{
+		{
+			name:     "Constant",
+			node:     &NumberExpression{Value: 2},
+			expected: nil,
+		},
+		{
+			name:     "Repeated",
+			node:     &BinaryExpressionNode{LHS: x, Operator: string(MulOperator), RHS: x},
+			expected: []string{"x"},
+		},
+		{
+			name: "Sorted",
+			node: &BinaryExpressionNode{
+				LHS:      &SquareRootExpressionNode{Index: &NumberExpression{Value: 2}, Radicand: y},
+				Operator: string(PlusOperator),
+				RHS:      &AbsoluteValueExpressionNode{SubExpression: x},
+			},
+			expected: []string{"x", "y"},
+		},
+		{
+			name: "PiecewiseCondition",
+			node: &PiecewiseExpressionNode{
+				Cases: []PiecewiseCase{
+					{
+						Value: x,
+						Condition: &ComparisonExpressionNode{
+							LHS:      &VariableExpressionNode{Identifier: "t"},
+							Operator: string(LessEqualOperator),
+							RHS:      &NumberExpression{Value: 1},
+						},
+					},
+					{Value: &NumberExpression{Value: 0}},
+				},
+			},
+			expected: []string{"t", "x"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			variables := FreeVariables(test.node)
+
+			// Assert
+			assert.Equal(t, test.expected, variables)
+		})
+	}
+}
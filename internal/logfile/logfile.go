@@ -0,0 +1,60 @@
+// Package logfile manages the log file of a terminal session, making sure
+// its entries reach the disk when the program exits.
+package logfile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// DefaultPath is the log file used when the configuration leaves it empty.
+const DefaultPath = "nume.log"
+
+// File is an open log file. Close syncs it before closing, and is safe to
+// call from several exit paths, as only the first call has an effect.
+type File struct {
+	file      *os.File
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Open opens the log file at path, creating it when missing. Entries are
+// appended to the previous runs, unless truncate empties it first.
+func Open(path string, truncate bool) (*File, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if truncate {
+		flags |= os.O_TRUNC
+	}
+
+	file, err := os.OpenFile(path, flags, 0o666)
+	if err != nil {
+		return nil, fmt.Errorf("opening log file %s: %w", path, err)
+	}
+
+	return &File{file: file}, nil
+}
+
+// Write implements io.Writer.
+func (f *File) Write(p []byte) (int, error) {
+	return f.file.Write(p)
+}
+
+// Name is the path of the log file.
+func (f *File) Name() string {
+	return f.file.Name()
+}
+
+// Close flushes the written entries to the disk and closes the file.
+func (f *File) Close() error {
+	f.closeOnce.Do(func() {
+		f.closeErr = errors.Join(f.file.Sync(), f.file.Close())
+	})
+
+	return f.closeErr
+}
@@ -0,0 +1,119 @@
+package logfile
+
+import (
+	"bufio"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readMessages returns the msg of every JSON entry in the file at path.
+func readMessages(t *testing.T, path string) []string {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var messages []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry struct {
+			Msg string `json:"msg"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		messages = append(messages, entry.Msg)
+	}
+	require.NoError(t, scanner.Err())
+
+	return messages
+}
+
+// writeRun simulates a run of the program, logging messages and shutting
+// down by closing the file.
+func writeRun(t *testing.T, path string, truncate bool, messages ...string) {
+	t.Helper()
+
+	file, err := Open(path, truncate)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewJSONHandler(file, nil))
+	for _, message := range messages {
+		logger.Info(message)
+	}
+
+	require.NoError(t, file.Close())
+}
+
+func TestEntriesAreWrittenAfterShutdown(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "nume.log")
+
+	// Act
+	writeRun(t, path, false, "first", "second")
+
+	// Assert
+	assert.Equal(t, []string{"first", "second"}, readMessages(t, path))
+}
+
+func TestRunsAppendOrTruncate(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		truncate bool
+		expected []string
+	}{
+		{name: "Append", truncate: false, expected: []string{"previous", "current"}},
+		{name: "Truncate", truncate: true, expected: []string{"current"}},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "nume.log")
+			writeRun(t, path, false, "previous")
+
+			// Act
+			writeRun(t, path, test.truncate, "current")
+
+			// Assert
+			assert.Equal(t, test.expected, readMessages(t, path))
+		})
+	}
+}
+
+func TestCloseIsIdempotent(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	file, err := Open(filepath.Join(t.TempDir(), "nume.log"), false)
+	require.NoError(t, err)
+	_, err = file.Write([]byte("{}\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// Act
+	err = file.Close()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestOpenReportsMissingDirectories(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "missing", "nume.log")
+
+	// Act
+	_, err := Open(path, false)
+
+	// Assert
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
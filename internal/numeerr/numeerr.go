@@ -0,0 +1,78 @@
+// Package numeerr classifies the errors of the numerical methods with codes,
+// so callers such as the API can map any of them without listing every
+// sentinel.
+package numeerr
+
+import "errors"
+
+// Code is the class of a numerical error.
+type Code string
+
+const (
+	// CodeInvalidInput is an argument no method could work with, such as a
+	// zero width interval or mismatched dimensions
+	CodeInvalidInput Code = "invalid_input"
+	// CodeUnsupported is a valid request the method does not implement, such
+	// as an unknown strategy or order
+	CodeUnsupported Code = "unsupported"
+	// CodeDomain is an input outside the domain of the method, such as a
+	// singular matrix or a non-finite integrand
+	CodeDomain Code = "domain"
+	// CodeFailed is a method that ran but did not reach an answer, such as a
+	// stalled iteration or a failed decomposition
+	CodeFailed Code = "failed"
+)
+
+// Class errors match every sentinel of their code with errors.Is, so
+// errors.Is(err, numeerr.ErrInvalidInput) holds for any invalid input.
+var (
+	ErrInvalidInput error = class(CodeInvalidInput)
+	ErrUnsupported  error = class(CodeUnsupported)
+	ErrDomain       error = class(CodeDomain)
+	ErrFailed       error = class(CodeFailed)
+)
+
+type class Code
+
+// Error implements error.
+func (c class) Error() string {
+	return string(c)
+}
+
+// Error is a sentinel carrying its Code, created with New.
+type Error struct {
+	code    Code
+	message string
+}
+
+// New returns a sentinel error with message, classified as code.
+func New(code Code, message string) error {
+	return &Error{code: code, message: message}
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Code returns the class of the error.
+func (e *Error) Code() Code {
+	return e.code
+}
+
+// Is matches the class error of the sentinel code.
+func (e *Error) Is(target error) bool {
+	c, ok := target.(class)
+	return ok && Code(c) == e.code
+}
+
+// CodeOf returns the code of the first classified error in the chain of err,
+// reporting false when there is none.
+func CodeOf(err error) (Code, bool) {
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified.code, true
+	}
+
+	return "", false
+}
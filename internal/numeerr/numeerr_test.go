@@ -0,0 +1,72 @@
+package numeerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchesTheClassAcrossWrappedChains(t *testing.T) {
+	t.Parallel()
+
+	sentinel := New(CodeDomain, "singular matrix")
+
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{name: "Sentinel", err: sentinel},
+		{name: "Wrapped", err: fmt.Errorf("error inverting: %w", sentinel)},
+		{name: "WrappedTwice", err: fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", sentinel))},
+		{name: "Joined", err: fmt.Errorf("%w: %w", errors.New("cancelled"), sentinel)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			code, ok := CodeOf(testCase.err)
+
+			// Assert
+			assert.ErrorIs(t, testCase.err, sentinel)
+			assert.ErrorIs(t, testCase.err, ErrDomain)
+			assert.NotErrorIs(t, testCase.err, ErrInvalidInput)
+			assert.True(t, ok)
+			assert.Equal(t, CodeDomain, code)
+		})
+	}
+}
+
+func TestSentinelsOfTheSameCodeAreDistinct(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	first := New(CodeInvalidInput, "empty matrix")
+	second := New(CodeInvalidInput, "empty matrix")
+
+	// Act
+	err := fmt.Errorf("error: %w", first)
+
+	// Assert
+	assert.ErrorIs(t, err, first)
+	assert.NotErrorIs(t, err, second)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Equal(t, "error: empty matrix", err.Error())
+}
+
+func TestCodeOfUnclassifiedError(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	err := fmt.Errorf("wrapped: %w", errors.New("plain"))
+
+	// Act
+	code, ok := CodeOf(err)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Empty(t, code)
+	assert.NotErrorIs(t, err, ErrFailed)
+}
@@ -21,7 +21,7 @@ type participleExpr interface {
 }
 
 type participleExpression struct {
-	Expression additionExpressionNode `@@`
+	Expression orExpressionNode `@@`
 }
 
 // toLatexNode implements participleExpr.
@@ -35,6 +35,9 @@ var (
 	_ participleExpr = (*multiplicationExpressionNode)(nil)
 	_ participleExpr = (*powerExpressionNode)(nil)
 	_ participleExpr = (*unaryExpressionNode)(nil)
+	_ participleExpr = (*orExpressionNode)(nil)
+	_ participleExpr = (*andExpressionNode)(nil)
+	_ participleExpr = (*comparisonExpressionNode)(nil)
 )
 
 var (
@@ -42,104 +45,226 @@ var (
 	_ primaryExpressionNode = (*participleNumberExpressionNode)(nil)
 	_ primaryExpressionNode = (*participleConstantExpressionNode)(nil)
 	_ primaryExpressionNode = (*parenthesesExpressionNode)(nil)
+	_ primaryExpressionNode = (*sizedDelimiterExpressionNode)(nil)
 	_ primaryExpressionNode = (*squirlyExpressionNode)(nil)
 	_ primaryExpressionNode = (*participleSquareRootExpressionNode)(nil)
+	_ primaryExpressionNode = (*participleFunctionExpressionNode)(nil)
+	_ primaryExpressionNode = (*participlePiecewiseExpressionNode)(nil)
 )
 
-type additionExpressionNode struct {
+type orExpressionNode struct {
 	Pos    lexer.Position
 	EndPos lexer.Position
 	Tokens []lexer.Token
 
-	Multiplication multiplicationExpression `@@`
-	Operator       string                   `( @("+" | "-")`
-	Next           *additionExpressionNode  ` @@ )*`
+	And      andExpressionNode `@@`
+	Operator string            `( @("\\" "lor")`
+	Next     *orExpressionNode ` @@ )*`
 }
 
 // toLatexNode implements participleExpr.
-func (a *additionExpressionNode) toLatexNode() latex.ExpressionNode {
+func (o *orExpressionNode) toLatexNode() latex.ExpressionNode {
+	if o.Operator == "" {
+		return o.And.toLatexNode()
+	}
+
+	return &latex.LogicalExpressionNode{
+		LHS:      o.And.toLatexNode(),
+		Operator: string(latex.OrOperator),
+		RHS:      o.Next.toLatexNode(),
+	}
+}
+
+type andExpressionNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Comparison comparisonExpressionNode `@@`
+	Operator   string                   `( @("\\" "land")`
+	Next       *andExpressionNode       ` @@ )*`
+}
+
+// toLatexNode implements participleExpr.
+func (a *andExpressionNode) toLatexNode() latex.ExpressionNode {
 	if a.Operator == "" {
-		return a.Multiplication.toLatexNode()
+		return a.Comparison.toLatexNode()
+	}
+
+	return &latex.LogicalExpressionNode{
+		LHS:      a.Comparison.toLatexNode(),
+		Operator: string(latex.AndOperator),
+		RHS:      a.Next.toLatexNode(),
+	}
+}
+
+type comparisonExpressionNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Addition additionExpressionNode  `@@`
+	Operator string                  `( @( "<" "=" | ">" "=" | "<" | ">" | "=" | "\\" ( "leq" | "geq" | "le" | "ge" | "lt" | "gt" ) )`
+	Next     *additionExpressionNode ` @@ )?`
+}
+
+// toLatexNode implements participleExpr.
+func (c *comparisonExpressionNode) toLatexNode() latex.ExpressionNode {
+	if c.Operator == "" {
+		return c.Addition.toLatexNode()
 	}
 
 	var operator string
-	switch a.Operator {
-	case "+":
-		operator = string(latex.PlusOperator)
-	case "-":
-		operator = string(latex.MinusOperator)
+	switch c.Operator {
+	case "<", `\lt`:
+		operator = string(latex.LessOperator)
+	case "<=", `\leq`, `\le`:
+		operator = string(latex.LessEqualOperator)
+	case ">", `\gt`:
+		operator = string(latex.GreaterOperator)
+	case ">=", `\geq`, `\ge`:
+		operator = string(latex.GreaterEqualOperator)
+	case "=":
+		operator = string(latex.EqualOperator)
 	default:
-		panic("unknown operator: " + a.Operator)
+		panic("unknown operator for comparison: " + c.Operator)
 	}
 
-	return &latex.BinaryExpressionNode{
-		LHS:      a.Multiplication.toLatexNode(),
+	return &latex.ComparisonExpressionNode{
+		LHS:      c.Addition.toLatexNode(),
 		Operator: operator,
-		RHS:      a.Next.toLatexNode(),
+		RHS:      c.Next.toLatexNode(),
 	}
 }
 
-type multiplicationExpression interface {
+// additionExpressionNode keeps its terms as a flat list folded from the left,
+// so 8-4-2 reads as (8-4)-2.
+type additionExpressionNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Multiplication multiplicationExpressionNode `@@`
+	Rest           []*additionOperationNode     `@@*`
+}
+
+type additionOperationNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Operator       string                       `@("+" | "-")`
+	Multiplication multiplicationExpressionNode `@@`
+}
+
+// toLatexNode implements participleExpr.
+func (a *additionExpressionNode) toLatexNode() latex.ExpressionNode {
+	node := a.Multiplication.toLatexNode()
+
+	for _, operation := range a.Rest {
+		var operator string
+		switch operation.Operator {
+		case "+":
+			operator = string(latex.PlusOperator)
+		case "-":
+			operator = string(latex.MinusOperator)
+		default:
+			panic("unknown operator: " + operation.Operator)
+		}
+
+		node = &latex.BinaryExpressionNode{
+			LHS:      node,
+			Operator: operator,
+			RHS:      operation.Multiplication.toLatexNode(),
+		}
+	}
+
+	return node
+}
+
+type multiplicationFactor interface {
 	participleExpr
-	mult()
+	factor()
 }
 
 var (
-	_ multiplicationExpression = (*multiplicationExpressionNode)(nil)
-	_ multiplicationExpression = (*participleFractionExpressionNode)(nil)
+	_ multiplicationFactor = (*unaryExpressionNode)(nil)
+	_ multiplicationFactor = (*participleFractionExpressionNode)(nil)
 )
 
+// multiplicationExpressionNode keeps its factors as a flat list folded from
+// the left, so 8/4/2 reads as (8/4)/2.
 type multiplicationExpressionNode struct {
 	Pos    lexer.Position
 	EndPos lexer.Position
 	Tokens []lexer.Token
 
-	Power    *powerExpressionNode     `@@`
-	Operator string                   `( @("*" | "/")`
-	Next     multiplicationExpression ` @@ )*`
+	Factor multiplicationFactor           `@@`
+	Rest   []*multiplicationOperationNode `@@*`
 }
 
-// mult implements multiplicationExpression.
-func (m *multiplicationExpressionNode) mult() {
+type multiplicationOperationNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Operator string               `( @("*" | "/")`
+	Factor   multiplicationFactor ` @@`
+	// Juxtaposed is a factor written right after another one, as in 2x or
+	// (x+1)(x-1). A leading sign is left to the addition, x - 1 isn't x(-1)
+	Juxtaposed multiplicationFactor `| (?! "+" | "-") @@ )`
 }
 
 // toLatexNode implements participleExpr.
 func (m *multiplicationExpressionNode) toLatexNode() latex.ExpressionNode {
-	if m.Operator == "" {
-		return m.Power.toLatexNode()
+	node := m.Factor.toLatexNode()
+
+	for _, operation := range m.Rest {
+		if operation.Juxtaposed != nil {
+			node = &latex.BinaryExpressionNode{
+				LHS:      node,
+				Operator: string(latex.MulOperator),
+				RHS:      operation.Juxtaposed.toLatexNode(),
+			}
+			continue
+		}
+
+		var operator string
+		switch operation.Operator {
+		case "*":
+			operator = string(latex.MulOperator)
+		case "/":
+			operator = string(latex.DivOperator)
+		default:
+			panic("unknown operator for multiplication: " + operation.Operator)
+		}
+
+		node = &latex.BinaryExpressionNode{
+			LHS:      node,
+			Operator: operator,
+			RHS:      operation.Factor.toLatexNode(),
+		}
 	}
 
-	var operator string
-	switch m.Operator {
-	case "*":
-		operator = string(latex.MulOperator)
-	case "/":
-		operator = string(latex.DivOperator)
-	default:
-		panic("unknown operator for multiplication: " + m.Operator)
-	}
-
-	return &latex.BinaryExpressionNode{
-		LHS:      m.Power.toLatexNode(),
-		Operator: operator,
-		RHS:      m.Next.toLatexNode(),
-	}
+	return node
 }
 
+// powerExpressionNode binds tighter than a sign, -2^2 is -(2^2), and its
+// exponent may be a whole power itself, making 2^3^2 read as 2^(3^2).
 type powerExpressionNode struct {
 	Pos    lexer.Position
 	EndPos lexer.Position
 	Tokens []lexer.Token
 
-	Unary    *unaryExpressionNode `@@`
-	Operator string               `( @("^")`
-	Next     *powerExpressionNode ` @@ )*`
+	Base     primaryExpressionNode `@@`
+	Operator string                `( @("^")`
+	Exponent *unaryExpressionNode  ` @@ )?`
 }
 
 // toLatexNode implements participleExpr.
 func (p *powerExpressionNode) toLatexNode() latex.ExpressionNode {
 	if p.Operator == "" {
-		return p.Unary.toLatexNode()
+		return p.Base.toLatexNode()
 	}
 
 	var operator string
@@ -151,9 +276,9 @@ func (p *powerExpressionNode) toLatexNode() latex.ExpressionNode {
 	}
 
 	return &latex.BinaryExpressionNode{
-		LHS:      p.Unary.toLatexNode(),
+		LHS:      p.Base.toLatexNode(),
 		Operator: operator,
-		RHS:      p.Next.toLatexNode(),
+		RHS:      p.Exponent.toLatexNode(),
 	}
 }
 
@@ -162,16 +287,19 @@ type unaryExpressionNode struct {
 	EndPos lexer.Position
 	Tokens []lexer.Token
 
-	Operator string                `( @("+" | "-")`
-	Unary    *unaryExpressionNode  ` @@ )`
-	Primary  primaryExpressionNode `| @@`
+	Operator string               `( @("+" | "-")`
+	Unary    *unaryExpressionNode ` @@ )`
+	Power    *powerExpressionNode `| @@`
+}
+
+// factor implements multiplicationFactor.
+func (u *unaryExpressionNode) factor() {
 }
 
 // toLatexNode implements participleExpr.
 func (u *unaryExpressionNode) toLatexNode() latex.ExpressionNode {
 	if u.Operator == "" {
-		res := u.Primary.toLatexNode()
-		return res
+		return u.Power.toLatexNode()
 	}
 
 	var operator string
@@ -186,7 +314,7 @@ func (u *unaryExpressionNode) toLatexNode() latex.ExpressionNode {
 
 	return &latex.UnaryExpressionNode{
 		Operator:      operator,
-		SubExpression: u.Primary.toLatexNode(),
+		SubExpression: u.Unary.toLatexNode(),
 	}
 }
 
@@ -289,6 +417,44 @@ func (p *participleSquareRootExpressionNode) toLatexNode() latex.ExpressionNode
 	}
 }
 
+// participleFunctionExpressionNode applies an elementary function to the
+// group right after it, so \sin{x} and \sin(x) read the same. A bare argument
+// is rejected rather than guessed, as \sin x^2 and \sin 2x read as sin(x²) and
+// sin(2x) in textbooks while a single primary would make them (sin x)² and
+// (sin 2)·x.
+type participleFunctionExpressionNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Function string `"\\" @("sin" | "cos" | "tan" | "exp" | "ln")`
+	// Argument is one of the groups, braces, parentheses or sized delimiters
+	Argument functionArgumentNode `@@`
+}
+
+type functionArgumentNode interface {
+	participleExpr
+	functionArgument()
+}
+
+var (
+	_ functionArgumentNode = (*squirlyExpressionNode)(nil)
+	_ functionArgumentNode = (*parenthesesExpressionNode)(nil)
+	_ functionArgumentNode = (*sizedDelimiterExpressionNode)(nil)
+)
+
+// primary implements primaryExpressionNode.
+func (p *participleFunctionExpressionNode) primary() {
+}
+
+// toLatexNode implements primaryExpressionNode.
+func (p *participleFunctionExpressionNode) toLatexNode() latex.ExpressionNode {
+	return &latex.FunctionExpressionNode{
+		Function: p.Function,
+		Argument: p.Argument.toLatexNode(),
+	}
+}
+
 type participleFractionExpressionNode struct {
 	Pos    lexer.Position
 	EndPos lexer.Position
@@ -298,8 +464,8 @@ type participleFractionExpressionNode struct {
 	Denominator squirlyExpressionNode `@@`
 }
 
-// mult implements multiplicationExpression.
-func (p *participleFractionExpressionNode) mult() {
+// factor implements multiplicationFactor.
+func (p *participleFractionExpressionNode) factor() {
 }
 
 // toLatexNode implements ParticipleExpr.
@@ -323,11 +489,50 @@ type parenthesesExpressionNode struct {
 func (p *parenthesesExpressionNode) primary() {
 }
 
+// functionArgument implements functionArgumentNode.
+func (p *parenthesesExpressionNode) functionArgument() {
+}
+
 // toLatexNode implements primaryExpressionNode.
 func (p *parenthesesExpressionNode) toLatexNode() latex.ExpressionNode {
 	return p.Expr.toLatexNode()
 }
 
+// sizedDelimiterExpressionNode is a group sized with \left and \right, as
+// pasted MathJax usually is. Parentheses and brackets only group, like bare
+// parentheses, while bars take the absolute value.
+type sizedDelimiterExpressionNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Parentheses   *participleExpression `"\\" "left" ( "(" @@ "\\" "right" ")"`
+	Brackets      *participleExpression `            | "[" @@ "\\" "right" "]"`
+	AbsoluteValue *participleExpression `            | "|" @@ "\\" "right" "|" )`
+}
+
+// primary implements primaryExpressionNode.
+func (s *sizedDelimiterExpressionNode) primary() {
+}
+
+// functionArgument implements functionArgumentNode.
+func (s *sizedDelimiterExpressionNode) functionArgument() {
+}
+
+// toLatexNode implements primaryExpressionNode.
+func (s *sizedDelimiterExpressionNode) toLatexNode() latex.ExpressionNode {
+	switch {
+	case s.Parentheses != nil:
+		return s.Parentheses.toLatexNode()
+	case s.Brackets != nil:
+		return s.Brackets.toLatexNode()
+	default:
+		return &latex.AbsoluteValueExpressionNode{
+			SubExpression: s.AbsoluteValue.toLatexNode(),
+		}
+	}
+}
+
 type squirlyExpressionNode struct {
 	Pos    lexer.Position
 	EndPos lexer.Position
@@ -340,11 +545,62 @@ type squirlyExpressionNode struct {
 func (s *squirlyExpressionNode) primary() {
 }
 
+// functionArgument implements functionArgumentNode.
+func (s *squirlyExpressionNode) functionArgument() {
+}
+
 // toLatexNode implements primaryExpressionNode.
 func (s *squirlyExpressionNode) toLatexNode() latex.ExpressionNode {
 	return s.Expr.toLatexNode()
 }
 
+type participlePiecewiseExpressionNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Cases []*participleCaseNode `"\\" "begin" "{" "cases" "}" @@ ( "\\" "\\" @@ )* ( "\\" "\\" )? "\\" "end" "{" "cases" "}"`
+}
+
+// primary implements primaryExpressionNode.
+func (p *participlePiecewiseExpressionNode) primary() {
+}
+
+// toLatexNode implements primaryExpressionNode.
+func (p *participlePiecewiseExpressionNode) toLatexNode() latex.ExpressionNode {
+	cases := make([]latex.PiecewiseCase, 0, len(p.Cases))
+	for _, c := range p.Cases {
+		cases = append(cases, c.toLatexCase())
+	}
+
+	return &latex.PiecewiseExpressionNode{
+		Cases: cases,
+	}
+}
+
+type participleCaseNode struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+	Tokens []lexer.Token
+
+	Value     participleExpression  `@@ ","? "&"`
+	Otherwise bool                  `( ( "\\" "text" "{" @"otherwise" "}" | @"otherwise" )`
+	Condition *participleExpression `| ( "\\" "text" "{" "if" "}" | "if" )? @@ )`
+}
+
+func (c *participleCaseNode) toLatexCase() latex.PiecewiseCase {
+	if c.Otherwise {
+		return latex.PiecewiseCase{
+			Value: c.Value.toLatexNode(),
+		}
+	}
+
+	return latex.PiecewiseCase{
+		Value:     c.Value.toLatexNode(),
+		Condition: c.Condition.toLatexNode(),
+	}
+}
+
 type ParticipalMathJaxParser struct {
 	parser *participle.Parser[participleExpression]
 }
@@ -356,17 +612,25 @@ var (
 func NewParticipalLatexParser() (*ParticipalMathJaxParser, error) {
 	parser, err := participle.Build[participleExpression](
 		participle.UseLookahead(99999),
-		participle.Union[multiplicationExpression](
+		participle.Union[multiplicationFactor](
 			&participleFractionExpressionNode{},
-			&multiplicationExpressionNode{},
+			&unaryExpressionNode{},
+		),
+		participle.Union[functionArgumentNode](
+			&squirlyExpressionNode{},
+			&parenthesesExpressionNode{},
+			&sizedDelimiterExpressionNode{},
 		),
 		participle.Union[primaryExpressionNode](
 			&participleVariableExpressionNode{},
 			&participleConstantExpressionNode{},
 			&participleNumberExpressionNode{},
 			&parenthesesExpressionNode{},
+			&sizedDelimiterExpressionNode{},
 			&squirlyExpressionNode{},
 			&participleSquareRootExpressionNode{},
+			&participleFunctionExpressionNode{},
+			&participlePiecewiseExpressionNode{},
 		),
 	)
 	if err != nil {
@@ -383,5 +647,18 @@ func (p *ParticipalMathJaxParser) ParseExpression(
 	ctx context.Context,
 	input string,
 ) (*latex.ExpressionNode, error) {
-	panic("unimplemented")
+	expr, err := p.parser.ParseString("", input)
+	if err != nil {
+		slog.ErrorContext(
+			ctx,
+			"failed to parse latex expression",
+			slog.String("input", input),
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+
+	node := expr.toLatexNode()
+
+	return &node, nil
 }
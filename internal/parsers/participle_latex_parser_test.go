@@ -182,6 +182,111 @@ func TestParseSquareRoot(t *testing.T) {
 	}
 }
 
+func TestParseFunction(t *testing.T) {
+	t.Parallel()
+
+	sine := &latex.FunctionExpressionNode{
+		Function: "sin",
+		Argument: &latex.VariableExpressionNode{Identifier: "x"},
+	}
+
+	tt := []struct {
+		name               string
+		input              string
+		expectedExpression latex.ExpressionNode
+	}{
+		{name: "Braces", input: `\sin{x}`, expectedExpression: sine},
+		{name: "Parentheses", input: `\sin(x)`, expectedExpression: sine},
+		{name: "Sized parentheses", input: `\sin\left(x\right)`, expectedExpression: sine},
+		{
+			name:  "Power inside the argument",
+			input: `\sin{x^2}`,
+			expectedExpression: &latex.FunctionExpressionNode{
+				Function: "sin",
+				Argument: &latex.BinaryExpressionNode{
+					LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+					Operator: string(latex.PowerOperator),
+					RHS:      &latex.NumberExpression{Value: 2},
+				},
+			},
+		},
+		{
+			name:  "Product inside the argument",
+			input: `\sin(2x)`,
+			expectedExpression: &latex.FunctionExpressionNode{
+				Function: "sin",
+				Argument: &latex.BinaryExpressionNode{
+					LHS:      &latex.NumberExpression{Value: 2},
+					Operator: string(latex.MulOperator),
+					RHS:      &latex.VariableExpressionNode{Identifier: "x"},
+				},
+			},
+		},
+		{
+			name:  "Power of the function",
+			input: `\sin{x}^2`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      sine,
+				Operator: string(latex.PowerOperator),
+				RHS:      &latex.NumberExpression{Value: 2},
+			},
+		},
+		{
+			name:  "Scaled logarithm",
+			input: `2\ln{x}`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      &latex.NumberExpression{Value: 2},
+				Operator: string(latex.MulOperator),
+				RHS: &latex.FunctionExpressionNode{
+					Function: "ln",
+					Argument: &latex.VariableExpressionNode{Identifier: "x"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			result, err := parser.parser.ParseString("", test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedExpression, result.Expression.toLatexNode())
+		})
+	}
+}
+
+func TestParseFunctionRejectsBareArguments(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name  string
+		input string
+	}{
+		{name: "Variable", input: `\sin x`},
+		{name: "Power", input: `\sin x^2`},
+		{name: "Product", input: `\sin 2x`},
+		{name: "Logarithm", input: `\ln x`},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			// Act
+			_, err = parser.ParseExpression(t.Context(), test.input)
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestParseFrac(t *testing.T) {
 	t.Parallel()
 
@@ -308,15 +413,39 @@ func TestBinaryExpression(t *testing.T) {
 			name:  "1 + 2 + 3",
 			input: `1 + 2 + 3`,
 			expectedExpression: &latex.BinaryExpressionNode{
-				LHS: &latex.NumberExpression{
-					Value: 1.0,
+				LHS: &latex.BinaryExpressionNode{
+					LHS:      &latex.NumberExpression{Value: 1.0},
+					Operator: string(latex.PlusOperator),
+					RHS:      &latex.NumberExpression{Value: 2.0},
 				},
 				Operator: string(latex.PlusOperator),
-				RHS: &latex.BinaryExpressionNode{
-					LHS:      &latex.NumberExpression{Value: 2.0},
-					Operator: string(latex.PlusOperator),
-					RHS:      &latex.NumberExpression{Value: 3.0},
+				RHS:      &latex.NumberExpression{Value: 3.0},
+			},
+		},
+		{
+			name:  "8 - 4 - 2",
+			input: `8 - 4 - 2`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS: &latex.BinaryExpressionNode{
+					LHS:      &latex.NumberExpression{Value: 8.0},
+					Operator: string(latex.MinusOperator),
+					RHS:      &latex.NumberExpression{Value: 4.0},
 				},
+				Operator: string(latex.MinusOperator),
+				RHS:      &latex.NumberExpression{Value: 2.0},
+			},
+		},
+		{
+			name:  "8 / 4 / 2",
+			input: `8 / 4 / 2`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS: &latex.BinaryExpressionNode{
+					LHS:      &latex.NumberExpression{Value: 8.0},
+					Operator: string(latex.DivOperator),
+					RHS:      &latex.NumberExpression{Value: 4.0},
+				},
+				Operator: string(latex.DivOperator),
+				RHS:      &latex.NumberExpression{Value: 2.0},
 			},
 		},
 		{
@@ -411,3 +540,643 @@ func TestBinaryExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestImplicitMultiplication(t *testing.T) {
+	t.Parallel()
+
+	x := &latex.VariableExpressionNode{Identifier: "x"}
+
+	tt := []struct {
+		name               string
+		input              string
+		expectedExpression latex.ExpressionNode
+	}{
+		{
+			name:  "2x",
+			input: `2x`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      &latex.NumberExpression{Value: 2},
+				Operator: string(latex.MulOperator),
+				RHS:      x,
+			},
+		},
+		{
+			name:  "2\\pi",
+			input: `2\pi`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      &latex.NumberExpression{Value: 2},
+				Operator: string(latex.MulOperator),
+				RHS:      &latex.NumberExpression{Value: math.Pi},
+			},
+		},
+		{
+			name:  "(x+1)(x-1)",
+			input: `(x+1)(x-1)`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS: &latex.BinaryExpressionNode{
+					LHS:      x,
+					Operator: string(latex.PlusOperator),
+					RHS:      &latex.NumberExpression{Value: 1},
+				},
+				Operator: string(latex.MulOperator),
+				RHS: &latex.BinaryExpressionNode{
+					LHS:      x,
+					Operator: string(latex.MinusOperator),
+					RHS:      &latex.NumberExpression{Value: 1},
+				},
+			},
+		},
+		{
+			name:  "Coefficient binds tighter than power",
+			input: `3x^2`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      &latex.NumberExpression{Value: 3},
+				Operator: string(latex.MulOperator),
+				RHS: &latex.BinaryExpressionNode{
+					LHS:      x,
+					Operator: string(latex.PowerOperator),
+					RHS:      &latex.NumberExpression{Value: 2},
+				},
+			},
+		},
+		{
+			name:  "3\\sin{x}",
+			input: `3\sin{x}`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      &latex.NumberExpression{Value: 3},
+				Operator: string(latex.MulOperator),
+				RHS:      &latex.FunctionExpressionNode{Function: "sin", Argument: x},
+			},
+		},
+		{
+			name:  "Coefficient of a square root",
+			input: `2\sqrt{x}`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      &latex.NumberExpression{Value: 2},
+				Operator: string(latex.MulOperator),
+				RHS:      &latex.SquareRootExpressionNode{Index: &latex.NumberExpression{Value: 2}, Radicand: x},
+			},
+		},
+		{
+			name:  "Fraction coefficient",
+			input: `\frac{1}{2}x`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS: &latex.BinaryExpressionNode{
+					LHS:      &latex.NumberExpression{Value: 1},
+					Operator: string(latex.DivOperator),
+					RHS:      &latex.NumberExpression{Value: 2},
+				},
+				Operator: string(latex.MulOperator),
+				RHS:      x,
+			},
+		},
+		{
+			name:  "Subtraction after a fraction",
+			input: `\frac{1}{2} - x`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS: &latex.BinaryExpressionNode{
+					LHS:      &latex.NumberExpression{Value: 1},
+					Operator: string(latex.DivOperator),
+					RHS:      &latex.NumberExpression{Value: 2},
+				},
+				Operator: string(latex.MinusOperator),
+				RHS:      x,
+			},
+		},
+		{
+			name:  "Subtraction is not a juxtaposed negative factor",
+			input: `x - 1`,
+			expectedExpression: &latex.BinaryExpressionNode{
+				LHS:      x,
+				Operator: string(latex.MinusOperator),
+				RHS:      &latex.NumberExpression{Value: 1},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			result, err := parser.parser.ParseString("", test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedExpression, result.Expression.toLatexNode())
+		})
+	}
+}
+
+func TestParsePiecewise(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name               string
+		input              string
+		expectedExpression *latex.PiecewiseExpressionNode
+	}{
+		{
+			name:  "Two branches with otherwise",
+			input: `\begin{cases} x^2 & x < 0 \\ x & \text{otherwise} \end{cases}`,
+			expectedExpression: &latex.PiecewiseExpressionNode{
+				Cases: []latex.PiecewiseCase{
+					{
+						Value: &latex.BinaryExpressionNode{
+							LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+							Operator: string(latex.PowerOperator),
+							RHS:      &latex.NumberExpression{Value: 2.0},
+						},
+						Condition: &latex.ComparisonExpressionNode{
+							LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+							Operator: string(latex.LessOperator),
+							RHS:      &latex.NumberExpression{Value: 0.0},
+						},
+					},
+					{
+						Value: &latex.VariableExpressionNode{Identifier: "x"},
+					},
+				},
+			},
+		},
+		{
+			name:  "Two conditional branches",
+			input: `\begin{cases} 1, & \text{if } x \leq 1 \\ 0, & x > 1 \end{cases}`,
+			expectedExpression: &latex.PiecewiseExpressionNode{
+				Cases: []latex.PiecewiseCase{
+					{
+						Value: &latex.NumberExpression{Value: 1.0},
+						Condition: &latex.ComparisonExpressionNode{
+							LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+							Operator: string(latex.LessEqualOperator),
+							RHS:      &latex.NumberExpression{Value: 1.0},
+						},
+					},
+					{
+						Value: &latex.NumberExpression{Value: 0.0},
+						Condition: &latex.ComparisonExpressionNode{
+							LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+							Operator: string(latex.GreaterOperator),
+							RHS:      &latex.NumberExpression{Value: 1.0},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			result, err := parser.parser.ParseString("", test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedExpression, result.Expression.toLatexNode())
+		})
+	}
+}
+
+func TestEvaluatePiecewise(t *testing.T) {
+	t.Parallel()
+
+	input := `\begin{cases} 2 * x & x < 0 \\ x^2 & x >= 0 \end{cases}`
+
+	tt := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{name: "Left branch", x: -3, expected: -6},
+		{name: "Right branch", x: 3, expected: 9},
+		{name: "Boundary belongs to right branch", x: 0, expected: 0},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			node, err := parser.ParseExpression(t.Context(), input)
+			require.NoError(t, err)
+
+			result, err := latex.Evaluate(*node, latex.Environment{"x": test.x})
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, result, 1e-12)
+		})
+	}
+}
+
+func TestParseLogicalExpression(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name               string
+		input              string
+		expectedExpression latex.ExpressionNode
+	}{
+		{
+			name:  "x < 1",
+			input: `x < 1`,
+			expectedExpression: &latex.ComparisonExpressionNode{
+				LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+				Operator: string(latex.LessOperator),
+				RHS:      &latex.NumberExpression{Value: 1.0},
+			},
+		},
+		{
+			name:  `0 \leq x \land x \leq 1 \lor x = 2`,
+			input: `0 \leq x \land x \leq 1 \lor x = 2`,
+			expectedExpression: &latex.LogicalExpressionNode{
+				LHS: &latex.LogicalExpressionNode{
+					LHS: &latex.ComparisonExpressionNode{
+						LHS:      &latex.NumberExpression{Value: 0.0},
+						Operator: string(latex.LessEqualOperator),
+						RHS:      &latex.VariableExpressionNode{Identifier: "x"},
+					},
+					Operator: string(latex.AndOperator),
+					RHS: &latex.ComparisonExpressionNode{
+						LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+						Operator: string(latex.LessEqualOperator),
+						RHS:      &latex.NumberExpression{Value: 1.0},
+					},
+				},
+				Operator: string(latex.OrOperator),
+				RHS: &latex.ComparisonExpressionNode{
+					LHS:      &latex.VariableExpressionNode{Identifier: "x"},
+					Operator: string(latex.EqualOperator),
+					RHS:      &latex.NumberExpression{Value: 2.0},
+				},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			result, err := parser.parser.ParseString("", test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedExpression, result.Expression.toLatexNode())
+		})
+	}
+}
+
+func TestEvaluateComparison(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		x        float64
+		expected bool
+	}{
+		{name: "x < 1 left of the boundary", input: `x < 1`, x: 0.5, expected: true},
+		{name: "x < 1 right of the boundary", input: `x < 1`, x: 1.5, expected: false},
+		{name: "x < 1 at the boundary", input: `x < 1`, x: 1, expected: false},
+		{name: `x \geq 1 at the boundary`, input: `x \geq 1`, x: 1, expected: true},
+		{name: `\land inside`, input: `0 < x \land x < 1`, x: 0.5, expected: true},
+		{name: `\land outside`, input: `0 < x \land x < 1`, x: 2, expected: false},
+		{name: `\lor either side`, input: `x < 0 \lor x > 1`, x: 2, expected: true},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			node, err := parser.ParseExpression(t.Context(), test.input)
+			require.NoError(t, err)
+
+			holds, err := latex.EvaluateBool(*node, latex.Environment{"x": test.x})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, holds)
+
+			value, err := latex.Evaluate(*node, latex.Environment{"x": test.x})
+			require.NoError(t, err)
+			if test.expected {
+				assert.Equal(t, 1.0, value)
+			} else {
+				assert.Equal(t, 0.0, value)
+			}
+		})
+	}
+}
+
+func TestPowerAssociativity(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{name: "Tower 2^3^2 is 2^(3^2)", input: `2^3^2`, expected: 512},
+		{name: "Tower 2^2^3 is 2^(2^3)", input: `2^2^3`, expected: 256},
+		{name: "Braced tower", input: `2^{3^{2}}`, expected: 512},
+		{name: "Grouped base", input: `(2^3)^2`, expected: 64},
+		{name: "Sign binds looser than power", input: `-2^2`, expected: -4},
+		{name: "Parenthesized negative base", input: `(-2)^2`, expected: 4},
+		{name: "Negative exponent", input: `2^-1`, expected: 0.5},
+		{name: "Power before product", input: `3 * 2^2`, expected: 12},
+		{name: "Power of a juxtaposed coefficient", input: `-3x^2`, expected: -12},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			node, err := parser.ParseExpression(t.Context(), test.input)
+			require.NoError(t, err)
+
+			value, err := latex.Evaluate(*node, latex.Environment{"x": 2})
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, value, 1e-12)
+		})
+	}
+}
+
+func TestSubtractionAndDivisionAssociativity(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{name: "8-4-2 is (8-4)-2", input: `8-4-2`, expected: 2},
+		{name: "8/4/2 is (8/4)/2", input: `8/4/2`, expected: 1},
+		{name: "x-1-1 is (x-1)-1", input: `x-1-1`, expected: 2},
+		{name: "2/x/2 is (2/x)/2", input: `2/x/2`, expected: 0.25},
+		{name: "Mixed sum", input: `8-4+2`, expected: 6},
+		{name: "Mixed product", input: `8/4*2`, expected: 4},
+		{name: "Fraction in a chain", input: `8-\frac{4}{2}-2`, expected: 4},
+		{name: "Power stays right associative", input: `16/2^2^1`, expected: 4},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			node, err := parser.ParseExpression(t.Context(), test.input)
+			require.NoError(t, err)
+
+			value, err := latex.Evaluate(*node, latex.Environment{"x": 4})
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, value, 1e-12)
+		})
+	}
+}
+
+func TestPowerTreeIsRightAssociative(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	parser, err := NewParticipalLatexParser()
+	require.NoError(t, err)
+
+	// Act
+	result, err := parser.parser.ParseString("", `-2^3^2`)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, &latex.UnaryExpressionNode{
+		Operator: string(latex.MinusOperator),
+		SubExpression: &latex.BinaryExpressionNode{
+			LHS:      &latex.NumberExpression{Value: 2},
+			Operator: string(latex.PowerOperator),
+			RHS: &latex.BinaryExpressionNode{
+				LHS:      &latex.NumberExpression{Value: 3},
+				Operator: string(latex.PowerOperator),
+				RHS:      &latex.NumberExpression{Value: 2},
+			},
+		},
+	}, result.Expression.toLatexNode())
+}
+
+func TestChainedUnaryOperators(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected latex.ExpressionNode
+		value    float64
+	}{
+		{
+			name:  "Double negation",
+			input: `--5`,
+			expected: &latex.UnaryExpressionNode{
+				Operator: string(latex.MinusOperator),
+				SubExpression: &latex.UnaryExpressionNode{
+					Operator:      string(latex.MinusOperator),
+					SubExpression: &latex.NumberExpression{Value: 5},
+				},
+			},
+			value: 5,
+		},
+		{
+			name:  "Negated unary plus",
+			input: `-+3`,
+			expected: &latex.UnaryExpressionNode{
+				Operator: string(latex.MinusOperator),
+				SubExpression: &latex.UnaryExpressionNode{
+					Operator:      string(latex.PlusOperator),
+					SubExpression: &latex.NumberExpression{Value: 3},
+				},
+			},
+			value: -3,
+		},
+		{
+			name:  "Unary plus of a negation",
+			input: `+-2`,
+			expected: &latex.UnaryExpressionNode{
+				Operator: string(latex.PlusOperator),
+				SubExpression: &latex.UnaryExpressionNode{
+					Operator:      string(latex.MinusOperator),
+					SubExpression: &latex.NumberExpression{Value: 2},
+				},
+			},
+			value: -2,
+		},
+		{
+			name:  "Double negation of a variable",
+			input: `--x`,
+			expected: &latex.UnaryExpressionNode{
+				Operator: string(latex.MinusOperator),
+				SubExpression: &latex.UnaryExpressionNode{
+					Operator:      string(latex.MinusOperator),
+					SubExpression: &latex.VariableExpressionNode{Identifier: "x"},
+				},
+			},
+			value: 7,
+		},
+		{
+			name:  "Negated parenthesized negation",
+			input: `-(-x)`,
+			expected: &latex.UnaryExpressionNode{
+				Operator: string(latex.MinusOperator),
+				SubExpression: &latex.UnaryExpressionNode{
+					Operator:      string(latex.MinusOperator),
+					SubExpression: &latex.VariableExpressionNode{Identifier: "x"},
+				},
+			},
+			value: 7,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			// Act
+			node, err := parser.ParseExpression(t.Context(), test.input)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, *node)
+
+			value, err := latex.Evaluate(*node, latex.Environment{"x": 7})
+			require.NoError(t, err)
+			assert.Equal(t, test.value, value)
+		})
+	}
+}
+
+func TestParseSizedDelimiters(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{name: "Sized parentheses", input: `\left(1+2\right)^3`, expected: 27},
+		{name: "Sized brackets", input: `\left[x+1\right]^2`, expected: 9},
+		{name: "Absolute value", input: `\left|x-5\right|`, expected: 3},
+		{name: "Absolute value of a positive", input: `\left|5-x\right|`, expected: 3},
+		{name: "Juxtaposed group", input: `2\left(x+1\right)`, expected: 6},
+		{name: "Nested groups", input: `\left[\left|1-x\right|+1\right]^2`, expected: 4},
+		{name: "Fraction of groups", input: `\frac{\left(x+2\right)}{\left|-x\right|}`, expected: 2},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			// Act
+			node, err := parser.ParseExpression(t.Context(), test.input)
+
+			// Assert
+			require.NoError(t, err)
+			value, err := latex.Evaluate(*node, latex.Environment{"x": 2})
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, value, 1e-12)
+		})
+	}
+}
+
+func TestSizedParenthesesGroupLikeBareOnes(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	parser, err := NewParticipalLatexParser()
+	require.NoError(t, err)
+
+	// Act
+	sized, err := parser.ParseExpression(t.Context(), `\left(1+2\right)^3`)
+	require.NoError(t, err)
+	bare, err := parser.ParseExpression(t.Context(), `(1+2)^3`)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, *bare, *sized)
+	assert.Equal(t, &latex.BinaryExpressionNode{
+		LHS: &latex.BinaryExpressionNode{
+			LHS:      &latex.NumberExpression{Value: 1},
+			Operator: string(latex.PlusOperator),
+			RHS:      &latex.NumberExpression{Value: 2},
+		},
+		Operator: string(latex.PowerOperator),
+		RHS:      &latex.NumberExpression{Value: 3},
+	}, *sized)
+}
+
+func TestParseSizedAbsoluteValue(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	parser, err := NewParticipalLatexParser()
+	require.NoError(t, err)
+
+	// Act
+	node, err := parser.ParseExpression(t.Context(), `\left|x\right|`)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, &latex.AbsoluteValueExpressionNode{
+		SubExpression: &latex.VariableExpressionNode{Identifier: "x"},
+	}, *node)
+}
+
+func TestParseFormattedExpression(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	parser, err := NewParticipalLatexParser()
+	require.NoError(t, err)
+	node, err := parser.ParseExpression(t.Context(), `(x+1)^2 - \left|x - 4\right|`)
+	require.NoError(t, err)
+
+	// Act
+	reparsed, err := parser.ParseExpression(t.Context(), latex.Format(*node))
+
+	// Assert
+	require.NoError(t, err)
+	for _, point := range []float64{-1, 0.5, 3} {
+		expected, err := latex.Evaluate(*node, latex.Environment{"x": point})
+		require.NoError(t, err)
+		value, err := latex.Evaluate(*reparsed, latex.Environment{"x": point})
+		require.NoError(t, err)
+		assert.InDelta(t, expected, value, 1e-12)
+	}
+}
+
+func TestParseMismatchedSizedDelimiters(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name  string
+		input string
+	}{
+		{name: "Parenthesis closed by a bracket", input: `\left(x\right]`},
+		{name: "Bar closed by a parenthesis", input: `\left|x\right)`},
+		{name: "Unclosed", input: `\left(x+1`},
+		{name: "Bare right", input: `x\right)`},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			parser, err := NewParticipalLatexParser()
+			require.NoError(t, err)
+
+			// Act
+			_, err = parser.ParseExpression(t.Context(), test.input)
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package precision
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+var ErrUnknownArithmetic = errors.New("unknown arithmetic")
+
+// Arithmetic is the number representation sums are accumulated in. The
+// integrand and the matrix entries stay float64 either way, only the
+// accumulation is extended, which is where cancellation loses digits.
+type Arithmetic string
+
+const (
+	// Float64 accumulates in hardware floats, about 16 significant digits
+	Float64 Arithmetic = "float64"
+	// BigFloat accumulates in big.Float with a BigFloatPrecision bit mantissa.
+	// Every addition allocates and runs in software, so it is one to two
+	// orders of magnitude slower than Float64, which is only worth it to show
+	// what rounding costs on ill-conditioned inputs
+	BigFloat Arithmetic = "bigfloat"
+)
+
+// BigFloatPrecision is the mantissa of the BigFloat arithmetic, in bits. It
+// holds every product of two float64 exactly and sums of them spanning the
+// whole exponent range of the terms most real inputs have.
+const BigFloatPrecision = 512
+
+// Arithmetics returns every arithmetic, the cheapest first.
+func Arithmetics() []Arithmetic {
+	return []Arithmetic{Float64, BigFloat}
+}
+
+// ParseArithmetic returns the arithmetic named name, the empty name being
+// Float64.
+func ParseArithmetic(name string) (Arithmetic, error) {
+	switch arithmetic := Arithmetic(name); arithmetic {
+	case "":
+		return Float64, nil
+	case Float64, BigFloat:
+		return arithmetic, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownArithmetic, name)
+	}
+}
+
+// Accumulator sums terms and products in its arithmetic. The zero value
+// accumulates in Float64.
+type Accumulator struct {
+	sum      float64
+	extended *big.Float
+	// nan is set once the extended sum is undefined, as big.Float panics on
+	// NaN instead of propagating it
+	nan bool
+}
+
+// NewAccumulator returns an empty accumulator in arithmetic.
+func NewAccumulator(arithmetic Arithmetic) *Accumulator {
+	if arithmetic == BigFloat {
+		return &Accumulator{extended: new(big.Float).SetPrec(BigFloatPrecision)}
+	}
+
+	return &Accumulator{}
+}
+
+// Add adds x to the sum.
+func (a *Accumulator) Add(x float64) {
+	if a.extended == nil {
+		a.sum += x
+		return
+	}
+
+	if math.IsNaN(x) {
+		a.nan = true
+		return
+	}
+
+	a.addExtended(big.NewFloat(x))
+}
+
+// AddProduct adds x·y to the sum, the product being exact in BigFloat.
+func (a *Accumulator) AddProduct(x, y float64) {
+	if a.extended == nil {
+		a.sum += x * y
+		return
+	}
+
+	if math.IsNaN(x * y) {
+		a.nan = true
+		return
+	}
+
+	product := new(big.Float).SetPrec(BigFloatPrecision).SetFloat64(x)
+	product.Mul(product, big.NewFloat(y))
+	a.addExtended(product)
+}
+
+// addExtended adds term to the extended sum, which becomes NaN when term and
+// the sum are opposite infinities.
+func (a *Accumulator) addExtended(term *big.Float) {
+	if a.nan || (a.extended.IsInf() && term.IsInf() && a.extended.Sign() != term.Sign()) {
+		a.nan = true
+		return
+	}
+
+	a.extended.Add(a.extended, term)
+}
+
+// Float64 returns the sum, rounded to the nearest float64.
+func (a *Accumulator) Float64() float64 {
+	if a.extended == nil {
+		return a.sum
+	}
+
+	if a.nan {
+		return math.NaN()
+	}
+
+	value, _ := a.extended.Float64()
+	return value
+}
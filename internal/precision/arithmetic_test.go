@@ -0,0 +1,138 @@
+package precision
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccumulatorKeepsTheDigitsFloat64Loses(t *testing.T) {
+	t.Parallel()
+
+	// 1e16 + 1 rounds back to 1e16, as float64 spacing is 2 there, so the
+	// ones vanish before the large terms cancel
+	terms := []float64{1e16, 1, 1, 1, -1e16}
+
+	tt := []struct {
+		arithmetic Arithmetic
+		expected   float64
+	}{
+		{arithmetic: Float64, expected: 0},
+		{arithmetic: BigFloat, expected: 3},
+	}
+
+	for _, test := range tt {
+		t.Run(string(test.arithmetic), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			sum := NewAccumulator(test.arithmetic)
+
+			// Act
+			for _, term := range terms {
+				sum.Add(term)
+			}
+
+			// Assert
+			assert.Equal(t, test.expected, sum.Float64())
+		})
+	}
+}
+
+func TestAccumulatorProductsAreExactInBigFloat(t *testing.T) {
+	t.Parallel()
+
+	// (1 + 2⁻³⁰)(1 - 2⁻³⁰) = 1 - 2⁻⁶⁰, which float64 rounds to 1
+	epsilon := math.Ldexp(1, -30)
+
+	tt := []struct {
+		arithmetic Arithmetic
+		expected   float64
+	}{
+		{arithmetic: Float64, expected: 0},
+		{arithmetic: BigFloat, expected: -math.Ldexp(1, -60)},
+	}
+
+	for _, test := range tt {
+		t.Run(string(test.arithmetic), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			sum := NewAccumulator(test.arithmetic)
+
+			// Act
+			sum.AddProduct(1+epsilon, 1-epsilon)
+			sum.Add(-1)
+
+			// Assert
+			assert.Equal(t, test.expected, sum.Float64())
+		})
+	}
+}
+
+func TestAccumulatorPropagatesNaN(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name string
+		add  func(sum *Accumulator)
+	}{
+		{name: "NaN", add: func(sum *Accumulator) { sum.Add(math.NaN()) }},
+		{name: "OppositeInfinities", add: func(sum *Accumulator) { sum.Add(math.Inf(1)); sum.Add(math.Inf(-1)) }},
+		{name: "ZeroTimesInfinity", add: func(sum *Accumulator) { sum.AddProduct(0, math.Inf(1)) }},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			sum := NewAccumulator(BigFloat)
+
+			// Act
+			assert.NotPanics(t, func() { test.add(sum) })
+			sum.Add(1)
+
+			// Assert
+			assert.True(t, math.IsNaN(sum.Float64()))
+		})
+	}
+}
+
+func TestParseArithmetic(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected Arithmetic
+	}{
+		{name: "Empty", input: "", expected: Float64},
+		{name: "Float64", input: "float64", expected: Float64},
+		{name: "BigFloat", input: "bigfloat", expected: BigFloat},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			arithmetic, err := ParseArithmetic(test.input)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, arithmetic)
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+
+		// Act
+		_, err := ParseArithmetic("float128")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnknownArithmetic)
+	})
+}
@@ -0,0 +1,99 @@
+// Package precision holds the numeric profiles, which trade speed for
+// accuracy by setting the defaults of every numerical method at once.
+package precision
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownProfile = errors.New("unknown precision profile")
+
+// Profile names a set of numeric defaults.
+type Profile string
+
+const (
+	Fast     Profile = "fast"
+	Balanced Profile = "balanced"
+	Accurate Profile = "accurate"
+)
+
+// Defaults are the parameters a computation uses when the user leaves them
+// out.
+type Defaults struct {
+	// Epsilon stops the iterative methods, the power methods and the
+	// iterative linear solvers, once the change between iterates is below it
+	Epsilon float64
+	// MaxIterations bounds the iterative methods
+	MaxIterations uint64
+	// IntegralTolerance is the error the accurate integration mode aims for
+	IntegralTolerance float64
+	// Partitions is the number of partitions of a fixed grid integration
+	Partitions uint64
+	// QuadratureOrder is the order of the Newton-Cotes formula
+	QuadratureOrder int
+	// DecompositionTolerance and DecompositionMaxIterations drive the QR
+	// iterations of the complete eigendecomposition
+	DecompositionTolerance     float64
+	DecompositionMaxIterations int
+}
+
+var profiles = map[Profile]Defaults{
+	Fast: {
+		Epsilon:                    1e-4,
+		MaxIterations:              50,
+		IntegralTolerance:          1e-5,
+		Partitions:                 8,
+		QuadratureOrder:            1,
+		DecompositionTolerance:     1e-8,
+		DecompositionMaxIterations: 200,
+	},
+	Balanced: {
+		Epsilon:                    1e-6,
+		MaxIterations:              100,
+		IntegralTolerance:          1e-8,
+		Partitions:                 16,
+		QuadratureOrder:            2,
+		DecompositionTolerance:     1e-12,
+		DecompositionMaxIterations: 1000,
+	},
+	Accurate: {
+		Epsilon:                    1e-10,
+		MaxIterations:              1000,
+		IntegralTolerance:          1e-11,
+		Partitions:                 64,
+		QuadratureOrder:            3,
+		DecompositionTolerance:     1e-14,
+		DecompositionMaxIterations: 5000,
+	},
+}
+
+// Profiles returns the profiles from the fastest to the most accurate.
+func Profiles() []Profile {
+	return []Profile{Fast, Balanced, Accurate}
+}
+
+// ParseProfile returns the profile named name, the empty name being Balanced.
+func ParseProfile(name string) (Profile, error) {
+	if name == "" {
+		return Balanced, nil
+	}
+
+	profile := Profile(name)
+	if _, ok := profiles[profile]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownProfile, name)
+	}
+
+	return profile, nil
+}
+
+// Defaults returns the defaults of the profile. The zero Profile, and any
+// unknown one, gets the Balanced defaults, so an unset profile keeps the
+// historical behavior.
+func (p Profile) Defaults() Defaults {
+	if defaults, ok := profiles[p]; ok {
+		return defaults
+	}
+
+	return profiles[Balanced]
+}
@@ -0,0 +1,82 @@
+package precision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfilesTradeSpeedForAccuracy(t *testing.T) {
+	t.Parallel()
+
+	profiles := Profiles()
+	for i := 1; i < len(profiles); i++ {
+		faster, slower := profiles[i-1].Defaults(), profiles[i].Defaults()
+
+		t.Run(string(profiles[i]), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			// Assert
+			assert.Less(t, slower.Epsilon, faster.Epsilon)
+			assert.Greater(t, slower.MaxIterations, faster.MaxIterations)
+			assert.Less(t, slower.IntegralTolerance, faster.IntegralTolerance)
+			assert.Greater(t, slower.Partitions, faster.Partitions)
+			assert.Greater(t, slower.QuadratureOrder, faster.QuadratureOrder)
+			assert.Less(t, slower.DecompositionTolerance, faster.DecompositionTolerance)
+			assert.Greater(t, slower.DecompositionMaxIterations, faster.DecompositionMaxIterations)
+		})
+	}
+}
+
+func TestParseProfile(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected Profile
+	}{
+		{name: "Empty", input: "", expected: Balanced},
+		{name: "Fast", input: "fast", expected: Fast},
+		{name: "Accurate", input: "accurate", expected: Accurate},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			profile, err := ParseProfile(test.input)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, profile)
+		})
+	}
+}
+
+func TestParseProfileRejectsUnknownProfiles(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	_, err := ParseProfile("reckless")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownProfile)
+}
+
+func TestUnknownProfileGetsBalancedDefaults(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	defaults := Profile("").Defaults()
+
+	// Assert
+	assert.Equal(t, Balanced.Defaults(), defaults)
+}
@@ -0,0 +1,163 @@
+// Package reports renders computations as documents for lab reports.
+package reports
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+// EigenReport is an eigen computation as written up in a report: the power
+// method run on Matrix and, when the matrix is symmetric, its complete
+// decomposition A = VΛVᵀ.
+type EigenReport struct {
+	Matrix [][]float64
+	Method usecases.PowerMethod
+	Params usecases.PowerParams
+	Result *usecases.PowerResult
+	// Decomposition is left out of the report when nil
+	Decomposition *usecases.EigenDecomposition
+}
+
+// Document renders the report as a standalone LaTeX document.
+func (r EigenReport) Document() string {
+	var b strings.Builder
+
+	b.WriteString("\\documentclass{article}\n")
+	b.WriteString("\\usepackage{amsmath}\n")
+	b.WriteString("\\begin{document}\n\n")
+	b.WriteString(r.Body())
+	b.WriteString("\n\\end{document}\n")
+
+	return b.String()
+}
+
+// Body renders the sections of the report, ready to paste into an existing
+// document loading amsmath.
+func (r EigenReport) Body() string {
+	var b strings.Builder
+
+	b.WriteString("\\section*{Eigen computation}\n\n")
+
+	b.WriteString("\\subsection*{Input matrix}\n\n")
+	fmt.Fprintf(&b, "\\[\nA = %s\n\\]\n\n", matrixLaTeX(r.Matrix))
+
+	fmt.Fprintf(&b, "\\subsection*{Method: %s}\n\n", r.Method.Describe())
+	b.WriteString(r.iterationLaTeX())
+
+	b.WriteString("\\subsection*{Result}\n\n")
+	if r.Result != nil {
+		fmt.Fprintf(&b, "After %d iterations:\n", r.Result.NumIterations)
+		fmt.Fprintf(&b, "\\[\n\\lambda = %s, \\qquad v = %s\n\\]\n\n",
+			numberLaTeX(r.Result.Eigenvalue), vectorLaTeX(r.Result.Eigenvector))
+	}
+
+	if r.Decomposition != nil {
+		b.WriteString(r.decompositionLaTeX())
+	}
+
+	return b.String()
+}
+
+// iterationLaTeX is the iteration formula of the method, its parameters and
+// its stopping criterion.
+func (r EigenReport) iterationLaTeX() string {
+	var b strings.Builder
+
+	b.WriteString("Starting from $x_0 = y_0 / \\|y_0\\|_2$, each iteration computes\n\\[\n")
+	switch r.Method {
+	case usecases.PowerMethodInverse:
+		b.WriteString("y_{k+1} = A^{-1} x_k, \\quad \\mu_{k+1} = x_k^{T} y_{k+1}, \\quad " +
+			"x_{k+1} = \\frac{y_{k+1}}{\\|y_{k+1}\\|_2}, \\quad \\lambda_{k+1} = \\frac{1}{\\mu_{k+1}}\n")
+	case usecases.PowerMethodFarthest:
+		b.WriteString("y_{k+1} = (A - \\sigma I) x_k, \\quad \\mu_{k+1} = x_k^{T} y_{k+1}, \\quad " +
+			"x_{k+1} = \\frac{y_{k+1}}{\\|y_{k+1}\\|_2}, \\quad \\lambda_{k+1} = \\mu_{k+1} + \\sigma\n")
+	case usecases.PowerMethodNearest:
+		b.WriteString("y_{k+1} = (A - \\sigma I)^{-1} x_k, \\quad \\mu_{k+1} = x_k^{T} y_{k+1}, \\quad " +
+			"x_{k+1} = \\frac{y_{k+1}}{\\|y_{k+1}\\|_2}, \\quad \\lambda_{k+1} = \\frac{1}{\\mu_{k+1}} + \\sigma\n")
+	default:
+		b.WriteString("y_{k+1} = A x_k, \\quad \\mu_{k+1} = x_k^{T} y_{k+1}, \\quad " +
+			"x_{k+1} = \\frac{y_{k+1}}{\\|y_{k+1}\\|_2}, \\quad \\lambda_{k+1} = \\mu_{k+1}\n")
+	}
+	b.WriteString("\\]\n")
+
+	if r.Method.UsesShift() {
+		fmt.Fprintf(&b, "with the shift $\\sigma = %s$, ", numberLaTeX(r.Params.Shift))
+	}
+	fmt.Fprintf(&b, "stopping once $\\left|\\frac{\\mu_{k+1} - \\mu_k}{\\mu_{k+1}}\\right| < \\varepsilon = %s$ "+
+		"or after %d iterations.\n\n", numberLaTeX(r.Params.Epsilon), r.Params.MaxIterations)
+
+	return b.String()
+}
+
+// decompositionLaTeX writes out A = VΛVᵀ and how well it reconstructs A.
+func (r EigenReport) decompositionLaTeX() string {
+	var b strings.Builder
+
+	d := r.Decomposition
+
+	diagonal := make([][]float64, len(d.Eigenvalues))
+	for i, eigenvalue := range d.Eigenvalues {
+		diagonal[i] = make([]float64, len(d.Eigenvalues))
+		diagonal[i][i] = eigenvalue
+	}
+
+	transposed := make([][]float64, len(d.Eigenvalues))
+	for i := range transposed {
+		transposed[i] = make([]float64, len(d.Eigenvectors))
+		for j, row := range d.Eigenvectors {
+			transposed[i][j] = row[i]
+		}
+	}
+
+	b.WriteString("\\subsection*{Reconstruction}\n\n")
+	fmt.Fprintf(&b, "The complete decomposition took %d iterations:\n", d.Iterations)
+	fmt.Fprintf(&b, "\\[\nA = V \\Lambda V^{T} = %s %s %s\n\\]\n",
+		matrixLaTeX(d.Eigenvectors), matrixLaTeX(diagonal), matrixLaTeX(transposed))
+	fmt.Fprintf(&b, "with $\\|A - V \\Lambda V^{T}\\|_F = %s$.\n", numberLaTeX(d.ReconstructionError))
+
+	return b.String()
+}
+
+func matrixLaTeX(matrix [][]float64) string {
+	rows := make([]string, len(matrix))
+	for i, row := range matrix {
+		cells := make([]string, len(row))
+		for j, value := range row {
+			cells[j] = numberLaTeX(value)
+		}
+		rows[i] = strings.Join(cells, " & ")
+	}
+
+	return "\\begin{bmatrix} " + strings.Join(rows, " \\\\ ") + " \\end{bmatrix}"
+}
+
+// vectorLaTeX writes vector as a column.
+func vectorLaTeX(vector []float64) string {
+	column := make([][]float64, len(vector))
+	for i, value := range vector {
+		column[i] = []float64{value}
+	}
+
+	return matrixLaTeX(column)
+}
+
+// numberLaTeX keeps six significant digits, writing exponents as powers of
+// ten.
+func numberLaTeX(value float64) string {
+	formatted := strconv.FormatFloat(value, 'g', 6, 64)
+
+	mantissa, exponent, found := strings.Cut(formatted, "e")
+	if !found {
+		return formatted
+	}
+
+	power, err := strconv.Atoi(exponent)
+	if err != nil {
+		return formatted
+	}
+
+	return fmt.Sprintf("%s \\times 10^{%d}", mantissa, power)
+}
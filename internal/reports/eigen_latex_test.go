@@ -0,0 +1,137 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestEigenReportBody(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	report := EigenReport{
+		Matrix: [][]float64{{2, 0}, {0, 1}},
+		Method: usecases.PowerMethodRegular,
+		Params: usecases.PowerParams{Epsilon: 1e-6, MaxIterations: 100},
+		Result: &usecases.PowerResult{Eigenvalue: 2, Eigenvector: []float64{1, 0}, NumIterations: 3},
+		Decomposition: &usecases.EigenDecomposition{
+			Eigenvalues:         []float64{2, 1},
+			Eigenvectors:        [][]float64{{1, 0}, {0, 1}},
+			Iterations:          1,
+			ReconstructionError: 0,
+		},
+	}
+
+	// Act
+	body := report.Body()
+
+	// Assert
+	expected := "\\section*{Eigen computation}\n\n" +
+		"\\subsection*{Input matrix}\n\n" +
+		"\\[\nA = \\begin{bmatrix} 2 & 0 \\\\ 0 & 1 \\end{bmatrix}\n\\]\n\n" +
+		"\\subsection*{Method: Regular Power Method}\n\n" +
+		"Starting from $x_0 = y_0 / \\|y_0\\|_2$, each iteration computes\n\\[\n" +
+		"y_{k+1} = A x_k, \\quad \\mu_{k+1} = x_k^{T} y_{k+1}, \\quad " +
+		"x_{k+1} = \\frac{y_{k+1}}{\\|y_{k+1}\\|_2}, \\quad \\lambda_{k+1} = \\mu_{k+1}\n\\]\n" +
+		"stopping once $\\left|\\frac{\\mu_{k+1} - \\mu_k}{\\mu_{k+1}}\\right| < \\varepsilon = 1 \\times 10^{-6}$ " +
+		"or after 100 iterations.\n\n" +
+		"\\subsection*{Result}\n\n" +
+		"After 3 iterations:\n" +
+		"\\[\n\\lambda = 2, \\qquad v = \\begin{bmatrix} 1 \\\\ 0 \\end{bmatrix}\n\\]\n\n" +
+		"\\subsection*{Reconstruction}\n\n" +
+		"The complete decomposition took 1 iterations:\n" +
+		"\\[\nA = V \\Lambda V^{T} = \\begin{bmatrix} 1 & 0 \\\\ 0 & 1 \\end{bmatrix} " +
+		"\\begin{bmatrix} 2 & 0 \\\\ 0 & 1 \\end{bmatrix} \\begin{bmatrix} 1 & 0 \\\\ 0 & 1 \\end{bmatrix}\n\\]\n" +
+		"with $\\|A - V \\Lambda V^{T}\\|_F = 0$.\n"
+	assert.Equal(t, expected, body)
+}
+
+func TestEigenReportOfAComputation(t *testing.T) {
+	t.Parallel()
+
+	matrix := [][]float64{{2, 1}, {1, 2}}
+
+	tt := []struct {
+		name    string
+		method  usecases.PowerMethod
+		formula string
+	}{
+		{name: "Regular", method: usecases.PowerMethodRegular, formula: "y_{k+1} = A x_k"},
+		{name: "Inverse", method: usecases.PowerMethodInverse, formula: "y_{k+1} = A^{-1} x_k"},
+		{name: "Farthest", method: usecases.PowerMethodFarthest, formula: "y_{k+1} = (A - \\sigma I) x_k"},
+		{name: "Nearest", method: usecases.PowerMethodNearest, formula: "y_{k+1} = (A - \\sigma I)^{-1} x_k"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			params := usecases.PowerParams{InitialGuess: []float64{1, 0}, Shift: 0.5, Epsilon: 1e-10, MaxIterations: 1000}
+			result, err := usecases.NewPowerUseCase().Solve(t.Context(), test.method, matrix, params)
+			require.NoError(t, err)
+			decomposition, err := usecases.NewSimilarityTransformationUseCase().DecomposeSymmetric(t.Context(), matrix, 1000, 1e-12)
+			require.NoError(t, err)
+			report := EigenReport{Matrix: matrix, Method: test.method, Params: params, Result: result, Decomposition: decomposition}
+
+			// Act
+			document := report.Document()
+
+			// Assert
+			assert.True(t, strings.HasPrefix(document, "\\documentclass{article}\n"))
+			assert.True(t, strings.HasSuffix(document, "\\end{document}\n"))
+			assert.Contains(t, document, "A = \\begin{bmatrix} 2 & 1 \\\\ 1 & 2 \\end{bmatrix}")
+			assert.Contains(t, document, test.formula)
+			assert.Contains(t, document, "\\lambda = "+numberLaTeX(result.Eigenvalue))
+			assert.Contains(t, document, "\\begin{bmatrix} 3 & 0 \\\\ 0 & 1 \\end{bmatrix}")
+			assert.Equal(t, test.method.UsesShift(), strings.Contains(document, "\\sigma = 0.5"))
+		})
+	}
+}
+
+func TestEigenReportLeavesOutMissingDecomposition(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	report := EigenReport{
+		Matrix: [][]float64{{1, 2}, {0, 3}},
+		Method: usecases.PowerMethodRegular,
+		Result: &usecases.PowerResult{Eigenvalue: 3, Eigenvector: []float64{0.707107, 0.707107}},
+	}
+
+	// Act
+	body := report.Body()
+
+	// Assert
+	assert.NotContains(t, body, "Reconstruction")
+}
+
+func TestNumberLaTeX(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		value    float64
+		expected string
+	}{
+		{value: 2, expected: "2"},
+		{value: -0.125, expected: "-0.125"},
+		{value: 1.0 / 3, expected: "0.333333"},
+		{value: 2.5e-12, expected: "2.5 \\times 10^{-12}"},
+		{value: 1e21, expected: "1 \\times 10^{21}"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.expected, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			formatted := numberLaTeX(test.value)
+
+			// Assert
+			assert.Equal(t, test.expected, formatted)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+// maxDerivativeOrder bounds the order of a derivative, higher differences
+// being dominated by round-off for any usable delta.
+const maxDerivativeOrder = 4
+
+// defaultPhilosophy is the difference philosophy when the request leaves it
+// out, the most accurate for a given delta.
+const defaultPhilosophy = "central"
+
+// maxRefinedOrder is the highest order the refinement halves delta for.
+const maxRefinedOrder = 2
+
+var (
+	ErrInvalidDerivativeOrder = fmt.Errorf("derivative order must be between 1 and %d", maxDerivativeOrder)
+	ErrRefinedOrder           = fmt.Errorf("only derivatives up to order %d can be refined", maxRefinedOrder)
+)
+
+// DerivativeRequest differentiates an expression of Variable at Point with a
+// finite difference of step Delta.
+type DerivativeRequest struct {
+	Expression string  `json:"expression"`
+	Variable   string  `json:"variable"`
+	Point      float64 `json:"point"`
+	// Philosophy is forward, backward or central, central when empty
+	Philosophy string `json:"philosophy"`
+	// Order is the derivative order, 1 when zero
+	Order int     `json:"order"`
+	Delta float64 `json:"delta"`
+	// Refine halves Delta until successive estimates agree within the
+	// profile epsilon, for the first and second derivatives
+	Refine bool `json:"refine,omitempty"`
+}
+
+type DerivativeResponse struct {
+	Expression string  `json:"expression"`
+	Philosophy string  `json:"philosophy"`
+	Order      int     `json:"order"`
+	Point      float64 `json:"point"`
+	// Delta is the step of the final estimate, smaller than the requested
+	// one after a refinement
+	Delta      float64 `json:"delta"`
+	Derivative float64 `json:"derivative"`
+	// Iterations and Converged are only set for a refined derivative
+	Iterations uint64 `json:"iterations,omitempty"`
+	Converged  *bool  `json:"converged,omitempty"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r DerivativeResponse) MarshalCSV() ([]string, [][]string) {
+	converged := ""
+	if r.Converged != nil {
+		converged = strconv.FormatBool(*r.Converged)
+	}
+
+	return []string{"expression", "philosophy", "order", "point", "delta", "derivative", "iterations", "converged"},
+		[][]string{{
+			r.Expression,
+			r.Philosophy,
+			strconv.Itoa(r.Order),
+			formatFloat(r.Point),
+			formatFloat(r.Delta),
+			formatFloat(r.Derivative),
+			strconv.FormatUint(r.Iterations, 10),
+			converged,
+		}}
+}
+
+// DerivativeHandler computes the derivative of an expression at a point with
+// a finite difference.
+func (s *Server) DerivativeHandler(c echo.Context) error {
+	var req DerivativeRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if req.Variable == "" {
+		req.Variable = defaultVariable
+	}
+	if req.Philosophy == "" {
+		req.Philosophy = defaultPhilosophy
+	}
+	if req.Order == 0 {
+		req.Order = 1
+	}
+	logComputation(c, req)
+
+	switch {
+	case req.Delta == 0:
+		return echo.NewHTTPError(http.StatusBadRequest, usecases.ErrDeltaIsZero.Error())
+	case req.Order < 1 || req.Order > maxDerivativeOrder:
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s: %d", ErrInvalidDerivativeOrder, req.Order))
+	case req.Refine && req.Order > maxRefinedOrder:
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s: %d", ErrRefinedOrder, req.Order))
+	}
+
+	philosophy, err := usecases.ParseDifferenceStrategy(req.Philosophy)
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	expr, guard, err := s.compileExpression(ctx, req.Variable, req.Expression)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	useCase := usecases.NewDerivativeUseCase(philosophy)
+	response := DerivativeResponse{
+		Expression: req.Expression,
+		Philosophy: req.Philosophy,
+		Order:      req.Order,
+		Point:      req.Point,
+		Delta:      req.Delta,
+	}
+
+	if req.Refine {
+		defaults := s.profile.Defaults()
+		refine := useCase.Derivative
+		if req.Order == 2 {
+			refine = useCase.SecondDerivative
+		}
+
+		refined, err := refine(ctx, req.Point, expr, req.Delta, defaults.Epsilon, defaults.MaxIterations)
+		if err := evaluationError(guard, err); err != nil {
+			return err
+		}
+
+		response.Delta = refined.Delta
+		response.Derivative = refined.Derivative
+		response.Iterations = refined.Iterations
+		response.Converged = &refined.Converged
+		return Respond(c, http.StatusOK, response)
+	}
+
+	derivatives, err := useCase.DerivativesUpTo(ctx, expr, req.Point, req.Order, req.Delta, nil)
+	if err := evaluationError(guard, err); err != nil {
+		return err
+	}
+
+	derivative := derivatives[req.Order-1]
+	if math.IsNaN(derivative) || math.IsInf(derivative, 0) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity,
+			fmt.Sprintf("%s: %v", usecases.ErrNonFiniteDerivative, derivative))
+	}
+
+	response.Derivative = derivative
+	return Respond(c, http.StatusOK, response)
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestDerivativeHandler(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name      string
+		body      string
+		expected  float64
+		tolerance float64
+	}{
+		{
+			name:      "SquareCentral",
+			body:      `{"expression": "x^2", "point": 3, "delta": 0.001}`,
+			expected:  6,
+			tolerance: 1e-8,
+		},
+		{
+			name:      "SquareForward",
+			body:      `{"expression": "x^2", "point": 3, "philosophy": "forward", "delta": 0.001}`,
+			expected:  6,
+			tolerance: 2e-3,
+		},
+		{
+			name:      "SquareSecondOrder",
+			body:      `{"expression": "x^2", "point": -1, "philosophy": "backward", "order": 2, "delta": 0.01}`,
+			expected:  2,
+			tolerance: 1e-6,
+		},
+		{
+			name:      "Sine",
+			body:      `{"expression": "\\sin{x}", "point": 0, "delta": 0.001}`,
+			expected:  1,
+			tolerance: 1e-6,
+		},
+		{
+			name:      "SineSecondOrder",
+			body:      `{"expression": "\\sin{x}", "point": 1, "order": 2, "delta": 0.001}`,
+			expected:  -math.Sin(1),
+			tolerance: 1e-5,
+		},
+		{
+			name:      "OtherVariable",
+			body:      `{"expression": "\\sin{t}", "variable": "t", "point": 0, "delta": 0.001}`,
+			expected:  1,
+			tolerance: 1e-6,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/derivative", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := newTestServer(t)
+
+			// Act
+			err := s.DerivativeHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.Code)
+
+			var body DerivativeResponse
+			require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+			assert.InDelta(t, test.expected, body.Derivative, test.tolerance)
+		})
+	}
+}
+
+func TestDerivativeHandlerRefinesTheDelta(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		body     string
+		expected float64
+	}{
+		{
+			name:     "FirstOrder",
+			body:     `{"expression": "\\sin{x}", "point": 1, "delta": 0.5, "refine": true}`,
+			expected: math.Cos(1),
+		},
+		{
+			name:     "SecondOrder",
+			body:     `{"expression": "\\sin{x}", "point": 1, "order": 2, "delta": 0.5, "refine": true}`,
+			expected: -math.Sin(1),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/derivative", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := newTestServer(t)
+
+			// Act
+			err := s.DerivativeHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.Code)
+
+			var body DerivativeResponse
+			require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+			require.NotNil(t, body.Converged)
+			assert.True(t, *body.Converged)
+			assert.Positive(t, body.Iterations)
+			assert.Less(t, body.Delta, 0.5)
+			assert.InDelta(t, test.expected, body.Derivative, 1e-4)
+		})
+	}
+}
+
+func TestDerivativeHandlerRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name    string
+		body    string
+		status  int
+		message string
+	}{
+		{
+			name:    "ZeroDelta",
+			body:    `{"expression": "x^2", "point": 1}`,
+			status:  http.StatusBadRequest,
+			message: usecases.ErrDeltaIsZero.Error(),
+		},
+		{
+			name:    "OrderTooHigh",
+			body:    `{"expression": "x^2", "point": 1, "order": 5, "delta": 0.1}`,
+			status:  http.StatusBadRequest,
+			message: ErrInvalidDerivativeOrder.Error(),
+		},
+		{
+			name:    "RefinedOrderTooHigh",
+			body:    `{"expression": "x^3", "point": 1, "order": 3, "delta": 0.1, "refine": true}`,
+			status:  http.StatusBadRequest,
+			message: ErrRefinedOrder.Error(),
+		},
+		{
+			name:   "ParseError",
+			body:   `{"expression": "x^", "point": 1, "delta": 0.1}`,
+			status: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "BareFunctionArgument",
+			body:   `{"expression": "\\sin x^2", "point": 1, "delta": 0.1}`,
+			status: http.StatusUnprocessableEntity,
+		},
+		{
+			name:    "UnknownPhilosophy",
+			body:    `{"expression": "x^2", "point": 1, "philosophy": "sideways", "delta": 0.1}`,
+			status:  http.StatusUnprocessableEntity,
+			message: usecases.ErrUnsupportedPhilosophy.Error(),
+		},
+		{
+			name:    "NonFinite",
+			body:    `{"expression": "\\frac{1}{x}", "point": 0, "delta": 0.1, "philosophy": "forward"}`,
+			status:  http.StatusUnprocessableEntity,
+			message: usecases.ErrNonFiniteDerivative.Error(),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/derivative", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := newTestServer(t)
+
+			// Act
+			err := s.DerivativeHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, test.status, httpErr.Code)
+			assert.Contains(t, httpErr.Message, test.message)
+		})
+	}
+}
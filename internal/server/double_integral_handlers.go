@@ -0,0 +1,117 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+// maxDoublePartitions bounds the partitions per axis of a double integral, the
+// expression being evaluated partitions² times.
+const maxDoublePartitions = 1000
+
+var (
+	ErrTooManyDoublePartitions = fmt.Errorf("double integral cannot use more than %d partitions per axis",
+		maxDoublePartitions)
+	ErrNonFiniteDoubleIntegral = errors.New("integrand is not finite on the region")
+)
+
+// DoubleIntegralRequest integrates an expression of x and y over the box
+// [X0, X1] × [Y0, Y1], with Partitions cells along each axis, the precision
+// profile's partitions when zero. Samples also
+// returns the integrand at every cell midpoint, for a heatmap.
+type DoubleIntegralRequest struct {
+	Expression string  `json:"expression"`
+	X0         float64 `json:"x0"`
+	X1         float64 `json:"x1"`
+	Y0         float64 `json:"y0"`
+	Y1         float64 `json:"y1"`
+	Partitions uint64  `json:"partitions"`
+	Samples    bool    `json:"samples,omitempty"`
+}
+
+// DoubleIntegralSample is the integrand value at a cell midpoint.
+type DoubleIntegralSample struct {
+	MidX  float64 `json:"midX"`
+	MidY  float64 `json:"midY"`
+	Value float64 `json:"value"`
+}
+
+type DoubleIntegralResponse struct {
+	Expression string                 `json:"expression"`
+	Partitions uint64                 `json:"partitions"`
+	Result     float64                `json:"result"`
+	Samples    []DoubleIntegralSample `json:"samples,omitempty"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r DoubleIntegralResponse) MarshalCSV() ([]string, [][]string) {
+	return []string{"expression", "partitions", "result"},
+		[][]string{{r.Expression, strconv.FormatUint(r.Partitions, 10), formatFloat(r.Result)}}
+}
+
+// DoubleIntegralHandler integrates an expression of x and y over a box with
+// the midpoint rule.
+func (s *Server) DoubleIntegralHandler(c echo.Context) error {
+	var req DoubleIntegralRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if req.Partitions == 0 {
+		req.Partitions = s.profile.Defaults().Partitions
+	}
+	logComputation(c, req)
+
+	if req.Partitions > maxDoublePartitions {
+		return echo.NewHTTPError(http.StatusBadRequest, ErrTooManyDoublePartitions.Error())
+	}
+
+	ctx := c.Request().Context()
+	expr, guard, err := s.compileDualExpression(ctx, "x", "y", req.Expression)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var (
+		result  float64
+		samples []DoubleIntegralSample
+	)
+	useCase := usecases.NewDoubleIntegralUseCaseWithOptions(usecases.DoubleIntegralOptions{
+		Workers:       s.cfg.Integration.DoubleIntegralWorkers,
+		Deterministic: s.cfg.Integration.DoubleIntegralDeterministic,
+	})
+	if req.Samples {
+		var sampled *usecases.SampledArea
+		sampled, err = useCase.CalculateAreaWithSamples(ctx, expr, req.X0, req.X1, req.Y0, req.Y1, req.Partitions)
+		if sampled != nil {
+			result = sampled.Area
+			samples = make([]DoubleIntegralSample, len(sampled.Samples))
+			for i, sample := range sampled.Samples {
+				samples[i] = DoubleIntegralSample{MidX: sample.MidX, MidY: sample.MidY, Value: sample.Value}
+			}
+		}
+	} else {
+		result, err = useCase.CalculateArea(ctx, expr, req.X0, req.X1, req.Y0, req.Y1, req.Partitions)
+	}
+	if err := evaluationError(guard, err); err != nil {
+		return err
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity,
+			fmt.Sprintf("%s: result is %v", ErrNonFiniteDoubleIntegral, result))
+	}
+
+	return Respond(c, http.StatusOK, DoubleIntegralResponse{
+		Expression: req.Expression,
+		Partitions: req.Partitions,
+		Result:     result,
+		Samples:    samples,
+	})
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exprgenerators "github.com/taldoflemis/nume/internal/expr_generators"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestDoubleIntegralHandler(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		body     string
+		expected float64
+	}{
+		{
+			// ∫₀² ∫₀³ xy dy dx = (2²/2)(3²/2), exact for the midpoint rule
+			name:     "Product",
+			body:     `{"expression": "x*y", "x0": 0, "x1": 2, "y0": 0, "y1": 3, "partitions": 10}`,
+			expected: 9,
+		},
+		{
+			name:     "OnlyX",
+			body:     `{"expression": "x", "x0": 0, "x1": 1, "y0": 0, "y1": 4, "partitions": 10}`,
+			expected: 2,
+		},
+		{
+			name:     "Constant",
+			body:     `{"expression": "3", "x0": -1, "x1": 1, "y0": 0, "y1": 2, "partitions": 1}`,
+			expected: 12,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrate/double", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := newTestServer(t)
+
+			// Act
+			err := s.DoubleIntegralHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.Code)
+
+			var body DoubleIntegralResponse
+			require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+			assert.InDelta(t, test.expected, body.Result, 1e-9)
+		})
+	}
+}
+
+func TestDoubleIntegralHandlerRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name    string
+		body    string
+		message string
+	}{
+		{
+			name:    "FreeVariable",
+			body:    `{"expression": "x*z", "x0": 0, "x1": 1, "y0": 0, "y1": 1, "partitions": 10}`,
+			message: exprgenerators.ErrUnexpectedVariables.Error(),
+		},
+		{
+			name:    "TooManyPartitions",
+			body:    `{"expression": "x*y", "x0": 0, "x1": 1, "y0": 0, "y1": 1, "partitions": 1001}`,
+			message: ErrTooManyDoublePartitions.Error(),
+		},
+		{
+			name:    "ZeroWidth",
+			body:    `{"expression": "x*y", "x0": 1, "x1": 1, "y0": 0, "y1": 1, "partitions": 10}`,
+			message: usecases.ErrZeroWidthInterval.Error(),
+		},
+		{
+			name:    "TooManySamples",
+			body:    `{"expression": "x*y", "x0": 0, "x1": 1, "y0": 0, "y1": 1, "partitions": 300, "samples": true}`,
+			message: usecases.ErrTooManySamples.Error(),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrate/double", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := newTestServer(t)
+
+			// Act
+			err := s.DoubleIntegralHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+			assert.Contains(t, httpErr.Message, test.message)
+		})
+	}
+}
+
+func TestDoubleIntegralHandlerReturnsSamples(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	body := `{"expression": "x*y", "x0": 0, "x1": 2, "y0": 0, "y1": 3, "partitions": 4, "samples": true}`
+	req := httptest.NewRequest(http.MethodPost, "/integrate/double", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+
+	// Act
+	err := s.DoubleIntegralHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var response DoubleIntegralResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &response))
+	assert.InDelta(t, 9, response.Result, 1e-9)
+	require.Len(t, response.Samples, 16)
+	for _, sample := range response.Samples {
+		assert.InDelta(t, sample.MidX*sample.MidY, sample.Value, 1e-12)
+	}
+}
+
+func TestDoubleIntegralHandlerUsesTheConfiguredWorkers(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	body := `{"expression": "x*y", "x0": 0, "x1": 2, "y0": 0, "y1": 3, "partitions": 40}`
+	req := httptest.NewRequest(http.MethodPost, "/integrate/double", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+	s.cfg.Integration.DoubleIntegralWorkers = 4
+	s.cfg.Integration.DoubleIntegralDeterministic = true
+
+	// Act
+	err := s.DoubleIntegralHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var response DoubleIntegralResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &response))
+	assert.InDelta(t, 9.0, response.Result, 1e-9)
+}
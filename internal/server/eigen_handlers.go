@@ -0,0 +1,214 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/precision"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+type PowerRequest struct {
+	Method        string      `json:"method"`
+	Matrix        [][]float64 `json:"matrix"`
+	InitialGuess  []float64   `json:"initialGuess"`
+	Shift         float64     `json:"shift"`
+	Epsilon       float64     `json:"epsilon"`
+	MaxIterations uint64      `json:"maxIterations"`
+	// Polish refines the eigenvector with one step of inverse iteration
+	Polish bool `json:"polish"`
+	// Normalization is the norm of the returned eigenvector, l2 when empty
+	Normalization string `json:"normalization"`
+	// RandomRetries retries a stalled run with random initial guesses drawn
+	// from Seed
+	RandomRetries int    `json:"randomRetries"`
+	Seed          uint64 `json:"seed"`
+	// Arithmetic accumulates the dot products in float64 or, much slower, in
+	// bigfloat to compare what rounding costs, float64 when empty
+	Arithmetic string `json:"arithmetic"`
+}
+
+// ComplexValue is a complex number split in its real and imaginary parts.
+// Eigenvalues always take this shape, with a zero Imag when they are real, so
+// clients handle a single shape whatever the matrix.
+type ComplexValue struct {
+	Real float64 `json:"real"`
+	Imag float64 `json:"imag"`
+}
+
+func NewComplexValue(value complex128) ComplexValue {
+	return ComplexValue{Real: real(value), Imag: imag(value)}
+}
+
+type PowerResponse struct {
+	Method      string       `json:"method"`
+	Eigenvalue  ComplexValue `json:"eigenvalue"`
+	Eigenvector []float64    `json:"eigenvector"`
+	Iterations  uint64       `json:"iterations"`
+	Arithmetic  string       `json:"arithmetic"`
+	// Retries is how many random initial guesses were needed
+	Retries int `json:"retries,omitempty"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r PowerResponse) MarshalCSV() ([]string, [][]string) {
+	header := []string{"method", "eigenvalue_real", "eigenvalue_imag", "iterations"}
+	row := []string{
+		r.Method,
+		formatFloat(r.Eigenvalue.Real),
+		formatFloat(r.Eigenvalue.Imag),
+		strconv.FormatUint(r.Iterations, 10),
+	}
+
+	for i, component := range r.Eigenvector {
+		header = append(header, fmt.Sprintf("eigenvector_%d", i+1))
+		row = append(row, formatFloat(component))
+	}
+
+	return header, [][]string{row}
+}
+
+func (s *Server) PowerHandler(c echo.Context) error {
+	var req PowerRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	defaults := s.profile.Defaults()
+	if req.Method == "" {
+		req.Method = usecases.PowerMethodRegular.String()
+	}
+	if req.Epsilon == 0 {
+		req.Epsilon = defaults.Epsilon
+	}
+	if req.MaxIterations == 0 {
+		req.MaxIterations = defaults.MaxIterations
+	}
+	if req.Normalization == "" {
+		req.Normalization = usecases.NormalizationL2.String()
+	}
+	if req.Arithmetic == "" {
+		req.Arithmetic = string(precision.Float64)
+	}
+	logComputation(c, req)
+
+	method, err := usecases.ParsePowerMethod(req.Method)
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	normalization, err := usecases.ParseNormalizationMode(req.Normalization)
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	arithmetic, err := precision.ParseArithmetic(req.Arithmetic)
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	useCase := usecases.NewPowerUseCase(usecases.WithArithmetic(arithmetic))
+	result, err := useCase.Solve(c.Request().Context(), method, req.Matrix, usecases.PowerParams{
+		InitialGuess:  req.InitialGuess,
+		Shift:         req.Shift,
+		Epsilon:       req.Epsilon,
+		MaxIterations: req.MaxIterations,
+		Polish:        req.Polish,
+		Normalization: normalization,
+		RandomRetries: req.RandomRetries,
+		Seed:          req.Seed,
+	})
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	return Respond(c, http.StatusOK, PowerResponse{
+		Method:      req.Method,
+		Eigenvalue:  NewComplexValue(complex(result.Eigenvalue, 0)),
+		Eigenvector: result.Eigenvector,
+		Iterations:  result.NumIterations,
+		Arithmetic:  req.Arithmetic,
+		Retries:     result.Retries,
+	})
+}
+
+// DecomposeRequest takes the tolerance and iterations of the QR iterations
+// from the precision profile when they are left out.
+type DecomposeRequest struct {
+	Matrix        [][]float64 `json:"matrix"`
+	MaxIterations int         `json:"maxIterations"`
+	Tolerance     float64     `json:"tolerance"`
+}
+
+type DecomposeResponse struct {
+	// Eigenvalues are by decreasing magnitude, then decreasing value, real for
+	// a symmetric matrix but shaped like the power method's
+	Eigenvalues []ComplexValue `json:"eigenvalues"`
+	// Eigenvectors has the eigenvectors as columns, in the order of Eigenvalues
+	Eigenvectors [][]float64 `json:"eigenvectors"`
+	Iterations   int         `json:"iterations"`
+	// ReconstructionError is the Frobenius norm ‖A − VΛVᵀ‖
+	ReconstructionError float64 `json:"reconstructionError"`
+}
+
+// MarshalCSV implements CSVMarshaler, with one row per eigenpair.
+func (r DecomposeResponse) MarshalCSV() ([]string, [][]string) {
+	header := []string{"eigenvalue_real", "eigenvalue_imag", "iterations", "reconstruction_error"}
+	for i := range r.Eigenvectors {
+		header = append(header, fmt.Sprintf("eigenvector_%d", i+1))
+	}
+
+	rows := make([][]string, 0, len(r.Eigenvalues))
+	for j, eigenvalue := range r.Eigenvalues {
+		row := []string{
+			formatFloat(eigenvalue.Real),
+			formatFloat(eigenvalue.Imag),
+			strconv.Itoa(r.Iterations),
+			formatFloat(r.ReconstructionError),
+		}
+		for _, eigenvectorRow := range r.Eigenvectors {
+			row = append(row, formatFloat(eigenvectorRow[j]))
+		}
+		rows = append(rows, row)
+	}
+
+	return header, rows
+}
+
+func (s *Server) DecomposeHandler(c echo.Context) error {
+	var req DecomposeRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	defaults := s.profile.Defaults()
+	if req.MaxIterations == 0 {
+		req.MaxIterations = defaults.DecompositionMaxIterations
+	}
+	if req.Tolerance == 0 {
+		req.Tolerance = defaults.DecompositionTolerance
+	}
+	logComputation(c, req)
+
+	result, err := usecases.NewSimilarityTransformationUseCase().DecomposeSymmetric(
+		c.Request().Context(), req.Matrix, req.MaxIterations, req.Tolerance,
+	)
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	eigenvalues := make([]ComplexValue, len(result.Eigenvalues))
+	for i, eigenvalue := range result.Eigenvalues {
+		eigenvalues[i] = NewComplexValue(complex(eigenvalue, 0))
+	}
+
+	return Respond(c, http.StatusOK, DecomposeResponse{
+		Eigenvalues:         eigenvalues,
+		Eigenvectors:        result.Eigenvectors,
+		Iterations:          result.Iterations,
+		ReconstructionError: result.ReconstructionError,
+	})
+}
@@ -0,0 +1,424 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+const powerRequestBody = `{
+	"method": "regular",
+	"matrix": [[2, 3], [5, 4]],
+	"initialGuess": [1, 1],
+	"epsilon": 1e-8,
+	"maxIterations": 100
+}`
+
+func TestPowerHandler(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                string
+		accept              string
+		target              string
+		expectedContentType string
+	}{
+		{
+			name:                "JSON by default",
+			target:              "/eigen/power",
+			expectedContentType: echo.MIMEApplicationJSON,
+		},
+		{
+			name:                "CSV through Accept",
+			accept:              MIMETextCSV,
+			target:              "/eigen/power",
+			expectedContentType: MIMETextCSV,
+		},
+		{
+			name:                "CSV through format query",
+			target:              "/eigen/power?format=csv",
+			expectedContentType: MIMETextCSV,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, test.target, strings.NewReader(powerRequestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if test.accept != "" {
+				req.Header.Set(echo.HeaderAccept, test.accept)
+			}
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := &Server{}
+
+			// Act
+			err := s.PowerHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.Code)
+			assert.Contains(t, resp.Header().Get(echo.HeaderContentType), test.expectedContentType)
+
+			if test.expectedContentType == MIMETextCSV {
+				records, err := csv.NewReader(resp.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 2)
+				assert.Equal(t, []string{"method", "eigenvalue_real", "eigenvalue_imag", "iterations", "eigenvector_1", "eigenvector_2"}, records[0])
+				assert.Equal(t, "regular", records[1][0])
+				assert.True(t, strings.HasPrefix(records[1][1], "7"), "Expected dominant eigenvalue 7, got %s", records[1][1])
+				assert.Equal(t, "0", records[1][2])
+				return
+			}
+
+			var body PowerResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.InDelta(t, 7.0, body.Eigenvalue.Real, 1e-6)
+			assert.Zero(t, body.Eigenvalue.Imag)
+			assert.Len(t, body.Eigenvector, 2)
+		})
+	}
+}
+
+func TestPowerHandlerUnknownMethod(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/eigen/power",
+		strings.NewReader(`{"method": "sideways", "matrix": [[1]], "initialGuess": [1]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+	s := &Server{}
+
+	// Act
+	err := s.PowerHandler(c)
+
+	// Assert
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, httpErr.Code)
+}
+
+func TestPowerHandlerPolish(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/eigen/power", strings.NewReader(
+		`{"method": "regular", "matrix": [[2, 3], [5, 4]], "initialGuess": [1, 1], "epsilon": 1e-2, "maxIterations": 100, "polish": true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := &Server{}
+
+	// Act
+	err := s.PowerHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	var body PowerResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Eigenvector, 2)
+	assert.InDelta(t, 3.0/5.0, body.Eigenvector[0]/body.Eigenvector[1], 1e-6)
+}
+
+func TestPowerHandlerReturnsComplexEigenvalueForSymmetricMatrix(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/eigen/power", strings.NewReader(
+		`{"method": "regular", "matrix": [[2, 1], [1, 2]], "initialGuess": [1, 0], "epsilon": 1e-10, "maxIterations": 200}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := &Server{}
+
+	// Act
+	err := s.PowerHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	var body struct {
+		Eigenvalue map[string]float64 `json:"eigenvalue"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Eigenvalue, 2)
+	assert.InDelta(t, 3.0, body.Eigenvalue["real"], 1e-8)
+	assert.Contains(t, body.Eigenvalue, "imag")
+	assert.Zero(t, body.Eigenvalue["imag"])
+}
+
+func TestDecomposeHandler(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/eigen/decompose", strings.NewReader(
+		`{"matrix": [[4, 1, 0, 0], [1, 3, 1, 0], [0, 1, 3, 1], [0, 0, 1, 2]]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := &Server{}
+
+	// Act
+	err := s.DecomposeHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body DecomposeResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Eigenvalues, 4)
+	for _, eigenvalue := range body.Eigenvalues {
+		assert.Zero(t, eigenvalue.Imag)
+	}
+	require.Len(t, body.Eigenvectors, 4)
+	for _, row := range body.Eigenvectors {
+		assert.Len(t, row, 4)
+	}
+	assert.Less(t, body.ReconstructionError, 1e-8)
+}
+
+func TestDecomposeResponseMarshalCSV(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	response := DecomposeResponse{
+		Eigenvalues:         []ComplexValue{NewComplexValue(3), NewComplexValue(1)},
+		Eigenvectors:        [][]float64{{0.5, -0.5}, {0.5, 0.5}},
+		Iterations:          2,
+		ReconstructionError: 0,
+	}
+
+	// Act
+	header, rows := response.MarshalCSV()
+
+	// Assert
+	assert.Equal(t, []string{"eigenvalue_real", "eigenvalue_imag", "iterations", "reconstruction_error", "eigenvector_1", "eigenvector_2"}, header)
+	assert.Equal(t, [][]string{
+		{"3", "0", "2", "0", "0.5", "0.5"},
+		{"1", "0", "2", "0", "-0.5", "0.5"},
+	}, rows)
+}
+
+func TestDecomposeHandlerRejectsInvalidMatrices(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{
+			name:           "NonSymmetric",
+			body:           `{"matrix": [[2, 3], [5, 4]]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Rectangular",
+			body:           `{"matrix": [[1, 2, 3], [4, 5, 6]]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/eigen/decompose", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := &Server{}
+
+			// Act
+			err := s.DecomposeHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, test.expectedStatus, httpErr.Code)
+		})
+	}
+}
+
+func TestPowerHandlerUsesTheProfileDefaults(t *testing.T) {
+	t.Parallel()
+
+	iterations := make(map[precision.Profile]uint64)
+	for _, profile := range precision.Profiles() {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/eigen/power", strings.NewReader(
+			`{"method": "regular", "matrix": [[2, 1, 0], [1, 2, 1], [0, 1, 2]], "initialGuess": [1, 0, 0]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		resp := httptest.NewRecorder()
+		c := e.NewContext(req, resp)
+		s := &Server{profile: profile}
+
+		// Act
+		err := s.PowerHandler(c)
+
+		// Assert
+		require.NoError(t, err)
+		var body PowerResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		iterations[profile] = body.Iterations
+	}
+
+	assert.Less(t, iterations[precision.Fast], iterations[precision.Balanced])
+	assert.Less(t, iterations[precision.Balanced], iterations[precision.Accurate])
+}
+
+func TestDecomposeHandlerUsesTheProfileDefaults(t *testing.T) {
+	t.Parallel()
+
+	reconstruction := make(map[precision.Profile]float64)
+	for _, profile := range precision.Profiles() {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/eigen/decompose", strings.NewReader(
+			`{"matrix": [[4, 1, 2], [1, 3, 0], [2, 0, 5]]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		resp := httptest.NewRecorder()
+		c := e.NewContext(req, resp)
+		s := &Server{profile: profile}
+
+		// Act
+		err := s.DecomposeHandler(c)
+
+		// Assert
+		require.NoError(t, err)
+		var body DecomposeResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		reconstruction[profile] = body.ReconstructionError
+		assert.Less(t, body.ReconstructionError, profile.Defaults().DecompositionTolerance*1e3)
+	}
+
+	assert.Greater(t, reconstruction[precision.Fast], reconstruction[precision.Accurate])
+}
+
+func TestPowerHandlerNormalization(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		normalization string
+		expected      []float64
+		status        int
+	}{
+		{name: "Default", expected: []float64{3 / math.Sqrt(34), 5 / math.Sqrt(34)}, status: http.StatusOK},
+		{name: "Max", normalization: "max", expected: []float64{3.0 / 5, 1}, status: http.StatusOK},
+		{name: "L1", normalization: "l1", expected: []float64{3.0 / 8, 5.0 / 8}, status: http.StatusOK},
+		{name: "Unknown", normalization: "l3", status: http.StatusUnprocessableEntity},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/eigen/power", strings.NewReader(fmt.Sprintf(
+				`{"matrix": [[2, 3], [5, 4]], "initialGuess": [1, 1], "epsilon": 1e-12, "normalization": %q}`,
+				test.normalization)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := &Server{}
+
+			// Act
+			err := s.PowerHandler(c)
+
+			// Assert
+			if test.status != http.StatusOK {
+				var httpErr *echo.HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, test.status, httpErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			var body PowerResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.InDeltaSlice(t, test.expected, body.Eigenvector, 1e-9)
+		})
+	}
+}
+
+func TestPowerHandlerRetriesAStalledGuess(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/eigen/power", strings.NewReader(
+		`{"matrix": [[3, 0, 0], [0, 1, 0], [0, 0, -1]], "initialGuess": [0, 1, 1], "epsilon": 1e-10, "maxIterations": 100, "randomRetries": 5, "seed": 1}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := &Server{}
+
+	// Act
+	err := s.PowerHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	var body PowerResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.InDelta(t, 3, body.Eigenvalue.Real, 1e-8)
+	assert.Positive(t, body.Retries)
+}
+
+func TestPowerHandlerArithmetic(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name       string
+		arithmetic string
+		expected   string
+		status     int
+	}{
+		{name: "Default", expected: "float64", status: http.StatusOK},
+		{name: "BigFloat", arithmetic: "bigfloat", expected: "bigfloat", status: http.StatusOK},
+		{name: "Unknown", arithmetic: "float16", status: http.StatusUnprocessableEntity},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/eigen/power", strings.NewReader(fmt.Sprintf(
+				`{"matrix": [[2, 3], [5, 4]], "initialGuess": [1, 1], "epsilon": 1e-12, "arithmetic": %q}`,
+				test.arithmetic)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := &Server{}
+
+			// Act
+			err := s.PowerHandler(c)
+
+			// Assert
+			if test.status != http.StatusOK {
+				var httpErr *echo.HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, test.status, httpErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			var body PowerResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, test.expected, body.Arithmetic)
+			assert.InDelta(t, 7.0, body.Eigenvalue.Real, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+// maxEvaluationPoints bounds the work of a single evaluation request.
+const maxEvaluationPoints = 10000
+
+var (
+	ErrNoEvaluationPoints      = errors.New("either points or a range must be given")
+	ErrAmbiguousEvaluation     = errors.New("points and range cannot be given together")
+	ErrTooManyEvaluationPoints = fmt.Errorf("cannot evaluate more than %d points", maxEvaluationPoints)
+	ErrInvalidEvaluationRange  = errors.New("range needs left < right and at least two samples")
+)
+
+// EvaluationRange samples Samples equally spaced points over [Left, Right],
+// both ends included.
+type EvaluationRange struct {
+	Left    float64 `json:"left"`
+	Right   float64 `json:"right"`
+	Samples int     `json:"samples"`
+}
+
+type EvaluateRequest struct {
+	Expression string           `json:"expression"`
+	Variable   string           `json:"variable"`
+	Points     []float64        `json:"points"`
+	Range      *EvaluationRange `json:"range"`
+}
+
+// EvaluatedPoint is the value of the expression at X, Y is null where the
+// expression is not finite.
+type EvaluatedPoint struct {
+	X float64  `json:"x"`
+	Y *float64 `json:"y"`
+}
+
+type EvaluateResponse struct {
+	Expression string           `json:"expression"`
+	Points     []EvaluatedPoint `json:"points"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r EvaluateResponse) MarshalCSV() ([]string, [][]string) {
+	rows := make([][]string, 0, len(r.Points))
+	for _, point := range r.Points {
+		y := ""
+		if point.Y != nil {
+			y = formatFloat(*point.Y)
+		}
+		rows = append(rows, []string{formatFloat(point.X), y})
+	}
+
+	return []string{"x", "y"}, rows
+}
+
+// EvaluateHandler evaluates an expression at the given points, or over a
+// sampled range, for plotting.
+func (s *Server) EvaluateHandler(c echo.Context) error {
+	var req EvaluateRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if req.Variable == "" {
+		req.Variable = defaultVariable
+	}
+	logComputation(c, req)
+
+	points, err := req.evaluationPoints()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	expr, guard, err := s.compileExpression(c.Request().Context(), req.Variable, req.Expression)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	evaluated := make([]EvaluatedPoint, len(points))
+	for i, x := range points {
+		evaluated[i].X = x
+		if y, ok := expressions.SafeEval(expr, x); ok {
+			evaluated[i].Y = &y
+		}
+	}
+
+	if err := guard.Err(); err != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return Respond(c, http.StatusOK, EvaluateResponse{
+		Expression: req.Expression,
+		Points:     evaluated,
+	})
+}
+
+func (r EvaluateRequest) evaluationPoints() ([]float64, error) {
+	switch {
+	case r.Range != nil && len(r.Points) > 0:
+		return nil, ErrAmbiguousEvaluation
+	case r.Range != nil:
+		return r.Range.points()
+	case len(r.Points) == 0:
+		return nil, ErrNoEvaluationPoints
+	case len(r.Points) > maxEvaluationPoints:
+		return nil, ErrTooManyEvaluationPoints
+	default:
+		return r.Points, nil
+	}
+}
+
+func (r EvaluationRange) points() ([]float64, error) {
+	if r.Samples < 2 || !(r.Left < r.Right) {
+		return nil, ErrInvalidEvaluationRange
+	}
+	if r.Samples > maxEvaluationPoints {
+		return nil, ErrTooManyEvaluationPoints
+	}
+
+	points := make([]float64, r.Samples)
+	for i := range points {
+		points[i] = r.Left + (r.Right-r.Left)*float64(i)/float64(r.Samples-1)
+	}
+
+	return points, nil
+}
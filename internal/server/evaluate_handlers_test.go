@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/configs"
+	"github.com/taldoflemis/nume/internal/ast"
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+func TestEvaluateHandler(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		body     string
+		expected []*float64
+	}{
+		{
+			name:     "Range through the pole",
+			body:     `{"expression": "\\frac{1}{x}", "range": {"left": -1, "right": 1, "samples": 5}}`,
+			expected: []*float64{ptr(-1.0), ptr(-2.0), nil, ptr(2.0), ptr(1.0)},
+		},
+		{
+			name:     "Explicit points",
+			body:     `{"expression": "\\frac{1}{t}", "variable": "t", "points": [4, 0, -0.25]}`,
+			expected: []*float64{ptr(0.25), nil, ptr(-4.0)},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/evaluate", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := newTestServer(t)
+
+			// Act
+			err := s.EvaluateHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.Code)
+
+			var body EvaluateResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			require.Len(t, body.Points, len(test.expected))
+			for i, expected := range test.expected {
+				if expected == nil {
+					assert.Nil(t, body.Points[i].Y, "at x = %v", body.Points[i].X)
+					continue
+				}
+				require.NotNil(t, body.Points[i].Y, "at x = %v", body.Points[i].X)
+				assert.InDelta(t, *expected, *body.Points[i].Y, 1e-12)
+			}
+		})
+	}
+}
+
+func TestEvaluateHandlerWritesNullAsEmptyCSVCell(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/evaluate?format=csv",
+		strings.NewReader(`{"expression": "\\frac{1}{x}", "points": [0, 2]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+
+	// Act
+	err := s.EvaluateHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"x", "y"}, {"0", ""}, {"2", "0.5"}}, records)
+}
+
+func TestEvaluateHandlerRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "No points",
+			body: `{"expression": "x"}`,
+		},
+		{
+			name: "Points and range",
+			body: `{"expression": "x", "points": [1], "range": {"left": 0, "right": 1, "samples": 2}}`,
+		},
+		{
+			name: "Single sample range",
+			body: `{"expression": "x", "range": {"left": 0, "right": 1, "samples": 1}}`,
+		},
+		{
+			name: "Too many samples",
+			body: `{"expression": "x", "range": {"left": 0, "right": 1, "samples": 10001}}`,
+		},
+		{
+			name: "Unknown variable",
+			body: `{"expression": "y", "points": [1]}`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/evaluate", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := newTestServer(t)
+
+			// Act
+			err := s.EvaluateHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		})
+	}
+}
+
+func ptr(value float64) *float64 {
+	return &value
+}
+
+// slowExpressionGenerator compiles every expression to one sleeping for delay
+// on each evaluation.
+type slowExpressionGenerator struct {
+	delay time.Duration
+}
+
+func (g slowExpressionGenerator) GenerateSingleVariableExpression(
+	context.Context,
+	*ast.SingleVariableExpressionNode,
+) (expressions.SingleVariableExpr, error) {
+	return func(x float64) float64 {
+		time.Sleep(g.delay)
+		return x
+	}, nil
+}
+
+func TestHandlersTimeOutSlowEvaluations(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name    string
+		path    string
+		body    string
+		handler func(*Server, echo.Context) error
+	}{
+		{
+			name:    "Evaluate",
+			path:    "/evaluate",
+			body:    `{"expression": "x", "points": [0, 1, 2]}`,
+			handler: (*Server).EvaluateHandler,
+		},
+		{
+			name:    "Newton-Cotes",
+			path:    "/integrals/newton-cotes",
+			body:    newtonCotesRequestBody,
+			handler: (*Server).NewtonCotesHandler,
+		},
+		{
+			name:    "Derivative",
+			path:    "/derivative",
+			body:    `{"expression": "x", "point": 1, "delta": 0.1}`,
+			handler: (*Server).DerivativeHandler,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, test.path, strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := &Server{
+				cfg:                 configs.Config{HTTP: configs.HTTPCfg{EvaluationTimeoutInMilliseconds: 10}},
+				expressionGenerator: slowExpressionGenerator{delay: time.Second},
+			}
+
+			// Act
+			start := time.Now()
+			err := test.handler(s, c)
+
+			// Assert
+			assert.Less(t, time.Since(start), 500*time.Millisecond)
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusUnprocessableEntity, httpErr.Code)
+			assert.Contains(t, httpErr.Message, expressions.ErrEvaluationTimeout.Error())
+		})
+	}
+}
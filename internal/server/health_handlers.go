@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+	newtoncotes "github.com/taldoflemis/nume/internal/usecases/newton_cotes"
+)
+
+const (
+	healthStatusHealthy  = "healthy"
+	healthStatusDegraded = "degraded"
+)
+
+// computeCheck is a computation with a known answer, run on every compute
+// health request to tell a broken numerical core from a broken transport.
+type computeCheck struct {
+	name      string
+	expected  float64
+	tolerance float64
+	run       func(ctx context.Context) (float64, error)
+}
+
+// defaultComputeChecks are small enough to cost nothing and touch both the
+// eigenvalue and the integration paths.
+var defaultComputeChecks = []computeCheck{
+	{
+		name:      "dominantEigenvalue",
+		expected:  2,
+		tolerance: 1e-6,
+		run: func(ctx context.Context) (float64, error) {
+			result, err := usecases.NewPowerUseCase().
+				RegularPower(ctx, [][]float64{{2, 0}, {0, 1}}, []float64{1, 1}, 1e-10, 1000)
+			if err != nil {
+				return 0, err
+			}
+
+			return result.Eigenvalue, nil
+		},
+	},
+	{
+		name:      "trapezoidalIntegral",
+		expected:  0.5,
+		tolerance: 1e-12,
+		run: func(ctx context.Context) (float64, error) {
+			identity := func(x float64) float64 { return x }
+			return newtoncotes.NewNewtonCotesUseCase(&newtoncotes.TrapezoidalRule{}).
+				Calculate(ctx, identity, 0, 1, 4)
+		},
+	},
+}
+
+type ComputeCheckResult struct {
+	Name     string  `json:"name"`
+	Expected float64 `json:"expected"`
+	Actual   float64 `json:"actual"`
+	OK       bool    `json:"ok"`
+	// Error is why the computation failed, empty when it ran
+	Error string `json:"error,omitempty"`
+}
+
+type ComputeHealthResponse struct {
+	// Status is healthy when every check matched its known value, degraded
+	// otherwise
+	Status string               `json:"status"`
+	Checks []ComputeCheckResult `json:"checks"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r ComputeHealthResponse) MarshalCSV() ([]string, [][]string) {
+	rows := make([][]string, len(r.Checks))
+	for i, check := range r.Checks {
+		rows[i] = []string{
+			check.Name,
+			formatFloat(check.Expected),
+			formatFloat(check.Actual),
+			strconv.FormatBool(check.OK),
+			check.Error,
+		}
+	}
+
+	return []string{"name", "expected", "actual", "ok", "error"}, rows
+}
+
+// ComputeHealthHandler runs computations with known answers and reports the
+// service degraded when any of them fails or drifts from its value.
+func (s *Server) ComputeHealthHandler(c echo.Context) error {
+	checks := s.computeChecks
+	if checks == nil {
+		checks = defaultComputeChecks
+	}
+
+	ctx := c.Request().Context()
+
+	resp := ComputeHealthResponse{Status: healthStatusHealthy, Checks: make([]ComputeCheckResult, len(checks))}
+	for i, check := range checks {
+		result := ComputeCheckResult{Name: check.name, Expected: check.expected}
+
+		actual, err := check.run(ctx)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Actual = actual
+			result.OK = math.Abs(actual-check.expected) <= check.tolerance
+		}
+
+		if !result.OK {
+			slog.WarnContext(ctx, "Compute health check failed",
+				slog.String("check", check.name),
+				slog.Float64("expected", check.expected),
+				slog.Float64("actual", actual),
+				slog.Any("error", err),
+			)
+			resp.Status = healthStatusDegraded
+		}
+		resp.Checks[i] = result
+	}
+
+	status := http.StatusOK
+	if resp.Status == healthStatusDegraded {
+		status = http.StatusServiceUnavailable
+	}
+
+	return Respond(c, status, resp)
+}
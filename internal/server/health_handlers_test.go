@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeHealthHandlerIsHealthy(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/health/compute", nil)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+
+	// Act
+	err := s.ComputeHealthHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var body ComputeHealthResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, healthStatusHealthy, body.Status)
+	require.Len(t, body.Checks, len(defaultComputeChecks))
+	for _, check := range body.Checks {
+		assert.True(t, check.OK, "check %s", check.Name)
+		assert.InDelta(t, check.Expected, check.Actual, 1e-6, "check %s", check.Name)
+	}
+}
+
+func TestComputeHealthHandlerIsDegraded(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name  string
+		check computeCheck
+	}{
+		{
+			name: "WrongValue",
+			check: computeCheck{
+				name: "drifted", expected: 1, tolerance: 1e-9,
+				run: func(context.Context) (float64, error) { return 1.1, nil },
+			},
+		},
+		{
+			name: "Error",
+			check: computeCheck{
+				name: "broken", expected: 1, tolerance: 1e-9,
+				run: func(context.Context) (float64, error) { return 0, errors.New("did not converge") },
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/health/compute", nil)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := newTestServer(t)
+			s.computeChecks = append([]computeCheck{test.check}, defaultComputeChecks...)
+
+			// Act
+			err := s.ComputeHealthHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+
+			var body ComputeHealthResponse
+			require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+			assert.Equal(t, healthStatusDegraded, body.Status)
+			require.Len(t, body.Checks, len(defaultComputeChecks)+1)
+			assert.False(t, body.Checks[0].OK)
+			for _, check := range body.Checks[1:] {
+				assert.True(t, check.OK, "check %s", check.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	newtoncotes "github.com/taldoflemis/nume/internal/usecases/newton_cotes"
+)
+
+const (
+	defaultVariable = "x"
+
+	// Batch integration limits, at most maxBatchExpressions per request
+	// integrated by up to batchConcurrency goroutines
+	maxBatchExpressions = 32
+	batchConcurrency    = 4
+
+	// domainSamples is the number of points the integrand is probed at to
+	// tell where it is finite when its integral is not
+	domainSamples = 256
+)
+
+var (
+	ErrEmptyBatch           = errors.New("batch must contain at least one expression")
+	ErrBatchTooLarge        = fmt.Errorf("batch cannot contain more than %d expressions", maxBatchExpressions)
+	ErrIntegrationCancelled = errors.New("integration was cancelled")
+)
+
+type NewtonCotesRequest struct {
+	Expression string  `json:"expression"`
+	Variable   string  `json:"variable"`
+	Left       float64 `json:"left"`
+	Right      float64 `json:"right"`
+	// Partitions is the grid size, the precision profile's when zero
+	Partitions uint64 `json:"partitions"`
+	Formula    string `json:"formula"`
+	Order      int    `json:"order"`
+}
+
+// IntegralResponse reports the partitions actually used, Method is adaptive
+// when the requested ones were above the configured cap. ErrorOrder is p in the
+// O(hᵖ) error of the formula.
+type IntegralResponse struct {
+	Strategy   string  `json:"strategy"`
+	Left       float64 `json:"left"`
+	Right      float64 `json:"right"`
+	Partitions uint64  `json:"partitions"`
+	Result     float64 `json:"result"`
+	Method     string  `json:"method"`
+	ErrorOrder int     `json:"errorOrder"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r IntegralResponse) MarshalCSV() ([]string, [][]string) {
+	return []string{"strategy", "left", "right", "partitions", "result", "method", "errorOrder"},
+		[][]string{{
+			r.Strategy,
+			formatFloat(r.Left),
+			formatFloat(r.Right),
+			strconv.FormatUint(r.Partitions, 10),
+			formatFloat(r.Result),
+			r.Method,
+			strconv.Itoa(r.ErrorOrder),
+		}}
+}
+
+func (s *Server) NewtonCotesHandler(c echo.Context) error {
+	var req NewtonCotesRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if req.Variable == "" {
+		req.Variable = defaultVariable
+	}
+	if req.Order == 0 {
+		req.Order = s.profile.Defaults().QuadratureOrder
+	}
+	if req.Partitions == 0 {
+		req.Partitions = s.profile.Defaults().Partitions
+	}
+	logComputation(c, req)
+
+	strategy, err := newtoncotes.NewStrategy(newtoncotes.FormulaType(req.Formula), newtoncotes.NewtonCotesOrder(req.Order))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := s.integrate(c.Request().Context(), strategy, req.Variable, req.Expression, req.Left, req.Right, req.Partitions)
+	if err != nil {
+		return err
+	}
+
+	return Respond(c, http.StatusOK, IntegralResponse{
+		Strategy:   strategy.Description(),
+		Left:       req.Left,
+		Right:      req.Right,
+		Partitions: result.Partitions,
+		Result:     result.Area,
+		Method:     string(result.Method),
+		ErrorOrder: strategy.ErrorOrder(),
+	})
+}
+
+type BatchIntegralRequest struct {
+	Expressions []string `json:"expressions"`
+	Variable    string   `json:"variable"`
+	Left        float64  `json:"left"`
+	Right       float64  `json:"right"`
+	Partitions  uint64   `json:"partitions"`
+	Formula     string   `json:"formula"`
+	Order       int      `json:"order"`
+}
+
+// BatchIntegralResult is the outcome for one expression of a batch, a failing
+// expression carries its error instead of failing the whole batch.
+type BatchIntegralResult struct {
+	Expression string  `json:"expression"`
+	Result     float64 `json:"result"`
+	Method     string  `json:"method,omitempty"`
+	Error      string  `json:"error,omitempty"`
+}
+
+type BatchIntegralResponse struct {
+	Strategy          string                `json:"strategy"`
+	Left              float64               `json:"left"`
+	Right             float64               `json:"right"`
+	Partitions        uint64                `json:"partitions"`
+	ErrorOrder        int                   `json:"errorOrder"`
+	Results           []BatchIntegralResult `json:"results"`
+	WallTimeInSeconds float64               `json:"wallTimeInSeconds"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r BatchIntegralResponse) MarshalCSV() ([]string, [][]string) {
+	rows := make([][]string, 0, len(r.Results))
+	for _, result := range r.Results {
+		rows = append(rows, []string{
+			result.Expression,
+			r.Strategy,
+			formatFloat(r.Left),
+			formatFloat(r.Right),
+			strconv.FormatUint(r.Partitions, 10),
+			formatFloat(result.Result),
+			result.Error,
+			result.Method,
+			strconv.Itoa(r.ErrorOrder),
+		})
+	}
+
+	return []string{"expression", "strategy", "left", "right", "partitions", "result", "error", "method", "errorOrder"}, rows
+}
+
+// BatchIntegralHandler integrates several expressions over the same interval
+// with the same method, in parallel. Each expression is integrated by a single
+// goroutine, so its result is reproducible regardless of scheduling.
+func (s *Server) BatchIntegralHandler(c echo.Context) error {
+	var req BatchIntegralRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if req.Variable == "" {
+		req.Variable = defaultVariable
+	}
+	if req.Order == 0 {
+		req.Order = s.profile.Defaults().QuadratureOrder
+	}
+	if req.Partitions == 0 {
+		req.Partitions = s.profile.Defaults().Partitions
+	}
+	logComputation(c, req)
+
+	switch {
+	case len(req.Expressions) == 0:
+		return echo.NewHTTPError(http.StatusBadRequest, ErrEmptyBatch.Error())
+	case len(req.Expressions) > maxBatchExpressions:
+		return echo.NewHTTPError(http.StatusBadRequest, ErrBatchTooLarge.Error())
+	}
+
+	strategy, err := newtoncotes.NewStrategy(newtoncotes.FormulaType(req.Formula), newtoncotes.NewtonCotesOrder(req.Order))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	start := time.Now()
+
+	results := make([]BatchIntegralResult, len(req.Expressions))
+	semaphore := make(chan struct{}, batchConcurrency)
+
+	var wg sync.WaitGroup
+	for i, expression := range req.Expressions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i] = BatchIntegralResult{Expression: expression}
+
+			if ctx.Err() != nil {
+				results[i].Error = ErrIntegrationCancelled.Error()
+				return
+			}
+
+			value, err := s.integrate(ctx, strategy, req.Variable, expression, req.Left, req.Right, req.Partitions)
+			if err != nil {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					results[i].Error = fmt.Sprint(httpErr.Message)
+				} else {
+					results[i].Error = err.Error()
+				}
+				return
+			}
+			results[i].Result = value.Area
+			results[i].Method = string(value.Method)
+		}()
+	}
+	wg.Wait()
+
+	return Respond(c, http.StatusOK, BatchIntegralResponse{
+		Strategy:          strategy.Description(),
+		Left:              req.Left,
+		Right:             req.Right,
+		Partitions:        req.Partitions,
+		ErrorOrder:        strategy.ErrorOrder(),
+		Results:           results,
+		WallTimeInSeconds: time.Since(start).Seconds(),
+	})
+}
+
+// IntegrationMethod describes a Newton-Cotes formula the integration endpoints
+// accept, ErrorOrder is p in the O(hᵖ) error of its composite form.
+type IntegrationMethod struct {
+	Formula    string `json:"formula"`
+	Order      int    `json:"order"`
+	Strategy   string `json:"strategy"`
+	ErrorOrder int    `json:"errorOrder"`
+}
+
+type IntegrationMethodsResponse struct {
+	Methods []IntegrationMethod `json:"methods"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r IntegrationMethodsResponse) MarshalCSV() ([]string, [][]string) {
+	rows := make([][]string, 0, len(r.Methods))
+	for _, method := range r.Methods {
+		rows = append(rows, []string{
+			method.Formula,
+			strconv.Itoa(method.Order),
+			method.Strategy,
+			strconv.Itoa(method.ErrorOrder),
+		})
+	}
+
+	return []string{"formula", "order", "strategy", "errorOrder"}, rows
+}
+
+// IntegrationMethodsHandler lists the formula and order pairs the integration
+// endpoints accept, with the expected convergence of each.
+func (*Server) IntegrationMethodsHandler(c echo.Context) error {
+	var methods []IntegrationMethod
+
+	for _, formula := range []newtoncotes.FormulaType{newtoncotes.ClosedFormulaType, newtoncotes.OpenFormulaType} {
+		for _, order := range []newtoncotes.NewtonCotesOrder{
+			newtoncotes.FirstOrder, newtoncotes.SecondOrder, newtoncotes.ThirdOrder,
+		} {
+			strategy, err := newtoncotes.NewStrategy(formula, order)
+			if err != nil {
+				return err
+			}
+
+			methods = append(methods, IntegrationMethod{
+				Formula:    string(formula),
+				Order:      int(order),
+				Strategy:   strategy.Description(),
+				ErrorOrder: strategy.ErrorOrder(),
+			})
+		}
+	}
+
+	return Respond(c, http.StatusOK, IntegrationMethodsResponse{Methods: methods})
+}
+
+// integrate compiles expression and integrates it over [left, right], adaptively
+// when partitions is above the configured cap, failing with the HTTP error the
+// handlers should answer with.
+func (s *Server) integrate(
+	ctx context.Context,
+	strategy newtoncotes.NewtonCotesStrategy,
+	variable, expression string,
+	left, right float64,
+	partitions uint64,
+) (*newtoncotes.CappedResult, error) {
+	expr, guard, err := s.compileExpression(ctx, variable, expression)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := newtoncotes.NewNewtonCotesUseCase(strategy).
+		CalculateCapped(ctx, expr, left, right, partitions, s.cfg.Integration.MaxPartitions)
+	if err := evaluationError(guard, err); err != nil {
+		return nil, err
+	}
+	if math.IsNaN(result.Area) || math.IsInf(result.Area, 0) {
+		return nil, echo.NewHTTPError(http.StatusUnprocessableEntity, nonFiniteAreaMessage(expr, left, right, result.Area))
+	}
+
+	return result, nil
+}
+
+// nonFiniteAreaMessage explains a non-finite integral with the sub-intervals
+// where the integrand is finite, found by expressions.DetectDomain, which it
+// can be integrated over instead.
+func nonFiniteAreaMessage(expr expressions.SingleVariableExpr, left, right, area float64) string {
+	message := fmt.Sprintf("%s: area is %v", newtoncotes.ErrNonFiniteArea, area)
+
+	intervals, err := expressions.DetectDomain(expr, min(left, right), max(left, right), domainSamples)
+	switch {
+	case err != nil:
+		return message
+	case len(intervals) == 0:
+		return message + ", the integrand is not finite anywhere on the interval"
+	}
+
+	finite := make([]string, len(intervals))
+	for i, interval := range intervals {
+		finite[i] = fmt.Sprintf("[%g, %g]", interval.Left, interval.Right)
+	}
+
+	return message + ", the integrand is only finite on " + strings.Join(finite, ", ")
+}
@@ -0,0 +1,397 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	exprgenerators "github.com/taldoflemis/nume/internal/expr_generators"
+	"github.com/taldoflemis/nume/internal/parsers"
+	"github.com/taldoflemis/nume/internal/precision"
+	newtoncotes "github.com/taldoflemis/nume/internal/usecases/newton_cotes"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	parser, err := parsers.NewParticipalLatexParser()
+	require.NoError(t, err)
+
+	generator := exprgenerators.NewLatexExpressionGenerator(parser)
+
+	return &Server{
+		expressionGenerator:     generator,
+		dualExpressionGenerator: generator,
+	}
+}
+
+const newtonCotesRequestBody = `{
+	"expression": "x^2",
+	"left": 0,
+	"right": 3,
+	"partitions": 30,
+	"formula": "closed",
+	"order": 2
+}`
+
+func TestNewtonCotesHandler(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                string
+		accept              string
+		expectedContentType string
+	}{
+		{
+			name:                "JSON",
+			accept:              echo.MIMEApplicationJSON,
+			expectedContentType: echo.MIMEApplicationJSON,
+		},
+		{
+			name:                "CSV",
+			accept:              "text/csv, application/json;q=0.9",
+			expectedContentType: MIMETextCSV,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrals/newton-cotes",
+				strings.NewReader(newtonCotesRequestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAccept, test.accept)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := newTestServer(t)
+
+			// Act
+			err := s.NewtonCotesHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.Code)
+			assert.Contains(t, resp.Header().Get(echo.HeaderContentType), test.expectedContentType)
+
+			var result float64
+			if test.expectedContentType == MIMETextCSV {
+				records, err := csv.NewReader(resp.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 2)
+				assert.Equal(t, []string{"strategy", "left", "right", "partitions", "result", "method", "errorOrder"}, records[0])
+				assert.Equal(t, "4", records[1][6])
+				assert.Equal(t, "Simpson's One-Third Rule", records[1][0])
+
+				result, err = strconv.ParseFloat(records[1][4], 64)
+				require.NoError(t, err)
+			} else {
+				var body IntegralResponse
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				assert.Equal(t, "Simpson's One-Third Rule", body.Strategy)
+				assert.Equal(t, 4, body.ErrorOrder)
+				result = body.Result
+			}
+
+			assert.InDelta(t, 9.0, result, 1e-2)
+		})
+	}
+}
+
+func TestNewtonCotesHandlerRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "Unknown formula",
+			body: `{"expression": "x", "left": 0, "right": 1, "partitions": 1, "formula": "ajar", "order": 1}`,
+		},
+		{
+			name: "Unknown variable",
+			body: `{"expression": "y", "left": 0, "right": 1, "partitions": 1, "formula": "closed", "order": 1}`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrals/newton-cotes", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := newTestServer(t)
+
+			// Act
+			err := s.NewtonCotesHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		})
+	}
+}
+
+func TestNewtonCotesHandlerReportsTheFiniteDomain(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "Logarithm of negative values",
+			body:     `{"expression": "\\ln{x}", "left": -1, "right": 1, "partitions": 10, "formula": "closed", "order": 1}`,
+			expected: ", 1]",
+		},
+		{
+			name:     "Nowhere finite",
+			body:     `{"expression": "\\ln{x}", "left": -2, "right": -1, "partitions": 10, "formula": "closed", "order": 1}`,
+			expected: "not finite anywhere",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrals/newton-cotes", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := newTestServer(t)
+
+			// Act
+			err := s.NewtonCotesHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusUnprocessableEntity, httpErr.Code)
+			assert.Contains(t, httpErr.Message, newtoncotes.ErrNonFiniteArea.Error())
+			assert.Contains(t, httpErr.Message, test.expected)
+		})
+	}
+}
+
+func TestNewtonCotesHandlerUsesTheProfilePartitions(t *testing.T) {
+	t.Parallel()
+
+	for _, profile := range precision.Profiles() {
+		t.Run(string(profile), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrals/newton-cotes", strings.NewReader(
+				`{"expression": "x^2", "left": 0, "right": 1, "formula": "closed", "order": 1}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := newTestServer(t)
+			s.profile = profile
+
+			// Act
+			err := s.NewtonCotesHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			var body IntegralResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, profile.Defaults().Partitions, body.Partitions)
+		})
+	}
+}
+
+func TestBatchIntegralHandler(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	body := `{
+		"expressions": ["x^2", "2*x", "1"],
+		"left": 0,
+		"right": 3,
+		"partitions": 30,
+		"formula": "closed",
+		"order": 2
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/integrate/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+
+	// Act
+	err := s.BatchIntegralHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var response BatchIntegralResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	assert.Equal(t, "Simpson's One-Third Rule", response.Strategy)
+	assert.Equal(t, 4, response.ErrorOrder)
+	assert.Positive(t, response.WallTimeInSeconds)
+	require.Len(t, response.Results, 3)
+
+	expected := []struct {
+		expression string
+		result     float64
+	}{
+		{expression: "x^2", result: 9},
+		{expression: "2*x", result: 9},
+		{expression: "1", result: 3},
+	}
+	for i, want := range expected {
+		assert.Equal(t, want.expression, response.Results[i].Expression)
+		assert.Empty(t, response.Results[i].Error)
+		assert.InDelta(t, want.result, response.Results[i].Result, 1e-2)
+	}
+}
+
+func TestBatchIntegralHandlerReportsFailingExpressions(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	body := `{"expressions": ["x", "y"], "left": 0, "right": 1, "partitions": 10, "formula": "closed", "order": 1}`
+	req := httptest.NewRequest(http.MethodPost, "/integrate/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAccept, MIMETextCSV)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+
+	// Act
+	err := s.BatchIntegralHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"expression", "strategy", "left", "right", "partitions", "result", "error", "method", "errorOrder"}, records[0])
+	_, err = strconv.ParseFloat(records[1][5], 64)
+	assert.NoError(t, err)
+	assert.Empty(t, records[1][6])
+	assert.Equal(t, "y", records[2][0])
+	assert.NotEmpty(t, records[2][6])
+}
+
+func TestBatchIntegralHandlerRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "Empty batch",
+			body: `{"expressions": [], "left": 0, "right": 1, "partitions": 1, "formula": "closed", "order": 1}`,
+		},
+		{
+			name: "Too many expressions",
+			body: `{"expressions": [` + strings.Repeat(`"x", `, maxBatchExpressions) + `"x"], "left": 0, "right": 1, "partitions": 1, "formula": "closed", "order": 1}`,
+		},
+		{
+			name: "Unknown formula",
+			body: `{"expressions": ["x"], "left": 0, "right": 1, "partitions": 1, "formula": "ajar", "order": 1}`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrate/batch", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := newTestServer(t)
+
+			// Act
+			err := s.BatchIntegralHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		})
+	}
+}
+
+func TestNewtonCotesHandlerFallsBackToAdaptiveAboveTheCap(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	body := `{"expression": "\\sqrt{x}", "left": 0, "right": 1, "partitions": 1000000000, "formula": "closed", "order": 2}`
+	req := httptest.NewRequest(http.MethodPost, "/integrals/newton-cotes", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+	s.cfg.Integration.MaxPartitions = 1000
+
+	// Act
+	err := s.NewtonCotesHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	var response IntegralResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	assert.Equal(t, "adaptive", response.Method)
+	assert.LessOrEqual(t, response.Partitions, uint64(1000))
+	assert.InDelta(t, 2.0/3, response.Result, 1e-8)
+}
+
+func TestIntegrationMethodsHandler(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/integrals/methods", nil)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+
+	// Act
+	err := s.IntegrationMethodsHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var response IntegrationMethodsResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	expected := []struct {
+		formula    string
+		order      int
+		errorOrder int
+	}{
+		{formula: "closed", order: 1, errorOrder: 2},
+		{formula: "closed", order: 2, errorOrder: 4},
+		{formula: "closed", order: 3, errorOrder: 4},
+		{formula: "open", order: 1, errorOrder: 2},
+		{formula: "open", order: 2, errorOrder: 4},
+		{formula: "open", order: 3, errorOrder: 4},
+	}
+	require.Len(t, response.Methods, len(expected))
+	for i, want := range expected {
+		assert.Equal(t, want.formula, response.Methods[i].Formula)
+		assert.Equal(t, want.order, response.Methods[i].Order)
+		assert.Equal(t, want.errorOrder, response.Methods[i].ErrorOrder)
+		assert.NotEmpty(t, response.Methods[i].Strategy)
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+// maxBenchmarkEvaluations bounds the evaluations of each benchmarked method.
+const maxBenchmarkEvaluations = 100000
+
+var ErrTooManyBenchmarkEvaluations = fmt.Errorf("benchmark cannot use more than %d evaluations per method",
+	maxBenchmarkEvaluations)
+
+// IntegrationBenchmarkRequest compares the integration methods on an
+// expression whose integral over [Left, Right] is Exact, giving each method
+// about Evaluations function evaluations.
+type IntegrationBenchmarkRequest struct {
+	Expression  string  `json:"expression"`
+	Variable    string  `json:"variable"`
+	Left        float64 `json:"left"`
+	Right       float64 `json:"right"`
+	Exact       float64 `json:"exact"`
+	Evaluations uint64  `json:"evaluations"`
+}
+
+type IntegrationBenchmarkEntry struct {
+	Method              string  `json:"method"`
+	Partitions          uint64  `json:"partitions"`
+	Evaluations         uint64  `json:"evaluations"`
+	Area                float64 `json:"area"`
+	AbsoluteError       float64 `json:"absoluteError"`
+	CorrectDigits       float64 `json:"correctDigits"`
+	DigitsPerEvaluation float64 `json:"digitsPerEvaluation"`
+}
+
+// IntegrationBenchmarkResponse has the methods from the most to the least
+// accurate.
+type IntegrationBenchmarkResponse struct {
+	Exact   float64                     `json:"exact"`
+	Best    string                      `json:"best"`
+	Methods []IntegrationBenchmarkEntry `json:"methods"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r IntegrationBenchmarkResponse) MarshalCSV() ([]string, [][]string) {
+	rows := make([][]string, 0, len(r.Methods))
+	for _, method := range r.Methods {
+		rows = append(rows, []string{
+			method.Method,
+			strconv.FormatUint(method.Partitions, 10),
+			strconv.FormatUint(method.Evaluations, 10),
+			formatFloat(method.Area),
+			formatFloat(method.AbsoluteError),
+			formatFloat(method.CorrectDigits),
+			formatFloat(method.DigitsPerEvaluation),
+		})
+	}
+
+	return []string{
+		"method", "partitions", "evaluations", "area", "absoluteError", "correctDigits", "digitsPerEvaluation",
+	}, rows
+}
+
+// IntegrationBenchmarkHandler tells which integration method is the most
+// accurate for an expression at matched cost.
+func (s *Server) IntegrationBenchmarkHandler(c echo.Context) error {
+	var req IntegrationBenchmarkRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if req.Variable == "" {
+		req.Variable = defaultVariable
+	}
+	logComputation(c, req)
+
+	if req.Evaluations > maxBenchmarkEvaluations {
+		return echo.NewHTTPError(http.StatusBadRequest, ErrTooManyBenchmarkEvaluations.Error())
+	}
+
+	ctx := c.Request().Context()
+	expr, guard, err := s.compileExpression(ctx, req.Variable, req.Expression)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	benchmark, err := usecases.BenchmarkIntegration(ctx, expr, req.Left, req.Right, req.Exact, req.Evaluations)
+	if err := evaluationError(guard, err); err != nil {
+		return err
+	}
+
+	methods := make([]IntegrationBenchmarkEntry, len(benchmark.Entries))
+	for i, entry := range benchmark.Entries {
+		methods[i] = IntegrationBenchmarkEntry(entry)
+	}
+
+	return Respond(c, http.StatusOK, IntegrationBenchmarkResponse{
+		Exact:   benchmark.Exact,
+		Best:    benchmark.Best().Method,
+		Methods: methods,
+	})
+}
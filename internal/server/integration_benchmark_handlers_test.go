@@ -0,0 +1,87 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationBenchmarkHandler(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/integrals/benchmark", strings.NewReader(
+		`{"expression": "x^4", "left": 0, "right": 1, "exact": 0.2, "evaluations": 24}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := newTestServer(t)
+
+	// Act
+	err := s.IntegrationBenchmarkHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var body IntegrationBenchmarkResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	require.NotEmpty(t, body.Methods)
+	assert.Contains(t, body.Best, "Gauss-Legendre", "x⁴ is integrated exactly by the 3 and 4 point rules")
+	assert.Equal(t, body.Best, body.Methods[0].Method)
+	for _, method := range body.Methods {
+		assert.LessOrEqual(t, method.Evaluations, uint64(24), method.Method)
+	}
+}
+
+func TestIntegrationBenchmarkHandlerRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "NoBudget",
+			body: `{"expression": "x", "left": 0, "right": 1, "exact": 0.5}`,
+		},
+		{
+			name: "TooManyEvaluations",
+			body: `{"expression": "x", "left": 0, "right": 1, "exact": 0.5, "evaluations": 100001}`,
+		},
+		{
+			name: "ReversedInterval",
+			body: `{"expression": "x", "left": 1, "right": 0, "exact": -0.5, "evaluations": 10}`,
+		},
+		{
+			name: "UnknownVariable",
+			body: `{"expression": "y", "left": 0, "right": 1, "exact": 0.5, "evaluations": 10}`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/integrals/benchmark", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+			s := newTestServer(t)
+
+			// Act
+			err := s.IntegrationBenchmarkHandler(c)
+
+			// Assert
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/taldoflemis/nume/configs"
+)
+
+var (
+	ErrMatrixTooLarge    = errors.New("matrix exceeds the maximum dimension")
+	ErrExpressionTooLong = errors.New("expression exceeds the maximum length")
+)
+
+// matrixPayload is a request carrying matrices, checked against the maximum
+// dimension once bound.
+type matrixPayload interface {
+	payloadMatrices() [][][]float64
+}
+
+// expressionPayload is a request carrying expressions, checked against the
+// maximum length once bound.
+type expressionPayload interface {
+	payloadExpressions() []string
+}
+
+// limitedBinder binds like echo's default binder, then rejects matrices and
+// expressions beyond the configured limits, so each handler reports them as
+// a bad request along with its other binding errors.
+type limitedBinder struct {
+	echo.DefaultBinder
+	limits configs.RequestLimitsCfg
+}
+
+func (b *limitedBinder) Bind(i any, c echo.Context) error {
+	if err := b.DefaultBinder.Bind(i, c); err != nil {
+		return err
+	}
+
+	return checkPayloadLimits(i, b.limits)
+}
+
+// checkPayloadLimits checks the matrices and expressions of payload, a zero
+// limit disabling its check.
+func checkPayloadLimits(payload any, limits configs.RequestLimitsCfg) error {
+	if matrices, ok := payload.(matrixPayload); ok && limits.MaxMatrixDimension > 0 {
+		for _, matrix := range matrices.payloadMatrices() {
+			if err := checkMatrixDimension(matrix, limits.MaxMatrixDimension); err != nil {
+				return err
+			}
+		}
+	}
+
+	if expressions, ok := payload.(expressionPayload); ok && limits.MaxExpressionLength > 0 {
+		for i, expression := range expressions.payloadExpressions() {
+			if len(expression) > limits.MaxExpressionLength {
+				return fmt.Errorf("%w: expression %d has %d bytes, the maximum is %d",
+					ErrExpressionTooLong, i, len(expression), limits.MaxExpressionLength)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkMatrixDimension bounds both the rows and the columns, as rectangular
+// and ragged matrices are only rejected later by the use cases.
+func checkMatrixDimension(matrix [][]float64, maxDimension int) error {
+	if len(matrix) > maxDimension {
+		return fmt.Errorf("%w: %d rows, the maximum is %d", ErrMatrixTooLarge, len(matrix), maxDimension)
+	}
+
+	for i, row := range matrix {
+		if len(row) > maxDimension {
+			return fmt.Errorf("%w: row %d has %d columns, the maximum is %d",
+				ErrMatrixTooLarge, i, len(row), maxDimension)
+		}
+	}
+
+	return nil
+}
+
+// newBodyLimit rejects API requests whose body exceeds maxBytes with a 413.
+func newBodyLimit(maxBytes int64) echo.MiddlewareFunc {
+	return middleware.BodyLimit(strconv.FormatInt(maxBytes, 10) + "B")
+}
+
+func (r PowerRequest) payloadMatrices() [][][]float64 { return [][][]float64{r.Matrix} }
+
+func (r DecomposeRequest) payloadMatrices() [][][]float64 { return [][][]float64{r.Matrix} }
+
+func (r LinearSystemRequest) payloadMatrices() [][][]float64 { return [][][]float64{r.Matrix} }
+
+func (r MatrixRequest) payloadMatrices() [][][]float64 { return [][][]float64{r.Matrix} }
+
+func (r EvaluateRequest) payloadExpressions() []string { return []string{r.Expression} }
+
+func (r NewtonCotesRequest) payloadExpressions() []string { return []string{r.Expression} }
+
+func (r IntegrationBenchmarkRequest) payloadExpressions() []string { return []string{r.Expression} }
+
+func (r DerivativeRequest) payloadExpressions() []string { return []string{r.Expression} }
+
+func (r DoubleIntegralRequest) payloadExpressions() []string { return []string{r.Expression} }
+
+func (r BatchIntegralRequest) payloadExpressions() []string { return r.Expressions }
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/configs"
+)
+
+func newLimitedServer(t *testing.T, limits configs.RequestLimitsCfg) *Server {
+	t.Helper()
+
+	s := NewServer(configs.Config{HTTP: configs.HTTPCfg{APIPrefix: "/api", Limits: limits}})
+	s.SetDefaultMiddlewares()
+	require.NoError(t, s.RegisterRoutes())
+
+	return s
+}
+
+func post(s *Server, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	s.BaseEchoServer.ServeHTTP(resp, req)
+
+	return resp
+}
+
+// identityJSON is the JSON of the n×n identity matrix.
+func identityJSON(n int) string {
+	rows := make([]string, n)
+	for i := range rows {
+		row := make([]string, n)
+		for j := range row {
+			row[j] = "0"
+		}
+		row[i] = "1"
+		rows[i] = "[" + strings.Join(row, ",") + "]"
+	}
+
+	return "[" + strings.Join(rows, ",") + "]"
+}
+
+func TestRequestLimits(t *testing.T) {
+	t.Parallel()
+
+	limits := configs.RequestLimitsCfg{
+		MaxBodyBytes:        4096,
+		MaxMatrixDimension:  4,
+		MaxExpressionLength: 32,
+	}
+	longExpression := strings.Repeat("x+", 20) + "1"
+
+	tt := []struct {
+		name     string
+		target   string
+		body     string
+		expected int
+		message  string
+	}{
+		{
+			name:     "MatrixWithinTheLimit",
+			target:   "/api/matrix/invert",
+			body:     fmt.Sprintf(`{"matrix": %s}`, identityJSON(4)),
+			expected: http.StatusOK,
+		},
+		{
+			name:     "OversizedMatrix",
+			target:   "/api/matrix/invert",
+			body:     fmt.Sprintf(`{"matrix": %s}`, identityJSON(5)),
+			expected: http.StatusBadRequest,
+			message:  ErrMatrixTooLarge.Error(),
+		},
+		{
+			name:     "OversizedEigenMatrix",
+			target:   "/api/eigen/decompose",
+			body:     fmt.Sprintf(`{"matrix": %s}`, identityJSON(5)),
+			expected: http.StatusBadRequest,
+			message:  ErrMatrixTooLarge.Error(),
+		},
+		{
+			name:     "TooManyColumns",
+			target:   "/api/linear-systems/solve",
+			body:     `{"method": "gauss-seidel", "matrix": [[1, 2, 3, 4, 5]], "b": [1]}`,
+			expected: http.StatusBadRequest,
+			message:  ErrMatrixTooLarge.Error(),
+		},
+		{
+			name:     "LongExpression",
+			target:   "/api/evaluate",
+			body:     fmt.Sprintf(`{"expression": %q, "points": [1]}`, longExpression),
+			expected: http.StatusBadRequest,
+			message:  ErrExpressionTooLong.Error(),
+		},
+		{
+			name:   "LongExpressionInABatch",
+			target: "/api/integrate/batch",
+			body: fmt.Sprintf(`{"expressions": ["x", %q], "left": 0, "right": 1, "partitions": 2}`,
+				longExpression),
+			expected: http.StatusBadRequest,
+			message:  ErrExpressionTooLong.Error(),
+		},
+		{
+			name:     "OversizedBody",
+			target:   "/api/matrix/invert",
+			body:     fmt.Sprintf(`{"matrix": [[1]], "padding": %q}`, strings.Repeat("a", 5000)),
+			expected: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			s := newLimitedServer(t, limits)
+
+			// Act
+			resp := post(s, test.target, test.body)
+
+			// Assert
+			assert.Equal(t, test.expected, resp.Code, resp.Body.String())
+			assert.Contains(t, resp.Body.String(), test.message)
+		})
+	}
+}
+
+func TestRequestLimitsAreDisabledByZero(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s := newLimitedServer(t, configs.RequestLimitsCfg{})
+
+	// Act
+	resp := post(s, "/api/matrix/invert", fmt.Sprintf(`{"matrix": %s}`, identityJSON(50)))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+	"github.com/taldoflemis/nume/internal/usecases/linearsystems"
+)
+
+const (
+	LinearSystemMethodLU                  = "lu"
+	LinearSystemMethodGaussianElimination = "gauss"
+	LinearSystemMethodCholesky            = "cholesky"
+	LinearSystemMethodJacobi              = "jacobi"
+	LinearSystemMethodGaussSeidel         = "gauss-seidel"
+	LinearSystemMethodSOR                 = "sor"
+)
+
+var ErrUnknownLinearSystemMethod = errors.New("unknown linear system method")
+
+// LinearSystemRequest solves Ax = b. Relaxation is the factor ω of SOR,
+// Gauss-Seidel's 1 when left out.
+type LinearSystemRequest struct {
+	Method        string      `json:"method"`
+	Matrix        [][]float64 `json:"matrix"`
+	B             []float64   `json:"b"`
+	Epsilon       float64     `json:"epsilon"`
+	MaxIterations uint64      `json:"maxIterations"`
+	Relaxation    float64     `json:"relaxation,omitempty"`
+}
+
+// LinearSystemResponse has the residual after each iteration of the
+// iterative methods as Residuals. Converged is false when they ran out of
+// iterations, and always true for the direct methods, which report their
+// factors PA = LU instead, see linearsystems.DirectSolution.
+type LinearSystemResponse struct {
+	Method      string      `json:"method"`
+	Solution    []float64   `json:"solution"`
+	Iterations  uint64      `json:"iterations"`
+	Residual    float64     `json:"residual"`
+	Residuals   []float64   `json:"residuals,omitempty"`
+	Converged   bool        `json:"converged"`
+	Lower       [][]float64 `json:"lower,omitempty"`
+	Upper       [][]float64 `json:"upper,omitempty"`
+	Forward     []float64   `json:"forward,omitempty"`
+	Permutation []int       `json:"permutation,omitempty"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r LinearSystemResponse) MarshalCSV() ([]string, [][]string) {
+	header := []string{"method", "iterations", "residual", "converged"}
+	row := []string{
+		r.Method,
+		strconv.FormatUint(r.Iterations, 10),
+		formatFloat(r.Residual),
+		strconv.FormatBool(r.Converged),
+	}
+
+	for i, component := range r.Solution {
+		header = append(header, fmt.Sprintf("x_%d", i+1))
+		row = append(row, formatFloat(component))
+	}
+
+	return header, [][]string{row}
+}
+
+func (s *Server) LinearSystemHandler(c echo.Context) error {
+	var req LinearSystemRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	defaults := s.profile.Defaults()
+	if req.Method == "" {
+		req.Method = LinearSystemMethodLU
+	}
+	if req.Epsilon == 0 {
+		req.Epsilon = defaults.Epsilon
+	}
+	if req.MaxIterations == 0 {
+		req.MaxIterations = defaults.MaxIterations
+	}
+	if req.Method == LinearSystemMethodSOR && req.Relaxation == 0 {
+		req.Relaxation = 1
+	}
+	logComputation(c, req)
+
+	ctx := c.Request().Context()
+	useCase := usecases.NewLinearSystemUseCase()
+	directUseCase := linearsystems.NewDirectUseCase()
+
+	var result *usecases.LinearSystemResult
+	var direct *linearsystems.DirectSolution
+	var err error
+
+	switch req.Method {
+	case LinearSystemMethodLU:
+		direct, err = directUseCase.LU(ctx, req.Matrix, req.B)
+	case LinearSystemMethodGaussianElimination:
+		direct, err = directUseCase.GaussianElimination(ctx, req.Matrix, req.B)
+	case LinearSystemMethodCholesky:
+		direct, err = directUseCase.Cholesky(ctx, req.Matrix, req.B)
+	case LinearSystemMethodJacobi:
+		result, err = useCase.Jacobi(ctx, req.Matrix, req.B, req.Epsilon, req.MaxIterations)
+	case LinearSystemMethodGaussSeidel:
+		result, err = useCase.GaussSeidel(ctx, req.Matrix, req.B, req.Epsilon, req.MaxIterations)
+	case LinearSystemMethodSOR:
+		result, err = useCase.SOR(ctx, req.Matrix, req.B, req.Relaxation, req.Epsilon, req.MaxIterations)
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownLinearSystemMethod, req.Method)
+	}
+
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	if direct != nil {
+		return Respond(c, http.StatusOK, LinearSystemResponse{
+			Method:      req.Method,
+			Solution:    direct.Solution,
+			Residual:    direct.Residual,
+			Converged:   true,
+			Lower:       direct.Lower,
+			Upper:       direct.Upper,
+			Forward:     direct.Forward,
+			Permutation: direct.Permutation,
+		})
+	}
+
+	return Respond(c, http.StatusOK, LinearSystemResponse{
+		Method:     req.Method,
+		Solution:   result.Solution,
+		Iterations: result.Iterations,
+		Residual:   result.Residual,
+		Residuals:  result.Residuals,
+		Converged:  result.Converged,
+	})
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinearSystemHandler(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		body             string
+		expectedStatus   int
+		expectedSolution []float64
+		maxResidual      float64
+	}{
+		{
+			name:             "LU by default",
+			body:             `{"matrix": [[4, -1], [-1, 4]], "b": [7, 2]}`,
+			expectedStatus:   http.StatusOK,
+			expectedSolution: []float64{2, 1},
+			maxResidual:      1e-12,
+		},
+		{
+			name:             "Gauss-Seidel",
+			body:             `{"method": "gauss-seidel", "matrix": [[4, -1], [-1, 4]], "b": [7, 2], "epsilon": 1e-12, "maxIterations": 100}`,
+			expectedStatus:   http.StatusOK,
+			expectedSolution: []float64{2, 1},
+			maxResidual:      1e-10,
+		},
+		{
+			name:             "SOR",
+			body:             `{"method": "sor", "relaxation": 1.1, "matrix": [[4, -1], [-1, 4]], "b": [7, 2], "epsilon": 1e-12, "maxIterations": 100}`,
+			expectedStatus:   http.StatusOK,
+			expectedSolution: []float64{2, 1},
+			maxResidual:      1e-10,
+		},
+		{
+			name:           "SOR outside (0, 2)",
+			body:           `{"method": "sor", "relaxation": 2.5, "matrix": [[4, -1], [-1, 4]], "b": [7, 2]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Dimension mismatch",
+			body:           `{"matrix": [[4, -1], [-1, 4]], "b": [7]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unknown method",
+			body:           `{"method": "cramer", "matrix": [[1]], "b": [1]}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/linear-systems/solve", strings.NewReader(test.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := &Server{}
+
+			// Act
+			err := s.LinearSystemHandler(c)
+
+			// Assert
+			if test.expectedStatus != http.StatusOK {
+				var httpErr *echo.HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, test.expectedStatus, httpErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			var body LinearSystemResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.InDeltaSlice(t, test.expectedSolution, body.Solution, 1e-9)
+			assert.LessOrEqual(t, body.Residual, test.maxResidual)
+			assert.True(t, body.Converged)
+		})
+	}
+}
+
+func TestLinearSystemHandlerReturnsTheFactors(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name   string
+		method string
+	}{
+		{name: "LU", method: LinearSystemMethodLU},
+		{name: "Gaussian elimination", method: LinearSystemMethodGaussianElimination},
+		{name: "Cholesky", method: LinearSystemMethodCholesky},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/linear-systems/solve", strings.NewReader(
+				`{"method": "`+test.method+`", "matrix": [[4, -1], [-1, 4]], "b": [7, 2]}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			resp := httptest.NewRecorder()
+			c := e.NewContext(req, resp)
+			s := &Server{}
+
+			// Act
+			err := s.LinearSystemHandler(c)
+
+			// Assert
+			require.NoError(t, err)
+			var body LinearSystemResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.InDeltaSlice(t, []float64{2, 1}, body.Solution, 1e-12)
+			assert.True(t, body.Converged)
+			require.Len(t, body.Lower, 2)
+			require.Len(t, body.Upper, 2)
+			assert.Zero(t, body.Lower[0][1])
+			assert.Zero(t, body.Upper[1][0])
+			assert.Len(t, body.Forward, 2)
+			assert.Equal(t, []int{0, 1}, body.Permutation)
+			assert.Empty(t, body.Residuals)
+		})
+	}
+}
+
+func TestLinearSystemHandlerReturnsTheResidualHistory(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/linear-systems/solve", strings.NewReader(
+		`{"method": "jacobi", "matrix": [[4, -1], [-1, 4]], "b": [7, 2], "epsilon": 1e-12, "maxIterations": 3}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	c := e.NewContext(req, resp)
+	s := &Server{}
+
+	// Act
+	err := s.LinearSystemHandler(c)
+
+	// Assert
+	require.NoError(t, err)
+	var body LinearSystemResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.False(t, body.Converged)
+	require.Len(t, body.Residuals, 3)
+	assert.Less(t, body.Residuals[2], body.Residuals[0])
+	assert.Equal(t, body.Residual, body.Residuals[2])
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+type MatrixRequest struct {
+	Matrix [][]float64 `json:"matrix"`
+}
+
+type MatrixInverseResponse struct {
+	Inverse [][]float64 `json:"inverse"`
+}
+
+// MarshalCSV implements CSVMarshaler.
+func (r MatrixInverseResponse) MarshalCSV() ([]string, [][]string) {
+	return matrixToCSV(r.Inverse)
+}
+
+func (*Server) MatrixInverseHandler(c echo.Context) error {
+	var req MatrixRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	logComputation(c, req)
+
+	inverse, err := usecases.NewMatrixUseCase().MatrixInverse(c.Request().Context(), req.Matrix)
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	return Respond(c, http.StatusOK, MatrixInverseResponse{
+		Inverse: inverse,
+	})
+}
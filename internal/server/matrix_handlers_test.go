@@ -0,0 +1,88 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatrixInverseHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Invertible matrix as JSON", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/matrix/invert",
+			strings.NewReader(`{"matrix": [[4, 7], [2, 6]]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		resp := httptest.NewRecorder()
+		c := e.NewContext(req, resp)
+		s := &Server{}
+
+		// Act
+		err := s.MatrixInverseHandler(c)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		var body MatrixInverseResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		expected := [][]float64{{0.6, -0.7}, {-0.2, 0.4}}
+		for i := range expected {
+			for j := range expected[i] {
+				assert.InDelta(t, expected[i][j], body.Inverse[i][j], 1e-12)
+			}
+		}
+	})
+
+	t.Run("Invertible matrix as CSV", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/matrix/invert",
+			strings.NewReader(`{"matrix": [[2, 0], [0, 4]]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAccept, MIMETextCSV)
+		resp := httptest.NewRecorder()
+		c := e.NewContext(req, resp)
+		s := &Server{}
+
+		// Act
+		err := s.MatrixInverseHandler(c)
+
+		// Assert
+		require.NoError(t, err)
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"col_1", "col_2"}, {"0.5", "0"}, {"0", "0.25"}}, records)
+	})
+
+	t.Run("Singular matrix", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/matrix/invert",
+			strings.NewReader(`{"matrix": [[1, 2], [2, 4]]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		c := e.NewContext(req, httptest.NewRecorder())
+		s := &Server{}
+
+		// Act
+		err := s.MatrixInverseHandler(c)
+
+		// Assert
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusUnprocessableEntity, httpErr.Code)
+		assert.Contains(t, httpErr.Message, "singular")
+	})
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	MIMETextCSV            = "text/csv"
+	MIMETextCSVCharsetUTF8 = MIMETextCSV + "; charset=UTF-8"
+	formatQueryParam       = "format"
+)
+
+// CSVMarshaler is implemented by responses that can also be rendered as rows.
+type CSVMarshaler interface {
+	MarshalCSV() (header []string, rows [][]string)
+}
+
+// Respond writes the payload as CSV when the client asks for it, through the
+// Accept header or the format query parameter, and as JSON otherwise.
+func Respond(c echo.Context, status int, payload any) error {
+	if csvPayload, ok := payload.(CSVMarshaler); ok && wantsCSV(c.Request()) {
+		return writeCSV(c, status, csvPayload)
+	}
+
+	return c.JSON(status, payload)
+}
+
+func wantsCSV(r *http.Request) bool {
+	if format := r.URL.Query().Get(formatQueryParam); format != "" {
+		return strings.EqualFold(format, "csv")
+	}
+
+	accept := r.Header.Get(echo.HeaderAccept)
+
+	return preferredMediaType(accept, echo.MIMEApplicationJSON, MIMETextCSV) == MIMETextCSV
+}
+
+// preferredMediaType returns the offer with the highest quality in the Accept
+// header, each offer takes the quality of its most specific matching range.
+// Ties, and a missing header, favor the first offer.
+func preferredMediaType(accept string, offers ...string) string {
+	if strings.TrimSpace(accept) == "" {
+		return offers[0]
+	}
+
+	best := ""
+	bestQuality := 0.0
+
+	for _, offer := range offers {
+		quality := offerQuality(accept, offer)
+		if quality > bestQuality {
+			best = offer
+			bestQuality = quality
+		}
+	}
+
+	return best
+}
+
+func offerQuality(accept, offer string) float64 {
+	offerType, _, _ := strings.Cut(offer, "/")
+
+	quality := 0.0
+	specificity := -1
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaRange, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		mediaRange = strings.ToLower(strings.TrimSpace(mediaRange))
+
+		rangeSpecificity := -1
+		switch {
+		case mediaRange == offer:
+			rangeSpecificity = 2
+		case mediaRange == offerType+"/*":
+			rangeSpecificity = 1
+		case mediaRange == "*/*":
+			rangeSpecificity = 0
+		}
+
+		if rangeSpecificity <= specificity {
+			continue
+		}
+
+		specificity = rangeSpecificity
+		quality = parseQuality(params)
+	}
+
+	return quality
+}
+
+func parseQuality(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found || strings.TrimSpace(name) != "q" {
+			continue
+		}
+
+		quality, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0
+		}
+		return quality
+	}
+
+	return 1
+}
+
+func writeCSV(c echo.Context, status int, payload CSVMarshaler) error {
+	header, rows := payload.MarshalCSV()
+
+	c.Response().Header().Set(echo.HeaderContentType, MIMETextCSVCharsetUTF8)
+	c.Response().WriteHeader(status)
+
+	writer := csv.NewWriter(c.Response())
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+// matrixToCSV renders each matrix row as a record, with a col_N header.
+func matrixToCSV(matrix [][]float64) ([]string, [][]string) {
+	header := []string{}
+	if len(matrix) > 0 {
+		for j := range matrix[0] {
+			header = append(header, "col_"+strconv.Itoa(j+1))
+		}
+	}
+
+	rows := make([][]string, 0, len(matrix))
+	for _, row := range matrix {
+		record := make([]string, 0, len(row))
+		for _, value := range row {
+			record = append(record, formatFloat(value))
+		}
+		rows = append(rows, record)
+	}
+
+	return header, rows
+}
+
+func formatFloat(value float64) string {
+	// Avoid printing -0, common in computed matrices
+	if value == 0 {
+		value = 0
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
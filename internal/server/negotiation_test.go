@@ -0,0 +1,33 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferredMediaType(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		accept   string
+		expected string
+	}{
+		{name: "Missing header", accept: "", expected: echo.MIMEApplicationJSON},
+		{name: "Wildcard", accept: "*/*", expected: echo.MIMEApplicationJSON},
+		{name: "Exact CSV", accept: "text/csv", expected: MIMETextCSV},
+		{name: "Type wildcard", accept: "text/*", expected: MIMETextCSV},
+		{name: "Higher quality CSV", accept: "application/json;q=0.5, text/csv", expected: MIMETextCSV},
+		{name: "Specific range wins over wildcard", accept: "text/csv;q=0.2, */*", expected: echo.MIMEApplicationJSON},
+		{name: "Unsupported type", accept: "application/xml", expected: ""},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, preferredMediaType(test.accept, echo.MIMEApplicationJSON, MIMETextCSV))
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package server
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	openAPIVersion = "3.0.3"
+
+	defaultAPITitle   = "nume"
+	defaultAPIVersion = "dev"
+
+	componentsSchemasRef = "#/components/schemas/"
+)
+
+var (
+	csvMarshalerType = reflect.TypeFor[CSVMarshaler]()
+
+	// errorResponse is the body echo answers HTTP errors with
+	errorResponse = struct {
+		Message string `json:"message"`
+	}{}
+)
+
+type OpenAPIDocument struct {
+	OpenAPI    string                                 `json:"openapi"`
+	Info       OpenAPIInfo                            `json:"info"`
+	Servers    []OpenAPIServer                        `json:"servers"`
+	Paths      map[string]map[string]OpenAPIOperation `json:"paths"`
+	Components OpenAPIComponents                      `json:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type OpenAPIServer struct {
+	URL string `json:"url"`
+}
+
+type OpenAPIOperation struct {
+	Summary     string                     `json:"summary"`
+	OperationID string                     `json:"operationId"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+}
+
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas"`
+}
+
+// OpenAPISchema is the subset of the OpenAPI schema object the API structs
+// need.
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Minimum              *float64                  `json:"minimum,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AllOf                []*OpenAPISchema          `json:"allOf,omitempty"`
+}
+
+// OpenAPIHandler serves the OpenAPI document of the API routes, generated
+// from their request and response structs.
+func (s *Server) OpenAPIHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, s.openAPIDocument())
+}
+
+func (s *Server) openAPIDocument() OpenAPIDocument {
+	info := OpenAPIInfo{Title: s.cfg.App.Name, Version: s.cfg.App.Version}
+	if info.Title == "" {
+		info.Title = defaultAPITitle
+	}
+	if info.Version == "" {
+		info.Version = defaultAPIVersion
+	}
+
+	generator := newSchemaGenerator()
+	paths := make(map[string]map[string]OpenAPIOperation)
+
+	for _, route := range s.apiRoutes() {
+		operation := OpenAPIOperation{
+			Summary:     route.summary,
+			OperationID: route.operationID,
+			Responses: map[string]OpenAPIResponse{
+				"200": generator.successResponse(route.response),
+				"default": {
+					Description: "Error",
+					Content:     jsonContent(generator.schema(reflect.TypeOf(errorResponse), true)),
+				},
+			},
+		}
+
+		if route.request != nil {
+			operation.RequestBody = &OpenAPIRequestBody{
+				Required: true,
+				Content:  jsonContent(generator.schema(reflect.TypeOf(route.request), false)),
+			}
+		}
+
+		if paths[route.path] == nil {
+			paths[route.path] = make(map[string]OpenAPIOperation)
+		}
+		paths[route.path][strings.ToLower(route.method)] = operation
+	}
+
+	return OpenAPIDocument{
+		OpenAPI:    openAPIVersion,
+		Info:       info,
+		Servers:    []OpenAPIServer{{URL: s.cfg.HTTP.APIPrefix}},
+		Paths:      paths,
+		Components: OpenAPIComponents{Schemas: generator.components},
+	}
+}
+
+func jsonContent(schema *OpenAPISchema) map[string]OpenAPIMediaType {
+	return map[string]OpenAPIMediaType{echo.MIMEApplicationJSON: {Schema: schema}}
+}
+
+// schemaGenerator builds schemas from Go types, named structs are emitted
+// once as components and referenced everywhere else.
+type schemaGenerator struct {
+	components map[string]*OpenAPISchema
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{components: make(map[string]*OpenAPISchema)}
+}
+
+// successResponse documents the JSON body of response, and the CSV rendering
+// when it implements CSVMarshaler. A nil response is a free-form object.
+func (g *schemaGenerator) successResponse(response any) OpenAPIResponse {
+	if response == nil {
+		return OpenAPIResponse{
+			Description: "OK",
+			Content:     jsonContent(&OpenAPISchema{Type: "object"}),
+		}
+	}
+
+	responseType := reflect.TypeOf(response)
+	content := jsonContent(g.schema(responseType, true))
+	if responseType.Implements(csvMarshalerType) {
+		content[MIMETextCSV] = OpenAPIMediaType{Schema: &OpenAPISchema{Type: "string"}}
+	}
+
+	return OpenAPIResponse{Description: "OK", Content: content}
+}
+
+// schema describes t following its encoding/json encoding. Response fields
+// are required unless omitempty or a pointer, request fields all have
+// defaults or are validated by the handlers, so none is required.
+func (g *schemaGenerator) schema(t reflect.Type, response bool) *OpenAPISchema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := g.schema(t.Elem(), response)
+		if schema.Ref != "" {
+			// siblings of $ref are ignored, so nullable references go through
+			// a wrapping schema
+			return &OpenAPISchema{AllOf: []*OpenAPISchema{schema}, Nullable: true}
+		}
+		schema.Nullable = true
+		return schema
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		return &OpenAPISchema{Type: "integer", Format: "int64", Minimum: &zero}
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &OpenAPISchema{Type: "array", Items: g.schema(t.Elem(), response)}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: g.schema(t.Elem(), response)}
+	case reflect.Struct:
+		return g.structSchema(t, response)
+	default:
+		return &OpenAPISchema{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type, response bool) *OpenAPISchema {
+	name := t.Name()
+	if name != "" {
+		if _, ok := g.components[name]; ok {
+			return &OpenAPISchema{Ref: componentsSchemasRef + name}
+		}
+		// reserve the name first so recursive types terminate
+		g.components[name] = &OpenAPISchema{}
+	}
+
+	schema := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldName, omitEmpty, skip := jsonField(field)
+		if skip {
+			continue
+		}
+
+		schema.Properties[fieldName] = g.schema(field.Type, response)
+		if response && !omitEmpty && field.Type.Kind() != reflect.Pointer {
+			schema.Required = append(schema.Required, fieldName)
+		}
+	}
+
+	if name == "" {
+		return schema
+	}
+
+	*g.components[name] = *schema
+	return &OpenAPISchema{Ref: componentsSchemasRef + name}
+}
+
+// jsonField reads the encoding/json name of field and whether it is omitted
+// when empty or never encoded.
+func jsonField(field reflect.StructField) (name string, omitEmpty, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, strings.Contains(options, "omitempty"), false
+}
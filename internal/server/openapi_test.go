@@ -0,0 +1,113 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/configs"
+)
+
+func fetchOpenAPIDocument(t *testing.T) (*Server, []byte) {
+	t.Helper()
+
+	s := NewServer(configs.Config{HTTP: configs.HTTPCfg{APIPrefix: "/api"}})
+	require.NoError(t, s.RegisterRoutes())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil)
+	resp := httptest.NewRecorder()
+	s.BaseEchoServer.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	return s, resp.Body.Bytes()
+}
+
+func TestOpenAPIDocumentListsEveryRoute(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s, body := fetchOpenAPIDocument(t)
+
+	// Act
+	var document OpenAPIDocument
+	err := json.Unmarshal(body, &document)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, openAPIVersion, document.OpenAPI)
+	require.Len(t, document.Servers, 1)
+	assert.Equal(t, "/api", document.Servers[0].URL)
+
+	registered := 0
+	for _, route := range s.BaseEchoServer.Routes() {
+		path, ok := strings.CutPrefix(route.Path, "/api")
+		if !ok {
+			continue
+		}
+		registered++
+
+		operations, ok := document.Paths[path]
+		require.True(t, ok, "%s is not documented", route.Path)
+		assert.Contains(t, operations, strings.ToLower(route.Method), "%s %s is not documented", route.Method, route.Path)
+	}
+	assert.Equal(t, len(s.apiRoutes()), registered)
+}
+
+func TestOpenAPIDocumentReferencesResolve(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	_, body := fetchOpenAPIDocument(t)
+	var document OpenAPIDocument
+	require.NoError(t, json.Unmarshal(body, &document))
+
+	// Act
+	refs := regexp.MustCompile(`"\$ref":"`+componentsSchemasRef+`(\w+)"`).FindAllSubmatch(body, -1)
+
+	// Assert
+	require.NotEmpty(t, refs)
+	for _, ref := range refs {
+		assert.Contains(t, document.Components.Schemas, string(ref[1]))
+	}
+}
+
+func TestOpenAPISchemaFollowsTheJSONEncoding(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	_, body := fetchOpenAPIDocument(t)
+	var document OpenAPIDocument
+	require.NoError(t, json.Unmarshal(body, &document))
+
+	// Act
+	request := document.Components.Schemas["NewtonCotesRequest"]
+	evaluate := document.Components.Schemas["EvaluateRequest"]
+	point := document.Components.Schemas["EvaluatedPoint"]
+	batchResult := document.Components.Schemas["BatchIntegralResult"]
+
+	// Assert
+	require.NotNil(t, request)
+	assert.Equal(t, "object", request.Type)
+	assert.Equal(t, "number", request.Properties["left"].Type)
+	assert.Equal(t, "integer", request.Properties["partitions"].Type)
+	assert.Equal(t, "string", request.Properties["formula"].Type)
+	assert.Empty(t, request.Required)
+
+	require.NotNil(t, evaluate)
+	require.Len(t, evaluate.Properties["range"].AllOf, 1)
+	assert.Equal(t, componentsSchemasRef+"EvaluationRange", evaluate.Properties["range"].AllOf[0].Ref)
+	assert.True(t, evaluate.Properties["range"].Nullable)
+
+	require.NotNil(t, point)
+	assert.True(t, point.Properties["y"].Nullable)
+	assert.Equal(t, []string{"x"}, point.Required)
+
+	require.NotNil(t, batchResult)
+	assert.Equal(t, []string{"expression", "result"}, batchResult.Required)
+
+	newtonCotes := document.Paths["/integrals/newton-cotes"]["post"]
+	assert.Contains(t, newtonCotes.Responses["200"].Content, MIMETextCSV)
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+
+	"github.com/taldoflemis/nume/configs"
+)
+
+// newRateLimiter throttles the API routes per client IP, as extracted by the
+// server IPExtractor. Rejected requests get a 429 with a Retry-After of the
+// time needed to refill one token.
+func newRateLimiter(cfg configs.RateLimitCfg, apiPrefix string) echo.MiddlewareFunc {
+	burst := max(cfg.Burst, 1)
+	retryAfter := strconv.Itoa(int(math.Ceil(1 / cfg.RequestsPerSecond)))
+
+	store := middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+		Rate:      rate.Limit(cfg.RequestsPerSecond),
+		Burst:     burst,
+		ExpiresIn: time.Duration(cfg.ExpiresInSeconds) * time.Second,
+	})
+
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		// The frontend assets aren't CPU heavy
+		Skipper: func(c echo.Context) bool {
+			return !strings.HasPrefix(c.Request().URL.Path, apiPrefix)
+		},
+		Store: store,
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return c.RealIP(), nil
+		},
+		DenyHandler: func(c echo.Context, _ string, _ error) error {
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter)
+			return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taldoflemis/nume/configs"
+)
+
+func newRateLimitedServer(rateLimit configs.RateLimitCfg) *Server {
+	s := NewServer(configs.Config{
+		HTTP: configs.HTTPCfg{
+			APIPrefix: "/api",
+			RateLimit: rateLimit,
+		},
+	})
+	s.SetDefaultMiddlewares()
+	s.APIGroup.GET("/hello", s.HelloWorldHandler)
+
+	return s
+}
+
+// get requests target from clientIP, behind a trusted private proxy.
+func get(s *Server, target, clientIP string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.RemoteAddr = "10.0.0.1:4321"
+	req.Header.Set(echo.HeaderXForwardedFor, clientIP)
+	resp := httptest.NewRecorder()
+	s.BaseEchoServer.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestRateLimiterRejectsRequestsBeyondTheBurst(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s := newRateLimitedServer(configs.RateLimitCfg{RequestsPerSecond: 0.5, Burst: 2})
+
+	// Act
+	first := get(s, "/api/hello", "203.0.113.7")
+	second := get(s, "/api/hello", "203.0.113.7")
+	third := get(s, "/api/hello", "203.0.113.7")
+	otherClient := get(s, "/api/hello", "198.51.100.4")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, http.StatusTooManyRequests, third.Code)
+	assert.Equal(t, "2", third.Header().Get(echo.HeaderRetryAfter))
+	assert.Equal(t, http.StatusOK, otherClient.Code)
+}
+
+func TestRateLimiterRecoversAfterTheRefill(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s := newRateLimitedServer(configs.RateLimitCfg{RequestsPerSecond: 20, Burst: 1})
+	assert.Equal(t, http.StatusOK, get(s, "/api/hello", "203.0.113.7").Code)
+	assert.Equal(t, http.StatusTooManyRequests, get(s, "/api/hello", "203.0.113.7").Code)
+
+	// Act
+	time.Sleep(100 * time.Millisecond)
+	resp := get(s, "/api/hello", "203.0.113.7")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestRateLimiterOnlyThrottlesTheAPI(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s := newRateLimitedServer(configs.RateLimitCfg{RequestsPerSecond: 0.5, Burst: 1})
+	s.BaseEchoServer.GET("/index.html", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	// Act
+	codes := make([]int, 0, 3)
+	for range 3 {
+		codes = append(codes, get(s, "/index.html", "203.0.113.7").Code)
+	}
+
+	// Assert
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusOK}, codes)
+}
+
+func TestRateLimiterDisabledByZeroRate(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s := newRateLimitedServer(configs.RateLimitCfg{})
+
+	// Act
+	codes := make([]int, 0, 5)
+	for range 5 {
+		codes = append(codes, get(s, "/api/hello", "203.0.113.7").Code)
+	}
+
+	// Assert
+	assert.NotContains(t, codes, http.StatusTooManyRequests)
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	slogecho "github.com/samber/slog-echo"
+)
+
+const (
+	computationLogKey = "computation"
+	outcomeLogKey     = "outcome"
+
+	outcomeSuccess = "success"
+	outcomeError   = "error"
+)
+
+// logComputation attaches the parameters of the request to the access log
+// line written by the slog-echo middleware. Requests summarize themselves
+// through slog.LogValuer, so matrices and vectors are logged by size only.
+func logComputation(c echo.Context, params slog.LogValuer) {
+	slogecho.AddCustomAttributes(c, slog.Any(computationLogKey, params))
+}
+
+// logOutcome records whether the handler succeeded, the kind of error it
+// failed with and how long it took, next to the computation parameters.
+func logOutcome(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		err := next(c)
+
+		attrs := []any{slog.Duration("duration", time.Since(start))}
+		if err == nil {
+			attrs = append(attrs, slog.String("result", outcomeSuccess))
+		} else {
+			attrs = append(attrs, slog.String("result", outcomeError), slog.String("error_type", errorType(err)))
+		}
+		slogecho.AddCustomAttributes(c, slog.Group(outcomeLogKey, attrs...))
+
+		return err
+	}
+}
+
+// errorType names err by the status it is answered with, every error a
+// handler returns is an HTTP error or ends up as an internal one.
+func errorType(err error) string {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return http.StatusText(httpErr.Code)
+	}
+	return http.StatusText(http.StatusInternalServerError)
+}
+
+// dimensions summarizes a matrix as rows x columns, using the first row for
+// the column count.
+func dimensions(matrix [][]float64) string {
+	if len(matrix) == 0 {
+		return "0x0"
+	}
+	return fmt.Sprintf("%dx%d", len(matrix), len(matrix[0]))
+}
+
+// LogValue implements slog.LogValuer.
+func (r NewtonCotesRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "newton-cotes"),
+		slog.String("formula", r.Formula),
+		slog.Int("order", r.Order),
+		slog.Float64("left", r.Left),
+		slog.Float64("right", r.Right),
+		slog.Uint64("partitions", r.Partitions),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r BatchIntegralRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "batch-integral"),
+		slog.String("formula", r.Formula),
+		slog.Int("order", r.Order),
+		slog.Float64("left", r.Left),
+		slog.Float64("right", r.Right),
+		slog.Uint64("partitions", r.Partitions),
+		slog.Int("expressions", len(r.Expressions)),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r IntegrationBenchmarkRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "integration-benchmark"),
+		slog.Float64("left", r.Left),
+		slog.Float64("right", r.Right),
+		slog.Float64("exact", r.Exact),
+		slog.Uint64("evaluations", r.Evaluations),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r DoubleIntegralRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "double-integral"),
+		slog.Float64("x0", r.X0),
+		slog.Float64("x1", r.X1),
+		slog.Float64("y0", r.Y0),
+		slog.Float64("y1", r.Y1),
+		slog.Uint64("partitions", r.Partitions),
+		slog.Bool("samples", r.Samples),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r DerivativeRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "derivative"),
+		slog.String("variable", r.Variable),
+		slog.Float64("point", r.Point),
+		slog.String("philosophy", r.Philosophy),
+		slog.Int("order", r.Order),
+		slog.Float64("delta", r.Delta),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r PowerRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "power"),
+		slog.String("method", r.Method),
+		slog.String("matrix", dimensions(r.Matrix)),
+		slog.Float64("shift", r.Shift),
+		slog.Float64("epsilon", r.Epsilon),
+		slog.Uint64("max_iterations", r.MaxIterations),
+		slog.Bool("polish", r.Polish),
+		slog.String("normalization", r.Normalization),
+		slog.String("arithmetic", r.Arithmetic),
+		slog.Int("random_retries", r.RandomRetries),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r DecomposeRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "eigen-decompose"),
+		slog.String("matrix", dimensions(r.Matrix)),
+		slog.Int("max_iterations", r.MaxIterations),
+		slog.Float64("tolerance", r.Tolerance),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r MatrixRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "matrix-inverse"),
+		slog.String("matrix", dimensions(r.Matrix)),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r LinearSystemRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("kind", "linear-system"),
+		slog.String("method", r.Method),
+		slog.String("matrix", dimensions(r.Matrix)),
+		slog.Int("b", len(r.B)),
+		slog.Float64("epsilon", r.Epsilon),
+		slog.Uint64("max_iterations", r.MaxIterations),
+	)
+}
+
+// LogValue implements slog.LogValuer.
+func (r EvaluateRequest) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("kind", "evaluate"),
+		slog.Int("points", len(r.Points)),
+	}
+	if r.Range != nil {
+		attrs = append(attrs,
+			slog.Float64("left", r.Range.Left),
+			slog.Float64("right", r.Range.Right),
+			slog.Int("samples", r.Range.Samples),
+		)
+	}
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	slogecho "github.com/samber/slog-echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveLogged sends body to the newton-cotes route behind the access log
+// middleware and returns the decoded log line.
+func serveLogged(t *testing.T, body string) (int, map[string]any) {
+	t.Helper()
+
+	var logs bytes.Buffer
+	e := echo.New()
+	e.Use(slogecho.New(slog.New(slog.NewJSONHandler(&logs, nil))))
+	api := e.Group("/api")
+	api.Use(logOutcome)
+	api.POST("/integrals/newton-cotes", newTestServer(t).NewtonCotesHandler)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/integrals/newton-cotes", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	resp := httptest.NewRecorder()
+	e.ServeHTTP(resp, req)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+
+	return resp.Code, entry
+}
+
+func TestRequestLoggingRecordsIntegrationParameters(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	status, entry := serveLogged(t, newtonCotesRequestBody)
+
+	// Assert
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, map[string]any{
+		"kind":       "newton-cotes",
+		"formula":    "closed",
+		"order":      float64(2),
+		"left":       float64(0),
+		"right":      float64(3),
+		"partitions": float64(30),
+	}, entry[computationLogKey])
+
+	outcome, ok := entry[outcomeLogKey].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, outcomeSuccess, outcome["result"])
+	assert.Contains(t, outcome, "duration")
+	assert.NotContains(t, outcome, "error_type")
+}
+
+func TestRequestLoggingRecordsTheErrorType(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	body := `{"expression": "x", "left": 0, "right": 1, "partitions": 4, "formula": "closed", "order": 7}`
+
+	// Act
+	status, entry := serveLogged(t, body)
+
+	// Assert
+	require.Equal(t, http.StatusBadRequest, status)
+	outcome, ok := entry[outcomeLogKey].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, outcomeError, outcome["result"])
+	assert.Equal(t, http.StatusText(http.StatusBadRequest), outcome["error_type"])
+}
+
+func TestRequestLogValuesSummarizeMatrices(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		request  slog.LogValuer
+		expected string
+	}{
+		{
+			name:     "Matrix inverse",
+			request:  MatrixRequest{Matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}},
+			expected: "2x3",
+		},
+		{
+			name: "Linear system",
+			request: LinearSystemRequest{
+				Method: LinearSystemMethodLU,
+				Matrix: [][]float64{{4, 1}, {1, 3}},
+				B:      []float64{1, 2},
+			},
+			expected: "2x2",
+		},
+		{
+			name:     "Empty matrix",
+			request:  PowerRequest{},
+			expected: "0x0",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			attrs := test.request.LogValue().Group()
+
+			// Assert
+			var matrix string
+			for _, attr := range attrs {
+				if attr.Key == "matrix" {
+					matrix = attr.Value.String()
+				}
+			}
+			assert.Equal(t, test.expected, matrix)
+		})
+	}
+}
@@ -1,26 +1,176 @@
 package server
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	exprgenerators "github.com/taldoflemis/nume/internal/expr_generators"
+	"github.com/taldoflemis/nume/internal/parsers"
+)
+
+var (
+	ErrFrontendRoutes   = errors.New("failed to register the frontend routes")
+	ErrExpressionParser = errors.New("failed to build the expression parser")
+	ErrAPIRoutes        = errors.New("failed to register the API routes")
 )
 
+// RegisterRoutes registers the frontend and every API route. It runs once,
+// later calls return the outcome of the first, so routes are never
+// registered twice. The error wraps the sentinel of the group that failed.
 func (s *Server) RegisterRoutes() error {
-	// Register the frontend route
-	err := NewFrontendRoute(s.cfg, s.BaseEchoServer)
-	if err != nil {
+	s.registerOnce.Do(func() {
+		s.registerErr = s.registerRoutes()
+	})
+
+	return s.registerErr
+}
+
+func (s *Server) registerRoutes() error {
+	if err := NewFrontendRoute(s.cfg, s.BaseEchoServer); err != nil {
 		slog.Error("failed to register frontend route", slog.Any("error", err))
-		return err
+		return fmt.Errorf("%w: %w", ErrFrontendRoutes, err)
+	}
+
+	parser, err := parsers.NewParticipalLatexParser()
+	if err != nil {
+		slog.Error("failed to build the latex parser", slog.Any("error", err))
+		return fmt.Errorf("%w: %w", ErrExpressionParser, err)
+	}
+	generator := exprgenerators.NewLatexExpressionGenerator(parser)
+	s.expressionGenerator = generator
+	s.dualExpressionGenerator = generator
+
+	routes := s.apiRoutes()
+	if err := validateRoutes(routes); err != nil {
+		slog.Error("invalid API routes", slog.Any("error", err))
+		return fmt.Errorf("%w: %w", ErrAPIRoutes, err)
+	}
+
+	for _, route := range routes {
+		s.APIGroup.Add(route.method, route.path, route.handler)
 	}
 
-	// Register the API routes
-	s.APIGroup.GET("/hello", s.HelloWorldHandler)
+	return nil
+}
+
+// validateRoutes checks that no two routes share a method and path or an
+// operation ID, echo silently keeping the last handler of a duplicate.
+func validateRoutes(routes []apiRoute) error {
+	endpoints := make(map[string]bool, len(routes))
+	operations := make(map[string]bool, len(routes))
+
+	for _, route := range routes {
+		endpoint := route.method + " " + route.path
+		if endpoints[endpoint] {
+			return fmt.Errorf("duplicate route %s", endpoint)
+		}
+		endpoints[endpoint] = true
+
+		if operations[route.operationID] {
+			return fmt.Errorf("duplicate operation ID %q on %s", route.operationID, endpoint)
+		}
+		operations[route.operationID] = true
+
+		if route.handler == nil {
+			return fmt.Errorf("route %s has no handler", endpoint)
+		}
+	}
 
 	return nil
 }
 
+// apiRoute describes an API endpoint, it is both registered and documented in
+// the OpenAPI document from this description.
+type apiRoute struct {
+	method      string
+	path        string
+	operationID string
+	summary     string
+	handler     echo.HandlerFunc
+	// request and response are zero values of the bodies, nil when the
+	// endpoint takes no body or answers free-form JSON
+	request  any
+	response any
+}
+
+func (s *Server) apiRoutes() []apiRoute {
+	return []apiRoute{
+		{
+			method: http.MethodGet, path: "/hello", operationID: "hello",
+			summary: "Health check", handler: s.HelloWorldHandler,
+			response: map[string]string{},
+		},
+		{
+			method: http.MethodGet, path: "/health/compute", operationID: "computeHealth",
+			summary: "Known computations checked against their values, degraded when any drifts", handler: s.ComputeHealthHandler,
+			response: ComputeHealthResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/openapi.json", operationID: "openapi",
+			summary: "This OpenAPI document", handler: s.OpenAPIHandler,
+		},
+		{
+			method: http.MethodPost, path: "/eigen/power", operationID: "powerMethod",
+			summary: "Dominant, inverse or shifted eigenpair by the power method", handler: s.PowerHandler,
+			request: PowerRequest{}, response: PowerResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/eigen/decompose", operationID: "eigenDecompose",
+			summary: "Eigenvalues and eigenvectors of a symmetric matrix with the reconstruction error", handler: s.DecomposeHandler,
+			request: DecomposeRequest{}, response: DecomposeResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/integrals/newton-cotes", operationID: "newtonCotes",
+			summary: "Definite integral by a Newton-Cotes formula", handler: s.NewtonCotesHandler,
+			request: NewtonCotesRequest{}, response: IntegralResponse{},
+		},
+		{
+			method: http.MethodGet, path: "/integrals/methods", operationID: "integrationMethods",
+			summary: "Newton-Cotes formulas and their error orders", handler: s.IntegrationMethodsHandler,
+			response: IntegrationMethodsResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/integrals/benchmark", operationID: "integrationBenchmark",
+			summary: "Accuracy of every integration method at matched cost against an analytic value", handler: s.IntegrationBenchmarkHandler,
+			request: IntegrationBenchmarkRequest{}, response: IntegrationBenchmarkResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/integrate/batch", operationID: "batchIntegral",
+			summary: "Definite integrals of several expressions with the same formula", handler: s.BatchIntegralHandler,
+			request: BatchIntegralRequest{}, response: BatchIntegralResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/integrate/double", operationID: "doubleIntegral",
+			summary: "Double integral of an expression of x and y over a box", handler: s.DoubleIntegralHandler,
+			request: DoubleIntegralRequest{}, response: DoubleIntegralResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/matrix/invert", operationID: "invertMatrix",
+			summary: "Inverse of a square matrix", handler: s.MatrixInverseHandler,
+			request: MatrixRequest{}, response: MatrixInverseResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/linear-systems/solve", operationID: "solveLinearSystem",
+			summary: "Solution of a linear system by LU, Gaussian elimination, Cholesky, Jacobi, Gauss-Seidel or SOR", handler: s.LinearSystemHandler,
+			request: LinearSystemRequest{}, response: LinearSystemResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/evaluate", operationID: "evaluate",
+			summary: "Values of an expression at points or over a sampled range", handler: s.EvaluateHandler,
+			request: EvaluateRequest{}, response: EvaluateResponse{},
+		},
+		{
+			method: http.MethodPost, path: "/derivative", operationID: "derivative",
+			summary: "Derivative of an expression at a point by a finite difference", handler: s.DerivativeHandler,
+			request: DerivativeRequest{}, response: DerivativeResponse{},
+		},
+	}
+}
+
 func (*Server) HelloWorldHandler(c echo.Context) error {
 	resp := map[string]string{
 		"message": "Hello World",
@@ -8,6 +8,10 @@ import (
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/configs"
 )
 
 func TestHandler(t *testing.T) {
@@ -38,3 +42,100 @@ func TestHandler(t *testing.T) {
 		return
 	}
 }
+
+func TestRegisterRoutesRegistersEveryEndpoint(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s := NewServer(configs.Config{HTTP: configs.HTTPCfg{APIPrefix: "/api"}})
+	expected := []string{
+		"GET /api/hello",
+		"GET /api/health/compute",
+		"GET /api/openapi.json",
+		"POST /api/eigen/power",
+		"POST /api/eigen/decompose",
+		"POST /api/integrals/newton-cotes",
+		"GET /api/integrals/methods",
+		"POST /api/integrals/benchmark",
+		"POST /api/integrate/batch",
+		"POST /api/integrate/double",
+		"POST /api/matrix/invert",
+		"POST /api/linear-systems/solve",
+		"POST /api/evaluate",
+		"POST /api/derivative",
+	}
+
+	// Act
+	err := s.RegisterRoutes()
+	again := s.RegisterRoutes()
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, again)
+
+	var registered []string
+	for _, route := range s.BaseEchoServer.Routes() {
+		registered = append(registered, route.Method+" "+route.Path)
+	}
+	assert.ElementsMatch(t, expected, registered, "every route is registered exactly once")
+}
+
+func TestValidateRoutesRejectsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	handler := func(echo.Context) error { return nil }
+
+	tt := []struct {
+		name          string
+		routes        []apiRoute
+		expectedError string
+	}{
+		{
+			name: "Endpoint",
+			routes: []apiRoute{
+				{method: http.MethodPost, path: "/evaluate", operationID: "evaluate", handler: handler},
+				{method: http.MethodPost, path: "/evaluate", operationID: "evaluateAgain", handler: handler},
+			},
+			expectedError: "duplicate route POST /evaluate",
+		},
+		{
+			name: "OperationID",
+			routes: []apiRoute{
+				{method: http.MethodPost, path: "/evaluate", operationID: "evaluate", handler: handler},
+				{method: http.MethodGet, path: "/evaluate", operationID: "evaluate", handler: handler},
+			},
+			expectedError: `duplicate operation ID "evaluate"`,
+		},
+		{
+			name: "MissingHandler",
+			routes: []apiRoute{
+				{method: http.MethodGet, path: "/hello", operationID: "hello"},
+			},
+			expectedError: "route GET /hello has no handler",
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			err := validateRoutes(test.routes)
+
+			// Assert
+			assert.ErrorContains(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestValidateRoutesAcceptsTheAPIRoutes(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	s := &Server{}
+
+	// Act
+	err := validateRoutes(s.apiRoutes())
+
+	// Assert
+	assert.NoError(t, err)
+}
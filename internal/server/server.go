@@ -1,9 +1,11 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -11,6 +13,11 @@ import (
 	slogecho "github.com/samber/slog-echo"
 
 	"github.com/taldoflemis/nume/configs"
+	"github.com/taldoflemis/nume/internal/ast"
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/interfaces"
+	"github.com/taldoflemis/nume/internal/numeerr"
+	"github.com/taldoflemis/nume/internal/precision"
 )
 
 type Server struct {
@@ -18,10 +25,25 @@ type Server struct {
 	BaseEchoServer *echo.Echo
 	cfg            configs.Config
 	APIGroup       *echo.Group
+
+	// profile sets the parameters requests leave out
+	profile precision.Profile
+
+	expressionGenerator     interfaces.EvaluableExpressionGenerator
+	dualExpressionGenerator interfaces.DualVariableExpressionGenerator
+
+	// computeChecks are run by ComputeHealthHandler, defaultComputeChecks
+	// when nil
+	computeChecks []computeCheck
+
+	// registerOnce guards RegisterRoutes, registerErr keeping its outcome
+	registerOnce sync.Once
+	registerErr  error
 }
 
 func NewServer(httpConfig configs.Config) *Server {
 	e := echo.New()
+	e.Binder = &limitedBinder{limits: httpConfig.HTTP.Limits}
 	api := e.Group(httpConfig.HTTP.APIPrefix)
 
 	newServer := &Server{
@@ -29,6 +51,7 @@ func NewServer(httpConfig configs.Config) *Server {
 		BaseEchoServer: e,
 		cfg:            httpConfig,
 		APIGroup:       api,
+		profile:        precision.Profile(httpConfig.Numerics.Profile),
 	}
 
 	return newServer
@@ -37,6 +60,7 @@ func NewServer(httpConfig configs.Config) *Server {
 func (s *Server) SetDefaultMiddlewares() {
 	s.BaseEchoServer.IPExtractor = echo.ExtractIPFromXFFHeader()
 	s.BaseEchoServer.Use(slogecho.New(slog.Default()))
+	s.APIGroup.Use(logOutcome)
 	s.BaseEchoServer.Use(middleware.Recover())
 	s.BaseEchoServer.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     s.cfg.HTTP.CORS.Origins,
@@ -45,6 +69,14 @@ func (s *Server) SetDefaultMiddlewares() {
 		AllowCredentials: true,
 		MaxAge:           s.cfg.HTTP.CORS.MaxAge,
 	}))
+
+	if s.cfg.HTTP.RateLimit.RequestsPerSecond > 0 {
+		s.BaseEchoServer.Use(newRateLimiter(s.cfg.HTTP.RateLimit, s.cfg.HTTP.APIPrefix))
+	}
+
+	if s.cfg.HTTP.Limits.MaxBodyBytes > 0 {
+		s.APIGroup.Use(newBodyLimit(s.cfg.HTTP.Limits.MaxBodyBytes))
+	}
 }
 
 func (s *Server) ToHTTPServer() *http.Server {
@@ -62,3 +94,75 @@ func (s *Server) ToHTTPServer() *http.Server {
 
 	return server
 }
+
+// compileExpression generates the user expression, guarding each evaluation
+// with the configured timeout. The guard reports an abandoned evaluation
+// through Err, which stays nil when no timeout is configured.
+func (s *Server) compileExpression(
+	ctx context.Context,
+	variable, expression string,
+) (expressions.SingleVariableExpr, *expressions.EvaluationGuard, error) {
+	expr, err := s.expressionGenerator.GenerateSingleVariableExpression(ctx, &ast.SingleVariableExpressionNode{
+		VariableIdentifier: variable,
+		Expression:         expression,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timeout := time.Duration(s.cfg.HTTP.EvaluationTimeoutInMilliseconds) * time.Millisecond
+	guard := expressions.NewEvaluationGuard(timeout)
+	if timeout == 0 {
+		return expr, guard, nil
+	}
+
+	return guard.Guard(ctx, expr), guard, nil
+}
+
+// compileDualExpression is compileExpression for an expression of two
+// variables.
+func (s *Server) compileDualExpression(
+	ctx context.Context,
+	first, second, expression string,
+) (expressions.DualVariableExpr, *expressions.EvaluationGuard, error) {
+	expr, err := s.dualExpressionGenerator.GenerateDualVariableExpression(ctx, &ast.DualVariableExpressionNode{
+		FirstVariableIdentifier:  first,
+		SecondVariableIdentifier: second,
+		Expression:               expression,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timeout := time.Duration(s.cfg.HTTP.EvaluationTimeoutInMilliseconds) * time.Millisecond
+	guard := expressions.NewEvaluationGuard(timeout)
+	if timeout == 0 {
+		return expr, guard, nil
+	}
+
+	return guard.GuardDual(ctx, expr), guard, nil
+}
+
+// evaluationError is the 422 for a computation over a guarded expression, an
+// abandoned evaluation taking precedence over err as it explains whatever
+// the computation made of its NaN. It is nil when both are.
+func evaluationError(guard *expressions.EvaluationGuard, err error) error {
+	if guardErr := guard.Err(); guardErr != nil {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, guardErr.Error())
+	}
+	if err != nil {
+		return echo.NewHTTPError(errorStatus(err), err.Error())
+	}
+
+	return nil
+}
+
+// errorStatus tells malformed input, such as an empty matrix or a zero width
+// interval, apart from well formed input the computation cannot handle, by
+// the numeerr code of err.
+func errorStatus(err error) int {
+	if code, ok := numeerr.CodeOf(err); ok && code == numeerr.CodeInvalidInput {
+		return http.StatusBadRequest
+	}
+	return http.StatusUnprocessableEntity
+}
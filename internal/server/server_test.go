@@ -0,0 +1,45 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taldoflemis/nume/internal/precision"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestErrorStatusFollowsTheCode(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "ZeroWidthInterval", err: usecases.ErrZeroWidthInterval, expected: http.StatusBadRequest},
+		{name: "TooManySamples", err: usecases.ErrTooManySamples, expected: http.StatusBadRequest},
+		{name: "ZeroEvaluationBudget", err: usecases.ErrZeroEvaluationBudget, expected: http.StatusBadRequest},
+		{name: "WrappedInvalidInput", err: fmt.Errorf("error solving: %w", usecases.ErrInvalidBenchmarkBounds), expected: http.StatusBadRequest},
+		{name: "Unsupported", err: usecases.ErrUnknownNormalization, expected: http.StatusUnprocessableEntity},
+		{name: "Domain", err: usecases.ErrPointOutsideDomain, expected: http.StatusUnprocessableEntity},
+		{name: "Unclassified", err: fmt.Errorf("%w: %q", precision.ErrUnknownArithmetic, "float16"), expected: http.StatusUnprocessableEntity},
+		{name: "Plain", err: errors.New("plain"), expected: http.StatusUnprocessableEntity},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			status := errorStatus(test.err)
+
+			// Assert
+			assert.Equal(t, test.expected, status)
+		})
+	}
+}
@@ -334,3 +334,18 @@ func ThemeCatppuccin(renderer *lipgloss.Renderer) *Theme {
 	t.Group.Description = t.Focused.Description
 	return t
 }
+
+// NamedTheme pairs a theme constructor with the name shown to the user.
+type NamedTheme struct {
+	Name  string
+	Build func(renderer *lipgloss.Renderer) *Theme
+}
+
+// Themes are the themes the command palette cycles through, starting from
+// the default one.
+var Themes = []NamedTheme{
+	{Name: "Catppuccin", Build: ThemeCatppuccin},
+	{Name: "Charm", Build: ThemeCharm},
+	{Name: "Dracula", Build: ThemeDracula},
+	{Name: "Base16", Build: ThemeBase16},
+}
@@ -4,13 +4,21 @@ package models
 const (
 	MinimalWidth  = 80
 	MinimalHeight = 24
+
+	// TabContentPadding surrounds the active tab on every side
+	TabContentPadding = 1
+	// DefaultTabContentWidth lays out the tabs until the window size is known
+	DefaultTabContentWidth = 100
+	// NavigationColumnPercent is the share of the tab width given to the
+	// section navigation, the content taking the rest
+	NavigationColumnPercent = 40
+	// StackedLayoutWidth is the tab width below which the section content is
+	// stacked under the navigation instead of beside it
+	StackedLayoutWidth = 60
 )
 
 // Numerical constants
 const (
-	// Glamour rendering width
-	GlamourRenderWidth = 70
-
 	// Default numerical values
 	DefaultPolynomialOrder = 3
 	DefaultPhilosophy      = 2 // central difference
@@ -70,16 +78,9 @@ const (
 const (
 	EigenSectionPowerMethodSelection = 0
 	EigenSectionMatrixSelection      = 1
-	EigenSectionArguments            = 2
-	EigenSectionCalculate            = 3
-)
-
-// Power method indices
-const (
-	PowerMethodRegular  = 0
-	PowerMethodInverse  = 1
-	PowerMethodFarthest = 2
-	PowerMethodNearest  = 3
+	EigenSectionMatrixEditor         = 2
+	EigenSectionArguments            = 3
+	EigenSectionCalculate            = 4
 )
 
 // Matrix selection indices
@@ -90,13 +91,75 @@ const (
 	Matrix4x4Simple = 3
 )
 
-// Default eigen values
+// Eigen section count
+const (
+	EigenSectionCount = 5
+)
+
+// SlowConvergenceRatio is the |λ₂/λ₁| above which the power method is flagged
+// as slow, each iteration removing less than a tenth of the error
+const SlowConvergenceRatio = 0.9
+
+// EpsilonSweepDecades is the number of epsilons of the eigen tab sweep, from
+// 1e-1 down to 1e-12
+const EpsilonSweepDecades = 12
+
+// Linear system section indices
 const (
-	DefaultEpsilon      = 1e-6
-	DefaultMaxIterations = 100
+	LinearSystemSectionMethodSelection = 0
+	LinearSystemSectionMatrix          = 1
+	LinearSystemSectionVector          = 2
+	LinearSystemSectionArguments       = 3
+	LinearSystemSectionCalculate       = 4
+	LinearSystemSectionCount           = 5
 )
 
-// Eigen section count
+// Linear system method indices
+const (
+	LinearSystemMethodLU                  = 0
+	LinearSystemMethodGaussianElimination = 1
+	LinearSystemMethodCholesky            = 2
+	LinearSystemMethodJacobi              = 3
+	LinearSystemMethodGaussSeidel         = 4
+	LinearSystemMethodSOR                 = 5
+)
+
+// DefaultRelaxation is the SOR factor the linear system tab starts with
+const DefaultRelaxation = 1.25
+
+// ResidualHistoryRows caps the rows of the residual history of the linear
+// system tab, longer histories keeping their first and last iterations
+const ResidualHistoryRows = 10
+
+// Integral section indices
+const (
+	IntegralSectionFunctionSelection = 0
+	IntegralSectionMode              = 1
+	IntegralSectionArguments         = 2
+	IntegralSectionCalculate         = 3
+	IntegralSectionCount             = 4
+)
+
+// Integral mode indices
+const (
+	IntegralModeAccurate = 0
+	IntegralModeFixed    = 1
+)
+
+// Default integral values
 const (
-	EigenSectionCount = 4
+	DefaultIntegralLeft  = 0.0
+	DefaultIntegralRight = 1.0
+
+	// MaxIntegralPartitions bounds the doubling of the accurate mode
+	MaxIntegralPartitions = 1 << 22
+
+	// MaxPreviewPartitions bounds the partitions whose areas are kept for
+	// the subdivision preview, beyond it only their sum is computed
+	MaxPreviewPartitions = 1 << 16
+	// PartitionPreviewRows is the most rows of the subdivision preview,
+	// consecutive partitions being grouped beyond it
+	PartitionPreviewRows = 8
+	// PartitionPreviewBarWidth is the width of the largest bar of the preview
+	PartitionPreviewBarWidth = 20
 )
@@ -2,11 +2,12 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"math"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
@@ -15,10 +16,43 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/taldoflemis/nume/internal/explanations"
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/latex"
 	"github.com/taldoflemis/nume/internal/usecases"
 )
 
+var ErrNoComparisonReference = errors.New("enter a reference value to compare the methods of this function")
+
+// DerivativeResult is the outcome of a derivative computation, kept apart from
+// its rendering so it can be exported or reused.
+type DerivativeResult struct {
+	Function   string
+	Order      int
+	Philosophy string
+	Delta      float64
+	TestPoint  float64
+	Value      float64
+	// SuggestedDelta is the smallest delta free of catastrophic cancellation,
+	// DeltaTooSmall reports whether Delta is below it
+	SuggestedDelta float64
+	DeltaTooSmall  bool
+	// SymbolicDerivative is the exact derivative as LaTeX, empty when the
+	// function has no symbolic form. ExactValue is its value at the test point
+	// and AbsoluteError the distance to the finite difference Value
+	SymbolicDerivative string
+	ExactValue         float64
+	AbsoluteError      float64
+	// Reference compares Value with the reference the user supplied, nil
+	// when none was given
+	Reference *ErrorEstimate
+	// Diff compares Value with the pinned result, nil when none is pinned
+	Diff *ResultDiff
+	// Refined halves Delta until successive estimates agree, nil for the
+	// orders it does not support
+	Refined *usecases.ImprovedDerivative
+}
+
 type DerivativeModel struct {
 	// Current focus section (0-5)
 	focusedSection int
@@ -36,19 +70,36 @@ type DerivativeModel struct {
 	// Section 4: Philosophy (difference method)
 	philosophy int // 0: forward, 1: backward, 2: central
 
-	// Section 5: Arguments (Delta and Test Point inputs)
+	// Section 5: Arguments (Delta, Test Point and optional Reference inputs)
 	deltaInput     textinput.Model
 	testPointInput textinput.Model
+	referenceInput textinput.Model
 	delta          float64
 	testPoint      float64
 
 	// Calculation results
-	result          string
+	result          *DerivativeResult
+	resultErr       error
 	showExplanation bool
 	explanation     string
 	functionExpr    expressions.SingleVariableExpr
 
+	// Methods comparison across deltas and philosophies, shown instead of
+	// the section content while showComparison is set
+	comparison     *usecases.DerivativeComparison
+	comparisonErr  error
+	showComparison bool
+
+	// Result the following ones are compared against, nil when none is
+	// pinned
+	pinned *PinnedResult
+
+	// Session and cancellation of the in-flight computation
+	session *Session
+	cancel  context.CancelFunc
+
 	// Styling
+	layout   columnLayout
 	renderer *glamour.TermRenderer
 	*Theme
 }
@@ -68,6 +119,8 @@ type derivativeKeyMap struct {
 	Enter            key.Binding
 	Space            key.Binding
 	Explain          key.Binding
+	Compare          key.Binding
+	Pin              key.Binding
 	Reset            key.Binding
 }
 
@@ -79,10 +132,10 @@ func (k derivativeKeyMap) ShortHelp() []key.Binding {
 // FullHelp returns keybindings for the expanded help view
 func (k derivativeKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.TabD, k.TabI, k.Help},                 // first column - navigation
-		{k.Up, k.Down, k.Left, k.Right},          // second column - movement
-		{k.CycleNextSection, k.CyclePrevSection}, // third column - sections
-		{k.Enter, k.Explain, k.Reset, k.Quit},    // fourth column - actions
+		{k.TabD, k.TabI, k.Help},                                         // first column - navigation
+		{k.Up, k.Down, k.Left, k.Right},                                  // second column - movement
+		{k.CycleNextSection, k.CyclePrevSection},                         // third column - sections
+		{k.Enter, k.Space, k.Explain, k.Compare, k.Pin, k.Reset, k.Quit}, // fourth column - actions
 	}
 }
 
@@ -131,10 +184,22 @@ var derivativeKeys = derivativeKeyMap{
 		key.WithKeys("enter"),
 		key.WithHelp("enter", "select/confirm"),
 	),
+	Space: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "calculate"),
+	),
 	Explain: key.NewBinding(
 		key.WithKeys("x"),
 		key.WithHelp("x", "toggle explanation"),
 	),
+	Compare: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "toggle methods comparison"),
+	),
+	Pin: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "pin result for comparison"),
+	),
 	Reset: key.NewBinding(
 		key.WithKeys("r"),
 		key.WithHelp("r", "reset"),
@@ -148,16 +213,23 @@ func (*DerivativeModel) GetHelpKeys() help.KeyMap {
 
 var _ (NumeTabContent) = (*DerivativeModel)(nil)
 
-func NewDerivativeModel(theme *Theme) *DerivativeModel {
-	renderer, _ := glamour.NewTermRenderer(
-		glamour.WithWordWrap(GlamourRenderWidth),
-		glamour.WithStandardStyle("dracula"),
-	)
+// derivativeSections name the sections whose explanation does not depend on the
+// selection, looked up in the explanations package
+var derivativeSections = map[int]explanations.Section{
+	SectionErrorOrder:      explanations.SectionErrorOrder,
+	SectionDerivativeOrder: explanations.SectionDerivativeOrder,
+	SectionPhilosophy:      explanations.SectionPhilosophy,
+	SectionArguments:       explanations.SectionArguments,
+}
+
+func NewDerivativeModel(theme *Theme, session *Session) *DerivativeModel {
+	layout := defaultColumnLayout()
 
 	// Create delta input
 	deltaInput := textinput.New()
 	deltaInput.Placeholder = "0.001"
 	deltaInput.CharLimit = 20
+	deltaInput.Validate = validateNumber
 	deltaInput.SetValue("0.001")
 
 	// Create test point input
@@ -166,6 +238,11 @@ func NewDerivativeModel(theme *Theme) *DerivativeModel {
 	testPointInput.CharLimit = 20
 	testPointInput.SetValue("1.0")
 
+	// Create reference input, left empty until the user knows the exact value
+	referenceInput := textinput.New()
+	referenceInput.Placeholder = "optional"
+	referenceInput.CharLimit = 30
+
 	return &DerivativeModel{
 		focusedSection: 0,
 		functionOptions: []string{
@@ -180,9 +257,12 @@ func NewDerivativeModel(theme *Theme) *DerivativeModel {
 		philosophy:       DefaultPhilosophy, // central
 		deltaInput:       deltaInput,
 		testPointInput:   testPointInput,
+		referenceInput:   referenceInput,
 		delta:            DefaultDelta,
 		testPoint:        DefaultTestPoint,
-		renderer:         renderer,
+		session:          session,
+		layout:           layout,
+		renderer:         layout.markdownRenderer(),
 		Theme:            theme,
 	}
 }
@@ -192,6 +272,11 @@ func (*DerivativeModel) Init() tea.Cmd {
 }
 
 func (m *DerivativeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.resize(size.Width)
+		return m, nil
+	}
+
 	var cmds []tea.Cmd
 
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
@@ -202,6 +287,10 @@ func (m *DerivativeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(keyMsg, derivativeKeys.CyclePrevSection):
 			m.focusedSection = (m.focusedSection - 1 + SectionCount) % SectionCount
 			return m, nil
+		case keyMsg.Type == tea.KeyRunes && m.capturesText():
+			// Typed characters go to the inputs ahead of the bindings, so
+			// the e of 1e-6 or the k of 10k don't act as keys
+			return m, m.updateArguments(keyMsg)
 		case key.Matches(keyMsg, derivativeKeys.Up):
 			return m.handleUp(), nil
 		case key.Matches(keyMsg, derivativeKeys.Down):
@@ -212,36 +301,68 @@ func (m *DerivativeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleRight(), nil
 		case key.Matches(keyMsg, derivativeKeys.Enter):
 			return m.handleEnter(), nil
+		case key.Matches(keyMsg, derivativeKeys.Space) && m.focusedSection != SectionArguments:
+			return m.handleSpace(), nil
 		case key.Matches(keyMsg, derivativeKeys.Explain):
 			m.showExplanation = !m.showExplanation
 			if m.showExplanation && m.explanation == "" {
 				m.generateExplanation()
 			}
 			return m, nil
+		case key.Matches(keyMsg, derivativeKeys.Compare):
+			m.showComparison = !m.showComparison
+			if m.showComparison {
+				m.generateComparison()
+			}
+			return m, nil
+		case key.Matches(keyMsg, derivativeKeys.Pin) && m.focusedSection != SectionArguments:
+			m.pinResult()
+			return m, nil
 		case key.Matches(keyMsg, derivativeKeys.Reset):
-			return NewDerivativeModel(m.Theme), nil
+			m.cancelInFlight()
+			return m.reset(), nil
 		}
 
 		// Handle input for text inputs
 		if m.focusedSection == SectionArguments {
-			var cmd tea.Cmd
-			m.deltaInput, cmd = m.deltaInput.Update(keyMsg)
-			if val, err := strconv.ParseFloat(m.deltaInput.Value(), 64); err == nil {
-				m.delta = val
-			}
-			cmds = append(cmds, cmd)
-
-			m.testPointInput, cmd = m.testPointInput.Update(keyMsg)
-			if val, err := strconv.ParseFloat(m.testPointInput.Value(), 64); err == nil {
-				m.testPoint = val
-			}
-			cmds = append(cmds, cmd)
+			cmds = append(cmds, m.updateArguments(keyMsg))
 		}
 	}
 
 	return m, tea.Batch(cmds...)
 }
 
+// capturesText reports whether a text input is focused, which then takes
+// the typed characters ahead of the tab and global keys.
+func (m *DerivativeModel) capturesText() bool {
+	return m.focusedSection == SectionArguments &&
+		(m.deltaInput.Focused() || m.testPointInput.Focused() || m.referenceInput.Focused())
+}
+
+// updateArguments feeds keyMsg to the argument inputs, only the focused one
+// taking it, and re-parses their values.
+func (m *DerivativeModel) updateArguments(keyMsg tea.KeyMsg) tea.Cmd {
+	var cmds []tea.Cmd
+
+	var cmd tea.Cmd
+	m.deltaInput, cmd = m.deltaInput.Update(keyMsg)
+	if val, err := ParseNumber(m.deltaInput.Value()); err == nil {
+		m.delta = val
+	}
+	cmds = append(cmds, cmd)
+
+	m.testPointInput, cmd = m.testPointInput.Update(keyMsg)
+	if val, err := ParseNumber(m.testPointInput.Value()); err == nil {
+		m.testPoint = val
+	}
+	cmds = append(cmds, cmd)
+
+	m.referenceInput, cmd = m.referenceInput.Update(keyMsg)
+	cmds = append(cmds, cmd)
+
+	return tea.Batch(cmds...)
+}
+
 func (m *DerivativeModel) handleUp() *DerivativeModel {
 	switch m.focusedSection {
 	case SectionFunctionSelection: // Function selection
@@ -272,9 +393,8 @@ func (m *DerivativeModel) handleUp() *DerivativeModel {
 			// Cycle to the last philosophy (central = 2)
 			m.philosophy = MaxPhilosophyIndex
 		}
-	case SectionArguments: // Arguments - focus delta input
-		m.deltaInput.Focus()
-		m.testPointInput.Blur()
+	case SectionArguments: // Arguments - focus previous input
+		m.moveArgumentFocus(-1)
 	case SectionCalculate: // Calculate button - no up action
 	}
 	return m
@@ -310,9 +430,8 @@ func (m *DerivativeModel) handleDown() *DerivativeModel {
 			// Cycle to the first philosophy (forward = 0)
 			m.philosophy = 0
 		}
-	case SectionArguments: // Arguments - focus test point input
-		m.deltaInput.Blur()
-		m.testPointInput.Focus()
+	case SectionArguments: // Arguments - focus next input
+		m.moveArgumentFocus(1)
 	case SectionCalculate: // Calculate button - no down action
 	}
 	return m
@@ -320,9 +439,8 @@ func (m *DerivativeModel) handleDown() *DerivativeModel {
 
 func (m *DerivativeModel) handleLeft() *DerivativeModel {
 	switch m.focusedSection {
-	case SectionArguments: // Arguments - focus delta input
-		m.deltaInput.Focus()
-		m.testPointInput.Blur()
+	case SectionArguments: // Arguments - focus previous input
+		m.moveArgumentFocus(-1)
 	case SectionCalculate: // Calculate button - no left action
 	}
 	return m
@@ -330,14 +448,37 @@ func (m *DerivativeModel) handleLeft() *DerivativeModel {
 
 func (m *DerivativeModel) handleRight() *DerivativeModel {
 	switch m.focusedSection {
-	case SectionArguments: // Arguments - focus test point input
-		m.deltaInput.Blur()
-		m.testPointInput.Focus()
+	case SectionArguments: // Arguments - focus next input
+		m.moveArgumentFocus(1)
 	case SectionCalculate: // Calculate button - no right action
 	}
 	return m
 }
 
+// moveArgumentFocus moves the focus step inputs away in the arguments
+// section, wrapping around at both ends.
+func (m *DerivativeModel) moveArgumentFocus(step int) {
+	inputs := []*textinput.Model{&m.deltaInput, &m.testPointInput, &m.referenceInput}
+
+	current := 0
+	for i, input := range inputs {
+		if input.Focused() {
+			current = i
+		}
+		input.Blur()
+	}
+
+	inputs[(current+step+len(inputs))%len(inputs)].Focus()
+}
+
+// handleSpace runs the calculation from any section, moving the focus to the
+// calculate section where the result is shown.
+func (m *DerivativeModel) handleSpace() *DerivativeModel {
+	m.focusedSection = SectionCalculate
+	m.generateResult()
+	return m
+}
+
 func (m *DerivativeModel) handleEnter() *DerivativeModel {
 	// Only generate result if calculate button is focused
 	if m.focusedSection == SectionCalculate {
@@ -346,25 +487,24 @@ func (m *DerivativeModel) handleEnter() *DerivativeModel {
 	return m
 }
 
-func (m *DerivativeModel) View() string {
-	// Create two-column layout: left side navigation, right side content
-	leftWidth := 40
-	rightWidth := 60
-
-	// Left side - Section navigation
-	leftContent := m.renderSectionNavigation()
+// resize lays the tab out for a window windowWidth columns wide.
+func (m *DerivativeModel) resize(windowWidth int) {
+	m.layout = newColumnLayout(windowWidth)
+	m.renderer = m.layout.markdownRenderer()
+}
 
-	// Right side - Markdown content based on focused section
-	rightContent := m.renderSectionContent()
+// reset returns a new model in the layout of this one, as the window size is
+// only sent again when it changes.
+func (m *DerivativeModel) reset() *DerivativeModel {
+	reset := NewDerivativeModel(m.Theme, m.session)
+	reset.layout = m.layout
+	reset.renderer = m.renderer
 
-	// Join horizontally
-	content := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		m.Renderer.NewStyle().Width(leftWidth).Render(leftContent),
-		m.Renderer.NewStyle().Width(rightWidth).Render(rightContent),
-	)
+	return reset
+}
 
-	return content
+func (m *DerivativeModel) View() string {
+	return m.layout.render(m.Renderer, m.renderSectionNavigation(), m.renderSectionContent())
 }
 
 func (m *DerivativeModel) renderSectionNavigation() string {
@@ -439,8 +579,9 @@ func (m *DerivativeModel) renderSectionNavigation() string {
 			}
 		case SectionArguments: // Arguments
 			// TODO: handle this with renderer from theme and use a custom prompt from the lib
-			sections = append(sections, fmt.Sprintf("  Delta: %s", m.deltaInput.View()))
+			sections = append(sections, fmt.Sprintf("  Delta: %s", m.renderValidatedInput(m.deltaInput)))
 			sections = append(sections, fmt.Sprintf("  Test Point: %s", m.testPointInput.View()))
+			sections = append(sections, fmt.Sprintf("  Reference: %s", m.referenceInput.View()))
 		case SectionCalculate: // Calculate button
 			// Create a styled button
 			var buttonStyle lipgloss.Style
@@ -459,127 +600,140 @@ func (m *DerivativeModel) renderSectionNavigation() string {
 }
 
 func (m *DerivativeModel) renderSectionContent() string {
-	var content string
-
-	switch m.focusedSection {
-	case SectionFunctionSelection: // Function Selection
-		content = `# Function Selection
-
-Choose the mathematical function for derivative calculation:
-
-## Available Functions
-
-- **Polynomial**: f(x) = x^4 - 2x² + 5x - 1
-- **Exponential**: f(x) = e^3x
-- **Trigonometric**: f(x) = sin(2x)
-- **Hyperbolic**: f(x) = cosh(x)
-
-Use ↑/↓ arrows to select a function type.
-`
-	case SectionErrorOrder: // Error Order
-		content = `# Error Order
-
-Choose the degree of the error for the approximation:
-
-## Available Orders
-
-- **Linear (degree 1)**: O(h)
-- **Quadratic (degree 2)**: O(h²)
-- **Cubic (degree 3)**: O(h³)
-- **Quartic (degree 4)**: O(h⁴)
-
-Use ↑/↓ arrows to select the approximation degree.`
-	case SectionDerivativeOrder: // Derivative Order
-		content = `# Derivative Order
-
-Select the order of derivative to calculate:
-
-## Available Orders
-
-- **First derivative**: f'(x) - Rate of change
-- **Second derivative**: f''(x) - Concavity and acceleration  
-- **Third derivative**: f'''(x) - Rate of change of acceleration
-
-Use ↑/↓ arrows to select the derivative order.
-
-## Mathematical Notation
-- First: f'(x) or df/dx
-- Second: f''(x) or d²f/dx²
-- Third: f'''(x) or d³f/dx³`
-	case SectionPhilosophy: // Philosophy
-		content = `# Philosophy
-
-Choose the finite difference method for numerical differentiation:
+	if m.showComparison {
+		return m.renderMarkdown(m.renderComparison())
+	}
 
-## Available Methods
+	var explanation explanations.Explanation
 
-- **Forward Difference**: Uses f(x+h) - f(x)
-  - Good for left boundary points
-  - First-order accurate: O(h)
+	switch m.focusedSection {
+	case SectionFunctionSelection:
+		explanation = explanations.Explanation{
+			Title:           "Function Selection",
+			Overview:        "Choose the mathematical function for derivative calculation.",
+			ParametersTitle: "Available Functions",
+			Tips:            []string{"Use ↑/↓ arrows to select a function type."},
+		}
+		for _, function := range m.functionOptions {
+			name, formula, _ := strings.Cut(function, ":")
+			explanation.Parameters = append(explanation.Parameters, explanations.Parameter{
+				Name:        name,
+				Description: strings.TrimSpace(formula),
+			})
+		}
+	case SectionCalculate:
+		explanation = explanations.Explanation{
+			Title:           "Calculate",
+			Overview:        "Execute the derivative calculation with the configured parameters.",
+			ParametersTitle: "Current Configuration",
+			Parameters: []explanations.Parameter{
+				{Name: "Function", Description: strings.Split(m.functionOptions[m.selectedFunction], ":")[0]},
+				{Name: "Derivative Order", Description: m.getDerivativeOrderText()},
+				{Name: "Philosophy", Description: []string{"Forward", "Backward", "Central"}[m.philosophy] + " difference"},
+				{Name: "Delta (h)", Description: fmt.Sprintf("%.6f", m.delta)},
+				{Name: "Test Point", Description: fmt.Sprintf("%.1f", m.testPoint)},
+			},
+			Tips: []string{"Press **Enter** on the Calculate button to run the calculation."},
+		}
+	default:
+		explanation, _ = explanations.Lookup(explanations.TabDerivative, derivativeSections[m.focusedSection], m.session.numericProfile())
+	}
 
-- **Backward Difference**: Uses f(x) - f(x-h)  
-  - Good for right boundary points
-  - First-order accurate: O(h)
+	content := explanation.Markdown()
 
-- **Central Difference**: Uses f(x+h) - f(x-h)
-  - Most accurate for interior points
-  - Second-order accurate: O(h²)
+	if m.focusedSection == SectionCalculate {
+		// Add results section if available
+		if result := m.renderResult(); result != "" {
+			content += "\n# Result\n\n" + result
+		}
+	}
 
-Use ↑/↓ arrows to select the difference method.
+	return m.renderMarkdown(content)
+}
 
-**Recommended**: Central difference for most applications.`
-	case SectionArguments: // Arguments
-		content = `# Arguments
+// renderMarkdown renders content with glamour, falling back to the raw
+// markdown when rendering fails.
+func (m *DerivativeModel) renderMarkdown(content string) string {
+	if rendered, err := m.renderer.Render(content); err == nil {
+		return rendered
+	}
+	return content
+}
 
-Configure the numerical calculation parameters:
+func (m *DerivativeModel) renderResult() string {
+	if m.resultErr != nil {
+		return m.Focused.ErrorMessage.Render(
+			fmt.Sprintf("Error calculating derivative: %v", m.resultErr),
+		)
+	}
 
-## Delta (h)
-The step size for finite difference calculation.
-- Smaller values: More accurate but prone to numerical errors
-- Larger values: Less accurate but more stable
-- Typical range: 1e-6 to 1e-2
-- **Default**: 0.001
+	if m.result == nil {
+		return ""
+	}
 
-## Test Point
-The x-coordinate where the derivative is evaluated.
-- Choose based on your function's domain
-- Avoid singularities (e.g., x=0 for 1/x)
-- **Default**: 1.0
+	rendered := fmt.Sprintf(`%.6f`, m.result.Value)
+
+	if m.result.SymbolicDerivative != "" {
+		rendered = fmt.Sprintf(`- **Numerical**: %.6f
+- **Symbolic**: `+"`f%s(x) = %s`"+`
+- **Exact value**: %.6f
+- **Approximation error**: %.2e`,
+			m.result.Value,
+			strings.Repeat("'", m.result.Order), m.result.SymbolicDerivative,
+			m.result.ExactValue,
+			m.result.AbsoluteError,
+		)
+	}
 
-Use ←/→ arrows to switch between input fields.`
-	case SectionCalculate: // Calculate
-		content = `# Calculate
+	if m.result.Refined != nil {
+		rendered += "\n" + m.result.renderRefined()
+	}
 
-Execute the derivative calculation with the configured parameters:
+	if m.result.Reference != nil {
+		rendered += "\n" + m.result.Reference.render()
+	}
 
-## Current Configuration
+	if m.result.Diff != nil {
+		rendered += "\n" + m.result.Diff.render()
+	}
 
-- **Function**: ` + strings.Split(m.functionOptions[m.selectedFunction], ":")[0] + `
-- **Derivative Order**: ` + m.getDerivativeOrderText() + `
-- **Philosophy**: ` + []string{"Forward", "Backward", "Central"}[m.philosophy] + ` difference
-- **Delta (h)**: ` + fmt.Sprintf("%.6f", m.delta) + `
-- **Test Point**: ` + fmt.Sprintf("%.1f", m.testPoint) + `
+	if m.result.DeltaTooSmall {
+		rendered += fmt.Sprintf(`
 
-Press **Enter** on the Calculate button to run the calculation.`
+> **Warning**: delta %.2e is too small, round-off errors dominate the difference quotient. Try h ≈ %.2e`,
+			m.result.Delta, m.result.SuggestedDelta,
+		)
+	}
 
-		// Add results section if available
-		if m.result != "" {
-			content += `
+	return rendered
+}
 
-# Result
+func (m *DerivativeModel) generateResult() {
+	m.result, m.resultErr = m.computeResult()
+}
 
-` + m.result
-		}
+// pinResult pins the current result for the following ones to be compared
+// against, unpinning when there is no result.
+func (m *DerivativeModel) pinResult() {
+	if m.result == nil {
+		m.pinned = nil
+		return
 	}
 
-	// Render with glamour
-	if rendered, err := m.renderer.Render(content); err == nil {
-		return rendered
+	m.pinned = &PinnedResult{
+		Label: fmt.Sprintf("%s, %s order %d at x = %g, h = %g", m.result.Function,
+			m.result.Philosophy, m.result.Order, m.result.TestPoint, m.result.Delta),
+		Value: m.result.Value,
 	}
-	return content
+	m.result.Diff = diffAgainst(m.pinned, m.result.Value)
 }
 
-func (m *DerivativeModel) generateResult() {
+// computeResult evaluates the selected derivative at the test point.
+func (m *DerivativeModel) computeResult() (*DerivativeResult, error) {
+	if err := inputsError(labeledInput{"delta", m.deltaInput}); err != nil {
+		return nil, err
+	}
+
 	m.setupFunctionExpression()
 
 	// Choose strategy based on philosophy
@@ -595,7 +749,16 @@ func (m *DerivativeModel) generateResult() {
 		strategy = &usecases.CentralDifferenceStrategy{}
 	}
 
-	ctx := context.Background()
+	ctx, cancel := m.requestContext()
+	defer cancel()
+
+	logger := LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "Calculating derivative from the TUI",
+		slog.Int("derivativeOrder", m.derivativeOrder),
+		slog.Int("philosophy", m.philosophy),
+		slog.Float64("delta", m.delta),
+		slog.Float64("testPoint", m.testPoint),
+	)
 
 	// Calculate derivative based on order
 	var derivativeExpr expressions.SingleVariableExpr
@@ -622,16 +785,244 @@ func (m *DerivativeModel) generateResult() {
 	}
 
 	if err != nil {
-		m.result = m.Focused.ErrorMessage.Render(
-			fmt.Sprintf("Error calculating derivative: %v", err),
+		logger.ErrorContext(ctx, "Failed to calculate derivative", slog.Any("error", err))
+		return nil, err
+	}
+
+	result := &DerivativeResult{
+		Function:       strings.Split(m.functionOptions[m.selectedFunction], ":")[0],
+		Order:          m.derivativeOrder,
+		Philosophy:     []string{"Forward", "Backward", "Central"}[m.philosophy],
+		Delta:          m.delta,
+		TestPoint:      m.testPoint,
+		Value:          derivativeExpr(m.testPoint),
+		SuggestedDelta: suggestedDelta(strategy, m.testPoint),
+	}
+
+	if m.delta < result.SuggestedDelta {
+		logger.WarnContext(ctx, "Delta is prone to catastrophic cancellation",
+			slog.Float64("delta", m.delta),
+			slog.Float64("suggestedDelta", result.SuggestedDelta),
 		)
-		return
+		result.DeltaTooSmall = true
+	}
+
+	if reference, ok := parseReference(m.referenceInput.Value()); ok {
+		estimate := NewErrorEstimate(result.Value, reference)
+		result.Reference = &estimate
+	}
+
+	result.Diff = diffAgainst(m.pinned, result.Value)
+
+	if refined, err := m.refine(ctx, strategy); err != nil {
+		logger.WarnContext(ctx, "Failed to refine the derivative", slog.Any("error", err))
+	} else {
+		result.Refined = refined
+	}
+
+	if node := m.functionNode(); node != nil {
+		if err := result.compareWithSymbolic(node); err != nil {
+			logger.WarnContext(ctx, "Failed to differentiate symbolically", slog.Any("error", err))
+		}
+	}
+
+	return result, nil
+}
+
+// refine improves the first and second derivatives halving the delta, nil
+// for the third which the use case does not refine.
+func (m *DerivativeModel) refine(ctx context.Context, strategy usecases.DifferenceStrategy) (*usecases.ImprovedDerivative, error) {
+	defaults := m.session.numericDefaults()
+	useCase := usecases.NewDerivativeUseCase(strategy)
+
+	switch m.derivativeOrder {
+	case DerivativeOrderFirst:
+		return useCase.Derivative(ctx, m.testPoint, m.functionExpr, m.delta, defaults.Epsilon, defaults.MaxIterations)
+	case DerivativeOrderSecond:
+		return useCase.SecondDerivative(ctx, m.testPoint, m.functionExpr, m.delta, defaults.Epsilon, defaults.MaxIterations)
+	default:
+		return nil, nil
+	}
+}
+
+// renderRefined shows the refined derivative and whether the halvings
+// converged.
+func (r *DerivativeResult) renderRefined() string {
+	if !r.Refined.Converged {
+		return fmt.Sprintf("- **Refined**: %.6f (h = %.2e, did not converge after %d estimates)",
+			r.Refined.Derivative, r.Refined.Delta, r.Refined.Iterations)
+	}
+	return fmt.Sprintf("- **Refined**: %.6f (h = %.2e, converged after %d estimates)",
+		r.Refined.Derivative, r.Refined.Delta, r.Refined.Iterations)
+}
+
+func (m *DerivativeModel) generateComparison() {
+	m.comparison, m.comparisonErr = m.computeComparison()
+}
+
+// computeComparison builds the table of the selected derivative for every
+// philosophy across DerivativeComparisonDeltas, measured against the exact
+// derivative or, when there is none, the reference the user supplied.
+func (m *DerivativeModel) computeComparison() (*usecases.DerivativeComparison, error) {
+	m.setupFunctionExpression()
+
+	reference, ok := m.exactDerivative()
+	if !ok {
+		reference, ok = parseReference(m.referenceInput.Value())
+	}
+	if !ok {
+		return nil, ErrNoComparisonReference
+	}
+
+	ctx, cancel := m.requestContext()
+	defer cancel()
+
+	logger := LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "Comparing derivative methods from the TUI",
+		slog.Int("derivativeOrder", m.derivativeOrder),
+		slog.Float64("testPoint", m.testPoint),
+		slog.Float64("reference", reference),
+	)
+
+	useCase := usecases.NewDerivativeUseCase(&usecases.CentralDifferenceStrategy{})
+	comparison, err := useCase.CompareMethods(ctx, m.functionExpr, m.testPoint, m.derivativeOrder, nil, reference)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to compare derivative methods", slog.Any("error", err))
+		return nil, err
 	}
 
-	// Evaluate at test point
-	derivativeValue := derivativeExpr(m.testPoint)
+	return comparison, nil
+}
+
+// exactDerivative evaluates the symbolic derivative of the selected function
+// at the test point, false when it has no symbolic form.
+func (m *DerivativeModel) exactDerivative() (float64, bool) {
+	node := m.functionNode()
+	if node == nil {
+		return 0, false
+	}
+
+	result := &DerivativeResult{Order: m.derivativeOrder, TestPoint: m.testPoint}
+	if err := result.compareWithSymbolic(node); err != nil {
+		return 0, false
+	}
 
-	m.result = fmt.Sprintf(`%.6f`, derivativeValue)
+	return result.ExactValue, true
+}
+
+// renderComparison renders the comparison as a markdown table, with the most
+// accurate cell in bold.
+func (m *DerivativeModel) renderComparison() string {
+	content := "# Methods Comparison\n\n"
+
+	if m.comparisonErr != nil {
+		return content + m.Focused.ErrorMessage.Render(
+			fmt.Sprintf("Error comparing methods: %v", m.comparisonErr),
+		)
+	}
+
+	if m.comparison == nil {
+		return content
+	}
+
+	content += fmt.Sprintf("%s at x = %g, each cell with its error against the reference %.6f\n\n",
+		m.getDerivativeOrderText(), m.comparison.Point, m.comparison.Reference,
+	)
+
+	content += "| Delta (h) | " + strings.Join(m.comparison.Philosophies, " | ") + " |\n"
+	content += "|---|" + strings.Repeat("---|", len(m.comparison.Philosophies)) + "\n"
+
+	bestRow, bestColumn := m.comparison.Best()
+	outsideDomain := false
+	for i, row := range m.comparison.Rows {
+		content += fmt.Sprintf("| %.0e |", row.Delta)
+		for j, cell := range row.Cells {
+			if cell.OutsideDomain {
+				outsideDomain = true
+				content += " outside domain |"
+				continue
+			}
+
+			rendered := fmt.Sprintf("%.6g (%.0e)", cell.Derivative, cell.AbsoluteError)
+			if i == bestRow && j == bestColumn {
+				rendered = "**" + rendered + "**"
+			}
+			content += " " + rendered + " |"
+		}
+		content += "\n"
+	}
+
+	content += "| Mean ± σ |"
+	for _, summary := range m.comparison.Summaries {
+		if summary.Count == 0 {
+			content += " — |"
+			continue
+		}
+		content += fmt.Sprintf(" %.6g ± %.0e |", summary.Mean, summary.StdDev)
+	}
+	content += "\n"
+
+	if outsideDomain {
+		content += fmt.Sprintf("\nCells outside domain have a stencil leaving [%g, %g], where the function is finite.\n",
+			m.comparison.Domain.Left, m.comparison.Domain.Right,
+		)
+	}
+
+	return content + `
+Smaller deltas shrink the truncation error until round-off takes over, and the
+central difference shrinks it fastest. Press **c** to go back.`
+}
+
+// compareWithSymbolic differentiates node exactly, filling the symbolic
+// derivative and the error of the finite difference against it.
+func (r *DerivativeResult) compareWithSymbolic(node latex.ExpressionNode) error {
+	derivative := node
+	for range r.Order {
+		var err error
+		derivative, err = latex.Differentiate(derivative, "x")
+		if err != nil {
+			return err
+		}
+	}
+
+	exact, err := latex.Evaluate(derivative, latex.Environment{"x": r.TestPoint})
+	if err != nil {
+		return err
+	}
+
+	r.SymbolicDerivative = latex.Format(derivative)
+	r.ExactValue = exact
+	r.AbsoluteError = math.Abs(r.Value - exact)
+
+	return nil
+}
+
+// suggestedDelta is the step below which round-off errors outweigh the
+// truncation error, the OptimalDelta of a first derivative with strategy.
+func suggestedDelta(strategy usecases.DifferenceStrategy, point float64) float64 {
+	return usecases.OptimalDelta(point, 1, usecases.AccuracyOrder(strategy))
+}
+
+// requestContext cancels any in-flight computation and builds the context for
+// a new one from the model session.
+func (m *DerivativeModel) requestContext() (context.Context, context.CancelFunc) {
+	m.cancelInFlight()
+
+	if m.session == nil {
+		m.session = NewSession("")
+	}
+
+	ctx, cancel := m.session.NewRequestContext(context.Background())
+	m.cancel = cancel
+
+	return ctx, cancel
+}
+
+func (m *DerivativeModel) cancelInFlight() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
 }
 
 func (m *DerivativeModel) getDerivativeOrderText() string {
@@ -671,6 +1062,48 @@ func (m *DerivativeModel) setupFunctionExpression() {
 	}
 }
 
+// functionNode returns the selected function as an expression tree for
+// symbolic differentiation, nil when the tree cannot represent it.
+func (m *DerivativeModel) functionNode() latex.ExpressionNode {
+	x := &latex.VariableExpressionNode{Identifier: "x"}
+
+	switch m.selectedFunction {
+	case SectionFunctionSelection: // Polynomial
+		return latexBinary(
+			latexBinary(
+				latexBinary(latexBinary(x, latex.PowerOperator, latexNumber(PolynomialPower)), latex.MinusOperator,
+					latexBinary(latexNumber(2), latex.MulOperator, latexBinary(x, latex.PowerOperator, latexNumber(2)))),
+				latex.PlusOperator,
+				latexBinary(latexNumber(5), latex.MulOperator, x),
+			),
+			latex.MinusOperator,
+			latexNumber(1),
+		)
+	case SectionErrorOrder: // Exponential
+		return latexBinary(latexNumber(math.E), latex.PowerOperator, latexBinary(latexNumber(ExponentialMultiple), latex.MulOperator, x))
+	case SectionPhilosophy: // Hyperbolic, cosh(x) = (e^x + e^-x) / 2
+		return latexBinary(
+			latexBinary(
+				latexBinary(latexNumber(math.E), latex.PowerOperator, x),
+				latex.PlusOperator,
+				latexBinary(latexNumber(math.E), latex.PowerOperator, &latex.UnaryExpressionNode{Operator: "-", SubExpression: x}),
+			),
+			latex.DivOperator,
+			latexNumber(2),
+		)
+	default:
+		return nil
+	}
+}
+
+func latexBinary(lhs latex.ExpressionNode, operator latex.Operator, rhs latex.ExpressionNode) latex.ExpressionNode {
+	return &latex.BinaryExpressionNode{LHS: lhs, Operator: string(operator), RHS: rhs}
+}
+
+func latexNumber(value float64) latex.ExpressionNode {
+	return &latex.NumberExpression{Value: value}
+}
+
 func (m *DerivativeModel) generateExplanation() {
 	philosophyName := []string{"forward", "backward", "central"}[m.philosophy]
 	filename := fmt.Sprintf("%s_difference.md", philosophyName)
@@ -0,0 +1,427 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/taldoflemis/nume/internal/explanations"
+	"github.com/taldoflemis/nume/internal/precision"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestDerivativeModelDeltaStabilityWarning(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		function      int
+		delta         float64
+		expectWarning bool
+	}{
+		{
+			name:          "Default delta",
+			function:      0,
+			delta:         DefaultDelta,
+			expectWarning: false,
+		},
+		{
+			name:          "Tiny delta",
+			function:      0,
+			delta:         1e-14,
+			expectWarning: true,
+		},
+		{
+			name:          "Central threshold is the cube root of epsilon",
+			function:      1,
+			delta:         1e-7,
+			expectWarning: true,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+			model.selectedFunction = test.function
+			model.delta = test.delta
+			model.focusedSection = SectionCalculate
+
+			// Act
+			model.handleEnter()
+
+			// Assert
+			assert.NoError(t, model.resultErr)
+			assert.Equal(t, test.expectWarning, model.result.DeltaTooSmall)
+			if test.expectWarning {
+				assert.Contains(t, model.renderResult(), "Try h ≈")
+				assert.Contains(t, model.renderSectionContent(), "Warning")
+			} else {
+				assert.NotContains(t, model.renderResult(), "Warning")
+			}
+		})
+	}
+}
+
+func TestDerivativeModelComputeResultIsStructured(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Polynomial", result.Function)
+	assert.Equal(t, DerivativeOrderFirst, result.Order)
+	assert.Equal(t, "Central", result.Philosophy)
+	assert.Equal(t, DefaultDelta, result.Delta)
+	assert.Equal(t, DefaultTestPoint, result.TestPoint)
+	// p'(x) = 4x³ - 4x + 5
+	assert.InDelta(t, 5, result.Value, 1e-4)
+	assert.False(t, result.DeltaTooSmall)
+}
+
+func TestDerivativeModelComparesWithSymbolicDerivative(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		function         int
+		order            int
+		expectedSymbolic string
+	}{
+		{
+			name:             "Polynomial",
+			function:         0,
+			order:            DerivativeOrderFirst,
+			expectedSymbolic: "4x^{3} - 4x + 5",
+		},
+		{
+			name:             "Exponential second derivative",
+			function:         1,
+			order:            DerivativeOrderSecond,
+			expectedSymbolic: "9e^{3x}",
+		},
+		{
+			name:             "Hyperbolic",
+			function:         3,
+			order:            DerivativeOrderFirst,
+			expectedSymbolic: `\frac{e^{x} - e^{-x}}{2}`,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+			model.selectedFunction = test.function
+			model.derivativeOrder = test.order
+
+			// Act
+			result, err := model.computeResult()
+			model.result = result
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSymbolic, result.SymbolicDerivative)
+			assert.InDelta(t, result.ExactValue, result.Value, 1e-3*max(1, result.ExactValue))
+			assert.InDelta(t, 0, result.AbsoluteError, 1e-3*max(1, result.ExactValue))
+
+			rendered := model.renderResult()
+			assert.Contains(t, rendered, "**Numerical**")
+			assert.Contains(t, rendered, test.expectedSymbolic)
+			assert.Contains(t, rendered, "**Approximation error**")
+		})
+	}
+}
+
+func TestDerivativeModelWithoutSymbolicForm(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedFunction = 2 // sin(2x)
+
+	// Act
+	result, err := model.computeResult()
+	model.result = result
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.SymbolicDerivative)
+	assert.NotContains(t, model.renderResult(), "Symbolic")
+}
+
+func TestDerivativeModelComparesWithReference(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+	// f'(1) = 5 for the polynomial, so a reference of 4 is off by 1
+	model.referenceInput.SetValue("4")
+
+	// Act
+	result, err := model.computeResult()
+	model.result = result
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result.Reference)
+	assert.InDelta(t, 4, result.Reference.Reference, 0)
+	assert.InDelta(t, 1, result.Reference.Absolute, 1e-5)
+	assert.InDelta(t, 0.25, result.Reference.Relative, 1e-5)
+
+	rendered := model.renderResult()
+	assert.Contains(t, rendered, "**Absolute error**")
+	assert.Contains(t, rendered, "**Relative error**")
+}
+
+func TestDerivativeModelWithoutReference(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	result, err := model.computeResult()
+	model.result = result
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, result.Reference)
+	assert.NotContains(t, model.renderResult(), "Relative error")
+}
+
+func TestDerivativeModelRefinesTheDelta(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		order           int
+		expectedRefined bool
+	}{
+		{
+			name:            "First",
+			order:           DerivativeOrderFirst,
+			expectedRefined: true,
+		},
+		{
+			name:            "Second",
+			order:           DerivativeOrderSecond,
+			expectedRefined: true,
+		},
+		{
+			name:  "Third",
+			order: DerivativeOrderThird,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+			model.derivativeOrder = test.order
+
+			// Act
+			result, err := model.computeResult()
+			model.result = result
+
+			// Assert
+			require.NoError(t, err)
+			if !test.expectedRefined {
+				assert.Nil(t, result.Refined)
+				assert.NotContains(t, model.renderResult(), "Refined")
+				return
+			}
+			require.NotNil(t, result.Refined)
+			assert.True(t, result.Refined.Converged)
+			assert.InDelta(t, result.Value, result.Refined.Derivative, 1e-3)
+			assert.Contains(t, model.renderResult(), "converged after")
+		})
+	}
+}
+
+func TestTabSectionsHaveExplanations(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		tab      explanations.Tab
+		sections map[int]explanations.Section
+	}{
+		{name: "Derivative", tab: explanations.TabDerivative, sections: derivativeSections},
+		{name: "Integral", tab: explanations.TabIntegral, sections: integralSections},
+		{name: "Eigen", tab: explanations.TabEigen, sections: eigenSections},
+		{name: "LinearSystem", tab: explanations.TabLinearSystem, sections: linearSystemSections},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			for section, name := range test.sections {
+				// Act
+				explanation, found := explanations.Lookup(test.tab, name, precision.Balanced)
+
+				// Assert
+				assert.True(t, found, "section %d", section)
+				assert.NotEmpty(t, explanation.Title, "section %d", section)
+			}
+		})
+	}
+}
+
+func TestDerivativeModelArgumentFocusWraps(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+	model.focusedSection = SectionArguments
+
+	// Act
+	model.handleDown()
+	model.handleDown()
+
+	// Assert
+	assert.True(t, model.referenceInput.Focused())
+	model.handleRight()
+	assert.True(t, model.deltaInput.Focused())
+	assert.False(t, model.referenceInput.Focused())
+	model.handleLeft()
+	assert.True(t, model.referenceInput.Focused())
+}
+
+func TestDerivativeModelSpaceCalculates(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		section           int
+		expectCalculation bool
+	}{
+		{name: "From the function selection", section: SectionFunctionSelection, expectCalculation: true},
+		{name: "From the calculate button", section: SectionCalculate, expectCalculation: true},
+		{name: "Typed into the arguments", section: SectionArguments, expectCalculation: false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+			model.focusedSection = test.section
+
+			// Act
+			model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+
+			// Assert
+			if test.expectCalculation {
+				require.NotNil(t, model.result)
+				assert.Equal(t, SectionCalculate, model.focusedSection)
+				assert.Contains(t, model.renderSectionContent(), "Result")
+			} else {
+				assert.Nil(t, model.result)
+				assert.Equal(t, test.section, model.focusedSection)
+			}
+		})
+	}
+}
+
+func TestDerivativeModelHelpListsSpace(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	bindings := model.GetHelpKeys().FullHelp()
+
+	// Assert
+	var helps []string
+	for _, column := range bindings {
+		for _, binding := range column {
+			assert.True(t, binding.Enabled(), "binding %q should be initialized", binding.Help().Key)
+			helps = append(helps, binding.Help().Key)
+		}
+	}
+	assert.Contains(t, helps, "space")
+}
+
+func TestDerivativeModelMethodsComparison(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedFunction = 1 // Exponential, e^3x
+
+	// Act
+	newModel, _ := model.Update(runes("c"))
+	model = newModel.(*DerivativeModel)
+
+	// Assert
+	require.True(t, model.showComparison)
+	require.NoError(t, model.comparisonErr)
+	require.Len(t, model.comparison.Rows, len(usecases.DerivativeComparisonDeltas))
+	for _, row := range model.comparison.Rows {
+		assert.Len(t, row.Cells, 3)
+	}
+	row, column := model.comparison.Best()
+	assert.Equal(t, len(usecases.DerivativeComparisonDeltas)-1, row)
+	assert.Equal(t, "Central", model.comparison.Philosophies[column])
+
+	rendered := model.renderComparison()
+	assert.Contains(t, rendered, "# Methods Comparison")
+	assert.Contains(t, rendered, "| Delta (h) | Forward | Backward | Central |")
+	assert.Contains(t, rendered, "**60.2566 (9e-09)**")
+	assert.Contains(t, rendered, "| Mean ± σ |")
+}
+
+func TestDerivativeModelMethodsComparisonNeedsReference(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedFunction = 2 // Trigonometric, without a symbolic form
+
+	// Act
+	model.generateComparison()
+	withoutReference := model.comparisonErr
+	model.referenceInput.SetValue("-0.832293673") // 2cos(2)
+	model.generateComparison()
+
+	// Assert
+	assert.ErrorIs(t, withoutReference, ErrNoComparisonReference)
+	require.NoError(t, model.comparisonErr)
+	assert.InDelta(t, -0.832293673, model.comparison.Reference, 1e-12)
+}
+
+func TestSuggestedDeltaScalesWithThePoint(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		strategy usecases.DifferenceStrategy
+		point    float64
+		expected float64
+	}{
+		{name: "ForwardSmallPoint", strategy: &usecases.ForwardDifferenceStrategy{}, point: 0.5, expected: math.Sqrt(0x1p-52)},
+		{name: "ForwardLargePoint", strategy: &usecases.ForwardDifferenceStrategy{}, point: 2000, expected: 2000 * math.Sqrt(0x1p-52)},
+		{name: "BackwardNegativePoint", strategy: &usecases.BackwardDifferenceStrategy{}, point: -100, expected: 100 * math.Sqrt(0x1p-52)},
+		{name: "CentralSmallPoint", strategy: &usecases.CentralDifferenceStrategy{}, point: 0.5, expected: math.Cbrt(0x1p-52)},
+		{name: "CentralLargePoint", strategy: &usecases.CentralDifferenceStrategy{}, point: 1000, expected: 1000 * math.Cbrt(0x1p-52)},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			delta := suggestedDelta(test.strategy, test.point)
+
+			// Assert
+			assert.InDelta(t, test.expected, delta, test.expected*1e-12)
+		})
+	}
+}
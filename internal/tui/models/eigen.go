@@ -2,8 +2,11 @@ package models
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -13,15 +16,51 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/taldoflemis/nume/internal/explanations"
+	"github.com/taldoflemis/nume/internal/precision"
+	"github.com/taldoflemis/nume/internal/reports"
 	"github.com/taldoflemis/nume/internal/usecases"
 )
 
+var (
+	ErrInitialVectorDimension = errors.New("initial vector dimension must match matrix dimension")
+	ErrZeroInitialVector      = errors.New("initial vector cannot be zero")
+)
+
+// EigenResult is the outcome of a power method computation, kept apart from
+// its rendering so it can be exported or reused.
+type EigenResult struct {
+	Method string
+	// PowerMethod and Params are the run the result came from, written out
+	// by its report
+	PowerMethod usecases.PowerMethod
+	Params      usecases.PowerParams
+	Matrix      [][]float64
+	Eigenvalue  float64
+	Eigenvector []float64
+	// Normalization is the norm Eigenvector is scaled to
+	Normalization usecases.NormalizationMode
+	Iterations    uint64
+	// ShiftAtEigenvalue reports that k was itself an eigenvalue, found by
+	// nudging it
+	ShiftAtEigenvalue bool
+	// Reference compares Eigenvalue with the reference the user supplied, nil
+	// when none was given
+	Reference *ErrorEstimate
+	// Diff compares Eigenvalue with the pinned result, nil when none is
+	// pinned
+	Diff *ResultDiff
+	// Convergence predicts the regular power method convergence from a full
+	// decomposition, nil for the other methods or when it failed
+	Convergence *usecases.PowerConvergence
+}
+
 type EigenModel struct {
-	// Current focus section (0-3)
+	// Current focus section (0-4)
 	focusedSection int
 
 	// Section 1: Power Method Selection
-	powerMethodOptions  []string
+	powerMethods        []usecases.PowerMethod
 	selectedPowerMethod int
 
 	// Section 2: Matrix Selection
@@ -29,29 +68,78 @@ type EigenModel struct {
 	selectedMatrix     int
 	predefinedMatrices [][][]float64
 
-	// Section 3: Arguments (Vector, Epsilon, Max Iterations, K Eigenvalue inputs)
+	// Section 3: Matrix Editor, loaded from the selected predefined matrix
+	matrixEditor MatrixEditorModel
+
+	// Section 4: Arguments (Vector, Epsilon, Max Iterations, K Eigenvalue,
+	// optional Reference and Scale Factor inputs)
 	vectorInput        textinput.Model
 	epsilonInput       textinput.Model
 	maxIterationsInput textinput.Model
 	kEigenvalueInput   textinput.Model
+	referenceInput     textinput.Model
+	scaleInput         textinput.Model
 	initialVector      []float64
 	epsilon            float64
 	maxIterations      uint64
 	kEigenvalue        float64
+	scaleFactor        float64
+
+	// Norm the eigenvector is scaled to, cycled with the normalize key
+	normalization usecases.NormalizationMode
+	// Arithmetic the power iteration accumulates in, cycled with the
+	// arithmetic key
+	arithmetic precision.Arithmetic
 
 	// Calculation results
-	result          string
+	result          *EigenResult
+	resultErr       error
 	showExplanation bool
 	explanation     string
 
+	// Epsilon sweep of the power method, shown instead of the section
+	// content while showSweep is set
+	sweep     []usecases.EpsilonSweepPoint
+	sweepErr  error
+	showSweep bool
+
+	// Result the following ones are compared against, nil when none is
+	// pinned
+	pinned *PinnedResult
+
 	// Use case
-	useCase *usecases.PowerUseCase
+	useCase powerUseCase
+
+	// Session and cancellation of the in-flight computation
+	session *Session
+	cancel  context.CancelFunc
 
 	// Styling
+	layout   columnLayout
 	renderer *glamour.TermRenderer
 	*Theme
 }
 
+// powerUseCase is the subset of usecases.PowerUseCase used by the model
+type powerUseCase interface {
+	Solve(
+		ctx context.Context,
+		method usecases.PowerMethod,
+		matrix [][]float64,
+		params usecases.PowerParams,
+	) (*usecases.PowerResult, error)
+	PredictConvergence(ctx context.Context, matrix [][]float64) (*usecases.PowerConvergence, error)
+	SweepEpsilon(
+		ctx context.Context,
+		method usecases.PowerMethod,
+		matrix [][]float64,
+		params usecases.PowerParams,
+		epsilons []float64,
+	) ([]usecases.EpsilonSweepPoint, error)
+}
+
+var _ powerUseCase = (*usecases.PowerUseCase)(nil)
+
 // keyMap defines the keybindings for the eigen model
 type eigenKeyMap struct {
 	Quit             key.Binding
@@ -59,6 +147,7 @@ type eigenKeyMap struct {
 	TabD             key.Binding
 	TabI             key.Binding
 	TabE             key.Binding
+	TabS             key.Binding
 	CycleNextSection key.Binding
 	CyclePrevSection key.Binding
 	Up               key.Binding
@@ -68,6 +157,12 @@ type eigenKeyMap struct {
 	Enter            key.Binding
 	Space            key.Binding
 	Explain          key.Binding
+	Sweep            key.Binding
+	Pin              key.Binding
+	Transpose        key.Binding
+	Scale            key.Binding
+	Normalize        key.Binding
+	Arithmetic       key.Binding
 	Reset            key.Binding
 }
 
@@ -79,10 +174,10 @@ func (k eigenKeyMap) ShortHelp() []key.Binding {
 // FullHelp returns keybindings for the expanded help view
 func (k eigenKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.TabD, k.TabI, k.TabE, k.Help},         // first column - navigation
+		{k.TabD, k.TabI, k.TabE, k.TabS, k.Help}, // first column - navigation
 		{k.Up, k.Down, k.Left, k.Right},          // second column - movement
 		{k.CycleNextSection, k.CyclePrevSection}, // third column - sections
-		{k.Enter, k.Explain, k.Reset, k.Quit},    // fourth column - actions
+		{k.Enter, k.Space, k.Explain, k.Sweep, k.Pin, k.Transpose, k.Scale, k.Normalize, k.Arithmetic, k.Reset, k.Quit}, // fourth column - actions
 	}
 }
 
@@ -107,6 +202,10 @@ var eigenKeys = eigenKeyMap{
 		key.WithKeys("e"),
 		key.WithHelp("e", "eigen tab"),
 	),
+	TabS: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "solve tab"),
+	),
 	CycleNextSection: key.NewBinding(
 		key.WithKeys("tab"),
 		key.WithHelp("tab", "cycle to next section"),
@@ -135,10 +234,38 @@ var eigenKeys = eigenKeyMap{
 		key.WithKeys("enter"),
 		key.WithHelp("enter", "select/confirm"),
 	),
+	Space: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "calculate"),
+	),
 	Explain: key.NewBinding(
 		key.WithKeys("x"),
 		key.WithHelp("x", "toggle explanation"),
 	),
+	Sweep: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "toggle epsilon sweep"),
+	),
+	Pin: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "pin result for comparison"),
+	),
+	Transpose: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "transpose matrix"),
+	),
+	Scale: key.NewBinding(
+		key.WithKeys("*"),
+		key.WithHelp("*", "scale matrix by the scale factor"),
+	),
+	Normalize: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "cycle eigenvector normalization"),
+	),
+	Arithmetic: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "cycle arithmetic"),
+	),
 	Reset: key.NewBinding(
 		key.WithKeys("r"),
 		key.WithHelp("r", "reset"),
@@ -152,11 +279,17 @@ func (*EigenModel) GetHelpKeys() help.KeyMap {
 
 var _ (NumeTabContent) = (*EigenModel)(nil)
 
-func NewEigenModel(theme *Theme) *EigenModel {
-	renderer, _ := glamour.NewTermRenderer(
-		glamour.WithWordWrap(GlamourRenderWidth),
-		glamour.WithStandardStyle("dracula"),
-	)
+// eigenSections name the sections whose explanation does not depend on the
+// selection, looked up in the explanations package
+var eigenSections = map[int]explanations.Section{
+	EigenSectionPowerMethodSelection: explanations.SectionMethod,
+	EigenSectionMatrixSelection:      explanations.SectionMatrixSelection,
+	EigenSectionMatrixEditor:         explanations.SectionMatrixEditor,
+	EigenSectionArguments:            explanations.SectionArguments,
+}
+
+func NewEigenModel(theme *Theme, session *Session) *EigenModel {
+	layout := defaultColumnLayout()
 
 	// Create input fields
 	vectorInput := textinput.New()
@@ -164,61 +297,69 @@ func NewEigenModel(theme *Theme) *EigenModel {
 	vectorInput.CharLimit = 50
 	vectorInput.SetValue("1.0,1.0")
 
+	defaults := session.numericDefaults()
+
 	epsilonInput := textinput.New()
-	epsilonInput.Placeholder = "1e-6"
+	epsilonInput.Placeholder = FormatNumber(defaults.Epsilon)
 	epsilonInput.CharLimit = 20
-	epsilonInput.SetValue("1e-6")
+	epsilonInput.Validate = validateNumber
+	epsilonInput.SetValue(FormatNumber(defaults.Epsilon))
 
 	maxIterationsInput := textinput.New()
-	maxIterationsInput.Placeholder = "100"
+	maxIterationsInput.Placeholder = strconv.FormatUint(defaults.MaxIterations, 10)
 	maxIterationsInput.CharLimit = 10
-	maxIterationsInput.SetValue("100")
+	maxIterationsInput.Validate = validateCount
+	maxIterationsInput.SetValue(strconv.FormatUint(defaults.MaxIterations, 10))
 
 	kEigenvalueInput := textinput.New()
 	kEigenvalueInput.Placeholder = "0.0"
 	kEigenvalueInput.CharLimit = 20
 	kEigenvalueInput.SetValue("0.0")
 
-	// Predefined matrices
-	predefinedMatrices := [][][]float64{
-		// 2x2 Simple
-		{{2.0, 3.0}, {5.0, 4.0}},
-		// 3x3 Simple
-		{{2.0, 1.0, 0.0}, {1.0, 2.0, 1.0}, {0.0, 1.0, 2.0}},
-		// 4x4 Simple
-		{{4.0, 1.0, 0.0, 0.0}, {1.0, 3.0, 1.0, 0.0}, {0.0, 1.0, 3.0, 1.0}, {0.0, 0.0, 1.0, 2.0}},
-		// 5x5 Real
-		{{6.0, 1.0, 2.0, 0.0, 0.0}, {1.0, 5.0, 1.0, 1.0, 0.0}, {2.0, 1.0, 4.0, 1.0, 1.0}, {0.0, 1.0, 1.0, 3.0, 1.0}, {0.0, 0.0, 1.0, 1.0, 2.0}},
+	referenceInput := textinput.New()
+	referenceInput.Placeholder = "optional"
+	referenceInput.CharLimit = 30
+
+	scaleInput := textinput.New()
+	scaleInput.Placeholder = "2"
+	scaleInput.CharLimit = 20
+	scaleInput.Validate = validateNumber
+	scaleInput.SetValue("2")
+
+	// Predefined matrices, the builtin ones followed by any registered
+	matrices := eigenMatrices()
+	matrixOptions := make([]string, len(matrices))
+	predefinedMatrices := make([][][]float64, len(matrices))
+	for i, matrix := range matrices {
+		matrixOptions[i] = matrix.Name
+		predefinedMatrices[i] = matrix.Values
 	}
 
 	return &EigenModel{
-		focusedSection: 0,
-		powerMethodOptions: []string{
-			"Regular Power Method",
-			"Inverse Power Method",
-			"Farthest Eigenvalue Power",
-			"Nearest Eigenvalue Power",
-		},
+		focusedSection:      0,
+		powerMethods:        usecases.PowerMethods(),
 		selectedPowerMethod: 0,
-		matrixOptions: []string{
-			"2x2 Simple Matrix",
-			"3x3 Simple Matrix",
-			"4x4 Simple Matrix",
-			"5x5 Real Matrix",
-		},
-		selectedMatrix:     0,
-		predefinedMatrices: predefinedMatrices,
-		vectorInput:        vectorInput,
-		epsilonInput:       epsilonInput,
-		maxIterationsInput: maxIterationsInput,
-		kEigenvalueInput:   kEigenvalueInput,
-		initialVector:      []float64{1.0, 1.0},
-		epsilon:            DefaultEpsilon,
-		maxIterations:      DefaultMaxIterations,
-		kEigenvalue:        0.0,
-		useCase:            usecases.NewPowerUseCase(),
-		renderer:           renderer,
-		Theme:              theme,
+		matrixOptions:       matrixOptions,
+		selectedMatrix:      0,
+		predefinedMatrices:  predefinedMatrices,
+		matrixEditor:        NewMatrixEditorModel(theme, predefinedMatrices[0]),
+		vectorInput:         vectorInput,
+		epsilonInput:        epsilonInput,
+		maxIterationsInput:  maxIterationsInput,
+		kEigenvalueInput:    kEigenvalueInput,
+		referenceInput:      referenceInput,
+		scaleInput:          scaleInput,
+		initialVector:       []float64{1.0, 1.0},
+		epsilon:             defaults.Epsilon,
+		maxIterations:       defaults.MaxIterations,
+		kEigenvalue:         0.0,
+		scaleFactor:         2,
+		arithmetic:          precision.Float64,
+		useCase:             usecases.NewPowerUseCase(),
+		session:             session,
+		layout:              layout,
+		renderer:            layout.markdownRenderer(),
+		Theme:               theme,
 	}
 }
 
@@ -227,16 +368,47 @@ func (*EigenModel) Init() tea.Cmd {
 }
 
 func (m *EigenModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.resize(size.Width)
+		return m, nil
+	}
+
 	var cmds []tea.Cmd
 
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
 		switch {
 		case key.Matches(keyMsg, eigenKeys.CycleNextSection):
-			m.focusedSection = (m.focusedSection + 1) % EigenSectionCount
+			m.setFocusedSection((m.focusedSection + 1) % EigenSectionCount)
 			return m, nil
 		case key.Matches(keyMsg, eigenKeys.CyclePrevSection):
-			m.focusedSection = (m.focusedSection - 1 + EigenSectionCount) % EigenSectionCount
+			m.setFocusedSection((m.focusedSection - 1 + EigenSectionCount) % EigenSectionCount)
+			return m, nil
+		case key.Matches(keyMsg, eigenKeys.Space) && m.focusedSection != EigenSectionArguments:
+			// Spaces may separate the initial vector components
+			return m.handleSpace(), nil
+		case key.Matches(keyMsg, eigenKeys.Transpose) && m.focusedSection != EigenSectionArguments:
+			m.transformMatrix(usecases.Transpose)
+			return m, nil
+		case key.Matches(keyMsg, eigenKeys.Scale) && m.focusedSection != EigenSectionArguments:
+			m.transformMatrix(func(matrix [][]float64) [][]float64 {
+				return usecases.Scale(matrix, m.scaleFactor)
+			})
+			return m, nil
+		case key.Matches(keyMsg, eigenKeys.Normalize) && m.focusedSection != EigenSectionArguments:
+			m.cycleNormalization()
 			return m, nil
+		case key.Matches(keyMsg, eigenKeys.Arithmetic) && m.focusedSection != EigenSectionArguments:
+			m.cycleArithmetic()
+			return m, nil
+		case m.focusedSection == EigenSectionMatrixEditor:
+			// The editor owns every other key while focused
+			var cmd tea.Cmd
+			m.matrixEditor, cmd = m.matrixEditor.Update(keyMsg)
+			return m, cmd
+		case keyMsg.Type == tea.KeyRunes && m.capturesText():
+			// Typed characters go to the inputs ahead of the bindings, so
+			// the k of 10k doesn't move the focus
+			return m, m.updateFocusedArgument(keyMsg)
 		case key.Matches(keyMsg, eigenKeys.Up):
 			return m.handleUp(), nil
 		case key.Matches(keyMsg, eigenKeys.Down):
@@ -253,40 +425,158 @@ func (m *EigenModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.generateExplanation()
 			}
 			return m, nil
+		case key.Matches(keyMsg, eigenKeys.Sweep) && m.focusedSection != EigenSectionArguments:
+			m.showSweep = !m.showSweep
+			if m.showSweep {
+				m.generateSweep()
+			}
+			return m, nil
+		case key.Matches(keyMsg, eigenKeys.Pin) && m.focusedSection != EigenSectionArguments:
+			m.pinResult()
+			return m, nil
 		case key.Matches(keyMsg, eigenKeys.Reset):
-			return NewEigenModel(m.Theme), nil
+			m.cancelInFlight()
+			return m.reset(), nil
 		}
 
-		// Handle input for text inputs
+		// Only the focused argument receives the key, so typing in one
+		// field can't leak into the others
 		if m.focusedSection == EigenSectionArguments {
-			var cmd tea.Cmd
-			m.vectorInput, cmd = m.vectorInput.Update(keyMsg)
-			if val := m.parseVector(m.vectorInput.Value()); val != nil {
-				m.initialVector = val
-			}
-			cmds = append(cmds, cmd)
+			cmds = append(cmds, m.updateFocusedArgument(keyMsg))
+		}
+	}
 
-			m.epsilonInput, cmd = m.epsilonInput.Update(keyMsg)
-			if val, err := strconv.ParseFloat(m.epsilonInput.Value(), 64); err == nil {
-				m.epsilon = val
-			}
-			cmds = append(cmds, cmd)
+	return m, tea.Batch(cmds...)
+}
 
-			m.maxIterationsInput, cmd = m.maxIterationsInput.Update(keyMsg)
-			if val, err := strconv.ParseUint(m.maxIterationsInput.Value(), 10, 64); err == nil {
-				m.maxIterations = val
-			}
-			cmds = append(cmds, cmd)
+// capturesText reports whether a text input or the matrix editor is focused,
+// which then takes the typed characters ahead of the global keys.
+func (m *EigenModel) capturesText() bool {
+	switch m.focusedSection {
+	case EigenSectionMatrixEditor:
+		return true
+	case EigenSectionArguments:
+		return m.vectorInput.Focused() || m.epsilonInput.Focused() || m.maxIterationsInput.Focused() ||
+			m.kEigenvalueInput.Focused() || m.referenceInput.Focused() || m.scaleInput.Focused()
+	}
+	return false
+}
 
-			m.kEigenvalueInput, cmd = m.kEigenvalueInput.Update(keyMsg)
-			if val, err := strconv.ParseFloat(m.kEigenvalueInput.Value(), 64); err == nil {
-				m.kEigenvalue = val
-			}
-			cmds = append(cmds, cmd)
+// updateFocusedArgument feeds keyMsg to the focused argument input and
+// re-parses the value that input edits.
+func (m *EigenModel) updateFocusedArgument(keyMsg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch {
+	case m.vectorInput.Focused():
+		m.vectorInput, cmd = m.vectorInput.Update(keyMsg)
+		if val := m.parseVector(m.vectorInput.Value()); val != nil {
+			m.initialVector = val
+		}
+	case m.epsilonInput.Focused():
+		m.epsilonInput, cmd = m.epsilonInput.Update(keyMsg)
+		if val, err := ParseNumber(m.epsilonInput.Value()); err == nil {
+			m.epsilon = val
+		}
+	case m.maxIterationsInput.Focused():
+		m.maxIterationsInput, cmd = m.maxIterationsInput.Update(keyMsg)
+		if val, err := ParseCount(m.maxIterationsInput.Value()); err == nil {
+			m.maxIterations = val
+		}
+	case m.kEigenvalueInput.Focused():
+		m.kEigenvalueInput, cmd = m.kEigenvalueInput.Update(keyMsg)
+		if val, err := ParseNumber(m.kEigenvalueInput.Value()); err == nil {
+			m.kEigenvalue = val
+		}
+	case m.referenceInput.Focused():
+		m.referenceInput, cmd = m.referenceInput.Update(keyMsg)
+	case m.scaleInput.Focused():
+		m.scaleInput, cmd = m.scaleInput.Update(keyMsg)
+		if val, err := ParseNumber(m.scaleInput.Value()); err == nil {
+			m.scaleFactor = val
 		}
 	}
 
-	return m, tea.Batch(cmds...)
+	return cmd
+}
+
+func (m *EigenModel) setFocusedSection(section int) {
+	m.focusedSection = section
+
+	if section == EigenSectionMatrixEditor {
+		m.matrixEditor.Focus()
+	} else {
+		m.matrixEditor.Blur()
+	}
+}
+
+// selectMatrix loads the predefined matrix at index in the editor. The
+// default all ones initial vector follows the matrix dimension, while a
+// vector the user typed is kept as it is.
+func (m *EigenModel) selectMatrix(index int) {
+	m.selectedMatrix = index
+	matrix := m.predefinedMatrices[index]
+	m.matrixEditor.SetMatrix(matrix)
+
+	if len(m.initialVector) == len(matrix) {
+		return
+	}
+	for _, value := range m.initialVector {
+		if value != 1 {
+			return
+		}
+	}
+
+	m.initialVector = make([]float64, len(matrix))
+	components := make([]string, len(matrix))
+	for i := range matrix {
+		m.initialVector[i] = 1
+		components[i] = "1.0"
+	}
+	m.vectorInput.SetValue(strings.Join(components, ","))
+	m.vectorInput.Placeholder = m.vectorInput.Value()
+}
+
+// transformMatrix replaces the editor matrix with transform applied to it,
+// recomputing the result and the sweep when they are shown.
+func (m *EigenModel) transformMatrix(transform func([][]float64) [][]float64) {
+	matrix, err := m.matrixEditor.Matrix()
+	if err != nil {
+		m.result, m.resultErr = nil, err
+		return
+	}
+
+	m.matrixEditor.SetMatrix(transform(matrix))
+
+	if m.result != nil || m.resultErr != nil {
+		m.generateResult()
+	}
+	if m.showSweep {
+		m.generateSweep()
+	}
+}
+
+// cycleNormalization moves to the next eigenvector normalization,
+// recomputing the result when it is shown.
+func (m *EigenModel) cycleNormalization() {
+	modes := usecases.NormalizationModes()
+	m.normalization = modes[(slices.Index(modes, m.normalization)+1)%len(modes)]
+
+	if m.result != nil || m.resultErr != nil {
+		m.generateResult()
+	}
+}
+
+// cycleArithmetic moves to the next arithmetic the power iteration
+// accumulates in, recomputing the result when it is shown.
+func (m *EigenModel) cycleArithmetic() {
+	arithmetics := precision.Arithmetics()
+	m.arithmetic = arithmetics[(slices.Index(arithmetics, m.arithmetic)+1)%len(arithmetics)]
+	m.useCase = usecases.NewPowerUseCase(usecases.WithArithmetic(m.arithmetic))
+
+	if m.result != nil || m.resultErr != nil {
+		m.generateResult()
+	}
 }
 
 func (m *EigenModel) handleUp() *EigenModel {
@@ -295,17 +585,23 @@ func (m *EigenModel) handleUp() *EigenModel {
 		if m.selectedPowerMethod > 0 {
 			m.selectedPowerMethod--
 		} else {
-			m.selectedPowerMethod = len(m.powerMethodOptions) - 1
+			m.selectedPowerMethod = len(m.powerMethods) - 1
 		}
 	case EigenSectionMatrixSelection: // Matrix selection
 		if m.selectedMatrix > 0 {
-			m.selectedMatrix--
+			m.selectMatrix(m.selectedMatrix - 1)
 		} else {
-			m.selectedMatrix = len(m.matrixOptions) - 1
+			m.selectMatrix(len(m.matrixOptions) - 1)
 		}
 	case EigenSectionArguments: // Arguments - cycle through inputs
 		// Cycle backwards through inputs (up key)
-		if m.kEigenvalueInput.Focused() {
+		if m.scaleInput.Focused() {
+			m.scaleInput.Blur()
+			m.referenceInput.Focus()
+		} else if m.referenceInput.Focused() {
+			m.referenceInput.Blur()
+			m.kEigenvalueInput.Focus()
+		} else if m.kEigenvalueInput.Focused() {
 			m.kEigenvalueInput.Blur()
 			m.maxIterationsInput.Focus()
 		} else if m.maxIterationsInput.Focused() {
@@ -315,11 +611,13 @@ func (m *EigenModel) handleUp() *EigenModel {
 			m.epsilonInput.Blur()
 			m.vectorInput.Focus()
 		} else {
-			// Default to k eigenvalue input (wrap around)
+			// Default to scale input (wrap around)
 			m.vectorInput.Blur()
 			m.epsilonInput.Blur()
 			m.maxIterationsInput.Blur()
-			m.kEigenvalueInput.Focus()
+			m.kEigenvalueInput.Blur()
+			m.referenceInput.Blur()
+			m.scaleInput.Focus()
 		}
 	case EigenSectionCalculate: // Calculate button - no up action
 	}
@@ -329,16 +627,16 @@ func (m *EigenModel) handleUp() *EigenModel {
 func (m *EigenModel) handleDown() *EigenModel {
 	switch m.focusedSection {
 	case EigenSectionPowerMethodSelection: // Power method selection
-		if m.selectedPowerMethod < len(m.powerMethodOptions)-1 {
+		if m.selectedPowerMethod < len(m.powerMethods)-1 {
 			m.selectedPowerMethod++
 		} else {
 			m.selectedPowerMethod = 0
 		}
 	case EigenSectionMatrixSelection: // Matrix selection
 		if m.selectedMatrix < len(m.matrixOptions)-1 {
-			m.selectedMatrix++
+			m.selectMatrix(m.selectedMatrix + 1)
 		} else {
-			m.selectedMatrix = 0
+			m.selectMatrix(0)
 		}
 	case EigenSectionArguments: // Arguments - cycle through inputs
 		// Cycle forwards through inputs (down key)
@@ -351,12 +649,20 @@ func (m *EigenModel) handleDown() *EigenModel {
 		} else if m.maxIterationsInput.Focused() {
 			m.maxIterationsInput.Blur()
 			m.kEigenvalueInput.Focus()
+		} else if m.kEigenvalueInput.Focused() {
+			m.kEigenvalueInput.Blur()
+			m.referenceInput.Focus()
+		} else if m.referenceInput.Focused() {
+			m.referenceInput.Blur()
+			m.scaleInput.Focus()
 		} else {
 			// Default to vector input (wrap around)
 			m.vectorInput.Focus()
 			m.epsilonInput.Blur()
 			m.maxIterationsInput.Blur()
 			m.kEigenvalueInput.Blur()
+			m.referenceInput.Blur()
+			m.scaleInput.Blur()
 		}
 	case EigenSectionCalculate: // Calculate button - no down action
 	}
@@ -367,7 +673,13 @@ func (m *EigenModel) handleLeft() *EigenModel {
 	switch m.focusedSection {
 	case EigenSectionArguments: // Arguments - focus previous input
 		// Cycle backwards through inputs
-		if m.kEigenvalueInput.Focused() {
+		if m.scaleInput.Focused() {
+			m.scaleInput.Blur()
+			m.referenceInput.Focus()
+		} else if m.referenceInput.Focused() {
+			m.referenceInput.Blur()
+			m.kEigenvalueInput.Focus()
+		} else if m.kEigenvalueInput.Focused() {
 			m.kEigenvalueInput.Blur()
 			m.maxIterationsInput.Focus()
 		} else if m.maxIterationsInput.Focused() {
@@ -382,6 +694,8 @@ func (m *EigenModel) handleLeft() *EigenModel {
 			m.epsilonInput.Blur()
 			m.maxIterationsInput.Blur()
 			m.kEigenvalueInput.Blur()
+			m.referenceInput.Blur()
+			m.scaleInput.Blur()
 		}
 	case EigenSectionCalculate: // Calculate button - no left action
 	}
@@ -401,18 +715,34 @@ func (m *EigenModel) handleRight() *EigenModel {
 		} else if m.maxIterationsInput.Focused() {
 			m.maxIterationsInput.Blur()
 			m.kEigenvalueInput.Focus()
+		} else if m.kEigenvalueInput.Focused() {
+			m.kEigenvalueInput.Blur()
+			m.referenceInput.Focus()
+		} else if m.referenceInput.Focused() {
+			m.referenceInput.Blur()
+			m.scaleInput.Focus()
 		} else {
-			// Default to k eigenvalue input
-			m.vectorInput.Blur()
+			// Default to vector input (wrap around)
+			m.vectorInput.Focus()
 			m.epsilonInput.Blur()
 			m.maxIterationsInput.Blur()
-			m.kEigenvalueInput.Focus()
+			m.kEigenvalueInput.Blur()
+			m.referenceInput.Blur()
+			m.scaleInput.Blur()
 		}
 	case EigenSectionCalculate: // Calculate button - no right action
 	}
 	return m
 }
 
+// handleSpace runs the calculation from any section, moving the focus to the
+// calculate section where the result is shown.
+func (m *EigenModel) handleSpace() *EigenModel {
+	m.setFocusedSection(EigenSectionCalculate)
+	m.generateResult()
+	return m
+}
+
 func (m *EigenModel) handleEnter() *EigenModel {
 	// Only generate result if calculate button is focused
 	if m.focusedSection == EigenSectionCalculate {
@@ -421,25 +751,24 @@ func (m *EigenModel) handleEnter() *EigenModel {
 	return m
 }
 
-func (m *EigenModel) View() string {
-	// Create two-column layout: left side navigation, right side content
-	leftWidth := 40
-	rightWidth := 60
-
-	// Left side - Section navigation
-	leftContent := m.renderSectionNavigation()
+// resize lays the tab out for a window windowWidth columns wide.
+func (m *EigenModel) resize(windowWidth int) {
+	m.layout = newColumnLayout(windowWidth)
+	m.renderer = m.layout.markdownRenderer()
+}
 
-	// Right side - Markdown content based on focused section
-	rightContent := m.renderSectionContent()
+// reset returns a new model in the layout of this one, as the window size is
+// only sent again when it changes.
+func (m *EigenModel) reset() *EigenModel {
+	reset := NewEigenModel(m.Theme, m.session)
+	reset.layout = m.layout
+	reset.renderer = m.renderer
 
-	// Join horizontally
-	content := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		m.Renderer.NewStyle().Width(leftWidth).Render(leftContent),
-		m.Renderer.NewStyle().Width(rightWidth).Render(rightContent),
-	)
+	return reset
+}
 
-	return content
+func (m *EigenModel) View() string {
+	return m.layout.render(m.Renderer, m.renderSectionNavigation(), m.renderSectionContent())
 }
 
 func (m *EigenModel) renderSectionNavigation() string {
@@ -449,6 +778,7 @@ func (m *EigenModel) renderSectionNavigation() string {
 	sectionNames := []string{
 		"Power Method Selection",
 		"Matrix Selection",
+		"Matrix Editor",
 		"Arguments",
 		"Calculate",
 	}
@@ -472,13 +802,17 @@ func (m *EigenModel) renderSectionNavigation() string {
 		// Add content based on section
 		switch i {
 		case EigenSectionPowerMethodSelection: // Power Method Selection
-			for j, method := range m.powerMethodOptions {
+			for j, method := range m.powerMethods {
 				style := m.Blurred.UnselectedPrefix
 				if j == m.selectedPowerMethod {
 					style = m.Focused.SelectedPrefix
 				}
-				sections = append(sections, style.Render(method))
+				sections = append(sections, style.Render(method.Describe()))
 			}
+			sections = append(sections, m.Blurred.Description.Render(
+				"  Eigenvector normalization: "+describeNormalization(m.normalization)))
+			sections = append(sections, m.Blurred.Description.Render(
+				"  Arithmetic: "+string(m.arithmetic)))
 		case EigenSectionMatrixSelection: // Matrix Selection
 			for j, matrix := range m.matrixOptions {
 				style := m.Blurred.UnselectedPrefix
@@ -487,11 +821,16 @@ func (m *EigenModel) renderSectionNavigation() string {
 				}
 				sections = append(sections, style.Render(matrix))
 			}
+			sections = append(sections, m.renderMatrixShape())
+		case EigenSectionMatrixEditor: // Matrix Editor
+			sections = append(sections, m.matrixEditor.View())
 		case EigenSectionArguments: // Arguments
 			sections = append(sections, fmt.Sprintf("  Initial Vector: %s", m.vectorInput.View()))
-			sections = append(sections, fmt.Sprintf("  Epsilon: %s", m.epsilonInput.View()))
-			sections = append(sections, fmt.Sprintf("  Max Iterations: %s", m.maxIterationsInput.View()))
+			sections = append(sections, fmt.Sprintf("  Epsilon: %s", m.renderValidatedInput(m.epsilonInput)))
+			sections = append(sections, fmt.Sprintf("  Max Iterations: %s", m.renderValidatedInput(m.maxIterationsInput)))
 			sections = append(sections, fmt.Sprintf("  K Eigenvalue: %s", m.kEigenvalueInput.View()))
+			sections = append(sections, fmt.Sprintf("  Reference: %s", m.referenceInput.View()))
+			sections = append(sections, fmt.Sprintf("  Scale Factor: %s", m.renderValidatedInput(m.scaleInput)))
 		case EigenSectionCalculate: // Calculate button
 			// Create a styled button
 			var buttonStyle lipgloss.Style
@@ -509,103 +848,136 @@ func (m *EigenModel) renderSectionNavigation() string {
 	return strings.Join(sections, "\n")
 }
 
-func (m *EigenModel) renderSectionContent() string {
-	var content string
+// renderMatrixShape describes the matrix in the editor, its dimension and
+// whether it is symmetric, so real eigenvalues are guaranteed.
+func (m *EigenModel) renderMatrixShape() string {
+	shape := fmt.Sprintf("%d×%d", m.matrixEditor.Rows(), m.matrixEditor.Columns())
 
-	switch m.focusedSection {
-	case EigenSectionPowerMethodSelection: // Power Method Selection
-		content = `# Power Method Selection
+	if matrix, err := m.matrixEditor.Matrix(); err == nil && usecases.IsSymmetric(matrix) {
+		shape += ", symmetric"
+	} else {
+		shape += ", not symmetric"
+	}
 
-Choose the eigenvalue calculation method:
+	return m.Blurred.Description.Render("  " + shape)
+}
 
-## Available Methods
+func (m *EigenModel) renderSectionContent() string {
+	if m.showSweep {
+		return m.renderMarkdown(m.renderSweep())
+	}
 
-- **Regular Power Method**: Finds the largest eigenvalue
-- **Inverse Power Method**: Finds the smallest eigenvalue
-- **Farthest Eigenvalue Power**: Finds eigenvalue farthest from given value
-- **Nearest Eigenvalue Power**: Finds eigenvalue nearest to given value
+	var explanation explanations.Explanation
 
-Use ↑/↓ arrows to select a power method.
-`
-	case EigenSectionMatrixSelection: // Matrix Selection
-		content = `# Matrix Selection
+	switch m.focusedSection {
+	case EigenSectionCalculate:
+		explanation = explanations.Explanation{
+			Title:           "Calculate",
+			Overview:        "Execute the eigenvalue calculation with the configured parameters.",
+			ParametersTitle: "Current Configuration",
+			Parameters: []explanations.Parameter{
+				{Name: "Power Method", Description: m.powerMethod().Describe()},
+				{Name: "Matrix", Description: fmt.Sprintf("%dx%d (from %s)",
+					m.matrixEditor.Rows(), m.matrixEditor.Columns(), m.matrixOptions[m.selectedMatrix])},
+				{Name: "Initial Vector", Description: m.formatVector(m.initialVector)},
+				{Name: "Epsilon", Description: fmt.Sprintf("%.2e", m.epsilon)},
+				{Name: "Max Iterations", Description: fmt.Sprintf("%d", m.maxIterations)},
+				{Name: "K Eigenvalue", Description: fmt.Sprintf("%.3f (used for nearest/farthest methods)", m.kEigenvalue)},
+				{Name: "Normalization", Description: describeNormalization(m.normalization)},
+				{Name: "Arithmetic", Description: string(m.arithmetic)},
+			},
+			Tips: []string{"Press **Enter** on the Calculate button to run the calculation."},
+		}
+	default:
+		explanation, _ = explanations.Lookup(explanations.TabEigen, eigenSections[m.focusedSection], m.session.numericProfile())
+	}
 
-Choose a predefined matrix for eigenvalue calculation:
+	content := explanation.Markdown()
+
+	switch m.focusedSection {
+	case EigenSectionMatrixSelection, EigenSectionMatrixEditor:
+		content += "\n## Current Matrix\n" + m.getMatrixDisplay()
+		if discs := m.renderGershgorinDiscs(); discs != "" {
+			content += "\n## Gershgorin Discs\n\n" + discs
+		}
+	case EigenSectionCalculate:
+		// Add results section if available
+		if result := m.renderResult(); result != "" {
+			content += "\n# Result\n\n" + result
+		}
+	}
 
-## Available Matrices
+	return m.renderMarkdown(content)
+}
 
-- **2x2 Simple**: Small symmetric matrix
-- **3x3 Simple**: Tridiagonal symmetric matrix
-- **4x4 Simple**: Larger tridiagonal matrix
-- **5x5 Real**: Large pentadiagonal matrix
+// renderMarkdown renders content with glamour, falling back to the raw
+// markdown when rendering fails.
+func (m *EigenModel) renderMarkdown(content string) string {
+	if rendered, err := m.renderer.Render(content); err == nil {
+		return rendered
+	}
+	return content
+}
 
-Use ↑/↓ arrows to select a matrix.
+func (m *EigenModel) renderResult() string {
+	if m.resultErr != nil {
+		return m.Focused.ErrorMessage.Render(m.resultErr.Error())
+	}
 
-## Current Matrix
-` + m.getMatrixDisplay()
-	case EigenSectionArguments: // Arguments
-		content = `# Arguments
+	if m.result == nil {
+		return ""
+	}
 
-Configure the power method parameters:
+	rendered := fmt.Sprintf(`**Eigenvalue**: %.6f
 
-## Initial Vector
-Starting eigenvector guess (comma-separated values).
-- Must have same dimension as matrix
-- Cannot be zero vector
-- **Format**: 1.0,1.0 or 1,0,1
-- **Default**: 1.0,1.0
+**Eigenvector**: %s, %s
 
-## Epsilon (ε)
-Convergence tolerance for the algorithm.
-- Smaller values: More precise but slower
-- Typical range: 1e-10 to 1e-3
-- **Default**: 1e-6
+**Iterations**: %d`,
+		m.result.Eigenvalue,
+		m.formatVector(m.result.Eigenvector), describeNormalization(m.result.Normalization),
+		m.result.Iterations)
 
-## Max Iterations
-Maximum number of iterations before stopping.
-- Higher values: More chances to converge
-- Typical range: 50 to 1000
-- **Default**: 100
+	if m.result.ShiftAtEigenvalue {
+		rendered += "\n\n> **Note**: k is an eigenvalue of the matrix, so A - kI is singular. It was nudged slightly to find it."
+	}
 
-## K Eigenvalue (Shift Value)
-Shift value for nearest/farthest eigenvalue methods.
-- Used only with "Nearest" and "Farthest" power methods
-- For nearest: finds eigenvalue closest to this value
-- For farthest: finds eigenvalue farthest from this value
-- **Default**: 0.0
+	if m.result.Convergence != nil {
+		rendered += "\n\n" + m.renderConvergence(m.result.Convergence)
+	}
 
-Use ←/→ arrows to switch between input fields.`
-	case EigenSectionCalculate: // Calculate
-		content = `# Calculate
+	if m.result.Reference != nil {
+		rendered += "\n\n" + m.result.Reference.render()
+	}
 
-Execute the eigenvalue calculation with the configured parameters:
+	if m.result.Diff != nil {
+		rendered += "\n\n" + m.result.Diff.render()
+	}
 
-## Current Configuration
+	return rendered
+}
 
-- **Power Method**: ` + m.powerMethodOptions[m.selectedPowerMethod] + `
-- **Matrix**: ` + m.matrixOptions[m.selectedMatrix] + `
-- **Initial Vector**: ` + m.formatVector(m.initialVector) + `
-- **Epsilon**: ` + fmt.Sprintf("%.2e", m.epsilon) + `
-- **Max Iterations**: ` + fmt.Sprintf("%d", m.maxIterations) + `
-- **K Eigenvalue**: ` + fmt.Sprintf("%.3f", m.kEigenvalue) + ` (used for nearest/farthest methods)
+// renderConvergence explains how fast the power method converges, the error
+// shrinking by |λ₂/λ₁| each iteration.
+func (m *EigenModel) renderConvergence(convergence *usecases.PowerConvergence) string {
+	rendered := fmt.Sprintf(`**Spectral radius**: %.6f
 
-Press **Enter** on the Calculate button to run the calculation.`
+**Convergence ratio**: |λ₂/λ₁| = %.4f`,
+		convergence.SpectralRadius,
+		convergence.ConvergenceRatio)
 
-		// Add results section if available
-		if m.result != "" {
-			content += `
+	switch {
+	case convergence.ConvergenceRatio >= 1:
+		rendered += `
 
-# Result
+> **Warning**: no eigenvalue dominates in modulus, the power method can't converge on this matrix`
+	case convergence.ConvergenceRatio > SlowConvergenceRatio:
+		rendered += fmt.Sprintf(`
 
-` + m.result
-		}
+> **Slow convergence**: |λ₂| is close to |λ₁|, expect about %.0f iterations to reach ε = %.2e`,
+			convergence.PredictedIterations(m.epsilon), m.epsilon)
 	}
 
-	// Render with glamour
-	if rendered, err := m.renderer.Render(content); err == nil {
-		return rendered
-	}
-	return content
+	return rendered
 }
 
 func (m *EigenModel) parseVector(input string) []float64 {
@@ -646,11 +1018,11 @@ func (m *EigenModel) formatVector(vector []float64) string {
 }
 
 func (m *EigenModel) getMatrixDisplay() string {
-	if m.selectedMatrix < 0 || m.selectedMatrix >= len(m.predefinedMatrices) {
-		return "Invalid matrix selection"
+	matrix, err := m.matrixEditor.Matrix()
+	if err != nil {
+		return err.Error()
 	}
 
-	matrix := m.predefinedMatrices[m.selectedMatrix]
 	var lines []string
 
 	for _, row := range matrix {
@@ -664,20 +1036,143 @@ func (m *EigenModel) getMatrixDisplay() string {
 	return "```\n" + strings.Join(lines, "\n") + "\n```"
 }
 
-func (m *EigenModel) generateResult() {
-	if m.selectedMatrix < 0 || m.selectedMatrix >= len(m.predefinedMatrices) {
-		m.result = m.Focused.ErrorMessage.Render("Invalid matrix selection")
+// renderGershgorinDiscs lists the disc of each row of the matrix in the
+// editor, locating its eigenvalues before any is computed. It is empty while
+// the matrix is invalid.
+func (m *EigenModel) renderGershgorinDiscs() string {
+	matrix, err := m.matrixEditor.Matrix()
+	if err != nil {
+		return ""
+	}
+
+	discs, err := usecases.GershgorinDiscs(matrix)
+	if err != nil {
+		return ""
+	}
+
+	var lines []string
+	for i, disc := range discs {
+		lines = append(lines, fmt.Sprintf("- **Row %d**: center %.3f, radius %.3f", i+1, disc.Center, disc.Radius))
+	}
+
+	lower, upper := usecases.GershgorinInterval(discs)
+	lines = append(lines, "", fmt.Sprintf(
+		"Every eigenvalue lies in the union of the discs, its real part within [%.3f, %.3f].", lower, upper))
+
+	return strings.Join(lines, "\n")
+}
+
+// pinResult pins the current result for the following ones to be compared
+// against, unpinning when there is no result.
+func (m *EigenModel) pinResult() {
+	if m.result == nil {
+		m.pinned = nil
 		return
 	}
 
-	matrix := m.predefinedMatrices[m.selectedMatrix]
+	m.pinned = &PinnedResult{
+		Label: fmt.Sprintf("%s on %s", m.result.Method, m.matrixOptions[m.selectedMatrix]),
+		Value: m.result.Eigenvalue,
+	}
+	m.result.Diff = diffAgainst(m.pinned, m.result.Eigenvalue)
+}
+
+func (m *EigenModel) generateResult() {
+	m.result, m.resultErr = m.computeResult()
+}
+
+func (m *EigenModel) generateSweep() {
+	m.sweep, m.sweepErr = m.computeSweep()
+}
+
+// computeSweep re-runs the selected power method for every epsilon decade,
+// the other arguments unchanged.
+func (m *EigenModel) computeSweep() ([]usecases.EpsilonSweepPoint, error) {
+	matrix, err := m.validatedMatrix()
+	if err != nil {
+		return nil, err
+	}
+
+	epsilons, err := usecases.EpsilonDecades(EpsilonSweepDecades)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := m.requestContext()
+	defer cancel()
+
+	logger := LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "Sweeping the power method epsilon from the TUI",
+		slog.String("method", m.powerMethod().String()),
+		slog.String("matrix", m.matrixOptions[m.selectedMatrix]),
+	)
+
+	sweep, err := m.useCase.SweepEpsilon(ctx, m.powerMethod(), matrix, usecases.PowerParams{
+		InitialGuess:  m.initialVector,
+		Shift:         m.kEigenvalue,
+		MaxIterations: m.maxIterations,
+		Normalization: m.normalization,
+	}, epsilons)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to sweep the epsilon", slog.Any("error", err))
+		return nil, fmt.Errorf("error sweeping epsilon: %w", err)
+	}
+
+	return sweep, nil
+}
+
+// renderSweep renders the sweep as a markdown table, each eigenvalue with its
+// change from the looser epsilon before it.
+func (m *EigenModel) renderSweep() string {
+	content := "# Epsilon Sweep\n\n"
+
+	if m.sweepErr != nil {
+		return content + m.Focused.ErrorMessage.Render(m.sweepErr.Error())
+	}
+
+	if len(m.sweep) == 0 {
+		return content
+	}
+
+	content += fmt.Sprintf("%s with at most %d iterations\n\n", m.powerMethod().Describe(), m.maxIterations)
+	content += "| Epsilon | Iterations | Eigenvalue | Change |\n|---|---|---|---|\n"
+
+	for i, point := range m.sweep {
+		change := "-"
+		if i > 0 {
+			change = fmt.Sprintf("%.2e", math.Abs(point.Eigenvalue-m.sweep[i-1].Eigenvalue))
+		}
+		content += fmt.Sprintf("| %.0e | %d | %.12g | %s |\n", point.Epsilon, point.Iterations, point.Eigenvalue, change)
+	}
+
+	return content + `
+Each tighter epsilon costs more iterations, while the eigenvalue stops
+changing once its digits have settled. Runs reaching the max iterations stop
+before meeting their epsilon.`
+}
+
+// validatedMatrix returns the edited matrix once it and the arguments are
+// valid for the power methods.
+func (m *EigenModel) validatedMatrix() ([][]float64, error) {
+	if err := inputsError(
+		labeledInput{"epsilon", m.epsilonInput},
+		labeledInput{"max iterations", m.maxIterationsInput},
+	); err != nil {
+		return nil, err
+	}
+
+	matrix, err := m.matrixEditor.Matrix()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matrix) != len(matrix[0]) {
+		return nil, fmt.Errorf("%w, got %dx%d", usecases.ErrNonSquareMatrix, len(matrix), len(matrix[0]))
+	}
 
 	// Validate initial vector dimension
 	if len(m.initialVector) != len(matrix) {
-		m.result = m.Focused.ErrorMessage.Render(
-			fmt.Sprintf("Initial vector dimension (%d) must match matrix dimension (%d)",
-				len(m.initialVector), len(matrix)))
-		return
+		return nil, fmt.Errorf("%w: %d != %d", ErrInitialVectorDimension, len(m.initialVector), len(matrix))
 	}
 
 	// Check for zero vector
@@ -690,50 +1185,150 @@ func (m *EigenModel) generateResult() {
 		}
 	}
 	if allZero {
-		m.result = m.Focused.ErrorMessage.Render("Initial vector cannot be zero")
-		return
+		return nil, ErrZeroInitialVector
 	}
 
-	ctx := context.Background()
-	var powerResult *usecases.PowerResult
-	var err error
-
-	// Call appropriate power method
-	switch m.selectedPowerMethod {
-	case PowerMethodRegular:
-		powerResult, err = m.useCase.RegularPower(ctx, matrix, m.initialVector, m.epsilon, m.maxIterations)
-	case PowerMethodInverse:
-		powerResult, err = m.useCase.InversePower(ctx, matrix, m.initialVector, m.epsilon, m.maxIterations)
-	case PowerMethodFarthest:
-		// For farthest, we use the k eigenvalue as shift value
-		powerResult, err = m.useCase.FarthestEigenvaluePower(ctx, matrix, m.initialVector, m.kEigenvalue, m.epsilon, m.maxIterations)
-	case PowerMethodNearest:
-		// For nearest, we use the k eigenvalue as shift value
-		powerResult, err = m.useCase.NearestEigenvaluePower(ctx, matrix, m.initialVector, m.kEigenvalue, m.epsilon, m.maxIterations)
-	default:
-		m.result = m.Focused.ErrorMessage.Render("Unknown power method selected")
-		return
+	return matrix, nil
+}
+
+// computeResult runs the selected power method on the editor matrix.
+func (m *EigenModel) computeResult() (*EigenResult, error) {
+	matrix, err := m.validatedMatrix()
+	if err != nil {
+		return nil, err
 	}
 
+	ctx, cancel := m.requestContext()
+	defer cancel()
+
+	logger := LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "Calculating eigenvalue from the TUI",
+		slog.String("method", m.powerMethod().String()),
+		slog.String("matrix", m.matrixOptions[m.selectedMatrix]),
+	)
+
+	// The k eigenvalue is the shift of the farthest and nearest methods
+	params := usecases.PowerParams{
+		InitialGuess:  m.initialVector,
+		Shift:         m.kEigenvalue,
+		Epsilon:       m.epsilon,
+		MaxIterations: m.maxIterations,
+		Normalization: m.normalization,
+	}
+	powerResult, err := m.useCase.Solve(ctx, m.powerMethod(), matrix, params)
 	if err != nil {
-		m.result = m.Focused.ErrorMessage.Render(
-			fmt.Sprintf("Error calculating eigenvalue: %v", err))
-		return
+		logger.ErrorContext(ctx, "Failed to calculate eigenvalue", slog.Any("error", err))
+		return nil, fmt.Errorf("error calculating eigenvalue: %w", err)
 	}
 
-	// Format result
-	m.result = fmt.Sprintf(`**Eigenvalue**: %.6f
+	result := &EigenResult{
+		Method:        m.powerMethod().Describe(),
+		PowerMethod:   m.powerMethod(),
+		Params:        params,
+		Matrix:        matrix,
+		Eigenvalue:    powerResult.Eigenvalue,
+		Eigenvector:   powerResult.Eigenvector,
+		Normalization: m.normalization,
+		Iterations:    powerResult.NumIterations,
+
+		ShiftAtEigenvalue: powerResult.ShiftAtEigenvalue,
+	}
 
-**Eigenvector**: %s
+	if reference, ok := parseReference(m.referenceInput.Value()); ok {
+		estimate := NewErrorEstimate(result.Eigenvalue, reference)
+		result.Reference = &estimate
+	}
 
-**Iterations**: %d`,
-		powerResult.Eigenvalue,
-		m.formatVector(powerResult.Eigenvector),
-		powerResult.NumIterations)
+	result.Diff = diffAgainst(m.pinned, result.Eigenvalue)
+
+	if m.powerMethod() == usecases.PowerMethodRegular {
+		convergence, err := m.useCase.PredictConvergence(ctx, matrix)
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to predict the power method convergence", slog.Any("error", err))
+		}
+		result.Convergence = convergence
+	}
+
+	return result, nil
+}
+
+// buildReport writes the last result up as a LaTeX document, adding the
+// complete decomposition of the matrix when it is symmetric.
+func (m *EigenModel) buildReport() (string, error) {
+	if m.result == nil {
+		return "", ErrNoResult
+	}
+
+	ctx, cancel := m.requestContext()
+	defer cancel()
+
+	report := reports.EigenReport{
+		Matrix: m.result.Matrix,
+		Method: m.result.PowerMethod,
+		Params: m.result.Params,
+		Result: &usecases.PowerResult{
+			Eigenvalue:    m.result.Eigenvalue,
+			Eigenvector:   m.result.Eigenvector,
+			NumIterations: m.result.Iterations,
+		},
+	}
+
+	defaults := m.session.numericDefaults()
+	decomposition, err := usecases.NewSimilarityTransformationUseCase().DecomposeSymmetric(
+		ctx, m.result.Matrix, defaults.DecompositionMaxIterations, defaults.DecompositionTolerance,
+	)
+	if err != nil {
+		// Non-symmetric matrices are reported without the decomposition
+		LoggerFromContext(ctx).InfoContext(ctx, "Reporting the eigen computation without its decomposition",
+			slog.Any("error", err),
+		)
+	}
+	report.Decomposition = decomposition
+
+	return report.Document(), nil
+}
+
+// requestContext cancels any in-flight computation and builds the context for
+// a new one from the model session.
+func (m *EigenModel) requestContext() (context.Context, context.CancelFunc) {
+	m.cancelInFlight()
+
+	if m.session == nil {
+		m.session = NewSession("")
+	}
+
+	ctx, cancel := m.session.NewRequestContext(context.Background())
+	m.cancel = cancel
+
+	return ctx, cancel
+}
+
+// describeNormalization names the norm an eigenvector is scaled to.
+func describeNormalization(mode usecases.NormalizationMode) string {
+	switch mode {
+	case usecases.NormalizationL1:
+		return "L1 (absolute values sum to 1)"
+	case usecases.NormalizationMax:
+		return "max (largest element is 1)"
+	default:
+		return "L2 (unit length)"
+	}
+}
+
+// powerMethod is the method selected in the power method section.
+func (m *EigenModel) powerMethod() usecases.PowerMethod {
+	return m.powerMethods[m.selectedPowerMethod]
+}
+
+func (m *EigenModel) cancelInFlight() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
 }
 
 func (m *EigenModel) generateExplanation() {
-	methodName := []string{"regular", "inverse", "farthest", "nearest"}[m.selectedPowerMethod]
+	methodName := m.powerMethod().String()
 
 	// Fallback explanation
 	m.explanation = fmt.Sprintf(`# %s Power Method
@@ -753,7 +1348,7 @@ The %s power method for eigenvalue calculation.
 		strings.ToUpper(methodName[:1])+methodName[1:],
 		methodName,
 		m.matrixOptions[m.selectedMatrix],
-		m.powerMethodOptions[m.selectedPowerMethod],
+		m.powerMethod().Describe(),
 		m.epsilon,
 		m.maxIterations,
 		m.formatVector(m.initialVector))
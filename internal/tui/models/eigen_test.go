@@ -0,0 +1,706 @@
+package models
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+type stubPowerUseCase struct {
+	contexts    []context.Context
+	matrices    [][][]float64
+	methods     []usecases.PowerMethod
+	params      []usecases.PowerParams
+	convergence *usecases.PowerConvergence
+}
+
+func (s *stubPowerUseCase) Solve(
+	ctx context.Context, method usecases.PowerMethod, matrix [][]float64, params usecases.PowerParams,
+) (*usecases.PowerResult, error) {
+	s.contexts = append(s.contexts, ctx)
+	s.matrices = append(s.matrices, matrix)
+	s.methods = append(s.methods, method)
+	s.params = append(s.params, params)
+	return &usecases.PowerResult{
+		Eigenvalue:    7,
+		Eigenvector:   []float64{0.6, 1},
+		NumIterations: 1,
+	}, nil
+}
+
+func (s *stubPowerUseCase) PredictConvergence(
+	_ context.Context, _ [][]float64,
+) (*usecases.PowerConvergence, error) {
+	return s.convergence, nil
+}
+
+func (s *stubPowerUseCase) SweepEpsilon(
+	_ context.Context, method usecases.PowerMethod, matrix [][]float64, params usecases.PowerParams, epsilons []float64,
+) ([]usecases.EpsilonSweepPoint, error) {
+	s.matrices = append(s.matrices, matrix)
+	s.methods = append(s.methods, method)
+	s.params = append(s.params, params)
+
+	points := make([]usecases.EpsilonSweepPoint, len(epsilons))
+	for i, epsilon := range epsilons {
+		points[i] = usecases.EpsilonSweepPoint{Epsilon: epsilon, Iterations: uint64(i + 1), Eigenvalue: 7}
+	}
+	return points, nil
+}
+
+func newTestTheme() *Theme {
+	return ThemeCatppuccin(lipgloss.DefaultRenderer())
+}
+
+func TestEigenModelThreadsSessionContextIntoUseCase(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	session := NewSession("gabrigas")
+	stub := &stubPowerUseCase{}
+
+	model := NewEigenModel(newTestTheme(), session)
+	model.useCase = stub
+	model.focusedSection = EigenSectionCalculate
+
+	// Act
+	model.handleEnter()
+	model.handleEnter()
+
+	// Assert
+	require.Len(t, stub.contexts, 2)
+
+	firstRequestID := ""
+	for i, ctx := range stub.contexts {
+		sessionID, ok := SessionIDFromContext(ctx)
+		assert.True(t, ok, "Expected session ID in context")
+		assert.Equal(t, session.ID, sessionID)
+
+		requestID, ok := RequestIDFromContext(ctx)
+		assert.True(t, ok, "Expected request ID in context")
+		assert.NotEmpty(t, requestID)
+
+		assert.NotSame(t, LoggerFromContext(context.Background()), LoggerFromContext(ctx),
+			"Expected the per-session logger to be carried")
+
+		if i == 0 {
+			firstRequestID = requestID
+		} else {
+			assert.NotEqual(t, firstRequestID, requestID, "Expected a new request ID per computation")
+		}
+
+		assert.ErrorIs(t, ctx.Err(), context.Canceled, "Expected context to be cancelled after the computation")
+	}
+}
+
+func TestEigenModelUsesMatrixFromEditor(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	stub := &stubPowerUseCase{}
+
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.useCase = stub
+	model.setFocusedSection(EigenSectionMatrixEditor)
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("9")})
+	model.setFocusedSection(EigenSectionCalculate)
+	model.handleEnter()
+
+	// Assert
+	require.Len(t, stub.matrices, 1)
+	assert.Equal(t, [][]float64{{29, 3}, {5, 4}}, stub.matrices[0])
+}
+
+func TestEigenModelTypesOnlyIntoFocusedArgument(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.setFocusedSection(EigenSectionArguments)
+	model.vectorInput.Blur()
+	model.epsilonInput.Focus()
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+
+	// Assert
+	assert.Equal(t, "1e-65", model.epsilonInput.Value())
+	assert.InDelta(t, 1e-65, model.epsilon, 1e-80)
+	assert.Equal(t, "1.0,1.0", model.vectorInput.Value())
+	assert.Equal(t, []float64{1, 1}, model.initialVector)
+	assert.Equal(t, "100", model.maxIterationsInput.Value())
+	assert.Equal(t, uint64(100), model.maxIterations)
+	assert.Equal(t, "0.0", model.kEigenvalueInput.Value())
+	assert.Empty(t, model.referenceInput.Value())
+}
+
+func TestEigenModelRejectsNonSquareMatrix(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	stub := &stubPowerUseCase{}
+
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.useCase = stub
+	model.setFocusedSection(EigenSectionMatrixEditor)
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("}")})
+	model.setFocusedSection(EigenSectionCalculate)
+	model.handleEnter()
+
+	// Assert
+	assert.Empty(t, stub.matrices)
+	assert.Nil(t, model.result)
+	assert.ErrorIs(t, model.resultErr, usecases.ErrNonSquareMatrix)
+	assert.Contains(t, model.renderResult(), "matrix is not square")
+}
+
+func TestEigenModelComputeResultIsStructured(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.useCase = &stubPowerUseCase{}
+
+	// Act
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, &EigenResult{
+		Method:      "Regular Power Method",
+		PowerMethod: usecases.PowerMethodRegular,
+		Params: usecases.PowerParams{
+			InitialGuess:  []float64{1, 1},
+			Epsilon:       1e-6,
+			MaxIterations: 100,
+		},
+		Matrix:      [][]float64{{2, 3}, {5, 4}},
+		Eigenvalue:  7,
+		Eigenvector: []float64{0.6, 1},
+		Iterations:  1,
+	}, result)
+}
+
+func TestEigenModelComparesWithReference(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.useCase = &stubPowerUseCase{}
+	// The stub always converges to 7
+	model.referenceInput.SetValue("8")
+
+	// Act
+	result, err := model.computeResult()
+	model.result = result
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, &ErrorEstimate{Reference: 8, Absolute: 1, Relative: 0.125}, result.Reference)
+	assert.Contains(t, model.renderResult(), "**Relative error**: 1.25e-01")
+}
+
+func TestEigenModelExplainsConvergence(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		method           usecases.PowerMethod
+		convergence      *usecases.PowerConvergence
+		expectedRendered []string
+		unexpected       []string
+	}{
+		{
+			name:             "Fast",
+			method:           usecases.PowerMethodRegular,
+			convergence:      &usecases.PowerConvergence{SpectralRadius: 7, SubdominantModulus: 1, ConvergenceRatio: 1.0 / 7},
+			expectedRendered: []string{"**Spectral radius**: 7.000000", "|λ₂/λ₁| = 0.1429"},
+			unexpected:       []string{"Slow convergence", "Warning"},
+		},
+		{
+			name:             "Slow",
+			method:           usecases.PowerMethodRegular,
+			convergence:      &usecases.PowerConvergence{SpectralRadius: 10, SubdominantModulus: 9.9, ConvergenceRatio: 0.99},
+			expectedRendered: []string{"|λ₂/λ₁| = 0.9900", "**Slow convergence**", "about 1375 iterations"},
+		},
+		{
+			name:             "No dominant eigenvalue",
+			method:           usecases.PowerMethodRegular,
+			convergence:      &usecases.PowerConvergence{SpectralRadius: 3, SubdominantModulus: 3, ConvergenceRatio: 1},
+			expectedRendered: []string{"can't converge"},
+		},
+		{
+			name:        "Only for the regular method",
+			method:      usecases.PowerMethodInverse,
+			convergence: &usecases.PowerConvergence{SpectralRadius: 7, SubdominantModulus: 1, ConvergenceRatio: 1.0 / 7},
+			unexpected:  []string{"Spectral radius"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+			model.useCase = &stubPowerUseCase{convergence: test.convergence}
+			model.selectedPowerMethod = slices.Index(model.powerMethods, test.method)
+
+			// Act
+			result, err := model.computeResult()
+			model.result = result
+
+			// Assert
+			require.NoError(t, err)
+			rendered := model.renderResult()
+			for _, expected := range test.expectedRendered {
+				assert.Contains(t, rendered, expected)
+			}
+			for _, unexpected := range test.unexpected {
+				assert.NotContains(t, rendered, unexpected)
+			}
+		})
+	}
+}
+
+func TestEigenModelSpaceCalculates(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		section           int
+		expectCalculation bool
+	}{
+		{name: "From the method section", section: EigenSectionPowerMethodSelection, expectCalculation: true},
+		{name: "From the matrix editor", section: EigenSectionMatrixEditor, expectCalculation: true},
+		{name: "Typed into the arguments", section: EigenSectionArguments, expectCalculation: false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			stub := &stubPowerUseCase{}
+			model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+			model.useCase = stub
+			model.setFocusedSection(test.section)
+
+			// Act
+			model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+
+			// Assert
+			if test.expectCalculation {
+				assert.Len(t, stub.matrices, 1)
+				assert.Equal(t, EigenSectionCalculate, model.focusedSection)
+				assert.NotNil(t, model.result)
+			} else {
+				assert.Empty(t, stub.matrices)
+				assert.Equal(t, test.section, model.focusedSection)
+			}
+		})
+	}
+}
+
+func TestEigenModelHelpListsSpace(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	bindings := model.GetHelpKeys().FullHelp()
+
+	// Assert
+	var helps []string
+	for _, column := range bindings {
+		for _, binding := range column {
+			assert.True(t, binding.Enabled(), "binding %q should be initialized", binding.Help().Key)
+			helps = append(helps, binding.Help().Key)
+		}
+	}
+	assert.Contains(t, helps, "space")
+}
+
+func TestEigenModelNotesShiftAtEigenvalue(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedPowerMethod = slices.Index(model.powerMethods, usecases.PowerMethodNearest)
+	model.kEigenvalue = 7
+
+	// Act
+	model.generateResult()
+
+	// Assert
+	require.NoError(t, model.resultErr)
+	assert.True(t, model.result.ShiftAtEigenvalue)
+	assert.InDelta(t, 7, model.result.Eigenvalue, 1e-6)
+	assert.Contains(t, model.renderResult(), "k is an eigenvalue")
+}
+
+func TestEigenModelDispatchesSelectedMethod(t *testing.T) {
+	t.Parallel()
+
+	for _, method := range usecases.PowerMethods() {
+		t.Run(method.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			stub := &stubPowerUseCase{}
+			model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+			model.useCase = stub
+			model.kEigenvalue = 4
+			model.setFocusedSection(EigenSectionPowerMethodSelection)
+			for model.powerMethod() != method {
+				model.handleDown()
+			}
+
+			// Act
+			result, err := model.computeResult()
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, method.Describe(), result.Method)
+			require.Len(t, stub.methods, 1)
+			assert.Equal(t, method, stub.methods[0])
+			assert.Equal(t, 4.0, stub.params[0].Shift)
+			assert.Contains(t, model.View(), method.Describe())
+		})
+	}
+}
+
+func TestEigenModelResizesDefaultVectorWithMatrix(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.setFocusedSection(EigenSectionMatrixSelection)
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.generateResult()
+
+	// Assert
+	assert.Equal(t, "3x3 Simple Matrix", model.matrixOptions[model.selectedMatrix])
+	assert.Equal(t, []float64{1, 1, 1}, model.initialVector)
+	assert.Equal(t, "1.0,1.0,1.0", model.vectorInput.Value())
+	require.NoError(t, model.resultErr)
+	assert.InDelta(t, 2+math.Sqrt2, model.result.Eigenvalue, 1e-4)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, []float64{1, 1}, model.initialVector)
+}
+
+func TestEigenModelKeepsTypedVectorWhenChangingMatrix(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.setFocusedSection(EigenSectionArguments)
+	model.vectorInput.Focus()
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("5")})
+	model.setFocusedSection(EigenSectionMatrixSelection)
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	// Assert
+	assert.Equal(t, []float64{1, 1.5}, model.initialVector)
+	assert.Equal(t, "1.0,1.5", model.vectorInput.Value())
+}
+
+func TestEigenModelShowsMatrixShape(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		matrix   int
+		expected string
+	}{
+		{name: "Not symmetric", matrix: 0, expected: "2×2, not symmetric"},
+		{name: "Symmetric", matrix: 1, expected: "3×3, symmetric"},
+		{name: "Larger", matrix: 3, expected: "5×5, symmetric"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+
+			// Act
+			model.selectMatrix(test.matrix)
+
+			// Assert
+			assert.Contains(t, model.renderSectionNavigation(), test.expected)
+		})
+	}
+}
+
+func TestEigenModelSweepsEpsilon(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	stub := &stubPowerUseCase{}
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.useCase = stub
+	model.maxIterationsInput.SetValue("250")
+	model.maxIterations = 250
+
+	// Act
+	model.Update(runes("w"))
+
+	// Assert
+	require.NoError(t, model.sweepErr)
+	require.Len(t, model.sweep, EpsilonSweepDecades)
+	assert.InDelta(t, 1e-1, model.sweep[0].Epsilon, 1e-15)
+	assert.InDelta(t, 1e-12, model.sweep[EpsilonSweepDecades-1].Epsilon, 1e-24)
+	require.Len(t, stub.params, 1)
+	assert.Equal(t, uint64(250), stub.params[0].MaxIterations)
+	assert.Contains(t, model.renderSweep(), "| 1e-12 | 12 | 7 |")
+
+	// Act
+	model.Update(runes("w"))
+
+	// Assert
+	assert.False(t, model.showSweep)
+	assert.NotContains(t, model.renderSectionContent(), "Epsilon Sweep")
+}
+
+func TestEigenModelSweepRejectsInvalidArguments(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	stub := &stubPowerUseCase{}
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.useCase = stub
+	model.initialVector = []float64{1, 1, 1}
+
+	// Act
+	model.Update(runes("w"))
+
+	// Assert
+	require.ErrorIs(t, model.sweepErr, ErrInitialVectorDimension)
+	assert.Empty(t, stub.params)
+	assert.Contains(t, model.renderSweep(), ErrInitialVectorDimension.Error())
+}
+
+func TestEigenModelTransformsKeepTheSpectrumRelated(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name       string
+		key        string
+		expected   [][]float64
+		eigenvalue float64
+	}{
+		{name: "Transpose keeps the eigenvalue", key: "t", expected: [][]float64{{2, 5}, {3, 4}}, eigenvalue: 7},
+		{name: "Scale multiplies the eigenvalue", key: "*", expected: [][]float64{{4, 6}, {10, 8}}, eigenvalue: 14},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+			model.maxIterations = 1000
+			model.epsilon = 1e-12
+			model.generateResult()
+			require.NoError(t, model.resultErr)
+			require.InDelta(t, 7, model.result.Eigenvalue, 1e-9)
+			model.setFocusedSection(EigenSectionMatrixSelection)
+
+			// Act
+			model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(test.key)})
+
+			// Assert
+			matrix, err := model.matrixEditor.Matrix()
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, matrix)
+			require.NoError(t, model.resultErr)
+			assert.Equal(t, test.expected, model.result.Matrix)
+			assert.InDelta(t, test.eigenvalue, model.result.Eigenvalue, 1e-9)
+		})
+	}
+}
+
+func TestEigenModelCyclesTheNormalization(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name      string
+		presses   int
+		expected  usecases.NormalizationMode
+		checkNorm func(t *testing.T, eigenvector []float64)
+	}{
+		{
+			name:     "L1",
+			presses:  1,
+			expected: usecases.NormalizationL1,
+			checkNorm: func(t *testing.T, eigenvector []float64) {
+				sum := 0.0
+				for _, value := range eigenvector {
+					sum += math.Abs(value)
+				}
+				assert.InDelta(t, 1, sum, 1e-9)
+			},
+		},
+		{
+			name:     "Max",
+			presses:  2,
+			expected: usecases.NormalizationMax,
+			checkNorm: func(t *testing.T, eigenvector []float64) {
+				assert.InDelta(t, 1, slices.Max(eigenvector), 1e-9)
+			},
+		},
+		{
+			name:     "BackToL2",
+			presses:  3,
+			expected: usecases.NormalizationL2,
+			checkNorm: func(t *testing.T, eigenvector []float64) {
+				assert.InDelta(t, 1, math.Hypot(eigenvector[0], eigenvector[1]), 1e-9)
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+			model.maxIterations = 1000
+			model.epsilon = 1e-12
+			model.generateResult()
+			require.NoError(t, model.resultErr)
+
+			// Act
+			for range test.presses {
+				model.Update(runes("n"))
+			}
+
+			// Assert
+			assert.Equal(t, test.expected, model.normalization)
+			require.NoError(t, model.resultErr)
+			assert.Equal(t, test.expected, model.result.Normalization)
+			test.checkNorm(t, model.result.Eigenvector)
+			assert.Contains(t, model.renderResult(), describeNormalization(test.expected))
+		})
+	}
+}
+
+func TestEigenModelCyclesTheArithmetic(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		presses  int
+		expected precision.Arithmetic
+	}{
+		{name: "BigFloat", presses: 1, expected: precision.BigFloat},
+		{name: "BackToFloat64", presses: 2, expected: precision.Float64},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+			model.maxIterations = 1000
+			model.epsilon = 1e-12
+			model.generateResult()
+			require.NoError(t, model.resultErr)
+
+			// Act
+			for range test.presses {
+				model.Update(runes("a"))
+			}
+
+			// Assert
+			assert.Equal(t, test.expected, model.arithmetic)
+			powerUseCase, ok := model.useCase.(*usecases.PowerUseCase)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, powerUseCase.Arithmetic())
+			require.NoError(t, model.resultErr)
+			assert.InDelta(t, 7, model.result.Eigenvalue, 1e-9)
+		})
+	}
+}
+
+func TestEigenModelTransformsIgnoreTypedArguments(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.setFocusedSection(EigenSectionArguments)
+	model.handleDown()
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+
+	// Assert
+	matrix, err := model.matrixEditor.Matrix()
+	require.NoError(t, err)
+	assert.Equal(t, [][]float64{{2, 3}, {5, 4}}, matrix)
+}
+
+func TestEigenModelEigenvaluesLieInTheGershgorinDiscs(t *testing.T) {
+	t.Parallel()
+
+	for i, matrix := range builtinMatrices {
+		for _, method := range usecases.PowerMethods() {
+			t.Run(matrix.Name+"/"+method.String(), func(t *testing.T) {
+				// Arrange
+				t.Parallel()
+				model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+				model.selectMatrix(i)
+				model.setFocusedSection(EigenSectionPowerMethodSelection)
+				for model.powerMethod() != method {
+					model.handleDown()
+				}
+				discs, err := usecases.GershgorinDiscs(matrix.Values)
+				require.NoError(t, err)
+
+				// Act
+				result, err := model.computeResult()
+
+				// Assert
+				require.NoError(t, err)
+				// The eigenvalue is only accurate to epsilon, and -1 of the 2x2
+				// matrix sits right on a border
+				contained := slices.ContainsFunc(discs, func(disc usecases.GershgorinDisc) bool {
+					disc.Radius += 10 * model.epsilon
+					return disc.Contains(complex(result.Eigenvalue, 0))
+				})
+				assert.True(t, contained, "eigenvalue %v is outside every disc", result.Eigenvalue)
+			})
+		}
+	}
+}
+
+func TestEigenModelShowsTheGershgorinDiscs(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectMatrix(1)
+
+	// Act
+	discs := model.renderGershgorinDiscs()
+
+	// Assert
+	assert.Contains(t, discs, "**Row 1**: center 2.000, radius 1.000")
+	assert.Contains(t, discs, "**Row 2**: center 2.000, radius 2.000")
+	assert.Contains(t, discs, "[0.000, 4.000]")
+	model.setFocusedSection(EigenSectionMatrixSelection)
+	content := ansi.Strip(model.renderSectionContent())
+	assert.Contains(t, content, "Gershgorin Discs")
+	assert.Contains(t, content, "Row 3: center 2.000, radius 1.000")
+}
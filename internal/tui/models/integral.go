@@ -1,14 +1,165 @@
 package models
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/taldoflemis/nume/internal/explanations"
+	newtoncotes "github.com/taldoflemis/nume/internal/usecases/newton_cotes"
+)
+
+var (
+	ErrUnknownIntegralMode = errors.New("unknown integral mode selected")
+	ErrZeroPartitions      = errors.New("number of partitions must be greater than zero")
 )
 
+// maxFixedPartitions caps the uniform grid of the fixed mode, zero standing
+// for MaxIntegralPartitions
+var maxFixedPartitions atomic.Uint64
+
+// SetMaxFixedPartitions caps the uniform grid of the fixed integration mode,
+// partitions above it being integrated adaptively within the cap, like the
+// API does. The TUI integrates while handling the key press, so a zero
+// maxPartitions keeps the MaxIntegralPartitions cap instead of removing it.
+func SetMaxFixedPartitions(maxPartitions uint64) {
+	maxFixedPartitions.Store(maxPartitions)
+}
+
+func fixedPartitionsCap() uint64 {
+	if maxPartitions := maxFixedPartitions.Load(); maxPartitions > 0 {
+		return maxPartitions
+	}
+
+	return MaxIntegralPartitions
+}
+
+// IntegralResult is the outcome of an integral computation, kept apart from
+// its rendering so it can be exported or reused.
+type IntegralResult struct {
+	Function   string
+	Mode       string
+	Left       float64
+	Right      float64
+	Area       float64
+	Partitions uint64
+	// Method is how the fixed mode integrated, adaptively when the requested
+	// partitions exceed the cap, and Requested the partitions asked for
+	Method    newtoncotes.IntegrationMethod
+	Requested uint64
+	// ErrorOrder is p in the O(hᵖ) error of the formula
+	ErrorOrder int
+	// ErrorEstimate is the Richardson estimate of the absolute error, only
+	// set in the accurate mode. Converged reports whether it reached the
+	// tolerance within the partition budget
+	ErrorEstimate float64
+	Converged     bool
+	// Exact is the integral from the antiderivative and AbsoluteError the
+	// distance to Area
+	Exact         float64
+	AbsoluteError float64
+	// Contributions are the areas of the partitions, from left to right, only
+	// kept in the fixed mode with at most MaxPreviewPartitions
+	Contributions []newtoncotes.PartitionArea
+	// Ladder is the trapezoidal error against Exact on 1, 2, 4, ...
+	// partitions down to the tolerance, only set in the accurate mode
+	Ladder *newtoncotes.PrecisionLadder
+	// Reference compares Area with the reference the user supplied, nil when
+	// none was given
+	Reference *ErrorEstimate
+	// Diff compares Area with the pinned result, nil when none is pinned
+	Diff *ResultDiff
+}
+
+// integrand is a function offered by the integral tab, with an
+// antiderivative to measure the actual error of the approximation.
+type integrand struct {
+	name           string
+	formula        string
+	f              func(float64) float64
+	antiderivative func(float64) float64
+}
+
+var integrands = []integrand{
+	{
+		name:    "Polynomial",
+		formula: "f(x) = x^4 - 2x² + 5x - 1",
+		f: func(x float64) float64 {
+			return math.Pow(x, PolynomialPower) - 2*x*x + 5*x - 1
+		},
+		antiderivative: func(x float64) float64 {
+			return math.Pow(x, PolynomialPower+1)/(PolynomialPower+1) - 2*x*x*x/3 + 5*x*x/2 - x
+		},
+	},
+	{
+		name:           "Exponential",
+		formula:        "f(x) = e^3x",
+		f:              func(x float64) float64 { return math.Exp(ExponentialMultiple * x) },
+		antiderivative: func(x float64) float64 { return math.Exp(ExponentialMultiple*x) / ExponentialMultiple },
+	},
+	{
+		name:           "Trigonometric",
+		formula:        "f(x) = sin(2x)",
+		f:              func(x float64) float64 { return math.Sin(TrigMultiple * x) },
+		antiderivative: func(x float64) float64 { return -math.Cos(TrigMultiple*x) / TrigMultiple },
+	},
+	{
+		name:           "Hyperbolic",
+		formula:        "f(x) = cosh(x)",
+		f:              math.Cosh,
+		antiderivative: math.Sinh,
+	},
+}
+
 type IntegralModel struct {
-	// Placeholder for future integral functionality
+	// Current focus section (0-3)
+	focusedSection int
+
+	// Section 1: Function Selection
+	selectedFunction int
+
+	// Section 2: Mode, accurate or fixed partitions
+	modeOptions  []string
+	selectedMode int
+
+	// Section 3: Arguments
+	leftInput       textinput.Model
+	rightInput      textinput.Model
+	toleranceInput  textinput.Model
+	partitionsInput textinput.Model
+	referenceInput  textinput.Model
+	left            float64
+	right           float64
+	tolerance       float64
+	partitions      uint64
+
+	// Calculation results
+	result    *IntegralResult
+	resultErr error
+
+	// Result the following ones are compared against, nil when none is
+	// pinned
+	pinned *PinnedResult
+
+	// Session and cancellation of the in-flight computation
+	session *Session
+	cancel  context.CancelFunc
+
+	// Styling
+	layout   columnLayout
+	renderer *glamour.TermRenderer
+	*Theme
 }
 
 var integralKeys = derivativeKeyMap{
@@ -28,6 +179,38 @@ var integralKeys = derivativeKeyMap{
 		key.WithKeys("i"),
 		key.WithHelp("i", "integrals tab"),
 	),
+	CycleNextSection: key.NewBinding(
+		key.WithKeys("tab"),
+		key.WithHelp("tab", "cycle to next section"),
+	),
+	CyclePrevSection: key.NewBinding(
+		key.WithKeys("shift+tab"),
+		key.WithHelp("shift+tab", "cycle to previous section"),
+	),
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Enter: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "select/confirm"),
+	),
+	Space: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "calculate"),
+	),
+	Pin: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "pin result for comparison"),
+	),
+	Reset: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "reset"),
+	),
 }
 
 // GetHelpKeys implements NumeTabContent.
@@ -35,39 +218,613 @@ func (*IntegralModel) GetHelpKeys() help.KeyMap {
 	return integralKeys
 }
 
-var _ (NumeTabContent) = (*DerivativeModel)(nil)
+var _ (NumeTabContent) = (*IntegralModel)(nil)
+
+// integralSections name the sections whose explanation does not depend on the
+// selection, looked up in the explanations package
+var integralSections = map[int]explanations.Section{
+	IntegralSectionMode:      explanations.SectionMode,
+	IntegralSectionArguments: explanations.SectionArguments,
+}
+
+func NewIntegralModel(theme *Theme, session *Session) *IntegralModel {
+	layout := defaultColumnLayout()
+	defaults := session.numericDefaults()
+
+	newInput := func(value string, validate textinput.ValidateFunc) textinput.Model {
+		input := textinput.New()
+		input.Placeholder = value
+		input.CharLimit = 20
+		input.Validate = validate
+		input.SetValue(value)
+		return input
+	}
+
+	leftInput := newInput("0", validateNumber)
+	leftInput.Focus()
 
-func NewIntegralModel() *IntegralModel {
-	return &IntegralModel{}
+	// Create reference input, left empty until the user knows the exact value
+	referenceInput := textinput.New()
+	referenceInput.Placeholder = "optional"
+	referenceInput.CharLimit = 30
+
+	return &IntegralModel{
+		focusedSection: IntegralSectionFunctionSelection,
+		modeOptions: []string{
+			"Accurate (trapezoidal + Richardson)",
+			"Fixed partitions (trapezoidal)",
+		},
+		selectedMode:    IntegralModeAccurate,
+		leftInput:       leftInput,
+		rightInput:      newInput("1", validateNumber),
+		toleranceInput:  newInput(FormatNumber(defaults.IntegralTolerance), validateNumber),
+		partitionsInput: newInput(strconv.FormatUint(defaults.Partitions, 10), validateCount),
+		referenceInput:  referenceInput,
+		left:            DefaultIntegralLeft,
+		right:           DefaultIntegralRight,
+		tolerance:       defaults.IntegralTolerance,
+		partitions:      defaults.Partitions,
+		session:         session,
+		layout:          layout,
+		renderer:        layout.markdownRenderer(),
+		Theme:           theme,
+	}
 }
 
 func (*IntegralModel) Init() tea.Cmd {
 	return nil
 }
 
-func (*IntegralModel) Update(tea.Msg) (tea.Model, tea.Cmd) {
-	return &IntegralModel{}, nil
+func (m *IntegralModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.resize(size.Width)
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, integralKeys.CycleNextSection):
+		m.focusedSection = (m.focusedSection + 1) % IntegralSectionCount
+		return m, nil
+	case key.Matches(keyMsg, integralKeys.CyclePrevSection):
+		m.focusedSection = (m.focusedSection - 1 + IntegralSectionCount) % IntegralSectionCount
+		return m, nil
+	case keyMsg.Type == tea.KeyRunes && m.capturesText():
+		// Typed characters go to the inputs ahead of the bindings, so the k
+		// of 10k doesn't move the focus
+		return m, m.updateFocusedArgument(keyMsg)
+	case key.Matches(keyMsg, integralKeys.Up):
+		return m.handleStep(-1), nil
+	case key.Matches(keyMsg, integralKeys.Down):
+		return m.handleStep(1), nil
+	case key.Matches(keyMsg, integralKeys.Enter):
+		if m.focusedSection == IntegralSectionCalculate {
+			m.generateResult()
+		}
+		return m, nil
+	case key.Matches(keyMsg, integralKeys.Space) && m.focusedSection != IntegralSectionArguments:
+		m.focusedSection = IntegralSectionCalculate
+		m.generateResult()
+		return m, nil
+	case key.Matches(keyMsg, integralKeys.Pin) && m.focusedSection != IntegralSectionArguments:
+		m.pinResult()
+		return m, nil
+	case key.Matches(keyMsg, integralKeys.Reset) && m.focusedSection != IntegralSectionArguments:
+		m.cancelInFlight()
+		return m.reset(), nil
+	}
+
+	if m.focusedSection == IntegralSectionArguments {
+		return m, m.updateFocusedArgument(keyMsg)
+	}
+
+	return m, nil
+}
+
+// capturesText reports whether a text input is focused, which then takes
+// the typed characters ahead of the tab and global keys.
+func (m *IntegralModel) capturesText() bool {
+	if m.focusedSection != IntegralSectionArguments {
+		return false
+	}
+	for _, input := range m.arguments() {
+		if input.Focused() {
+			return true
+		}
+	}
+	return false
+}
+
+// handleStep moves the selection of the focused section step options away,
+// wrapping around at both ends.
+func (m *IntegralModel) handleStep(step int) *IntegralModel {
+	switch m.focusedSection {
+	case IntegralSectionFunctionSelection:
+		m.selectedFunction = (m.selectedFunction + step + len(integrands)) % len(integrands)
+	case IntegralSectionMode:
+		m.selectedMode = (m.selectedMode + step + len(m.modeOptions)) % len(m.modeOptions)
+	case IntegralSectionArguments:
+		m.moveArgumentFocus(step)
+	}
+	return m
+}
+
+// arguments returns the inputs shown in the arguments section, the tolerance
+// in the accurate mode and the partitions in the fixed one.
+func (m *IntegralModel) arguments() []*textinput.Model {
+	if m.selectedMode == IntegralModeFixed {
+		return []*textinput.Model{&m.leftInput, &m.rightInput, &m.partitionsInput, &m.referenceInput}
+	}
+	return []*textinput.Model{&m.leftInput, &m.rightInput, &m.toleranceInput, &m.referenceInput}
+}
+
+// moveArgumentFocus moves the focus step inputs away in the arguments
+// section, wrapping around at both ends.
+func (m *IntegralModel) moveArgumentFocus(step int) {
+	inputs := m.arguments()
+
+	current := 0
+	for i, input := range inputs {
+		if input.Focused() {
+			current = i
+		}
+	}
+	for _, input := range []*textinput.Model{
+		&m.leftInput, &m.rightInput, &m.toleranceInput, &m.partitionsInput, &m.referenceInput,
+	} {
+		input.Blur()
+	}
+
+	inputs[(current+step+len(inputs))%len(inputs)].Focus()
+}
+
+// updateFocusedArgument sends keyMsg to the focused input only, parsing its
+// value when it is a valid number.
+func (m *IntegralModel) updateFocusedArgument(keyMsg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch {
+	case m.leftInput.Focused():
+		m.leftInput, cmd = m.leftInput.Update(keyMsg)
+		if val, err := ParseNumber(m.leftInput.Value()); err == nil {
+			m.left = val
+		}
+	case m.rightInput.Focused():
+		m.rightInput, cmd = m.rightInput.Update(keyMsg)
+		if val, err := ParseNumber(m.rightInput.Value()); err == nil {
+			m.right = val
+		}
+	case m.toleranceInput.Focused():
+		m.toleranceInput, cmd = m.toleranceInput.Update(keyMsg)
+		if val, err := ParseNumber(m.toleranceInput.Value()); err == nil {
+			m.tolerance = val
+		}
+	case m.partitionsInput.Focused():
+		m.partitionsInput, cmd = m.partitionsInput.Update(keyMsg)
+		if val, err := ParseCount(m.partitionsInput.Value()); err == nil {
+			m.partitions = val
+		}
+	case m.referenceInput.Focused():
+		m.referenceInput, cmd = m.referenceInput.Update(keyMsg)
+	}
+
+	return cmd
+}
+
+// resize lays the tab out for a window windowWidth columns wide.
+func (m *IntegralModel) resize(windowWidth int) {
+	m.layout = newColumnLayout(windowWidth)
+	m.renderer = m.layout.markdownRenderer()
+}
+
+// reset returns a new model in the layout of this one, as the window size is
+// only sent again when it changes.
+func (m *IntegralModel) reset() *IntegralModel {
+	reset := NewIntegralModel(m.Theme, m.session)
+	reset.layout = m.layout
+	reset.renderer = m.renderer
+
+	return reset
+}
+
+func (m *IntegralModel) View() string {
+	return m.layout.render(m.Renderer, m.renderSectionNavigation(), m.renderSectionContent())
+}
+
+func (m *IntegralModel) renderSectionNavigation() string {
+	var sections []string
+
+	sectionNames := []string{
+		"Function Selection",
+		"Mode",
+		"Arguments",
+		"Calculate",
+	}
+
+	for i, name := range sectionNames {
+		var style lipgloss.Style
+		if i == m.focusedSection {
+			style = m.Renderer.NewStyle().
+				Foreground(m.Focused.Title.GetForeground()).
+				Bold(true)
+		} else {
+			style = m.Renderer.NewStyle().
+				Foreground(lipgloss.Color("#666666"))
+		}
+
+		sections = append(sections, style.Render(fmt.Sprintf("~ %s ~", name)))
+
+		switch i {
+		case IntegralSectionFunctionSelection:
+			for j, function := range integrands {
+				style := m.Blurred.UnselectedPrefix
+				if j == m.selectedFunction {
+					style = m.Focused.SelectedPrefix
+				}
+				sections = append(sections, style.Render(function.name))
+			}
+		case IntegralSectionMode:
+			for j, mode := range m.modeOptions {
+				style := m.Blurred.UnselectedPrefix
+				if j == m.selectedMode {
+					style = m.Focused.SelectedPrefix
+				}
+				sections = append(sections, style.Render(mode))
+			}
+		case IntegralSectionArguments:
+			sections = append(sections, fmt.Sprintf("  Left: %s", m.renderValidatedInput(m.leftInput)))
+			sections = append(sections, fmt.Sprintf("  Right: %s", m.renderValidatedInput(m.rightInput)))
+			if m.selectedMode == IntegralModeFixed {
+				sections = append(sections, fmt.Sprintf("  Partitions: %s", m.renderValidatedInput(m.partitionsInput)))
+			} else {
+				sections = append(sections, fmt.Sprintf("  Tolerance: %s", m.renderValidatedInput(m.toleranceInput)))
+			}
+			sections = append(sections, fmt.Sprintf("  Reference: %s", m.referenceInput.View()))
+		case IntegralSectionCalculate:
+			buttonStyle := m.Focused.BlurredButton
+			if i == m.focusedSection {
+				buttonStyle = m.Focused.FocusedButton
+			}
+			sections = append(sections, fmt.Sprintf("  %s", buttonStyle.Render(" CALCULATE ")))
+		}
+		sections = append(sections, "") // Add spacing
+	}
+
+	return strings.Join(sections, "\n")
+}
+
+func (m *IntegralModel) renderSectionContent() string {
+	var explanation explanations.Explanation
+
+	switch m.focusedSection {
+	case IntegralSectionFunctionSelection:
+		explanation = explanations.Explanation{
+			Title:           "Function Selection",
+			Overview:        "Choose the function to integrate.",
+			ParametersTitle: "Available Functions",
+			Tips:            []string{"Use ↑/↓ arrows to select a function."},
+		}
+		for _, function := range integrands {
+			explanation.Parameters = append(explanation.Parameters, explanations.Parameter{
+				Name:        function.name,
+				Description: function.formula,
+			})
+		}
+	case IntegralSectionCalculate:
+		function := integrands[m.selectedFunction]
+		explanation = explanations.Explanation{
+			Title:           "Calculate",
+			ParametersTitle: "Current Configuration",
+			Parameters: []explanations.Parameter{
+				{Name: "Function", Description: function.formula},
+				{Name: "Mode", Description: m.modeOptions[m.selectedMode]},
+				{Name: "Interval", Description: fmt.Sprintf("[%g, %g]", m.left, m.right)},
+			},
+			Tips: []string{"Press **Enter** on the Calculate button to run the calculation."},
+		}
+
+		if m.selectedMode == IntegralModeFixed {
+			explanation.Parameters = append(explanation.Parameters, explanations.Parameter{
+				Name: "Partitions", Description: fmt.Sprintf("%d", m.partitions),
+			})
+		} else {
+			explanation.Parameters = append(explanation.Parameters, explanations.Parameter{
+				Name: "Tolerance", Description: fmt.Sprintf("%.2e", m.tolerance),
+			})
+		}
+	default:
+		explanation, _ = explanations.Lookup(explanations.TabIntegral, integralSections[m.focusedSection], m.session.numericProfile())
+	}
+
+	content := explanation.Markdown()
+
+	if m.focusedSection == IntegralSectionCalculate {
+		if result := m.renderResult(); result != "" {
+			content += "\n# Result\n\n" + result
+		}
+	}
+
+	if rendered, err := m.renderer.Render(content); err == nil {
+		return rendered
+	}
+	return content
+}
+
+func (m *IntegralModel) renderResult() string {
+	if m.resultErr != nil {
+		return m.Focused.ErrorMessage.Render(
+			fmt.Sprintf("Error calculating integral: %v", m.resultErr),
+		)
+	}
+
+	if m.result == nil {
+		return ""
+	}
+
+	rendered := fmt.Sprintf(`- **Area**: %.10f
+- **Partitions**: %d
+- **Error order**: O(h%s)`, m.result.Area, m.result.Partitions, superscript(m.result.ErrorOrder))
+
+	if m.selectedMode == IntegralModeAccurate {
+		rendered += fmt.Sprintf("\n- **Estimated error**: %.2e", m.result.ErrorEstimate)
+	}
+
+	switch m.result.Method {
+	case newtoncotes.UniformIntegration:
+		rendered += "\n- **Method**: uniform grid"
+	case newtoncotes.AdaptiveIntegration:
+		rendered += fmt.Sprintf("\n- **Method**: adaptive, the %d partitions requested exceed the cap of %d",
+			m.result.Requested, fixedPartitionsCap())
+	}
+
+	rendered += fmt.Sprintf(`
+- **Exact value**: %.10f
+- **Actual error**: %.2e`, m.result.Exact, m.result.AbsoluteError)
+
+	if m.result.Reference != nil {
+		rendered += "\n" + m.result.Reference.render()
+	}
+
+	if m.result.Diff != nil {
+		rendered += "\n" + m.result.Diff.render()
+	}
+
+	if m.result.Ladder != nil {
+		rendered += "\n\n" + renderPrecisionLadder(m.result.Ladder)
+	}
+
+	if len(m.result.Contributions) > 0 {
+		rendered += "\n\n" + renderPartitionPreview(m.result.Contributions, m.result.Area)
+	}
+
+	if m.selectedMode == IntegralModeAccurate && !m.result.Converged {
+		rendered += fmt.Sprintf(`
+
+> **Warning**: the tolerance was not reached within %d partitions, the estimated error is %.2e`,
+			m.result.Partitions, m.result.ErrorEstimate,
+		)
+	}
+
+	return rendered
+}
+
+// renderPrecisionLadder shows the actual error shrinking as the partitions
+// double, down to the tolerance.
+func renderPrecisionLadder(ladder *newtoncotes.PrecisionLadder) string {
+	rendered := "**Precision ladder**\n\n" + ladder.Markdown()
+	if !ladder.Reached {
+		rendered += fmt.Sprintf("\n> The actual error did not reach %.2e within the partition budget", ladder.Tolerance)
+	}
+
+	return rendered
+}
+
+// groupPartitions merges consecutive partitions into at most rows groups of
+// about the same size, each spanning its partitions and summing their areas.
+func groupPartitions(partitions []newtoncotes.PartitionArea, rows int) []newtoncotes.PartitionArea {
+	if len(partitions) <= rows {
+		return partitions
+	}
+
+	groups := make([]newtoncotes.PartitionArea, rows)
+	for i := range groups {
+		first, last := i*len(partitions)/rows, (i+1)*len(partitions)/rows-1
+		groups[i] = newtoncotes.PartitionArea{Left: partitions[first].Left, Right: partitions[last].Right}
+		for _, partition := range partitions[first : last+1] {
+			groups[i].Area += partition.Area
+		}
+	}
+
+	return groups
+}
+
+// renderPartitionPreview shows where the area lies as a table, a bar per
+// group of partitions scaled to the largest one.
+func renderPartitionPreview(partitions []newtoncotes.PartitionArea, total float64) string {
+	groups := groupPartitions(partitions, PartitionPreviewRows)
+
+	largest := 0.0
+	for _, group := range groups {
+		largest = math.Max(largest, math.Abs(group.Area))
+	}
+
+	var b strings.Builder
+	b.WriteString("**Partitions**\n\n")
+	b.WriteString("| Interval | Area | Share | |\n|---|---|---|---|\n")
+	for _, group := range groups {
+		bar := 0
+		if largest > 0 {
+			bar = int(math.Round(PartitionPreviewBarWidth * math.Abs(group.Area) / largest))
+		}
+
+		share := "-"
+		if total != 0 {
+			share = fmt.Sprintf("%.1f%%", 100*group.Area/total)
+		}
+
+		fmt.Fprintf(&b, "| [%.4g, %.4g] | %.6g | %s | %s |\n",
+			group.Left, group.Right, group.Area, share, strings.Repeat("█", bar))
+	}
+
+	return b.String()
+}
+
+func (m *IntegralModel) generateResult() {
+	m.result, m.resultErr = m.computeResult()
 }
 
-func (_ *IntegralModel) View() string {
-	style := lipgloss.NewStyle().
-		Padding(ComponentPadding).
-		Width(GlamourRenderWidth)
+// pinResult pins the current result for the following ones to be compared
+// against, unpinning when there is no result.
+func (m *IntegralModel) pinResult() {
+	if m.result == nil {
+		m.pinned = nil
+		return
+	}
+
+	m.pinned = &PinnedResult{
+		Label: fmt.Sprintf("%s on [%g, %g], %d partitions", m.result.Function,
+			m.result.Left, m.result.Right, m.result.Partitions),
+		Value: m.result.Area,
+	}
+	m.result.Diff = diffAgainst(m.pinned, m.result.Area)
+}
+
+// computeResult integrates the selected function over the interval with the
+// selected mode.
+func (m *IntegralModel) computeResult() (*IntegralResult, error) {
+	inputs := []labeledInput{{"left", m.leftInput}, {"right", m.rightInput}, {"tolerance", m.toleranceInput}}
+	if m.selectedMode == IntegralModeFixed {
+		inputs[2] = labeledInput{"partitions", m.partitionsInput}
+	}
+	if err := inputsError(inputs...); err != nil {
+		return nil, err
+	}
+
+	function := integrands[m.selectedFunction]
 
-	content := `
-🚧 Integral Calculations
+	ctx, cancel := m.requestContext()
+	defer cancel()
 
-This section is under development.
+	logger := LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "Calculating integral from the TUI",
+		slog.String("function", function.name),
+		slog.String("mode", m.modeOptions[m.selectedMode]),
+		slog.Float64("left", m.left),
+		slog.Float64("right", m.right),
+	)
+
+	result := &IntegralResult{
+		Function:   function.name,
+		Mode:       m.modeOptions[m.selectedMode],
+		Left:       m.left,
+		Right:      m.right,
+		ErrorOrder: (&newtoncotes.TrapezoidalRule{}).ErrorOrder(),
+	}
+
+	switch m.selectedMode {
+	case IntegralModeAccurate:
+		accurate, err := newtoncotes.RichardsonTrapezoidal(ctx, function.f, m.left, m.right, m.tolerance, MaxIntegralPartitions)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to calculate integral", slog.Any("error", err))
+			return nil, err
+		}
+		result.Area = accurate.Area
+		result.Partitions = accurate.Partitions
+		result.ErrorEstimate = accurate.ErrorEstimate
+		result.Converged = accurate.Converged
+	case IntegralModeFixed:
+		if m.partitions == 0 {
+			return nil, ErrZeroPartitions
+		}
+		useCase := newtoncotes.NewNewtonCotesUseCase(&newtoncotes.TrapezoidalRule{})
+		if m.partitions <= min(MaxPreviewPartitions, fixedPartitionsCap()) {
+			contributions, err := useCase.CalculatePartitions(ctx, function.f, m.left, m.right, m.partitions)
+			if err != nil {
+				logger.ErrorContext(ctx, "Failed to calculate integral", slog.Any("error", err))
+				return nil, err
+			}
+			for _, contribution := range contributions {
+				result.Area += contribution.Area
+			}
+			result.Contributions = contributions
+			result.Partitions = m.partitions
+			result.Method = newtoncotes.UniformIntegration
+		} else {
+			capped, err := useCase.CalculateCapped(ctx, function.f, m.left, m.right, m.partitions, fixedPartitionsCap())
+			if err != nil {
+				logger.ErrorContext(ctx, "Failed to calculate integral", slog.Any("error", err))
+				return nil, err
+			}
+			result.Area = capped.Area
+			result.Partitions = capped.Partitions
+			result.Method = capped.Method
+		}
+		result.Requested = m.partitions
+	default:
+		return nil, ErrUnknownIntegralMode
+	}
+
+	result.Exact = function.antiderivative(m.right) - function.antiderivative(m.left)
+	result.AbsoluteError = math.Abs(result.Area - result.Exact)
+
+	if m.selectedMode == IntegralModeAccurate {
+		useCase := newtoncotes.NewNewtonCotesUseCase(&newtoncotes.TrapezoidalRule{})
+		ladder, err := useCase.PrecisionLadder(ctx, function.f, m.left, m.right, result.Exact, m.tolerance, MaxIntegralPartitions)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to build the precision ladder", slog.Any("error", err))
+			return nil, err
+		}
+		result.Ladder = ladder
+	}
+
+	if reference, ok := parseReference(m.referenceInput.Value()); ok {
+		estimate := NewErrorEstimate(result.Area, reference)
+		result.Reference = &estimate
+	}
+
+	result.Diff = diffAgainst(m.pinned, result.Area)
+
+	return result, nil
+}
+
+// requestContext cancels any in-flight computation and builds the context for
+// a new one from the model session.
+func (m *IntegralModel) requestContext() (context.Context, context.CancelFunc) {
+	m.cancelInFlight()
+
+	if m.session == nil {
+		m.session = NewSession("")
+	}
+
+	ctx, cancel := m.session.NewRequestContext(context.Background())
+	m.cancel = cancel
+
+	return ctx, cancel
+}
+
+func (m *IntegralModel) cancelInFlight() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+}
 
-Future features will include:
-• Numerical integration methods
-• Trapezoidal rule
-• Simpson's rule  
-• Gaussian quadrature
-• Error analysis
+// superscript writes n with superscript digits, for exponents such as h².
+func superscript(n int) string {
+	digits := []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
 
-Stay tuned for updates!
-`
+	var out strings.Builder
+	for _, digit := range strconv.Itoa(n) {
+		if digit == '-' {
+			out.WriteRune('⁻')
+			continue
+		}
+		out.WriteRune(digits[digit-'0'])
+	}
 
-	return style.Render(content)
+	return out.String()
 }
@@ -0,0 +1,258 @@
+package models
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+	newtoncotes "github.com/taldoflemis/nume/internal/usecases/newton_cotes"
+)
+
+func TestIntegralModelAccurateModeReachesTolerance(t *testing.T) {
+	t.Parallel()
+
+	for i, function := range integrands {
+		t.Run(function.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+			for range i {
+				model.Update(tea.KeyMsg{Type: tea.KeyDown})
+			}
+			model.focusedSection = IntegralSectionCalculate
+
+			// Act
+			model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+			// Assert
+			require.NoError(t, model.resultErr)
+			require.NotNil(t, model.result)
+			assert.Equal(t, function.name, model.result.Function)
+			assert.True(t, model.result.Converged)
+			assert.LessOrEqual(t, model.result.ErrorEstimate, precision.Balanced.Defaults().IntegralTolerance)
+			assert.Less(t, model.result.AbsoluteError, 10*precision.Balanced.Defaults().IntegralTolerance)
+			assert.Contains(t, model.renderResult(), "Estimated error")
+		})
+	}
+}
+
+func TestIntegralModelFixedMode(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	// Exponential, the polynomial's trapezoidal errors nearly cancel on [0, 1]
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.focusedSection = IntegralSectionMode
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+
+	// Assert
+	require.NoError(t, model.resultErr)
+	require.NotNil(t, model.result)
+	assert.Equal(t, IntegralSectionCalculate, model.focusedSection)
+	assert.Equal(t, model.modeOptions[IntegralModeFixed], model.result.Mode)
+	assert.Equal(t, precision.Balanced.Defaults().Partitions, model.result.Partitions)
+	// The trapezoidal error with 16 partitions is far above the accurate mode's
+	assert.Greater(t, model.result.AbsoluteError, 1e-4)
+	assert.NotContains(t, model.renderResult(), "Estimated error")
+	assert.Contains(t, model.renderResult(), "O(h²)")
+}
+
+func TestIntegralModelFixedModeCapsHugeGrids(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedMode = IntegralModeFixed
+	model.partitionsInput.SetValue("9000000000")
+	model.partitions = 9_000_000_000
+
+	// Act
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, newtoncotes.AdaptiveIntegration, result.Method)
+	assert.Equal(t, uint64(9_000_000_000), result.Requested)
+	assert.LessOrEqual(t, result.Partitions, uint64(MaxIntegralPartitions))
+	assert.Less(t, result.AbsoluteError, 1e-6)
+
+	model.result = result
+	assert.Contains(t, model.renderResult(), "adaptive, the 9000000000 partitions requested exceed the cap")
+}
+
+func TestIntegralModelTypesIntoFocusedArgument(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	model.focusedSection = IntegralSectionArguments
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.Update(runes("2"))
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "0", model.leftInput.Value())
+	assert.Equal(t, "2", model.rightInput.Value())
+	assert.InDelta(t, 2.0, result.Right, 0)
+	assert.InDelta(t, result.Exact, result.Area, 10*precision.Balanced.Defaults().IntegralTolerance)
+}
+
+func TestIntegralModelRejectsInvalidArguments(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	model.right = model.left
+
+	// Act
+	_, err := model.computeResult()
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestIntegralModelFixedModeContributionsSumToTheArea(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	model.focusedSection = IntegralSectionMode
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+
+	// Assert
+	require.NoError(t, model.resultErr)
+	require.NotNil(t, model.result)
+	require.Len(t, model.result.Contributions, int(model.result.Partitions))
+
+	sum := 0.0
+	for _, contribution := range model.result.Contributions {
+		sum += contribution.Area
+	}
+	assert.InDelta(t, model.result.Area, sum, 1e-12)
+	assert.InDelta(t, model.left, model.result.Contributions[0].Left, 0)
+	assert.InDelta(t, model.right, model.result.Contributions[len(model.result.Contributions)-1].Right, 1e-12)
+	assert.Contains(t, model.renderResult(), "**Partitions**")
+}
+
+func TestGroupPartitions(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	partitions := make([]newtoncotes.PartitionArea, 10)
+	for i := range partitions {
+		partitions[i] = newtoncotes.PartitionArea{Left: float64(i), Right: float64(i + 1), Area: float64(i)}
+	}
+
+	// Act
+	groups := groupPartitions(partitions, 4)
+
+	// Assert
+	require.Len(t, groups, 4)
+	sum := 0.0
+	for i, group := range groups {
+		sum += group.Area
+		if i > 0 {
+			assert.InDelta(t, groups[i-1].Right, group.Left, 0)
+		}
+	}
+	assert.InDelta(t, 45.0, sum, 0)
+	assert.InDelta(t, 0.0, groups[0].Left, 0)
+	assert.InDelta(t, 10.0, groups[3].Right, 0)
+}
+
+func TestIntegralModelAccurateModeHasNoContributions(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, result.Contributions)
+}
+
+func TestIntegralModelAccurateModeShowsThePrecisionLadder(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	tolerance := precision.Balanced.Defaults().IntegralTolerance
+
+	// Act
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result.Ladder)
+	assert.True(t, result.Ladder.Reached)
+	rungs := result.Ladder.Rungs
+	for i := 1; i < len(rungs); i++ {
+		assert.Less(t, rungs[i].AbsoluteError, rungs[i-1].AbsoluteError)
+	}
+	assert.LessOrEqual(t, rungs[len(rungs)-1].AbsoluteError, tolerance)
+
+	model.result = result
+	assert.Contains(t, model.renderResult(), "Precision ladder")
+}
+
+func TestIntegralModelFixedModeHasNoPrecisionLadder(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedMode = IntegralModeFixed
+
+	// Act
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, result.Ladder)
+}
+
+func TestIntegralModelComparesWithReference(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	// The polynomial integrates to 31/30 over [0, 1], so a reference of 1 is
+	// off by 1/30
+	model.referenceInput.SetValue("1")
+
+	// Act
+	result, err := model.computeResult()
+	model.result = result
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result.Reference)
+	assert.InDelta(t, 1, result.Reference.Reference, 0)
+	assert.InDelta(t, 1.0/30, result.Reference.Absolute, 1e-6)
+	assert.InDelta(t, 1.0/30, result.Reference.Relative, 1e-6)
+
+	rendered := model.renderResult()
+	assert.Contains(t, rendered, "**Absolute error**")
+	assert.Contains(t, rendered, "**Relative error**")
+}
+
+func TestIntegralModelWithoutReference(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	result, err := model.computeResult()
+	model.result = result
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, result.Reference)
+	assert.NotContains(t, model.renderResult(), "Relative error")
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"sync/atomic"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxRenderWidth caps the word wrap of the rendered section content, zero
+// wrapping it to the content column
+var maxRenderWidth atomic.Int64
+
+// SetMaxRenderWidth caps the word wrap of the section content of tabs laid
+// out afterwards, so very wide terminals keep readable lines. A non-positive
+// width removes the cap.
+func SetMaxRenderWidth(width int) {
+	maxRenderWidth.Store(int64(max(width, 0)))
+}
+
+// columnLayout splits the width left to a tab between the section navigation
+// and the section content, stacking them when side by side they would be too
+// narrow to read.
+type columnLayout struct {
+	// width is the width of the tab content, without the padding around it
+	width int
+	// maxRenderWidth caps the word wrap of the content, zero for none
+	maxRenderWidth int
+}
+
+// newColumnLayout lays out a tab shown in a window windowWidth columns wide,
+// taking out the padding the main model draws around the tab content.
+func newColumnLayout(windowWidth int) columnLayout {
+	return columnLayout{
+		width:          max(windowWidth-2*TabContentPadding, 1),
+		maxRenderWidth: int(maxRenderWidth.Load()),
+	}
+}
+
+// defaultColumnLayout is used until the window size is known.
+func defaultColumnLayout() columnLayout {
+	return columnLayout{width: DefaultTabContentWidth, maxRenderWidth: int(maxRenderWidth.Load())}
+}
+
+// stacked reports whether the content goes below the navigation.
+func (l columnLayout) stacked() bool {
+	return l.width < StackedLayoutWidth
+}
+
+// columns returns the width of the navigation and of the content, the full
+// width for both when stacked.
+func (l columnLayout) columns() (left, right int) {
+	if l.stacked() {
+		return l.width, l.width
+	}
+
+	left = l.width * NavigationColumnPercent / 100
+	return left, l.width - left
+}
+
+// render joins the navigation and the content, every line fitting the width.
+func (l columnLayout) render(renderer *lipgloss.Renderer, navigation, content string) string {
+	leftWidth, rightWidth := l.columns()
+	left := renderer.NewStyle().Width(leftWidth).MaxWidth(leftWidth).Render(navigation)
+	right := renderer.NewStyle().Width(rightWidth).MaxWidth(rightWidth).Render(content)
+
+	if l.stacked() {
+		return lipgloss.JoinVertical(lipgloss.Left, left, right)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, right)
+}
+
+// renderWidth is the word wrap of the section content, the width of its
+// column up to the configured maximum.
+func (l columnLayout) renderWidth() int {
+	_, rightWidth := l.columns()
+	if l.maxRenderWidth > 0 {
+		return min(l.maxRenderWidth, rightWidth)
+	}
+	return rightWidth
+}
+
+// markdownRenderer renders the section content wrapped to its column.
+func (l columnLayout) markdownRenderer() *glamour.TermRenderer {
+	renderer, _ := glamour.NewTermRenderer(
+		glamour.WithWordWrap(l.renderWidth()),
+		glamour.WithStandardStyle("dracula"),
+	)
+	return renderer
+}
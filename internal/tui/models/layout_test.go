@@ -0,0 +1,194 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMainModelFitsTheMinimalWidth(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		key      string
+		sections int
+	}{
+		{name: "Derivative", key: "d", sections: SectionCount},
+		{name: "Integral", key: "i", sections: IntegralSectionCount},
+		{name: "Eigen", key: "e", sections: EigenSectionCount},
+		{name: "Solve", key: "s", sections: LinearSystemSectionCount},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			var model tea.Model = NewMainModel(newTestTheme(), NewSession("gabrigas"))
+			model, _ = model.Update(tea.WindowSizeMsg{Width: MinimalWidth, Height: MinimalHeight})
+			model, _ = model.Update(runes(test.key))
+
+			for section := range test.sections {
+				// Act
+				view := model.View()
+
+				// Assert
+				for i, line := range strings.Split(view, "\n") {
+					require.LessOrEqual(t, lipgloss.Width(line), MinimalWidth,
+						"section %d, line %d overflows: %q", section, i, line)
+				}
+
+				model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+			}
+		})
+	}
+}
+
+func TestMainModelKeepsTheWidthAfterTheResetKey(t *testing.T) {
+	t.Parallel()
+
+	for _, tab := range []string{"d", "i", "e", "s"} {
+		t.Run(tab, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			var model tea.Model = NewMainModel(newTestTheme(), NewSession("gabrigas"))
+			model, _ = model.Update(tea.WindowSizeMsg{Width: MinimalWidth, Height: MinimalHeight})
+			model, _ = model.Update(runes(tab))
+			before := lipgloss.Width(model.View())
+
+			// Act
+			model, _ = model.Update(runes("r"))
+
+			// Assert
+			assert.Equal(t, before, lipgloss.Width(model.View()))
+			assert.LessOrEqual(t, lipgloss.Width(model.View()), MinimalWidth)
+		})
+	}
+}
+
+func TestColumnLayout(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		windowWidth     int
+		expectedStacked bool
+		expectedLeft    int
+		expectedRight   int
+	}{
+		{name: "Minimal", windowWidth: MinimalWidth, expectedLeft: 31, expectedRight: 47},
+		{name: "Wide", windowWidth: 162, expectedLeft: 64, expectedRight: 96},
+		{name: "Narrow", windowWidth: 50, expectedStacked: true, expectedLeft: 48, expectedRight: 48},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			layout := newColumnLayout(test.windowWidth)
+
+			// Act
+			left, right := layout.columns()
+			view := layout.render(lipgloss.DefaultRenderer(), "navigation", strings.Repeat("content ", 20))
+
+			// Assert
+			assert.Equal(t, test.expectedStacked, layout.stacked())
+			assert.Equal(t, test.expectedLeft, left)
+			assert.Equal(t, test.expectedRight, right)
+			assert.Equal(t, test.windowWidth-2*TabContentPadding, lipgloss.Width(view))
+		})
+	}
+}
+
+func TestColumnLayoutRenderWidth(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name           string
+		windowWidth    int
+		maxRenderWidth int
+		expected       int
+	}{
+		{name: "Wide", windowWidth: 162, expected: 96},
+		{name: "WideCapped", windowWidth: 162, maxRenderWidth: 70, expected: 70},
+		{name: "MinimalBelowTheCap", windowWidth: MinimalWidth, maxRenderWidth: 70, expected: 47},
+		{name: "Narrow", windowWidth: 50, expected: 48},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			layout := newColumnLayout(test.windowWidth)
+			layout.maxRenderWidth = test.maxRenderWidth
+
+			// Act
+			width := layout.renderWidth()
+
+			// Assert
+			assert.Equal(t, test.expected, width)
+		})
+	}
+}
+
+func TestTabsRenderToTheWidthAfterAResize(t *testing.T) {
+	t.Parallel()
+
+	// rendering returns the layout and the markdown renderer of a tab
+	type rendering func() (columnLayout, *glamour.TermRenderer)
+
+	tt := []struct {
+		name  string
+		model func() (tea.Model, rendering)
+	}{
+		{name: "Derivative", model: func() (tea.Model, rendering) {
+			m := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+			return m, func() (columnLayout, *glamour.TermRenderer) { return m.layout, m.renderer }
+		}},
+		{name: "Integral", model: func() (tea.Model, rendering) {
+			m := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+			return m, func() (columnLayout, *glamour.TermRenderer) { return m.layout, m.renderer }
+		}},
+		{name: "Eigen", model: func() (tea.Model, rendering) {
+			m := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+			return m, func() (columnLayout, *glamour.TermRenderer) { return m.layout, m.renderer }
+		}},
+		{name: "Solve", model: func() (tea.Model, rendering) {
+			m := NewLinearSystemModel(newTestTheme(), NewSession("gabrigas"))
+			return m, func() (columnLayout, *glamour.TermRenderer) { return m.layout, m.renderer }
+		}},
+	}
+
+	paragraph := strings.Repeat("the content wraps to the width of its column ", 10)
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model, rendered := test.model()
+			before, _ := rendered()
+
+			// Act
+			model.Update(tea.WindowSizeMsg{Width: 162, Height: MinimalHeight})
+
+			// Assert
+			layout, renderer := rendered()
+			assert.Equal(t, 60, before.renderWidth())
+			assert.Equal(t, 96, layout.renderWidth())
+
+			output, err := renderer.Render(paragraph)
+			require.NoError(t, err)
+			widest := 0
+			for _, line := range strings.Split(output, "\n") {
+				widest = max(widest, lipgloss.Width(line))
+			}
+			assert.LessOrEqual(t, widest, layout.renderWidth())
+			assert.Greater(t, widest, before.renderWidth())
+		})
+	}
+}
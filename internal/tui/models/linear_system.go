@@ -0,0 +1,767 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/taldoflemis/nume/internal/explanations"
+	"github.com/taldoflemis/nume/internal/usecases"
+	"github.com/taldoflemis/nume/internal/usecases/linearsystems"
+)
+
+var (
+	ErrRightHandSideNotVector = errors.New("right-hand side must be a single column")
+	ErrUnknownLinearMethod    = errors.New("unknown linear system method selected")
+)
+
+// LinearSystemSolveResult is the outcome of solving Ax = b, kept apart from its
+// rendering so it can be exported or reused.
+type LinearSystemSolveResult struct {
+	Method     string
+	Solution   []float64
+	Iterations uint64
+	// Residual is ‖Ax - b‖₂
+	Residual float64
+	// Residuals is the residual after each iteration of the iterative
+	// methods, which Converged unless they ran out of iterations
+	Residuals []float64
+	Converged bool
+	// Lower, Upper, Forward and Permutation are the factors of the direct
+	// methods, see linearsystems.DirectSolution, nil for the iterative ones
+	Lower       [][]float64
+	Upper       [][]float64
+	Forward     []float64
+	Permutation []int
+}
+
+type LinearSystemModel struct {
+	// Current focus section (0-4)
+	focusedSection int
+
+	// Section 1: Method Selection
+	methodOptions  []string
+	selectedMethod int
+
+	// Section 2 and 3: A and b editors
+	matrixEditor MatrixEditorModel
+	vectorEditor MatrixEditorModel
+
+	// Section 4: Arguments for the iterative methods
+	epsilonInput       textinput.Model
+	maxIterationsInput textinput.Model
+	relaxationInput    textinput.Model
+	epsilon            float64
+	maxIterations      uint64
+	relaxation         float64
+
+	// Calculation results
+	result    *LinearSystemSolveResult
+	resultErr error
+
+	// Use cases of the iterative and of the direct methods
+	useCase       linearSystemUseCase
+	directUseCase directUseCase
+
+	// Session and cancellation of the in-flight computation
+	session *Session
+	cancel  context.CancelFunc
+
+	// Styling
+	layout   columnLayout
+	renderer *glamour.TermRenderer
+	*Theme
+}
+
+// linearSystemUseCase is the subset of usecases.LinearSystemUseCase used by the
+// model
+type linearSystemUseCase interface {
+	Jacobi(
+		ctx context.Context,
+		matrix [][]float64,
+		b []float64,
+		epsilon float64,
+		maxNumberOfIterations uint64,
+	) (*usecases.LinearSystemResult, error)
+	GaussSeidel(
+		ctx context.Context,
+		matrix [][]float64,
+		b []float64,
+		epsilon float64,
+		maxNumberOfIterations uint64,
+	) (*usecases.LinearSystemResult, error)
+	SOR(
+		ctx context.Context,
+		matrix [][]float64,
+		b []float64,
+		omega float64,
+		epsilon float64,
+		maxNumberOfIterations uint64,
+	) (*usecases.LinearSystemResult, error)
+}
+
+var _ linearSystemUseCase = (*usecases.LinearSystemUseCase)(nil)
+
+// directUseCase is the subset of linearsystems.DirectUseCase used by the model
+type directUseCase interface {
+	LU(ctx context.Context, matrix [][]float64, b []float64) (*linearsystems.DirectSolution, error)
+	GaussianElimination(ctx context.Context, matrix [][]float64, b []float64) (*linearsystems.DirectSolution, error)
+	Cholesky(ctx context.Context, matrix [][]float64, b []float64) (*linearsystems.DirectSolution, error)
+}
+
+var _ directUseCase = (*linearsystems.DirectUseCase)(nil)
+
+// keyMap defines the keybindings for the linear system model
+type linearSystemKeyMap struct {
+	Quit             key.Binding
+	Help             key.Binding
+	TabD             key.Binding
+	TabI             key.Binding
+	TabE             key.Binding
+	TabS             key.Binding
+	CycleNextSection key.Binding
+	CyclePrevSection key.Binding
+	Up               key.Binding
+	Down             key.Binding
+	Enter            key.Binding
+	Reset            key.Binding
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view
+func (k linearSystemKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Help, k.Quit}
+}
+
+// FullHelp returns keybindings for the expanded help view
+func (k linearSystemKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.TabD, k.TabI, k.TabE, k.TabS, k.Help}, // first column - navigation
+		{k.Up, k.Down},                           // second column - movement
+		{k.CycleNextSection, k.CyclePrevSection}, // third column - sections
+		{k.Enter, k.Reset, k.Quit},               // fourth column - actions
+	}
+}
+
+var linearSystemKeys = linearSystemKeyMap{
+	Quit: key.NewBinding(
+		key.WithKeys("q", "ctrl+c"),
+		key.WithHelp("q", "quit"),
+	),
+	Help: key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "toggle help"),
+	),
+	TabD: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "derivatives tab"),
+	),
+	TabI: key.NewBinding(
+		key.WithKeys("i"),
+		key.WithHelp("i", "integrals tab"),
+	),
+	TabE: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "eigen tab"),
+	),
+	TabS: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "solve tab"),
+	),
+	CycleNextSection: key.NewBinding(
+		key.WithKeys("tab"),
+		key.WithHelp("tab", "cycle to next section"),
+	),
+	CyclePrevSection: key.NewBinding(
+		key.WithKeys("shift+tab"),
+		key.WithHelp("shift+tab", "cycle to previous section"),
+	),
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Enter: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "select/confirm"),
+	),
+	Reset: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "reset"),
+	),
+}
+
+// GetHelpKeys implements NumeTabContent.
+func (*LinearSystemModel) GetHelpKeys() help.KeyMap {
+	return linearSystemKeys
+}
+
+var _ (NumeTabContent) = (*LinearSystemModel)(nil)
+
+// linearSystemSections name the sections whose explanation does not depend on the
+// selection, looked up in the explanations package
+var linearSystemSections = map[int]explanations.Section{
+	LinearSystemSectionMethodSelection: explanations.SectionMethod,
+	LinearSystemSectionMatrix:          explanations.SectionMatrixEditor,
+	LinearSystemSectionVector:          explanations.SectionVector,
+	LinearSystemSectionArguments:       explanations.SectionArguments,
+}
+
+func NewLinearSystemModel(theme *Theme, session *Session) *LinearSystemModel {
+	layout := defaultColumnLayout()
+
+	defaults := session.numericDefaults()
+
+	epsilonInput := textinput.New()
+	epsilonInput.Placeholder = FormatNumber(defaults.Epsilon)
+	epsilonInput.CharLimit = 20
+	epsilonInput.Validate = validateNumber
+	epsilonInput.SetValue(FormatNumber(defaults.Epsilon))
+
+	maxIterationsInput := textinput.New()
+	maxIterationsInput.Placeholder = strconv.FormatUint(defaults.MaxIterations, 10)
+	maxIterationsInput.CharLimit = 10
+	maxIterationsInput.Validate = validateCount
+	maxIterationsInput.SetValue(strconv.FormatUint(defaults.MaxIterations, 10))
+
+	relaxationInput := textinput.New()
+	relaxationInput.Placeholder = FormatNumber(DefaultRelaxation)
+	relaxationInput.CharLimit = 20
+	relaxationInput.Validate = validateNumber
+	relaxationInput.SetValue(FormatNumber(DefaultRelaxation))
+
+	return &LinearSystemModel{
+		focusedSection: 0,
+		methodOptions: []string{
+			"LU Decomposition",
+			"Gaussian Elimination",
+			"Cholesky",
+			"Jacobi",
+			"Gauss-Seidel",
+			"SOR",
+		},
+		selectedMethod: LinearSystemMethodLU,
+		// Diagonally dominant default so the iterative methods converge
+		matrixEditor: NewMatrixEditorModel(theme, [][]float64{
+			{4, -1, 0},
+			{-1, 4, -1},
+			{0, -1, 4},
+		}),
+		vectorEditor:       NewMatrixEditorModel(theme, [][]float64{{2}, {4}, {10}}),
+		epsilonInput:       epsilonInput,
+		maxIterationsInput: maxIterationsInput,
+		relaxationInput:    relaxationInput,
+		epsilon:            defaults.Epsilon,
+		maxIterations:      defaults.MaxIterations,
+		relaxation:         DefaultRelaxation,
+		useCase:            usecases.NewLinearSystemUseCase(),
+		directUseCase:      linearsystems.NewDirectUseCase(),
+		session:            session,
+		layout:             layout,
+		renderer:           layout.markdownRenderer(),
+		Theme:              theme,
+	}
+}
+
+func (*LinearSystemModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *LinearSystemModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.resize(size.Width)
+		return m, nil
+	}
+
+	var cmds []tea.Cmd
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(keyMsg, linearSystemKeys.CycleNextSection):
+			m.setFocusedSection((m.focusedSection + 1) % LinearSystemSectionCount)
+			return m, nil
+		case key.Matches(keyMsg, linearSystemKeys.CyclePrevSection):
+			m.setFocusedSection((m.focusedSection - 1 + LinearSystemSectionCount) % LinearSystemSectionCount)
+			return m, nil
+		case m.focusedSection == LinearSystemSectionMatrix:
+			// The editors own every other key while focused
+			var cmd tea.Cmd
+			m.matrixEditor, cmd = m.matrixEditor.Update(keyMsg)
+			return m, cmd
+		case m.focusedSection == LinearSystemSectionVector:
+			var cmd tea.Cmd
+			m.vectorEditor, cmd = m.vectorEditor.Update(keyMsg)
+			return m, cmd
+		case keyMsg.Type == tea.KeyRunes && m.capturesText():
+			// Typed characters go to the inputs ahead of the bindings, so
+			// the k of 10k doesn't move the focus
+			return m, m.updateArguments(keyMsg)
+		case key.Matches(keyMsg, linearSystemKeys.Up):
+			return m.handleUp(), nil
+		case key.Matches(keyMsg, linearSystemKeys.Down):
+			return m.handleDown(), nil
+		case key.Matches(keyMsg, linearSystemKeys.Enter):
+			return m.handleEnter(), nil
+		case key.Matches(keyMsg, linearSystemKeys.Reset):
+			m.cancelInFlight()
+			return m.reset(), nil
+		}
+
+		// Handle input for text inputs
+		if m.focusedSection == LinearSystemSectionArguments {
+			cmds = append(cmds, m.updateArguments(keyMsg))
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// capturesText reports whether a text input or an editor is focused, which
+// then takes the typed characters ahead of the global keys.
+func (m *LinearSystemModel) capturesText() bool {
+	switch m.focusedSection {
+	case LinearSystemSectionMatrix, LinearSystemSectionVector:
+		return true
+	case LinearSystemSectionArguments:
+		return m.epsilonInput.Focused() || m.maxIterationsInput.Focused() || m.relaxationInput.Focused()
+	}
+	return false
+}
+
+// updateArguments feeds keyMsg to the argument inputs, only the focused one
+// taking it, and re-parses their values.
+func (m *LinearSystemModel) updateArguments(keyMsg tea.KeyMsg) tea.Cmd {
+	var cmds []tea.Cmd
+
+	var cmd tea.Cmd
+	m.epsilonInput, cmd = m.epsilonInput.Update(keyMsg)
+	if val, err := ParseNumber(m.epsilonInput.Value()); err == nil {
+		m.epsilon = val
+	}
+	cmds = append(cmds, cmd)
+
+	m.maxIterationsInput, cmd = m.maxIterationsInput.Update(keyMsg)
+	if val, err := ParseCount(m.maxIterationsInput.Value()); err == nil {
+		m.maxIterations = val
+	}
+	cmds = append(cmds, cmd)
+
+	m.relaxationInput, cmd = m.relaxationInput.Update(keyMsg)
+	if val, err := ParseNumber(m.relaxationInput.Value()); err == nil {
+		m.relaxation = val
+	}
+	cmds = append(cmds, cmd)
+
+	return tea.Batch(cmds...)
+}
+
+func (m *LinearSystemModel) setFocusedSection(section int) {
+	m.focusedSection = section
+
+	m.matrixEditor.Blur()
+	m.vectorEditor.Blur()
+
+	switch section {
+	case LinearSystemSectionMatrix:
+		m.matrixEditor.Focus()
+	case LinearSystemSectionVector:
+		m.vectorEditor.Focus()
+	}
+}
+
+func (m *LinearSystemModel) handleUp() *LinearSystemModel {
+	switch m.focusedSection {
+	case LinearSystemSectionMethodSelection:
+		m.selectedMethod = (m.selectedMethod - 1 + len(m.methodOptions)) % len(m.methodOptions)
+	case LinearSystemSectionArguments:
+		m.moveArgumentFocus(-1)
+	}
+	return m
+}
+
+func (m *LinearSystemModel) handleDown() *LinearSystemModel {
+	switch m.focusedSection {
+	case LinearSystemSectionMethodSelection:
+		m.selectedMethod = (m.selectedMethod + 1) % len(m.methodOptions)
+	case LinearSystemSectionArguments:
+		m.moveArgumentFocus(1)
+	}
+	return m
+}
+
+// moveArgumentFocus moves the focus step inputs away in the arguments
+// section, wrapping around at both ends. Nothing being focused yet, the
+// first step lands on the tolerance.
+func (m *LinearSystemModel) moveArgumentFocus(step int) {
+	inputs := []*textinput.Model{&m.epsilonInput, &m.maxIterationsInput, &m.relaxationInput}
+
+	next := 0
+	for i, input := range inputs {
+		if input.Focused() {
+			next = (i + step + len(inputs)) % len(inputs)
+		}
+		input.Blur()
+	}
+
+	inputs[next].Focus()
+}
+
+func (m *LinearSystemModel) handleEnter() *LinearSystemModel {
+	// Only generate result if calculate button is focused
+	if m.focusedSection == LinearSystemSectionCalculate {
+		m.generateResult()
+	}
+	return m
+}
+
+// resize lays the tab out for a window windowWidth columns wide.
+func (m *LinearSystemModel) resize(windowWidth int) {
+	m.layout = newColumnLayout(windowWidth)
+	m.renderer = m.layout.markdownRenderer()
+}
+
+// reset returns a new model in the layout of this one, as the window size is
+// only sent again when it changes.
+func (m *LinearSystemModel) reset() *LinearSystemModel {
+	reset := NewLinearSystemModel(m.Theme, m.session)
+	reset.layout = m.layout
+	reset.renderer = m.renderer
+
+	return reset
+}
+
+func (m *LinearSystemModel) View() string {
+	return m.layout.render(m.Renderer, m.renderSectionNavigation(), m.renderSectionContent())
+}
+
+func (m *LinearSystemModel) renderSectionNavigation() string {
+	var sections []string
+
+	// Section names with tilde formatting
+	sectionNames := []string{
+		"Method Selection",
+		"Matrix A",
+		"Vector b",
+		"Arguments",
+		"Calculate",
+	}
+
+	for i, name := range sectionNames {
+		var style lipgloss.Style
+		if i == m.focusedSection {
+			// Use focused title color from theme
+			style = m.Renderer.NewStyle().
+				Foreground(m.Focused.Title.GetForeground()).
+				Bold(true)
+		} else {
+			style = m.Renderer.NewStyle().
+				Foreground(lipgloss.Color("#666666"))
+		}
+
+		// Format with tildes
+		formattedName := fmt.Sprintf("~ %s ~", name)
+		sections = append(sections, style.Render(formattedName))
+
+		// Add content based on section
+		switch i {
+		case LinearSystemSectionMethodSelection:
+			for j, method := range m.methodOptions {
+				style := m.Blurred.UnselectedPrefix
+				if j == m.selectedMethod {
+					style = m.Focused.SelectedPrefix
+				}
+				sections = append(sections, style.Render(method))
+			}
+		case LinearSystemSectionMatrix:
+			sections = append(sections, m.matrixEditor.View())
+		case LinearSystemSectionVector:
+			sections = append(sections, m.vectorEditor.View())
+		case LinearSystemSectionArguments:
+			sections = append(sections, fmt.Sprintf("  Tolerance: %s", m.renderValidatedInput(m.epsilonInput)))
+			sections = append(sections, fmt.Sprintf("  Max Iterations: %s", m.renderValidatedInput(m.maxIterationsInput)))
+			sections = append(sections, fmt.Sprintf("  Relaxation ω: %s", m.renderValidatedInput(m.relaxationInput)))
+		case LinearSystemSectionCalculate:
+			// Create a styled button
+			var buttonStyle lipgloss.Style
+			if i == m.focusedSection {
+				buttonStyle = m.Focused.FocusedButton
+			} else {
+				buttonStyle = m.Focused.BlurredButton
+			}
+			button := buttonStyle.Render(" SOLVE ")
+			sections = append(sections, fmt.Sprintf("  %s", button))
+		}
+		sections = append(sections, "") // Add spacing
+	}
+
+	return strings.Join(sections, "\n")
+}
+
+func (m *LinearSystemModel) renderSectionContent() string {
+	var explanation explanations.Explanation
+
+	switch m.focusedSection {
+	case LinearSystemSectionCalculate:
+		explanation = explanations.Explanation{
+			Title:           "Solve",
+			Overview:        "Solve the system with the configured parameters.",
+			ParametersTitle: "Current Configuration",
+			Parameters: []explanations.Parameter{
+				{Name: "Method", Description: m.methodOptions[m.selectedMethod]},
+				{Name: "Matrix", Description: fmt.Sprintf("%dx%d", m.matrixEditor.Rows(), m.matrixEditor.Columns())},
+				{Name: "Tolerance", Description: fmt.Sprintf("%.2e", m.epsilon)},
+				{Name: "Max Iterations", Description: fmt.Sprintf("%d", m.maxIterations)},
+				{Name: "Relaxation ω", Description: FormatNumber(m.relaxation)},
+			},
+			Tips: []string{"Press **Enter** on the Solve button to run the calculation."},
+		}
+	default:
+		explanation, _ = explanations.Lookup(explanations.TabLinearSystem, linearSystemSections[m.focusedSection], m.session.numericProfile())
+	}
+
+	content := explanation.Markdown()
+
+	if m.focusedSection == LinearSystemSectionCalculate {
+		// Add results section if available
+		if result := m.renderResult(); result != "" {
+			content += "\n# Result\n\n" + result
+		}
+	}
+
+	// Render with glamour
+	if rendered, err := m.renderer.Render(content); err == nil {
+		return rendered
+	}
+	return content
+}
+
+func (m *LinearSystemModel) renderResult() string {
+	if m.resultErr != nil {
+		return m.Focused.ErrorMessage.Render(m.resultErr.Error())
+	}
+
+	if m.result == nil {
+		return ""
+	}
+
+	parts := make([]string, len(m.result.Solution))
+	for i, val := range m.result.Solution {
+		parts[i] = fmt.Sprintf("%.6f", val)
+	}
+
+	rendered := fmt.Sprintf(`**Solution**: [%s]
+
+**Residual ‖Ax − b‖**: %.3e`,
+		strings.Join(parts, ", "),
+		m.result.Residual)
+
+	if m.result.Iterations > 0 {
+		rendered += fmt.Sprintf(`
+
+**Iterations**: %d`, m.result.Iterations)
+		if !m.result.Converged {
+			rendered += " (did not converge, the tolerance was not reached)"
+		}
+	}
+
+	if m.result.Lower != nil {
+		rendered += "\n\n" + renderFactors(m.result)
+	}
+
+	if len(m.result.Residuals) > 0 {
+		rendered += "\n\n" + renderResidualHistory(m.result.Residuals)
+	}
+
+	return rendered
+}
+
+// renderFactors renders the factors of a direct method, PA = LU and the y of
+// Ly = Pb that back substitution turns into the solution.
+func renderFactors(result *LinearSystemSolveResult) string {
+	var content strings.Builder
+
+	content.WriteString("**L**\n\n")
+	content.WriteString(renderFactor(result.Lower))
+	content.WriteString("\n**U**\n\n")
+	content.WriteString(renderFactor(result.Upper))
+
+	forward := make([]string, len(result.Forward))
+	for i, val := range result.Forward {
+		forward[i] = fmt.Sprintf("%.6f", val)
+	}
+	fmt.Fprintf(&content, "\n**y** (Ly = Pb): [%s]", strings.Join(forward, ", "))
+
+	rows := make([]string, len(result.Permutation))
+	for i, row := range result.Permutation {
+		rows[i] = strconv.Itoa(row + 1)
+	}
+	fmt.Fprintf(&content, "\n\n**Row order of PA**: %s", strings.Join(rows, ", "))
+
+	return content.String()
+}
+
+func renderFactor(factor [][]float64) string {
+	lines := make([]string, len(factor))
+	for i, row := range factor {
+		entries := make([]string, len(row))
+		for j, val := range row {
+			entries[j] = fmt.Sprintf("%9.4f", val)
+		}
+		lines[i] = "[ " + strings.Join(entries, "  ") + " ]"
+	}
+
+	return "```\n" + strings.Join(lines, "\n") + "\n```\n"
+}
+
+// renderResidualHistory renders the residual after each iteration as a
+// markdown table, eliding the middle of histories longer than
+// ResidualHistoryRows.
+func renderResidualHistory(residuals []float64) string {
+	var content strings.Builder
+	content.WriteString("| Iteration | Residual ‖Ax − b‖ |\n|---|---|\n")
+
+	head, tail := len(residuals), 0
+	if len(residuals) > ResidualHistoryRows {
+		head = ResidualHistoryRows / 2
+		tail = ResidualHistoryRows - head
+	}
+
+	for i := range head {
+		fmt.Fprintf(&content, "| %d | %.3e |\n", i+1, residuals[i])
+	}
+	if tail > 0 {
+		content.WriteString("| … | … |\n")
+		for i := len(residuals) - tail; i < len(residuals); i++ {
+			fmt.Fprintf(&content, "| %d | %.3e |\n", i+1, residuals[i])
+		}
+	}
+
+	return content.String()
+}
+
+func (m *LinearSystemModel) generateResult() {
+	m.result, m.resultErr = m.computeResult()
+}
+
+// computeResult solves the system from the editors with the selected method.
+func (m *LinearSystemModel) computeResult() (*LinearSystemSolveResult, error) {
+	if err := inputsError(
+		labeledInput{"tolerance", m.epsilonInput},
+		labeledInput{"max iterations", m.maxIterationsInput},
+		labeledInput{"relaxation", m.relaxationInput},
+	); err != nil {
+		return nil, err
+	}
+
+	matrix, err := m.matrixEditor.Matrix()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matrix) != len(matrix[0]) {
+		return nil, fmt.Errorf("%w, got %dx%d", usecases.ErrNonSquareMatrix, len(matrix), len(matrix[0]))
+	}
+
+	column, err := m.vectorEditor.Matrix()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(column[0]) != 1 {
+		return nil, fmt.Errorf("%w, got %d columns", ErrRightHandSideNotVector, len(column[0]))
+	}
+
+	b := make([]float64, len(column))
+	for i, row := range column {
+		b[i] = row[0]
+	}
+
+	ctx, cancel := m.requestContext()
+	defer cancel()
+
+	logger := LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "Solving linear system from the TUI",
+		slog.String("method", m.methodOptions[m.selectedMethod]),
+		slog.Int("size", len(matrix)),
+	)
+
+	var solution *usecases.LinearSystemResult
+	var direct *linearsystems.DirectSolution
+
+	switch m.selectedMethod {
+	case LinearSystemMethodLU:
+		direct, err = m.directUseCase.LU(ctx, matrix, b)
+	case LinearSystemMethodGaussianElimination:
+		direct, err = m.directUseCase.GaussianElimination(ctx, matrix, b)
+	case LinearSystemMethodCholesky:
+		direct, err = m.directUseCase.Cholesky(ctx, matrix, b)
+	case LinearSystemMethodJacobi:
+		solution, err = m.useCase.Jacobi(ctx, matrix, b, m.epsilon, m.maxIterations)
+	case LinearSystemMethodGaussSeidel:
+		solution, err = m.useCase.GaussSeidel(ctx, matrix, b, m.epsilon, m.maxIterations)
+	case LinearSystemMethodSOR:
+		solution, err = m.useCase.SOR(ctx, matrix, b, m.relaxation, m.epsilon, m.maxIterations)
+	default:
+		return nil, ErrUnknownLinearMethod
+	}
+
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to solve linear system", slog.Any("error", err))
+		return nil, fmt.Errorf("error solving linear system: %w", err)
+	}
+
+	if direct != nil {
+		return &LinearSystemSolveResult{
+			Method:      m.methodOptions[m.selectedMethod],
+			Solution:    direct.Solution,
+			Residual:    direct.Residual,
+			Converged:   true,
+			Lower:       direct.Lower,
+			Upper:       direct.Upper,
+			Forward:     direct.Forward,
+			Permutation: direct.Permutation,
+		}, nil
+	}
+
+	return &LinearSystemSolveResult{
+		Method:     m.methodOptions[m.selectedMethod],
+		Solution:   solution.Solution,
+		Iterations: solution.Iterations,
+		Residual:   solution.Residual,
+		Residuals:  solution.Residuals,
+		Converged:  solution.Converged,
+	}, nil
+}
+
+// requestContext cancels any in-flight computation and builds the context for
+// a new one from the model session.
+func (m *LinearSystemModel) requestContext() (context.Context, context.CancelFunc) {
+	m.cancelInFlight()
+
+	if m.session == nil {
+		m.session = NewSession("")
+	}
+
+	ctx, cancel := m.session.NewRequestContext(context.Background())
+	m.cancel = cancel
+
+	return ctx, cancel
+}
+
+func (m *LinearSystemModel) cancelInFlight() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+}
@@ -0,0 +1,202 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestLinearSystemModelSolvesSystem(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name               string
+		method             int
+		expectedIterations bool
+	}{
+		{
+			name:   "LU",
+			method: LinearSystemMethodLU,
+		},
+		{
+			name:   "Gaussian elimination",
+			method: LinearSystemMethodGaussianElimination,
+		},
+		{
+			name:   "Cholesky",
+			method: LinearSystemMethodCholesky,
+		},
+		{
+			name:               "Jacobi",
+			method:             LinearSystemMethodJacobi,
+			expectedIterations: true,
+		},
+		{
+			name:               "Gauss-Seidel",
+			method:             LinearSystemMethodGaussSeidel,
+			expectedIterations: true,
+		},
+		{
+			name:               "SOR",
+			method:             LinearSystemMethodSOR,
+			expectedIterations: true,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewLinearSystemModel(newTestTheme(), NewSession("gabrigas"))
+			for range test.method {
+				model.Update(tea.KeyMsg{Type: tea.KeyDown})
+			}
+			model.setFocusedSection(LinearSystemSectionCalculate)
+
+			// Act
+			model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+			// Assert
+			require.NoError(t, model.resultErr)
+			require.NotNil(t, model.result)
+			assert.Equal(t, model.methodOptions[test.method], model.result.Method)
+			assert.InDeltaSlice(t, []float64{1, 2, 3}, model.result.Solution, 1e-5)
+			assert.Less(t, model.result.Residual, 1e-4)
+			assert.Equal(t, test.expectedIterations, model.result.Iterations > 0)
+			assert.Contains(t, model.renderResult(), "Residual")
+			assert.Equal(t, test.expectedIterations, len(model.result.Residuals) > 0)
+			assert.Equal(t, !test.expectedIterations, model.result.Lower != nil)
+			assert.Equal(t, !test.expectedIterations, strings.Contains(model.renderResult(), "Ly = Pb"))
+		})
+	}
+}
+
+func TestLinearSystemModelRendersTheResidualHistory(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewLinearSystemModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedMethod = LinearSystemMethodJacobi
+	model.maxIterations = 2 * ResidualHistoryRows
+	model.epsilon = 1e-300
+	model.setFocusedSection(LinearSystemSectionCalculate)
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	require.NoError(t, model.resultErr)
+	require.Len(t, model.result.Residuals, 2*ResidualHistoryRows)
+	assert.False(t, model.result.Converged)
+
+	rendered := model.renderResult()
+	assert.Contains(t, rendered, "did not converge")
+	assert.Contains(t, rendered, "| 1 |")
+	assert.Contains(t, rendered, "| … | … |")
+	assert.Contains(t, rendered, fmt.Sprintf("| %d |", 2*ResidualHistoryRows))
+	assert.NotContains(t, rendered, fmt.Sprintf("| %d |", ResidualHistoryRows))
+}
+
+func TestLinearSystemModelValidatesTheRelaxation(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewLinearSystemModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedMethod = LinearSystemMethodSOR
+	model.relaxation = 2.5
+	model.setFocusedSection(LinearSystemSectionCalculate)
+
+	// Act
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	require.ErrorIs(t, model.resultErr, usecases.ErrInvalidRelaxation)
+	assert.Nil(t, model.result)
+}
+
+func TestLinearSystemModelUsesEditors(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewLinearSystemModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Shrink to the 2x2 system [[4, -1], [-1, 4]] x = [7, 2]
+	model.setFocusedSection(LinearSystemSectionMatrix)
+	model.Update(runes("["))
+	model.Update(runes("{"))
+	model.setFocusedSection(LinearSystemSectionVector)
+	model.Update(runes("["))
+	model.Update(tea.KeyMsg{Type: tea.KeyDelete})
+	model.Update(runes("7"))
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeyDelete})
+	model.Update(runes("2"))
+	model.setFocusedSection(LinearSystemSectionCalculate)
+
+	// Act
+	result, err := model.computeResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{2, 1}, result.Solution, 1e-10)
+}
+
+func TestLinearSystemModelRejectsInvalidSystems(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		section     int
+		key         tea.KeyMsg
+		expectedErr error
+	}{
+		{
+			name:        "Non square matrix",
+			section:     LinearSystemSectionMatrix,
+			key:         runes("}"),
+			expectedErr: usecases.ErrNonSquareMatrix,
+		},
+		{
+			name:        "Right-hand side with two columns",
+			section:     LinearSystemSectionVector,
+			key:         runes("}"),
+			expectedErr: ErrRightHandSideNotVector,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			model := NewLinearSystemModel(newTestTheme(), NewSession("gabrigas"))
+			model.setFocusedSection(test.section)
+			model.Update(test.key)
+			model.setFocusedSection(LinearSystemSectionCalculate)
+
+			// Act
+			model.handleEnter()
+
+			// Assert
+			assert.Nil(t, model.result)
+			assert.ErrorIs(t, model.resultErr, test.expectedErr)
+		})
+	}
+}
+
+func TestMainModelSwitchesToSolveTab(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	updated, _ := model.Update(runes("s"))
+
+	// Assert
+	main, ok := updated.(MainModel)
+	require.True(t, ok)
+	assert.Equal(t, SolveTab, main.activeTab)
+	assert.Equal(t, linearSystemKeys, main.keys)
+}
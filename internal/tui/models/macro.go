@@ -0,0 +1,108 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MacroReplayDelay is the pause between replayed keys, slow enough for an
+// audience to follow the walkthrough
+const MacroReplayDelay = 300 * time.Millisecond
+
+type macroKeyMap struct {
+	Record key.Binding
+	Replay key.Binding
+}
+
+// macroKeys are handled by the main model, before the tabs see the keys
+var macroKeys = macroKeyMap{
+	Record: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "start/stop recording a macro"),
+	),
+	Replay: key.NewBinding(
+		key.WithKeys("ctrl+y"),
+		key.WithHelp("ctrl+y", "replay the macro"),
+	),
+}
+
+// Messages driving the macro recorder
+type (
+	toggleRecordingMsg struct{}
+	replayMacroMsg     struct{}
+	// macroStepMsg replays the first of keys and schedules the rest
+	macroStepMsg struct {
+		keys []tea.KeyMsg
+	}
+)
+
+// macroRecorder keeps the keys pressed while recording, to be fed back
+// through MainModel.Update as if typed again.
+type macroRecorder struct {
+	recording bool
+	keys      []tea.KeyMsg
+	// delay is the pause between replayed keys
+	delay time.Duration
+}
+
+func newMacroRecorder() macroRecorder {
+	return macroRecorder{delay: MacroReplayDelay}
+}
+
+// record appends keyMsg to the macro while recording.
+func (r *macroRecorder) record(keyMsg tea.KeyMsg) {
+	if r.recording {
+		r.keys = append(r.keys, keyMsg)
+	}
+}
+
+// toggle starts a new macro, dropping the previous one, or stops recording,
+// returning the status to show.
+func (r *macroRecorder) toggle() string {
+	if r.recording {
+		r.recording = false
+		return fmt.Sprintf("Recorded a macro of %s, ctrl+y to replay it", keyCount(len(r.keys)))
+	}
+
+	r.recording = true
+	r.keys = nil
+	return "Recording a macro, ctrl+r to stop"
+}
+
+// replay returns the command replaying the macro, nil with the reason when
+// there is nothing to replay.
+func (r *macroRecorder) replay() (tea.Cmd, string) {
+	if r.recording {
+		return nil, "Stop recording before replaying the macro"
+	}
+
+	if len(r.keys) == 0 {
+		return nil, "No macro recorded, ctrl+r to record one"
+	}
+
+	// The macro is copied, so recording a new one can't alter the replay
+	keys := append([]tea.KeyMsg(nil), r.keys...)
+	return r.step(keys), fmt.Sprintf("Replaying a macro of %s", keyCount(len(keys)))
+}
+
+// step schedules the replay of keys after the delay, nil once they are all
+// replayed.
+func (r *macroRecorder) step(keys []tea.KeyMsg) tea.Cmd {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return tea.Tick(r.delay, func(time.Time) tea.Msg {
+		return macroStepMsg{keys: keys}
+	})
+}
+
+func keyCount(count int) string {
+	if count == 1 {
+		return "1 key"
+	}
+	return fmt.Sprintf("%d keys", count)
+}
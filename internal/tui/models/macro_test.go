@@ -0,0 +1,107 @@
+package models
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runReplay runs the replay commands until the macro is over, dropping the
+// messages of anything else.
+func runReplay(t *testing.T, model tea.Model, cmd tea.Cmd) tea.Model {
+	t.Helper()
+
+	pending := []tea.Cmd{cmd}
+	for len(pending) > 0 {
+		cmd, pending = pending[0], pending[1:]
+		if cmd == nil {
+			continue
+		}
+
+		switch msg := cmd().(type) {
+		case macroStepMsg:
+			model, cmd = model.Update(msg)
+			pending = append(pending, cmd)
+		case tea.BatchMsg:
+			pending = append(pending, msg...)
+		}
+	}
+
+	return model
+}
+
+func TestMainModelReplaysRecordedMacro(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	walkthrough := []tea.KeyMsg{
+		runes("e"),
+		{Type: tea.KeyDown},
+		{Type: tea.KeyTab},
+		{Type: tea.KeyDown},
+		{Type: tea.KeyTab},
+		{Type: tea.KeyTab},
+		{Type: tea.KeyTab},
+		{Type: tea.KeyEnter},
+	}
+
+	var recorded tea.Model = NewMainModel(newTestTheme(), NewSession("gabrigas"))
+	recorded, _ = recorded.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	for _, keyMsg := range walkthrough {
+		recorded, _ = recorded.Update(keyMsg)
+	}
+	recorded, _ = recorded.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	replayed := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+	replayed.macro = recorded.(MainModel).macro
+	replayed.macro.delay = 0
+
+	// Act
+	model, cmd := replayed.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	model = runReplay(t, model, cmd)
+
+	// Assert
+	expected := recorded.(MainModel)
+	actual := model.(MainModel)
+	assert.Equal(t, walkthrough, expected.macro.keys)
+	assert.Equal(t, EigenTab, actual.activeTab)
+
+	expectedEigen := expected.models[EigenTab].(*EigenModel)
+	actualEigen := actual.models[EigenTab].(*EigenModel)
+	assert.Equal(t, EigenSectionCalculate, actualEigen.focusedSection)
+	assert.Equal(t, expectedEigen.selectedPowerMethod, actualEigen.selectedPowerMethod)
+	assert.Equal(t, expectedEigen.selectedMatrix, actualEigen.selectedMatrix)
+	require.NoError(t, actualEigen.resultErr)
+	require.NotNil(t, actualEigen.result)
+	assert.Equal(t, expectedEigen.result.Eigenvalue, actualEigen.result.Eigenvalue)
+	assert.Equal(t, expectedEigen.renderSectionContent(), actualEigen.renderSectionContent())
+}
+
+func TestMainModelMacroStatus(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	var model tea.Model = NewMainModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+
+	// Assert
+	assert.Nil(t, cmd)
+	assert.Contains(t, model.(MainModel).status, "No macro recorded")
+
+	// Act
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	model, _ = model.Update(runes("i"))
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+
+	// Assert
+	assert.Nil(t, cmd)
+	assert.Contains(t, model.(MainModel).status, "Stop recording")
+
+	// Act
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	// Assert
+	assert.Equal(t, "Recorded a macro of 1 key, ctrl+y to replay it", model.(MainModel).status)
+}
@@ -1,11 +1,22 @@
 package models
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+var (
+	ErrNoResult = errors.New("no result to export, calculate one first")
+	ErrNoReport = errors.New("the active tab has no report, only eigen computations have one")
 )
 
 type Tab int
@@ -14,15 +25,24 @@ const (
 	DerivativeTab Tab = 0
 	IntegralTab   Tab = 1
 	EigenTab      Tab = 2
+	SolveTab      Tab = 3
 )
 
 type MainModel struct {
+	title     string
 	tabs      []string
 	activeTab Tab
 	models    map[Tab]NumeModel
 	size      *tea.WindowSizeMsg
 	keys      help.KeyMap
 	help      help.Model
+	session   *Session
+	palette   CommandPaletteModel
+	// themeIndex is the position of the current theme in Themes
+	themeIndex int
+	status     string
+	// macro records key presses to replay them as a walkthrough
+	macro macroRecorder
 	*Theme
 }
 
@@ -35,28 +55,96 @@ type NumeModel interface {
 	NumeTabContent
 }
 
-func NewMainModel(theme *Theme) MainModel {
-	derivateModel := NewDerivativeModel(theme)
-	integralModel := NewIntegralModel()
-	eigenModel := NewEigenModel(theme)
+// resultRenderer is implemented by the tab models showing a result, as the
+// markdown rendered on their result panel.
+type resultRenderer interface {
+	renderResult() string
+}
+
+// reportBuilder is implemented by the tab models whose result can be written
+// up as a LaTeX document for a report.
+type reportBuilder interface {
+	buildReport() (string, error)
+}
 
-	models := make(map[Tab]NumeModel)
+// textCapturer is implemented by the tab models with text inputs. While one
+// is focused it takes the typed characters ahead of the global keys, so
+// values like 1e-6 or 10k don't switch tabs.
+type textCapturer interface {
+	capturesText() bool
+}
+
+// inFlightCanceller is implemented by the tab models running computations.
+type inFlightCanceller interface {
+	cancelInFlight()
+}
+
+// Messages dispatched by the command palette actions
+type (
+	switchThemeMsg  struct{}
+	copyResultMsg   struct{}
+	exportResultMsg struct{}
+	exportReportMsg struct{}
+	nextProfileMsg  struct{}
+	resetTabMsg     struct{}
+	toggleHelpMsg   struct{}
+)
 
-	models[DerivativeTab] = derivateModel
-	models[IntegralTab] = integralModel
-	models[EigenTab] = eigenModel
+func sendMsg(msg tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return msg
+	}
+}
+
+func mainPaletteActions() []PaletteAction {
+	return []PaletteAction{
+		{Title: "Switch theme", Description: "cycle the color theme", Run: sendMsg(switchThemeMsg{})},
+		{Title: "Copy result", Description: "copy to the clipboard", Run: sendMsg(copyResultMsg{})},
+		{Title: "Export result", Description: "save as markdown", Run: sendMsg(exportResultMsg{})},
+		{Title: "Export report", Description: "save as a LaTeX document", Run: sendMsg(exportReportMsg{})},
+		{Title: "Change precision", Description: "cycle the precision profile", Run: sendMsg(nextProfileMsg{})},
+		{Title: "Reset tab", Description: "clear inputs and result", Run: sendMsg(resetTabMsg{})},
+		{Title: "Toggle help", Description: "show all key bindings", Run: sendMsg(toggleHelpMsg{})},
+		{Title: "Record macro", Description: "start or stop recording keys", Run: sendMsg(toggleRecordingMsg{})},
+		{Title: "Replay macro", Description: "type the recorded keys again", Run: sendMsg(replayMacroMsg{})},
+		{Title: "Quit", Description: "exit nume", Run: tea.Quit},
+	}
+}
+
+func newTabModel(tab Tab, theme *Theme, session *Session) NumeModel {
+	switch tab {
+	case IntegralTab:
+		return NewIntegralModel(theme, session)
+	case EigenTab:
+		return NewEigenModel(theme, session)
+	case SolveTab:
+		return NewLinearSystemModel(theme, session)
+	default:
+		return NewDerivativeModel(theme, session)
+	}
+}
+
+func NewMainModel(theme *Theme, session *Session) MainModel {
+	models := make(map[Tab]NumeModel)
+	for _, tab := range []Tab{DerivativeTab, IntegralTab, EigenTab, SolveTab} {
+		models[tab] = newTabModel(tab, theme, session)
+	}
 
 	return MainModel{
-		tabs:      []string{"d Derivatives", "i Integrals", "e Eigen"},
+		title:     DefaultBranding().Title,
+		tabs:      []string{"d Derivatives", "i Integrals", "e Eigen", "s Solve"},
 		activeTab: DerivativeTab,
 		models:    models,
 		size: &tea.WindowSizeMsg{
 			Width:  0,
 			Height: 0,
 		},
-		keys:  derivateModel.GetHelpKeys(),
-		help:  help.New(),
-		Theme: theme,
+		keys:    models[DerivativeTab].GetHelpKeys(),
+		help:    help.New(),
+		session: session,
+		palette: NewCommandPaletteModel(theme, mainPaletteActions()),
+		macro:   newMacroRecorder(),
+		Theme:   theme,
 	}
 }
 
@@ -65,7 +153,79 @@ func (m MainModel) Init() tea.Cmd {
 }
 
 func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// The open palette owns the keyboard
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.palette.IsOpen() {
+		var cmd tea.Cmd
+		m.palette, cmd = m.palette.Update(keyMsg)
+		return m, cmd
+	}
+
 	switch msg := msg.(type) {
+	case switchThemeMsg:
+		m.themeIndex = (m.themeIndex + 1) % len(Themes)
+		next := Themes[m.themeIndex]
+		// Every tab shares this theme, so updating it in place restyles them all
+		*m.Theme = *next.Build(m.Renderer)
+		m.status = fmt.Sprintf("Theme switched to %s", next.Name)
+		return m, nil
+	case copyResultMsg:
+		result := m.activeResult()
+		if result == "" {
+			m.status = "Nothing to copy, calculate a result first"
+			return m, nil
+		}
+		m.Renderer.Output().Copy(result)
+		m.status = "Result copied to the clipboard"
+		return m, nil
+	case exportResultMsg:
+		if m.session != nil && m.session.Remote {
+			return m.sendResult(), nil
+		}
+
+		path, err := m.exportResult()
+		if err != nil {
+			m.status = fmt.Sprintf("Export failed: %v", err)
+			return m, nil
+		}
+		m.status = fmt.Sprintf("Result exported to %s", path)
+		return m, nil
+	case exportReportMsg:
+		return m.exportReport(), nil
+	case nextProfileMsg:
+		if m.session == nil {
+			m.session = NewSession("")
+		}
+		profile := m.session.NextProfile()
+
+		// The tabs read the defaults when created, so they start over with them
+		var cmds []tea.Cmd
+		for _, tab := range []Tab{DerivativeTab, IntegralTab, EigenTab, SolveTab} {
+			cmds = append(cmds, m.resetTab(tab))
+		}
+		m.status = fmt.Sprintf("Precision profile switched to %s, tabs reset to its defaults", profile)
+		return m, tea.Batch(cmds...)
+	case resetTabMsg:
+		cmd := m.resetTab(m.activeTab)
+		m.status = fmt.Sprintf("%s reset", m.tabName(m.activeTab))
+		return m, cmd
+	case toggleHelpMsg:
+		m.help.ShowAll = !m.help.ShowAll
+		return m, nil
+	case toggleRecordingMsg:
+		m.status = m.macro.toggle()
+		return m, nil
+	case replayMacroMsg:
+		var cmd tea.Cmd
+		cmd, m.status = m.macro.replay()
+		return m, cmd
+	case macroStepMsg:
+		model, cmd := m.Update(msg.keys[0])
+		main, ok := model.(MainModel)
+		if !ok {
+			// A replayed key quit or replaced the main model
+			return model, cmd
+		}
+		return main, tea.Batch(cmd, main.macro.step(msg.keys[1:]))
 	case tea.WindowSizeMsg:
 		m.size = &msg
 		// Set help width for responsive design
@@ -88,6 +248,23 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		return m, tea.Batch(cmds...)
 	case tea.KeyMsg:
+		if key.Matches(msg, paletteKeys.Open) {
+			m.status = ""
+			return m, m.palette.Open()
+		}
+
+		switch {
+		case key.Matches(msg, macroKeys.Record):
+			return m.Update(toggleRecordingMsg{})
+		case key.Matches(msg, macroKeys.Replay):
+			return m.Update(replayMacroMsg{})
+		}
+		m.macro.record(msg)
+
+		if m.typing(msg) {
+			break
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
@@ -112,6 +289,12 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.keys = m.models[m.activeTab].GetHelpKeys()
 			}
 			return m, nil
+		case "s":
+			if m.activeTab != SolveTab {
+				m.activeTab = SolveTab
+				m.keys = m.models[m.activeTab].GetHelpKeys()
+			}
+			return m, nil
 		}
 	}
 
@@ -130,6 +313,13 @@ func (m MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// typing reports whether msg is a character typed into a focused input of
+// the active tab.
+func (m MainModel) typing(msg tea.KeyMsg) bool {
+	capturer, ok := m.models[m.activeTab].(textCapturer)
+	return ok && msg.Type == tea.KeyRunes && capturer.capturesText()
+}
+
 func (m MainModel) View() string {
 	if m.size.Width < MinimalWidth || m.size.Height < MinimalHeight {
 		return lipgloss.Place(
@@ -170,7 +360,7 @@ func (m MainModel) View() string {
 	header := m.Renderer.NewStyle().
 		Bold(true).
 		Foreground(m.Focused.Title.GetForeground()).
-		Render("NUME - Numerical Methods Calculator")
+		Render(m.title)
 
 	// Use the help view directly
 	helpView := m.help.View(m.keys)
@@ -181,8 +371,8 @@ func (m MainModel) View() string {
 		BorderForeground(m.Focused.Base.GetBorderBottomForeground()).
 		Render(helpView)
 
-	// Content area
-	content := m.models[m.activeTab].View()
+	// Content area, the palette is drawn over it when open
+	content := m.palette.Overlay(m.models[m.activeTab].View())
 
 	// Layout
 	flexBox := lipgloss.JoinVertical(
@@ -190,10 +380,10 @@ func (m MainModel) View() string {
 		header,
 		"",
 		tabsRow,
-		"",
+		m.Renderer.NewStyle().Foreground(m.Focused.Description.GetForeground()).Render(m.status),
 		m.Renderer.NewStyle().
 			BorderTop(false).
-			Padding(1).
+			Padding(TabContentPadding).
 			Render(content),
 		"",
 		styledHelp,
@@ -205,3 +395,118 @@ func (m MainModel) View() string {
 		flexBox,
 	)
 }
+
+// activeResult is the result of the active tab as plain markdown, empty when
+// it has none.
+func (m MainModel) activeResult() string {
+	renderer, ok := m.models[m.activeTab].(resultRenderer)
+	if !ok {
+		return ""
+	}
+
+	// Errors are rendered already styled
+	return strings.TrimSpace(ansi.Strip(renderer.renderResult()))
+}
+
+// exportResult writes the result of the active tab to a markdown file in the
+// working directory, returning its path.
+func (m MainModel) exportResult() (string, error) {
+	result := m.activeResult()
+	if result == "" {
+		return "", ErrNoResult
+	}
+
+	return m.writeExport("md", m.exportContent(result))
+}
+
+// exportReport writes the LaTeX report of the active tab next to the markdown
+// exports or, for a remote session, copies it to the clipboard of the client.
+func (m MainModel) exportReport() MainModel {
+	builder, ok := m.models[m.activeTab].(reportBuilder)
+	if !ok {
+		m.status = fmt.Sprintf("Export failed: %v", ErrNoReport)
+		return m
+	}
+
+	report, err := builder.buildReport()
+	if err != nil {
+		m.status = fmt.Sprintf("Export failed: %v", err)
+		return m
+	}
+
+	if m.session != nil && m.session.Remote {
+		m.Renderer.Output().Copy(report)
+		m.status = "Report exported to your clipboard as LaTeX"
+		return m
+	}
+
+	path, err := m.writeExport("tex", report)
+	if err != nil {
+		m.status = fmt.Sprintf("Export failed: %v", err)
+		return m
+	}
+
+	m.status = fmt.Sprintf("Report exported to %s", path)
+	return m
+}
+
+// writeExport writes content to a file of the active tab in the working
+// directory with the extension ext, returning its path.
+func (m MainModel) writeExport(ext string, content string) (string, error) {
+	name := strings.ToLower(strings.Fields(m.tabName(m.activeTab))[0])
+	path := fmt.Sprintf("nume-%s-%d.%s", name, time.Now().Unix(), ext)
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// sendResult copies the markdown export of the active tab to the clipboard
+// of the client, which is how a remote session exports, as a file would be
+// written on the host serving it.
+func (m MainModel) sendResult() MainModel {
+	result := m.activeResult()
+	if result == "" {
+		m.status = fmt.Sprintf("Export failed: %v", ErrNoResult)
+		return m
+	}
+
+	m.Renderer.Output().Copy(m.exportContent(result))
+	m.status = "Result exported to your clipboard as markdown"
+	return m
+}
+
+// resetTab replaces the model of tab with a new one, sized like the one it
+// replaces, returning its Init command.
+func (m *MainModel) resetTab(tab Tab) tea.Cmd {
+	if canceller, ok := m.models[tab].(inFlightCanceller); ok {
+		canceller.cancelInFlight()
+	}
+
+	model := newTabModel(tab, m.Theme, m.session)
+	newModel, _ := model.Update(*m.size)
+	if sameModel, ok := newModel.(NumeModel); ok {
+		model = sameModel
+	}
+	m.models[tab] = model
+
+	if tab == m.activeTab {
+		m.keys = model.GetHelpKeys()
+	}
+
+	return model.Init()
+}
+
+// exportContent is the markdown document exporting result, the result of
+// the active tab.
+func (m MainModel) exportContent(result string) string {
+	return fmt.Sprintf("# %s\n\n%s\n", m.tabName(m.activeTab), result)
+}
+
+// tabName is the label of tab without its shortcut key.
+func (m MainModel) tabName(tab Tab) string {
+	_, name, _ := strings.Cut(m.tabs[tab], " ")
+	return name
+}
@@ -0,0 +1,403 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	ErrInvalidMatrixCell    = errors.New("invalid matrix cell")
+	ErrIncompleteMatrixCell = errors.New("incomplete matrix cell")
+)
+
+// MatrixRowError points at the first malformed cell of a row.
+type MatrixRowError struct {
+	Row    int
+	Column int
+	Cell   string
+	Err    error
+}
+
+func (e MatrixRowError) Error() string {
+	return fmt.Sprintf("row %d, column %d: %q is an %s", e.Row+1, e.Column+1, e.Cell, e.Err)
+}
+
+func (e MatrixRowError) Unwrap() error {
+	return e.Err
+}
+
+// Matrix editor limits
+const (
+	MatrixEditorMinSize   = 1
+	MatrixEditorMaxSize   = 8
+	MatrixEditorCellWidth = 7
+	MatrixEditorCharLimit = 12
+)
+
+// MatrixEditorModel is a grid of editable cells, navigated with the arrow keys,
+// that can grow and shrink and be extracted as a [][]float64.
+type MatrixEditorModel struct {
+	cells     [][]string
+	cursorRow int
+	cursorCol int
+	focused   bool
+
+	theme *Theme
+}
+
+type matrixEditorKeyMap struct {
+	Up           key.Binding
+	Down         key.Binding
+	Left         key.Binding
+	Right        key.Binding
+	Backspace    key.Binding
+	Clear        key.Binding
+	AddRow       key.Binding
+	RemoveRow    key.Binding
+	AddColumn    key.Binding
+	RemoveColumn key.Binding
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view
+func (k matrixEditorKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Left, k.Right}
+}
+
+// FullHelp returns keybindings for the expanded help view
+func (k matrixEditorKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right},
+		{k.Backspace, k.Clear},
+		{k.AddRow, k.RemoveRow, k.AddColumn, k.RemoveColumn},
+	}
+}
+
+var matrixEditorKeys = matrixEditorKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up"),
+		key.WithHelp("↑", "cell above"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down"),
+		key.WithHelp("↓", "cell below"),
+	),
+	Left: key.NewBinding(
+		key.WithKeys("left"),
+		key.WithHelp("←", "cell to the left"),
+	),
+	Right: key.NewBinding(
+		key.WithKeys("right"),
+		key.WithHelp("→", "cell to the right"),
+	),
+	Backspace: key.NewBinding(
+		key.WithKeys("backspace"),
+		key.WithHelp("backspace", "delete character"),
+	),
+	Clear: key.NewBinding(
+		key.WithKeys("delete"),
+		key.WithHelp("del", "clear cell"),
+	),
+	AddRow: key.NewBinding(
+		key.WithKeys("]"),
+		key.WithHelp("]", "add row"),
+	),
+	RemoveRow: key.NewBinding(
+		key.WithKeys("["),
+		key.WithHelp("[", "remove row"),
+	),
+	AddColumn: key.NewBinding(
+		key.WithKeys("}"),
+		key.WithHelp("}", "add column"),
+	),
+	RemoveColumn: key.NewBinding(
+		key.WithKeys("{"),
+		key.WithHelp("{", "remove column"),
+	),
+}
+
+func NewMatrixEditorModel(theme *Theme, matrix [][]float64) MatrixEditorModel {
+	m := MatrixEditorModel{
+		theme: theme,
+	}
+	m.SetMatrix(matrix)
+
+	return m
+}
+
+// SetMatrix replaces the editor content, moving the cursor to the first cell.
+func (m *MatrixEditorModel) SetMatrix(matrix [][]float64) {
+	cells := make([][]string, 0, len(matrix))
+	for _, row := range matrix {
+		cellRow := make([]string, 0, len(row))
+		for _, val := range row {
+			cellRow = append(cellRow, strconv.FormatFloat(val, 'g', -1, 64))
+		}
+		cells = append(cells, cellRow)
+	}
+
+	if len(cells) == 0 || len(cells[0]) == 0 {
+		cells = [][]string{{"0"}}
+	}
+
+	m.cells = cells
+	m.cursorRow = 0
+	m.cursorCol = 0
+}
+
+// Matrix parses every cell, empty cells are read as zero.
+func (m MatrixEditorModel) Matrix() ([][]float64, error) {
+	matrix := make([][]float64, len(m.cells))
+
+	for i, row := range m.cells {
+		matrix[i] = make([]float64, len(row))
+		for j, cell := range row {
+			if strings.TrimSpace(cell) == "" {
+				continue
+			}
+
+			val, err := strconv.ParseFloat(strings.TrimSpace(cell), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w at row %d, column %d: %q", ErrInvalidMatrixCell, i+1, j+1, cell)
+			}
+			matrix[i][j] = val
+		}
+	}
+
+	return matrix, nil
+}
+
+// Validate checks the cells as typed so far, returning the first problem of
+// each malformed row. Cells that are the start of a number, like "-" or "1E",
+// are reported as incomplete rather than invalid.
+func (m MatrixEditorModel) Validate() []MatrixRowError {
+	var problems []MatrixRowError
+
+	for i, row := range m.cells {
+		for j, cell := range row {
+			if err := validateMatrixCell(cell); err != nil {
+				problems = append(problems, MatrixRowError{Row: i, Column: j, Cell: cell, Err: err})
+				break
+			}
+		}
+	}
+
+	return problems
+}
+
+func validateMatrixCell(cell string) error {
+	cell = strings.TrimSpace(cell)
+	if cell == "" {
+		return nil
+	}
+
+	if _, err := strconv.ParseFloat(cell, 64); err == nil {
+		return nil
+	}
+
+	// A prefix of a number becomes one with a trailing digit
+	if _, err := strconv.ParseFloat(cell+"0", 64); err == nil {
+		return ErrIncompleteMatrixCell
+	}
+
+	return ErrInvalidMatrixCell
+}
+
+func (m MatrixEditorModel) Rows() int {
+	return len(m.cells)
+}
+
+func (m MatrixEditorModel) Columns() int {
+	return len(m.cells[0])
+}
+
+// Cursor returns the row and column of the selected cell.
+func (m MatrixEditorModel) Cursor() (int, int) {
+	return m.cursorRow, m.cursorCol
+}
+
+// Cell returns the raw text of a cell.
+func (m MatrixEditorModel) Cell(row, col int) string {
+	return m.cells[row][col]
+}
+
+func (m *MatrixEditorModel) Focus() {
+	m.focused = true
+}
+
+func (m *MatrixEditorModel) Blur() {
+	m.focused = false
+}
+
+func (m MatrixEditorModel) Focused() bool {
+	return m.focused
+}
+
+func (m *MatrixEditorModel) AddRow() {
+	if m.Rows() >= MatrixEditorMaxSize {
+		return
+	}
+
+	row := make([]string, m.Columns())
+	for j := range row {
+		row[j] = "0"
+	}
+	m.cells = append(m.cells, row)
+}
+
+func (m *MatrixEditorModel) RemoveRow() {
+	if m.Rows() <= MatrixEditorMinSize {
+		return
+	}
+
+	m.cells = m.cells[:m.Rows()-1]
+	m.cursorRow = min(m.cursorRow, m.Rows()-1)
+}
+
+func (m *MatrixEditorModel) AddColumn() {
+	if m.Columns() >= MatrixEditorMaxSize {
+		return
+	}
+
+	for i := range m.cells {
+		m.cells[i] = append(m.cells[i], "0")
+	}
+}
+
+func (m *MatrixEditorModel) RemoveColumn() {
+	if m.Columns() <= MatrixEditorMinSize {
+		return
+	}
+
+	for i := range m.cells {
+		m.cells[i] = m.cells[i][:len(m.cells[i])-1]
+	}
+	m.cursorCol = min(m.cursorCol, m.Columns()-1)
+}
+
+func (m MatrixEditorModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m MatrixEditorModel) Update(msg tea.Msg) (MatrixEditorModel, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, matrixEditorKeys.Up):
+		m.cursorRow = (m.cursorRow - 1 + m.Rows()) % m.Rows()
+	case key.Matches(keyMsg, matrixEditorKeys.Down):
+		m.cursorRow = (m.cursorRow + 1) % m.Rows()
+	case key.Matches(keyMsg, matrixEditorKeys.Left):
+		m.cursorCol = (m.cursorCol - 1 + m.Columns()) % m.Columns()
+	case key.Matches(keyMsg, matrixEditorKeys.Right):
+		m.cursorCol = (m.cursorCol + 1) % m.Columns()
+	case key.Matches(keyMsg, matrixEditorKeys.Backspace):
+		cell := m.cells[m.cursorRow][m.cursorCol]
+		if cell != "" {
+			m.cells[m.cursorRow][m.cursorCol] = cell[:len(cell)-1]
+		}
+	case key.Matches(keyMsg, matrixEditorKeys.Clear):
+		m.cells[m.cursorRow][m.cursorCol] = ""
+	case key.Matches(keyMsg, matrixEditorKeys.AddRow):
+		m.AddRow()
+	case key.Matches(keyMsg, matrixEditorKeys.RemoveRow):
+		m.RemoveRow()
+	case key.Matches(keyMsg, matrixEditorKeys.AddColumn):
+		m.AddColumn()
+	case key.Matches(keyMsg, matrixEditorKeys.RemoveColumn):
+		m.RemoveColumn()
+	case keyMsg.Type == tea.KeyRunes:
+		m.typeRunes(keyMsg.Runes)
+	}
+
+	return m, nil
+}
+
+// typeRunes appends the numeric characters to the selected cell, replacing a
+// lone zero so overwriting the default value needs no deletion first.
+func (m *MatrixEditorModel) typeRunes(runes []rune) {
+	cell := m.cells[m.cursorRow][m.cursorCol]
+
+	for _, r := range runes {
+		if !strings.ContainsRune("0123456789.-+E", r) {
+			continue
+		}
+
+		if len(cell) >= MatrixEditorCharLimit {
+			break
+		}
+
+		if cell == "0" && r != '.' {
+			cell = ""
+		}
+		cell += string(r)
+	}
+
+	m.cells[m.cursorRow][m.cursorCol] = cell
+}
+
+func (m MatrixEditorModel) View() string {
+	cellStyle := m.theme.Renderer.NewStyle().
+		Width(MatrixEditorCellWidth).
+		AlignHorizontal(lipgloss.Right)
+
+	selectedStyle := cellStyle.
+		Foreground(m.theme.Focused.Title.GetForeground()).
+		Bold(true).
+		Underline(true)
+
+	invalidStyle := cellStyle.
+		Foreground(m.theme.Focused.ErrorMessage.GetForeground())
+
+	errorStyle := m.theme.Renderer.NewStyle().
+		Foreground(m.theme.Focused.ErrorMessage.GetForeground())
+
+	problems := m.Validate()
+	malformedRows := make(map[int]bool, len(problems))
+	for _, problem := range problems {
+		malformedRows[problem.Row] = true
+	}
+
+	lines := make([]string, 0, m.Rows()+1+len(problems))
+	for i, row := range m.cells {
+		rendered := make([]string, 0, len(row))
+		for j, cell := range row {
+			style := cellStyle
+			if validateMatrixCell(cell) != nil {
+				style = invalidStyle
+			}
+			if m.focused && i == m.cursorRow && j == m.cursorCol {
+				style = selectedStyle
+				if cell == "" {
+					cell = "_"
+				}
+			}
+			rendered = append(rendered, style.Render(cell))
+		}
+
+		opening, closing := "[", " ]"
+		if malformedRows[i] {
+			opening, closing = errorStyle.Render(opening), errorStyle.Render(closing)
+		}
+		lines = append(lines, "  "+opening+strings.Join(rendered, "")+closing)
+	}
+
+	lines = append(lines, fmt.Sprintf("  %dx%d", m.Rows(), m.Columns()))
+	for _, problem := range problems {
+		lines = append(lines, "  "+errorStyle.Render(problem.Error()))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,271 @@
+package models
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func typeKeys(editor MatrixEditorModel, msgs ...tea.KeyMsg) MatrixEditorModel {
+	for _, msg := range msgs {
+		editor, _ = editor.Update(msg)
+	}
+	return editor
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestMatrixEditorCellEditing(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		keys         []tea.KeyMsg
+		expectedCell string
+		expectedRow  int
+		expectedCol  int
+	}{
+		{
+			name:         "Typing replaces a lone zero",
+			keys:         []tea.KeyMsg{runes("3.5")},
+			expectedCell: "3.5",
+		},
+		{
+			name:         "Typing appends to an existing value",
+			keys:         []tea.KeyMsg{{Type: tea.KeyRight}, runes("5")},
+			expectedCell: "15",
+			expectedCol:  1,
+		},
+		{
+			name:         "Non numeric runes are ignored",
+			keys:         []tea.KeyMsg{runes("-2x")},
+			expectedCell: "-2",
+		},
+		{
+			name:         "Backspace deletes the last characters",
+			keys:         []tea.KeyMsg{{Type: tea.KeyDown}, runes("42"), {Type: tea.KeyBackspace}, {Type: tea.KeyBackspace}},
+			expectedCell: "3",
+			expectedRow:  1,
+		},
+		{
+			name:         "Delete clears the cell",
+			keys:         []tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyRight}, {Type: tea.KeyDelete}},
+			expectedCell: "",
+			expectedRow:  1,
+			expectedCol:  1,
+		},
+		{
+			name:         "Navigation wraps around",
+			keys:         []tea.KeyMsg{{Type: tea.KeyUp}, {Type: tea.KeyLeft}},
+			expectedCell: "2",
+			expectedRow:  1,
+			expectedCol:  1,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{0, 1}, {3, 2}})
+			editor.Focus()
+
+			// Act
+			editor = typeKeys(editor, test.keys...)
+
+			// Assert
+			row, col := editor.Cursor()
+			assert.Equal(t, test.expectedRow, row)
+			assert.Equal(t, test.expectedCol, col)
+			assert.Equal(t, test.expectedCell, editor.Cell(row, col))
+		})
+	}
+}
+
+func TestMatrixEditorIgnoresKeysWhenBlurred(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{1}})
+
+	// Act
+	editor = typeKeys(editor, runes("9"))
+
+	// Assert
+	assert.Equal(t, "1", editor.Cell(0, 0))
+}
+
+func TestMatrixEditorResizing(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		keys            []tea.KeyMsg
+		expectedRows    int
+		expectedColumns int
+	}{
+		{
+			name:            "Add row",
+			keys:            []tea.KeyMsg{runes("]")},
+			expectedRows:    3,
+			expectedColumns: 2,
+		},
+		{
+			name:            "Add column",
+			keys:            []tea.KeyMsg{runes("}")},
+			expectedRows:    2,
+			expectedColumns: 3,
+		},
+		{
+			name:            "Remove row and column",
+			keys:            []tea.KeyMsg{runes("["), runes("{")},
+			expectedRows:    1,
+			expectedColumns: 1,
+		},
+		{
+			name:            "Cannot shrink below one cell",
+			keys:            []tea.KeyMsg{runes("["), runes("["), runes("{"), runes("{")},
+			expectedRows:    MatrixEditorMinSize,
+			expectedColumns: MatrixEditorMinSize,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{1, 2}, {3, 4}})
+			editor.Focus()
+
+			// Act
+			editor = typeKeys(editor, test.keys...)
+
+			// Assert
+			assert.Equal(t, test.expectedRows, editor.Rows())
+			assert.Equal(t, test.expectedColumns, editor.Columns())
+		})
+	}
+}
+
+func TestMatrixEditorRemovingClampsCursor(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{1, 2}, {3, 4}})
+	editor.Focus()
+
+	// Act
+	editor = typeKeys(editor,
+		tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyRight},
+		runes("["), runes("{"),
+	)
+
+	// Assert
+	row, col := editor.Cursor()
+	assert.Equal(t, 0, row)
+	assert.Equal(t, 0, col)
+}
+
+func TestMatrixEditorExtraction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Edited and resized matrix", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+		editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{4, 1}, {1, 2}})
+		editor.Focus()
+
+		// Act
+		editor = typeKeys(editor,
+			runes("]"), runes("}"),
+			tea.KeyMsg{Type: tea.KeyUp}, tea.KeyMsg{Type: tea.KeyLeft},
+			runes("-1.5"),
+			tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyRight},
+			tea.KeyMsg{Type: tea.KeyDelete},
+		)
+		matrix, err := editor.Matrix()
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, [][]float64{
+			{0, 1, 0},
+			{1, 2, 0},
+			{0, 0, -1.5},
+		}, matrix)
+	})
+
+	t.Run("Invalid cell", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+		editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{4, 1}, {1, 2}})
+		editor.Focus()
+
+		// Act
+		editor = typeKeys(editor, runes("--"))
+		_, err := editor.Matrix()
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidMatrixCell)
+	})
+}
+
+func TestMatrixEditorValidatesWhileTyping(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{0, 1}, {1, 2}})
+	editor.Focus()
+
+	steps := []struct {
+		key      string
+		cell     string
+		expected error
+	}{
+		{key: "-", cell: "-", expected: ErrIncompleteMatrixCell},
+		{key: "1", cell: "-1"},
+		{key: "E", cell: "-1E", expected: ErrIncompleteMatrixCell},
+		{key: "-", cell: "-1E-", expected: ErrIncompleteMatrixCell},
+		{key: "2", cell: "-1E-2"},
+		{key: ".", cell: "-1E-2.", expected: ErrInvalidMatrixCell},
+	}
+
+	for _, step := range steps {
+		// Act
+		editor = typeKeys(editor, runes(step.key))
+		problems := editor.Validate()
+
+		// Assert
+		require.Equal(t, step.cell, editor.Cell(0, 0))
+		if step.expected == nil {
+			assert.Empty(t, problems, "after typing %q", step.cell)
+			continue
+		}
+		require.Len(t, problems, 1, "after typing %q", step.cell)
+		assert.Equal(t, 0, problems[0].Row)
+		assert.Equal(t, 0, problems[0].Column)
+		assert.ErrorIs(t, problems[0], step.expected, "after typing %q", step.cell)
+	}
+}
+
+func TestMatrixEditorReportsEachMalformedRow(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	editor := NewMatrixEditorModel(newTestTheme(), [][]float64{{4, 1, 0}, {1, 2, 0}, {0, 0, 3}})
+	editor.Focus()
+
+	// Act
+	editor = typeKeys(editor,
+		tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyRight}, runes("+-"),
+		tea.KeyMsg{Type: tea.KeyRight}, runes("1.."),
+	)
+	problems := editor.Validate()
+	view := editor.View()
+
+	// Assert
+	require.Len(t, problems, 1)
+	assert.Equal(t, MatrixRowError{Row: 1, Column: 1, Cell: "2+-", Err: ErrInvalidMatrixCell}, problems[0])
+	assert.Contains(t, view, `row 2, column 2: "2+-" is an invalid matrix cell`)
+	assert.NotContains(t, view, "row 1,")
+	assert.NotContains(t, view, "row 3,")
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+var (
+	ErrInvalidNumber = errors.New("not a number")
+	ErrInvalidCount  = errors.New("not a whole count")
+)
+
+// maxExactCount is the largest count every float64 below it represents
+// exactly, so larger counts would silently be rounded
+const maxExactCount = 1 << 53
+
+// numberSuffixes scale the number they follow, so 5% is 0.05 and 2k is 2000
+var numberSuffixes = map[string]float64{
+	"%": 1e-2,
+	"k": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+	"m": 1e-3,
+	"u": 1e-6,
+	"µ": 1e-6,
+	"n": 1e-9,
+}
+
+// ParseNumber reads a finite number typed by the user, in decimal or
+// scientific notation and optionally followed by a percent sign or an SI
+// suffix from numberSuffixes.
+func ParseNumber(input string) (float64, error) {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" {
+		return 0, fmt.Errorf("%w: input is empty", ErrInvalidNumber)
+	}
+
+	number, scale := trimmed, 1.0
+	for suffix, factor := range numberSuffixes {
+		if before, ok := strings.CutSuffix(trimmed, suffix); ok {
+			number, scale = strings.TrimSpace(before), factor
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, input)
+	}
+
+	value *= scale
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("%w: %q is not finite", ErrInvalidNumber, input)
+	}
+
+	return value, nil
+}
+
+// ParseCount reads a non-negative whole number such as an iteration limit,
+// accepting every form ParseNumber does, so 1e3 and 10k are valid counts.
+func ParseCount(input string) (uint64, error) {
+	value, err := ParseNumber(input)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidCount, input)
+	}
+
+	switch {
+	case value < 0:
+		return 0, fmt.Errorf("%w: %q is negative", ErrInvalidCount, input)
+	case value != math.Trunc(value):
+		return 0, fmt.Errorf("%w: %q has a fractional part", ErrInvalidCount, input)
+	case value > maxExactCount:
+		return 0, fmt.Errorf("%w: %q is larger than %d", ErrInvalidCount, input, uint64(maxExactCount))
+	}
+
+	return uint64(value), nil
+}
+
+// FormatNumber writes value the way a user would type it, 1e-6 rather than
+// 1e-06, so ParseNumber reads it back unchanged.
+func FormatNumber(value float64) string {
+	formatted := strconv.FormatFloat(value, 'g', -1, 64)
+	formatted = strings.Replace(formatted, "e-0", "e-", 1)
+	return strings.Replace(formatted, "e+0", "e+", 1)
+}
+
+func validateNumber(input string) error {
+	_, err := ParseNumber(input)
+	return err
+}
+
+func validateCount(input string) error {
+	_, err := ParseCount(input)
+	return err
+}
+
+type labeledInput struct {
+	label string
+	input textinput.Model
+}
+
+// inputsError returns the validation error of the first invalid input,
+// prefixed with its label, so a calculation never runs on a stale value.
+func inputsError(inputs ...labeledInput) error {
+	for _, labeled := range inputs {
+		if labeled.input.Err != nil {
+			return fmt.Errorf("%s: %w", labeled.label, labeled.input.Err)
+		}
+	}
+
+	return nil
+}
+
+// renderValidatedInput renders input with its validation error, if any, on
+// the line below.
+func (t *Theme) renderValidatedInput(input textinput.Model) string {
+	if input.Err == nil {
+		return input.View()
+	}
+
+	return input.View() + "\n    " + t.Focused.ErrorMessage.Render(input.Err.Error())
+}
@@ -0,0 +1,288 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNumber(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{name: "Integer", input: "42", expected: 42},
+		{name: "Decimal", input: "0.000001", expected: 1e-6},
+		{name: "Negative", input: "-2.5", expected: -2.5},
+		{name: "Scientific", input: "1e-6", expected: 1e-6},
+		{name: "ScientificUppercase", input: "2.5E3", expected: 2500},
+		{name: "Percent", input: "5%", expected: 0.05},
+		{name: "PercentWithSpace", input: "0.5 %", expected: 0.005},
+		{name: "Kilo", input: "2k", expected: 2000},
+		{name: "Mega", input: "1.5M", expected: 1.5e6},
+		{name: "Giga", input: "3G", expected: 3e9},
+		{name: "Milli", input: "4m", expected: 4e-3},
+		{name: "Micro", input: "1u", expected: 1e-6},
+		{name: "MicroSign", input: "1µ", expected: 1e-6},
+		{name: "Nano", input: "7n", expected: 7e-9},
+		{name: "SurroundingSpaces", input: "  3.25  ", expected: 3.25},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			value, err := ParseNumber(test.input)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, value, 1e-9*max(1, test.expected))
+		})
+	}
+}
+
+func TestParseNumberRejectsGarbage(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name  string
+		input string
+	}{
+		{name: "Empty", input: ""},
+		{name: "Blank", input: "   "},
+		{name: "Letters", input: "abc"},
+		{name: "SuffixOnly", input: "%"},
+		{name: "UnknownSuffix", input: "3x"},
+		{name: "TwoSuffixes", input: "2k%"},
+		{name: "TrailingExponent", input: "1e"},
+		{name: "TwoDots", input: "1.2.3"},
+		{name: "Comma", input: "1,5"},
+		{name: "NaN", input: "NaN"},
+		{name: "Infinity", input: "inf"},
+		{name: "Overflow", input: "1e308k"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := ParseNumber(test.input)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidNumber)
+		})
+	}
+}
+
+func TestParseCount(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		input    string
+		expected uint64
+	}{
+		{name: "Integer", input: "100", expected: 100},
+		{name: "Zero", input: "0", expected: 0},
+		{name: "Scientific", input: "1e3", expected: 1000},
+		{name: "Kilo", input: "10k", expected: 10000},
+		{name: "WholeDecimal", input: "25.0", expected: 25},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			count, err := ParseCount(test.input)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, count)
+		})
+	}
+}
+
+func TestParseCountRejectsInvalidCounts(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name  string
+		input string
+	}{
+		{name: "Empty", input: ""},
+		{name: "Garbage", input: "lots"},
+		{name: "Negative", input: "-5"},
+		{name: "Fractional", input: "2.5"},
+		{name: "Percent", input: "50%"},
+		{name: "TooLarge", input: "1e20"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := ParseCount(test.input)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidCount)
+		})
+	}
+}
+
+func TestInvalidInputRejectsCalculation(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.maxIterationsInput.SetValue("many")
+
+	// Act
+	model.generateResult()
+
+	// Assert
+	require.ErrorIs(t, model.resultErr, ErrInvalidCount)
+	assert.ErrorContains(t, model.resultErr, "max iterations")
+	assert.Nil(t, model.result)
+	assert.Contains(t, model.renderValidatedInput(model.maxIterationsInput), ErrInvalidCount.Error())
+}
+
+func TestFormatNumberRoundTrips(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		value    float64
+		expected string
+	}{
+		{name: "Integer", value: 16, expected: "16"},
+		{name: "SmallExponent", value: 1e-6, expected: "1e-6"},
+		{name: "TwoDigitExponent", value: 1e-10, expected: "1e-10"},
+		{name: "Decimal", value: 0.25, expected: "0.25"},
+		{name: "Large", value: 2.5e21, expected: "2.5e+21"},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			formatted := FormatNumber(test.value)
+
+			// Assert
+			assert.Equal(t, test.expected, formatted)
+			parsed, err := ParseNumber(formatted)
+			require.NoError(t, err)
+			assert.InDelta(t, test.value, parsed, 0)
+		})
+	}
+}
+
+func TestMainModelSendsTypedCharactersToTheFocusedInput(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name  string
+		tab   Tab
+		typed string
+		// focus focuses and empties an input of the tab, returning it
+		focus func(model NumeModel) *textinput.Model
+	}{
+		{
+			name:  "DerivativeDelta",
+			tab:   DerivativeTab,
+			typed: "1e-6",
+			focus: func(model NumeModel) *textinput.Model {
+				m := model.(*DerivativeModel)
+				m.focusedSection = SectionArguments
+				m.deltaInput.Focus()
+				m.deltaInput.SetValue("")
+				return &m.deltaInput
+			},
+		},
+		{
+			name:  "IntegralPartitions",
+			tab:   IntegralTab,
+			typed: "10k",
+			focus: func(model NumeModel) *textinput.Model {
+				m := model.(*IntegralModel)
+				m.selectedMode = IntegralModeFixed
+				m.focusedSection = IntegralSectionArguments
+				m.leftInput.Blur()
+				m.partitionsInput.Focus()
+				m.partitionsInput.SetValue("")
+				return &m.partitionsInput
+			},
+		},
+		{
+			name:  "EigenEpsilon",
+			tab:   EigenTab,
+			typed: "1e-6",
+			focus: func(model NumeModel) *textinput.Model {
+				m := model.(*EigenModel)
+				m.setFocusedSection(EigenSectionArguments)
+				m.epsilonInput.Focus()
+				m.epsilonInput.SetValue("")
+				return &m.epsilonInput
+			},
+		},
+		{
+			name:  "LinearSystemIterations",
+			tab:   SolveTab,
+			typed: "10k",
+			focus: func(model NumeModel) *textinput.Model {
+				m := model.(*LinearSystemModel)
+				m.setFocusedSection(LinearSystemSectionArguments)
+				m.maxIterationsInput.Focus()
+				m.maxIterationsInput.SetValue("")
+				return &m.maxIterationsInput
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+			main.activeTab = test.tab
+			input := test.focus(main.models[test.tab])
+			var model tea.Model = main
+
+			// Act
+			for _, r := range test.typed {
+				model, _ = model.Update(runes(string(r)))
+			}
+
+			// Assert
+			require.IsType(t, MainModel{}, model)
+			assert.Equal(t, test.tab, model.(MainModel).activeTab)
+			assert.Equal(t, test.typed, input.Value())
+		})
+	}
+}
+
+func TestMainModelSwitchesTabsWithoutAFocusedInput(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	var model tea.Model = NewMainModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	model, _ = model.Update(runes("e"))
+
+	// Assert
+	assert.Equal(t, EigenTab, model.(MainModel).activeTab)
+}
@@ -0,0 +1,210 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// paletteWidth is the width of the palette box, in cells
+const paletteWidth = 50
+
+// PaletteAction is an entry of the command palette, Run is dispatched when it
+// is selected.
+type PaletteAction struct {
+	Title       string
+	Description string
+	Run         tea.Cmd
+}
+
+// CommandPaletteModel is an overlay listing actions, filtered by what is
+// typed, that any model can open over its own view.
+type CommandPaletteModel struct {
+	actions  []PaletteAction
+	filter   textinput.Model
+	selected int
+	open     bool
+	*Theme
+}
+
+type paletteKeyMap struct {
+	Open   key.Binding
+	Close  key.Binding
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+}
+
+var paletteKeys = paletteKeyMap{
+	Open: key.NewBinding(
+		key.WithKeys(":", "ctrl+p"),
+		key.WithHelp(":/ctrl+p", "command palette"),
+	),
+	Close: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "close palette"),
+	),
+	Up: key.NewBinding(
+		key.WithKeys("up", "ctrl+p", "ctrl+k"),
+		key.WithHelp("↑", "previous action"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "ctrl+n", "ctrl+j"),
+		key.WithHelp("↓", "next action"),
+	),
+	Select: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "run action"),
+	),
+}
+
+func NewCommandPaletteModel(theme *Theme, actions []PaletteAction) CommandPaletteModel {
+	filter := textinput.New()
+	filter.Placeholder = "type to filter"
+	filter.Prompt = ": "
+	filter.CharLimit = 40
+
+	return CommandPaletteModel{
+		actions: actions,
+		filter:  filter,
+		Theme:   theme,
+	}
+}
+
+// IsOpen reports whether the palette is shown and owns the keyboard.
+func (m CommandPaletteModel) IsOpen() bool {
+	return m.open
+}
+
+// Open shows the palette with an empty filter.
+func (m *CommandPaletteModel) Open() tea.Cmd {
+	m.open = true
+	m.selected = 0
+	m.filter.SetValue("")
+	return m.filter.Focus()
+}
+
+// Close hides the palette.
+func (m *CommandPaletteModel) Close() {
+	m.open = false
+	m.filter.Blur()
+}
+
+// Filtered returns the actions whose title contains every word of the
+// filter, ignoring case.
+func (m CommandPaletteModel) Filtered() []PaletteAction {
+	words := strings.Fields(strings.ToLower(m.filter.Value()))
+
+	var filtered []PaletteAction
+	for _, action := range m.actions {
+		title := strings.ToLower(action.Title)
+
+		matches := true
+		for _, word := range words {
+			if !strings.Contains(title, word) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			filtered = append(filtered, action)
+		}
+	}
+
+	return filtered
+}
+
+// Update handles the keys while the palette is open, closing it and returning
+// the Run command of the selected action on enter.
+func (m CommandPaletteModel) Update(msg tea.Msg) (CommandPaletteModel, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.open {
+		return m, nil
+	}
+
+	filtered := m.Filtered()
+
+	switch {
+	case key.Matches(keyMsg, paletteKeys.Close):
+		m.Close()
+		return m, nil
+	case key.Matches(keyMsg, paletteKeys.Select):
+		if len(filtered) == 0 {
+			return m, nil
+		}
+		m.Close()
+		return m, filtered[m.selected].Run
+	case key.Matches(keyMsg, paletteKeys.Up):
+		if len(filtered) > 0 {
+			m.selected = (m.selected - 1 + len(filtered)) % len(filtered)
+		}
+		return m, nil
+	case key.Matches(keyMsg, paletteKeys.Down):
+		if len(filtered) > 0 {
+			m.selected = (m.selected + 1) % len(filtered)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(keyMsg)
+
+	// Keep the selection within the new matches
+	if count := len(m.Filtered()); m.selected >= count {
+		m.selected = max(count-1, 0)
+	}
+
+	return m, cmd
+}
+
+// View renders the palette box, empty when it is closed.
+func (m CommandPaletteModel) View() string {
+	if !m.open {
+		return ""
+	}
+
+	lines := []string{
+		m.Renderer.NewStyle().Bold(true).Foreground(m.Focused.Title.GetForeground()).Render("Command Palette"),
+		m.filter.View(),
+		"",
+	}
+
+	filtered := m.Filtered()
+	if len(filtered) == 0 {
+		lines = append(lines, m.Renderer.NewStyle().Foreground(lipgloss.Color("#666666")).Render("  No matching actions"))
+	}
+
+	for i, action := range filtered {
+		style := m.Blurred.UnselectedPrefix
+		if i == m.selected {
+			style = m.Focused.SelectedPrefix
+		}
+		lines = append(lines, style.Render(fmt.Sprintf("%-18s %s", action.Title, action.Description)))
+	}
+
+	return m.Renderer.NewStyle().
+		Width(paletteWidth).
+		Padding(1, ComponentPadding).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Focused.Base.GetBorderBottomForeground()).
+		Render(strings.Join(lines, "\n"))
+}
+
+// Overlay draws the open palette centered over background, keeping the size
+// of background so the layout around it does not move.
+func (m CommandPaletteModel) Overlay(background string) string {
+	if !m.open {
+		return background
+	}
+
+	return lipgloss.Place(
+		lipgloss.Width(background), lipgloss.Height(background),
+		lipgloss.Center, lipgloss.Center,
+		m.View(),
+	)
+}
@@ -0,0 +1,366 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+type paletteTestMsg struct{ name string }
+
+func newTestPalette() CommandPaletteModel {
+	return NewCommandPaletteModel(newTestTheme(), []PaletteAction{
+		{Title: "Switch theme", Run: sendMsg(paletteTestMsg{name: "theme"})},
+		{Title: "Copy result", Run: sendMsg(paletteTestMsg{name: "copy"})},
+		{Title: "Export result", Run: sendMsg(paletteTestMsg{name: "export"})},
+	})
+}
+
+// typeMainKeys feeds each rune of s to the model as a key press.
+func typeMainKeys(t *testing.T, model tea.Model, s string) tea.Model {
+	t.Helper()
+
+	for _, r := range s {
+		model, _ = model.Update(runes(string(r)))
+	}
+
+	return model
+}
+
+func TestCommandPaletteFiltersActions(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name   string
+		filter string
+		titles []string
+	}{
+		{name: "Empty", filter: "", titles: []string{"Switch theme", "Copy result", "Export result"}},
+		{name: "CaseInsensitive", filter: "COPY", titles: []string{"Copy result"}},
+		{name: "EveryWord", filter: "res exp", titles: []string{"Export result"}},
+		{name: "NoMatch", filter: "precision", titles: nil},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			palette := newTestPalette()
+			palette.Open()
+
+			// Act
+			for _, r := range test.filter {
+				palette, _ = palette.Update(runes(string(r)))
+			}
+
+			// Assert
+			var titles []string
+			for _, action := range palette.Filtered() {
+				titles = append(titles, action.Title)
+			}
+			assert.Equal(t, test.titles, titles)
+		})
+	}
+}
+
+func TestCommandPaletteSelectDispatchesAction(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		keys     []tea.KeyMsg
+		expected paletteTestMsg
+	}{
+		{
+			name:     "First",
+			keys:     []tea.KeyMsg{{Type: tea.KeyEnter}},
+			expected: paletteTestMsg{name: "theme"},
+		},
+		{
+			name:     "Down",
+			keys:     []tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyEnter}},
+			expected: paletteTestMsg{name: "copy"},
+		},
+		{
+			name:     "UpWraps",
+			keys:     []tea.KeyMsg{{Type: tea.KeyUp}, {Type: tea.KeyEnter}},
+			expected: paletteTestMsg{name: "export"},
+		},
+		{
+			name:     "Filtered",
+			keys:     []tea.KeyMsg{runes("e"), runes("x"), {Type: tea.KeyEnter}},
+			expected: paletteTestMsg{name: "export"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			palette := newTestPalette()
+			palette.Open()
+
+			// Act
+			var cmd tea.Cmd
+			for _, key := range test.keys {
+				palette, cmd = palette.Update(key)
+			}
+
+			// Assert
+			assert.False(t, palette.IsOpen())
+			require.NotNil(t, cmd)
+			assert.Equal(t, test.expected, cmd())
+		})
+	}
+}
+
+func TestCommandPaletteEscapeCloses(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	palette := newTestPalette()
+	palette.Open()
+
+	// Act
+	palette, cmd := palette.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Assert
+	assert.False(t, palette.IsOpen())
+	assert.Nil(t, cmd)
+	assert.Empty(t, palette.View())
+}
+
+func TestMainModelPaletteSwitchesTheme(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	theme := newTestTheme()
+	main := NewMainModel(theme, NewSession("gabrigas"))
+	before := theme.Focused.Title.GetForeground()
+
+	// Act
+	model, _ := main.Update(runes(":"))
+	require.True(t, model.(MainModel).palette.IsOpen())
+	model = typeMainKeys(t, model, "theme")
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	model, _ = model.Update(msg)
+
+	// Assert
+	assert.IsType(t, switchThemeMsg{}, msg)
+	main = model.(MainModel)
+	assert.False(t, main.palette.IsOpen())
+	assert.Equal(t, 1, main.themeIndex)
+	assert.NotEqual(t, before, theme.Focused.Title.GetForeground())
+	assert.Contains(t, main.status, Themes[1].Name)
+}
+
+func TestMainModelPaletteOpensWithCtrlP(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	model, _ := main.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	// keys go to the palette filter instead of switching tabs
+	model = typeMainKeys(t, model, "e")
+
+	// Assert
+	main = model.(MainModel)
+	assert.True(t, main.palette.IsOpen())
+	assert.Equal(t, DerivativeTab, main.activeTab)
+}
+
+func TestMainModelCopiesResultToClipboard(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	var output bytes.Buffer
+	main := NewMainModel(ThemeCatppuccin(lipgloss.NewRenderer(&output)), NewSession("gabrigas"))
+	main.models[DerivativeTab].(*DerivativeModel).result = &DerivativeResult{Value: 2}
+
+	// Act
+	model, _ := main.Update(copyResultMsg{})
+
+	// Assert
+	assert.Contains(t, output.String(), "\x1b]52;c;")
+	assert.Equal(t, "Result copied to the clipboard", model.(MainModel).status)
+}
+
+func TestMainModelExportsResult(t *testing.T) {
+	// Arrange
+	t.Chdir(t.TempDir())
+	main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+	main.models[DerivativeTab].(*DerivativeModel).result = &DerivativeResult{Value: 2}
+
+	// Act
+	path, err := main.exportResult()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Regexp(t, `^nume-derivatives-\d+\.md$`, path)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "# Derivatives\n\n2.000000\n", string(content))
+}
+
+func TestMainModelExportWithoutResult(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+
+	// Act
+	_, err := main.exportResult()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNoResult)
+}
+
+func TestMainModelExportsEigenReport(t *testing.T) {
+	// Arrange
+	t.Chdir(t.TempDir())
+	main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+	main.activeTab = EigenTab
+	main.models[EigenTab].(*EigenModel).result = &EigenResult{
+		PowerMethod: usecases.PowerMethodRegular,
+		Params:      usecases.PowerParams{Epsilon: 1e-6, MaxIterations: 100},
+		Matrix:      [][]float64{{2, 1}, {1, 2}},
+		Eigenvalue:  3,
+		Eigenvector: []float64{1, 1},
+		Iterations:  5,
+	}
+
+	// Act
+	model, _ := main.Update(exportReportMsg{})
+
+	// Assert
+	status := model.(MainModel).status
+	require.Regexp(t, `^Report exported to nume-eigen-\d+\.tex$`, status)
+	content, err := os.ReadFile(strings.TrimPrefix(status, "Report exported to "))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\\documentclass{article}")
+	assert.Contains(t, string(content), "\\subsection*{Method: Regular Power Method}")
+	assert.Contains(t, string(content), "\\subsection*{Reconstruction}", "symmetric matrices add their decomposition")
+}
+
+func TestMainModelExportReportWithoutReport(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		tab      Tab
+		expected error
+	}{
+		{name: "TabWithoutReport", tab: DerivativeTab, expected: ErrNoReport},
+		{name: "EigenWithoutResult", tab: EigenTab, expected: ErrNoResult},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+			main.activeTab = test.tab
+
+			// Act
+			model, _ := main.Update(exportReportMsg{})
+
+			// Assert
+			assert.Equal(t, fmt.Sprintf("Export failed: %v", test.expected), model.(MainModel).status)
+		})
+	}
+}
+
+func TestMainModelRemoteExportSendsReportToTheClient(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	t.Chdir(dir)
+	var output bytes.Buffer
+	session := NewSession("gabrigas")
+	session.Remote = true
+	main := NewMainModel(ThemeCatppuccin(lipgloss.NewRenderer(&output)), session)
+	main.activeTab = EigenTab
+	main.models[EigenTab].(*EigenModel).result = &EigenResult{
+		PowerMethod: usecases.PowerMethodRegular,
+		Matrix:      [][]float64{{2, 0}, {1, 1}},
+		Eigenvalue:  2,
+		Eigenvector: []float64{1, 1},
+	}
+
+	// Act
+	model, _ := main.Update(exportReportMsg{})
+
+	// Assert
+	assert.Contains(t, output.String(), "\x1b]52;c;")
+	assert.Equal(t, "Report exported to your clipboard as LaTeX", model.(MainModel).status)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "remote sessions never write on the host")
+}
+
+func TestMainModelResetsActiveTab(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+	main.models[DerivativeTab].(*DerivativeModel).result = &DerivativeResult{Value: 2}
+
+	// Act
+	model, _ := main.Update(resetTabMsg{})
+
+	// Assert
+	main = model.(MainModel)
+	assert.Nil(t, main.models[DerivativeTab].(*DerivativeModel).result)
+	assert.Equal(t, "Derivatives reset", main.status)
+}
+
+func TestMainModelRemoteExportSendsResultToTheClient(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	t.Chdir(dir)
+	var output bytes.Buffer
+	session := NewSession("gabrigas")
+	session.Remote = true
+	main := NewMainModel(ThemeCatppuccin(lipgloss.NewRenderer(&output)), session)
+	main.models[DerivativeTab].(*DerivativeModel).result = &DerivativeResult{Value: 2}
+
+	// Act
+	model, _ := main.Update(exportResultMsg{})
+
+	// Assert
+	assert.Contains(t, output.String(), "\x1b]52;c;")
+	assert.Equal(t, "Result exported to your clipboard as markdown", model.(MainModel).status)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "remote sessions never write on the host")
+}
+
+func TestMainModelCyclesThePrecisionProfile(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	session := NewSessionWithProfile("gabrigas", precision.Balanced)
+	main := NewMainModel(newTestTheme(), session)
+	model, _ := main.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	width := lipgloss.Width(model.(MainModel).models[IntegralTab].View())
+
+	// Act
+	model, _ = model.Update(nextProfileMsg{})
+
+	// Assert
+	main = model.(MainModel)
+	assert.Equal(t, precision.Accurate, session.Profile)
+	assert.Equal(t, precision.Accurate.Defaults(), session.Defaults)
+	assert.Equal(t, precision.Accurate.Defaults().Partitions, main.models[IntegralTab].(*IntegralModel).partitions)
+	assert.Equal(t, width, lipgloss.Width(main.models[IntegralTab].View()), "the new tabs keep the window size")
+	assert.Contains(t, main.status, "accurate")
+
+	model, _ = model.Update(nextProfileMsg{})
+	assert.Equal(t, precision.Fast, session.Profile, "the most accurate profile wraps around")
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+	"math"
+)
+
+// PinnedResult is a result kept aside while the parameters change, so the
+// following computations can be compared against it.
+type PinnedResult struct {
+	// Label describes the pinned computation, such as the function and the
+	// point of a derivative
+	Label string
+	Value float64
+}
+
+// ResultDiff compares a computed value with the pinned one.
+type ResultDiff struct {
+	Pinned  PinnedResult
+	Current float64
+	// Delta is Current minus the pinned value, signed so the direction of the
+	// change is kept
+	Delta float64
+	// Relative is Delta scaled by the magnitude of the pinned value, infinite
+	// when the pinned value is zero and the current one is not
+	Relative float64
+}
+
+// NewResultDiff measures how current changed from the pinned result.
+func NewResultDiff(pinned PinnedResult, current float64) ResultDiff {
+	delta := current - pinned.Value
+
+	relative := delta / math.Abs(pinned.Value)
+	if delta == 0 {
+		relative = 0
+	}
+
+	return ResultDiff{
+		Pinned:   pinned,
+		Current:  current,
+		Delta:    delta,
+		Relative: relative,
+	}
+}
+
+// diffAgainst compares current with pinned, nil when nothing is pinned.
+func diffAgainst(pinned *PinnedResult, current float64) *ResultDiff {
+	if pinned == nil {
+		return nil
+	}
+
+	diff := NewResultDiff(*pinned, current)
+	return &diff
+}
+
+func (d ResultDiff) render() string {
+	return fmt.Sprintf(`- **Pinned**: %.10g (%s)
+- **Change**: %+.6e
+- **Relative change**: %+.2e`,
+		d.Pinned.Value, d.Pinned.Label, d.Delta, d.Relative)
+}
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+func TestNewResultDiff(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		pinned           float64
+		current          float64
+		expectedDelta    float64
+		expectedRelative float64
+	}{
+		{
+			name:             "Increase",
+			pinned:           2,
+			current:          2.5,
+			expectedDelta:    0.5,
+			expectedRelative: 0.25,
+		},
+		{
+			name:             "Decrease",
+			pinned:           4,
+			current:          3,
+			expectedDelta:    -1,
+			expectedRelative: -0.25,
+		},
+		{
+			name:             "Negative pinned value",
+			pinned:           -2,
+			current:          -1,
+			expectedDelta:    1,
+			expectedRelative: 0.5,
+		},
+		{
+			name:             "Unchanged zero",
+			pinned:           0,
+			current:          0,
+			expectedDelta:    0,
+			expectedRelative: 0,
+		},
+		{
+			name:             "Zero pinned value",
+			pinned:           0,
+			current:          1e-3,
+			expectedDelta:    1e-3,
+			expectedRelative: math.Inf(1),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			pinned := PinnedResult{Label: "pinned", Value: test.pinned}
+
+			// Act
+			diff := NewResultDiff(pinned, test.current)
+
+			// Assert
+			assert.Equal(t, pinned, diff.Pinned)
+			assert.InDelta(t, test.current, diff.Current, 0)
+			assert.InDelta(t, test.expectedDelta, diff.Delta, 1e-12)
+			if math.IsInf(test.expectedRelative, 1) {
+				assert.True(t, math.IsInf(diff.Relative, 1))
+			} else {
+				assert.InDelta(t, test.expectedRelative, diff.Relative, 1e-12)
+			}
+		})
+	}
+}
+
+func TestIntegralModelDiffsAgainstPinnedResult(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewIntegralModel(newTestTheme(), NewSession("gabrigas"))
+	model.selectedMode = IntegralModeFixed
+	model.focusedSection = IntegralSectionCalculate
+	model.generateResult()
+	require.NoError(t, model.resultErr)
+	pinned := model.result.Area
+	model.Update(runes("p"))
+
+	// Act
+	model.partitions = 2 * precision.Balanced.Defaults().Partitions
+	model.partitionsInput.SetValue("32")
+	model.generateResult()
+
+	// Assert
+	require.NoError(t, model.resultErr)
+	require.NotNil(t, model.result.Diff)
+	assert.InDelta(t, pinned, model.result.Diff.Pinned.Value, 0)
+	assert.Contains(t, model.result.Diff.Pinned.Label, "16 partitions")
+	assert.InDelta(t, model.result.Area-pinned, model.result.Diff.Delta, 1e-15)
+	assert.InDelta(t, (model.result.Area-pinned)/math.Abs(pinned), model.result.Diff.Relative, 1e-12)
+	// Halving h cuts the trapezoidal error, so the area moves towards the exact value
+	assert.Less(t, math.Abs(model.result.Area-model.result.Exact), math.Abs(pinned-model.result.Exact))
+	assert.Contains(t, model.renderResult(), "Relative change")
+}
+
+func TestPinningWithoutResultUnpins(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewDerivativeModel(newTestTheme(), NewSession("gabrigas"))
+	model.generateResult()
+	require.NoError(t, model.resultErr)
+	model.pinResult()
+	require.NotNil(t, model.pinned)
+	model.result = nil
+
+	// Act
+	model.pinResult()
+	model.generateResult()
+
+	// Assert
+	assert.Nil(t, model.pinned)
+	require.NoError(t, model.resultErr)
+	assert.Nil(t, model.result.Diff)
+}
+
+func TestEigenModelDiffsAgainstPinnedResult(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.generateResult()
+	require.NoError(t, model.resultErr)
+	pinned := model.result.Eigenvalue
+	model.pinResult()
+
+	// Act
+	model.epsilon = 1e-12
+	model.epsilonInput.SetValue("1e-12")
+	model.generateResult()
+
+	// Assert
+	require.NoError(t, model.resultErr)
+	require.NotNil(t, model.result.Diff)
+	assert.Contains(t, model.result.Diff.Pinned.Label, model.matrixOptions[0])
+	assert.InDelta(t, model.result.Eigenvalue-pinned, model.result.Diff.Delta, 1e-15)
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+var (
+	ErrUnnamedMatrix = errors.New("predefined matrix must have a name")
+	ErrEmptyMatrix   = errors.New("predefined matrix must have at least one row")
+)
+
+// NamedMatrix is a matrix offered in the matrix selection of the eigen tab.
+type NamedMatrix struct {
+	Name   string
+	Values [][]float64
+}
+
+// builtinMatrices are always offered, before any registered matrix
+var builtinMatrices = []NamedMatrix{
+	{Name: "2x2 Simple Matrix", Values: [][]float64{{2.0, 3.0}, {5.0, 4.0}}},
+	{Name: "3x3 Simple Matrix", Values: [][]float64{{2.0, 1.0, 0.0}, {1.0, 2.0, 1.0}, {0.0, 1.0, 2.0}}},
+	{Name: "4x4 Simple Matrix", Values: [][]float64{{4.0, 1.0, 0.0, 0.0}, {1.0, 3.0, 1.0, 0.0}, {0.0, 1.0, 3.0, 1.0}, {0.0, 0.0, 1.0, 2.0}}},
+	{Name: "5x5 Real Matrix", Values: [][]float64{{6.0, 1.0, 2.0, 0.0, 0.0}, {1.0, 5.0, 1.0, 1.0, 0.0}, {2.0, 1.0, 4.0, 1.0, 1.0}, {0.0, 1.0, 1.0, 3.0, 1.0}, {0.0, 0.0, 1.0, 1.0, 2.0}}},
+}
+
+// registeredMatrices are shared by every session, so they are set once at
+// startup, like a problem set shipped with the server
+var registeredMatrices struct {
+	sync.RWMutex
+	matrices []NamedMatrix
+}
+
+// RegisterMatrices validates matrices and appends them to the matrices
+// offered by eigen tabs created afterwards. Nothing is registered when any
+// of them is invalid.
+func RegisterMatrices(matrices ...NamedMatrix) error {
+	copies := make([]NamedMatrix, len(matrices))
+	for i, matrix := range matrices {
+		if err := matrix.validate(); err != nil {
+			return fmt.Errorf("matrix %d: %w", i, err)
+		}
+		copies[i] = matrix.clone()
+	}
+
+	registeredMatrices.Lock()
+	defer registeredMatrices.Unlock()
+
+	registeredMatrices.matrices = append(registeredMatrices.matrices, copies...)
+
+	return nil
+}
+
+// eigenMatrices returns the builtin matrices followed by the registered ones.
+func eigenMatrices() []NamedMatrix {
+	registeredMatrices.RLock()
+	defer registeredMatrices.RUnlock()
+
+	matrices := make([]NamedMatrix, 0, len(builtinMatrices)+len(registeredMatrices.matrices))
+	for _, matrix := range builtinMatrices {
+		matrices = append(matrices, matrix.clone())
+	}
+	for _, matrix := range registeredMatrices.matrices {
+		matrices = append(matrices, matrix.clone())
+	}
+
+	return matrices
+}
+
+// validate checks that the matrix is named and square.
+func (m NamedMatrix) validate() error {
+	if m.Name == "" {
+		return ErrUnnamedMatrix
+	}
+
+	if len(m.Values) == 0 {
+		return fmt.Errorf("%q: %w", m.Name, ErrEmptyMatrix)
+	}
+
+	for i, row := range m.Values {
+		if len(row) != len(m.Values) {
+			return fmt.Errorf("%q: %w, row %d has %d columns for %d rows",
+				m.Name, usecases.ErrNonSquareMatrix, i, len(row), len(m.Values))
+		}
+	}
+
+	return nil
+}
+
+// clone deep copies the matrix, so editing it in a tab leaves the option
+// untouched.
+func (m NamedMatrix) clone() NamedMatrix {
+	values := make([][]float64, len(m.Values))
+	for i, row := range m.Values {
+		values[i] = append([]float64(nil), row...)
+	}
+
+	return NamedMatrix{Name: m.Name, Values: values}
+}
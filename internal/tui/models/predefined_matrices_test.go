@@ -0,0 +1,101 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+// registerTestMatrices registers matrices for a single test, so it must not
+// run in parallel with tests creating eigen models.
+func registerTestMatrices(t *testing.T, matrices ...NamedMatrix) error {
+	t.Helper()
+
+	t.Cleanup(func() {
+		registeredMatrices.Lock()
+		defer registeredMatrices.Unlock()
+		registeredMatrices.matrices = nil
+	})
+
+	return RegisterMatrices(matrices...)
+}
+
+func TestRegisteredMatricesAreSelectable(t *testing.T) {
+	// Arrange
+	exercise := NamedMatrix{Name: "Exercise 1", Values: [][]float64{{4, 1}, {2, 3}}}
+	require.NoError(t, registerTestMatrices(t, exercise))
+	model := NewEigenModel(newTestTheme(), NewSession("gabrigas"))
+	model.setFocusedSection(EigenSectionMatrixSelection)
+
+	// Act
+	model.handleUp()
+	model.generateResult()
+
+	// Assert
+	assert.Equal(t, len(builtinMatrices)+1, len(model.matrixOptions))
+	assert.Equal(t, "Exercise 1", model.matrixOptions[model.selectedMatrix])
+	matrix, err := model.matrixEditor.Matrix()
+	require.NoError(t, err)
+	assert.Equal(t, exercise.Values, matrix)
+	require.NoError(t, model.resultErr)
+	assert.InDelta(t, 5.0, model.result.Eigenvalue, 1e-5)
+}
+
+func TestRegisteredMatricesAreCopied(t *testing.T) {
+	// Arrange
+	values := [][]float64{{1, 0}, {0, 1}}
+	require.NoError(t, registerTestMatrices(t, NamedMatrix{Name: "Identity", Values: values}))
+
+	// Act
+	values[0][0] = 42
+	matrices := eigenMatrices()
+
+	// Assert
+	assert.Equal(t, [][]float64{{1, 0}, {0, 1}}, matrices[len(matrices)-1].Values)
+}
+
+func TestRegisterMatricesRejectsInvalidMatrices(t *testing.T) {
+	tt := []struct {
+		name     string
+		matrix   NamedMatrix
+		expected error
+	}{
+		{
+			name:     "Unnamed",
+			matrix:   NamedMatrix{Values: [][]float64{{1}}},
+			expected: ErrUnnamedMatrix,
+		},
+		{
+			name:     "Empty",
+			matrix:   NamedMatrix{Name: "Empty"},
+			expected: ErrEmptyMatrix,
+		},
+		{
+			name:     "Rectangular",
+			matrix:   NamedMatrix{Name: "Wide", Values: [][]float64{{1, 2, 3}, {4, 5, 6}}},
+			expected: usecases.ErrNonSquareMatrix,
+		},
+		{
+			name:     "Ragged",
+			matrix:   NamedMatrix{Name: "Ragged", Values: [][]float64{{1, 2}, {3}}},
+			expected: usecases.ErrNonSquareMatrix,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			valid := NamedMatrix{Name: "Valid", Values: [][]float64{{1}}}
+
+			// Act
+			err := registerTestMatrices(t, valid, test.matrix)
+
+			// Assert
+			require.ErrorIs(t, err, test.expected)
+			assert.Len(t, eigenMatrices(), len(builtinMatrices), "no matrix is registered when one is invalid")
+		})
+	}
+}
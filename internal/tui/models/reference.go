@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrorEstimate compares a computed value with a reference the user trusts,
+// such as an analytic result or a high-accuracy estimate.
+type ErrorEstimate struct {
+	Reference float64
+	Absolute  float64
+	// Relative is Absolute scaled by the magnitude of Reference, infinite when
+	// the reference is zero and the value is not
+	Relative float64
+}
+
+// NewErrorEstimate measures how far value is from reference.
+func NewErrorEstimate(value, reference float64) ErrorEstimate {
+	absolute := math.Abs(value - reference)
+
+	relative := absolute / math.Abs(reference)
+	if absolute == 0 {
+		relative = 0
+	}
+
+	return ErrorEstimate{
+		Reference: reference,
+		Absolute:  absolute,
+		Relative:  relative,
+	}
+}
+
+// parseReference reads the optional reference input, returning false when it
+// is empty or not a number.
+func parseReference(input string) (float64, bool) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, false
+	}
+
+	reference, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return reference, true
+}
+
+func (e ErrorEstimate) render() string {
+	return fmt.Sprintf(`- **Reference**: %.6f
+- **Absolute error**: %.2e
+- **Relative error**: %.2e`,
+		e.Reference, e.Absolute, e.Relative)
+}
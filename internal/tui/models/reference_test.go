@@ -0,0 +1,105 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewErrorEstimate(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		value            float64
+		reference        float64
+		expectedAbsolute float64
+		expectedRelative float64
+	}{
+		{
+			name:             "Above the reference",
+			value:            5.5,
+			reference:        5,
+			expectedAbsolute: 0.5,
+			expectedRelative: 0.1,
+		},
+		{
+			name:             "Below a negative reference",
+			value:            -2.2,
+			reference:        -2,
+			expectedAbsolute: 0.2,
+			expectedRelative: 0.1,
+		},
+		{
+			name:             "Exact",
+			value:            3,
+			reference:        3,
+			expectedAbsolute: 0,
+			expectedRelative: 0,
+		},
+		{
+			name:             "Both zero",
+			value:            0,
+			reference:        0,
+			expectedAbsolute: 0,
+			expectedRelative: 0,
+		},
+		{
+			name:             "Zero reference",
+			value:            1e-3,
+			reference:        0,
+			expectedAbsolute: 1e-3,
+			expectedRelative: math.Inf(1),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			estimate := NewErrorEstimate(test.value, test.reference)
+
+			// Assert
+			assert.Equal(t, test.reference, estimate.Reference)
+			assert.InDelta(t, test.expectedAbsolute, estimate.Absolute, 1e-12)
+			if math.IsInf(test.expectedRelative, 1) {
+				assert.True(t, math.IsInf(estimate.Relative, 1))
+			} else {
+				assert.InDelta(t, test.expectedRelative, estimate.Relative, 1e-12)
+			}
+		})
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		input         string
+		expected      float64
+		expectedFound bool
+	}{
+		{name: "Number", input: "5", expected: 5, expectedFound: true},
+		{name: "Scientific notation with spaces", input: " 1e-3 ", expected: 1e-3, expectedFound: true},
+		{name: "Empty", input: "", expectedFound: false},
+		{name: "Not a number", input: "five", expectedFound: false},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			reference, found := parseReference(test.input)
+
+			// Assert
+			assert.Equal(t, test.expectedFound, found)
+			assert.Equal(t, test.expected, reference)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+// Session identifies a single TUI session (a local run or an SSH connection)
+// and carries the logger used for every computation triggered from it, along
+// with the defaults of the precision profile the tabs start with. Remote
+// sessions are served over SSH, so nothing they do may touch the host
+// filesystem.
+type Session struct {
+	ID       string
+	User     string
+	Logger   *slog.Logger
+	Profile  precision.Profile
+	Defaults precision.Defaults
+	Remote   bool
+
+	requestCounter *atomic.Uint64
+}
+
+type (
+	sessionIDContextKey struct{}
+	requestIDContextKey struct{}
+	loggerContextKey    struct{}
+)
+
+const sessionIDLength = 8
+
+func NewSession(user string) *Session {
+	return NewSessionWithProfile(user, precision.Balanced)
+}
+
+// NewSessionWithProfile is NewSession with the defaults of profile.
+func NewSessionWithProfile(user string, profile precision.Profile) *Session {
+	id := generateID()
+
+	return &Session{
+		ID:   id,
+		User: user,
+		// The session and request IDs come from the context through
+		// ContextHandler, adding them here would log them twice
+		Logger:         slog.Default().With(slog.String("user", user)),
+		Profile:        profile,
+		Defaults:       profile.Defaults(),
+		requestCounter: &atomic.Uint64{},
+	}
+}
+
+// NewRequestContext builds a cancellable context for a single computation,
+// carrying the session ID, a fresh request ID and the per-session logger,
+// which gets both IDs from the context when logging through ContextHandler.
+func (s *Session) NewRequestContext(parent context.Context) (context.Context, context.CancelFunc) {
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	requestID := s.nextRequestID()
+
+	ctx := context.WithValue(parent, sessionIDContextKey{}, s.ID)
+	ctx = context.WithValue(ctx, requestIDContextKey{}, requestID)
+	ctx = context.WithValue(ctx, loggerContextKey{}, s.Logger)
+
+	return context.WithCancel(ctx)
+}
+
+// NextProfile switches the session to the profile after its own, from the
+// fastest to the most accurate and back, returning it.
+func (s *Session) NextProfile() precision.Profile {
+	profiles := precision.Profiles()
+	next := profiles[0]
+	for i, profile := range profiles {
+		if profile == s.Profile {
+			next = profiles[(i+1)%len(profiles)]
+		}
+	}
+
+	s.Profile = next
+	s.Defaults = next.Defaults()
+
+	return next
+}
+
+// numericDefaults returns the defaults of the session profile, the tabs
+// being also created without a session.
+func (s *Session) numericDefaults() precision.Defaults {
+	if s == nil {
+		return precision.Balanced.Defaults()
+	}
+	return s.Defaults
+}
+
+// numericProfile returns the session profile, Balanced without a session as
+// for numericDefaults.
+func (s *Session) numericProfile() precision.Profile {
+	if s == nil {
+		return precision.Balanced
+	}
+	return s.Profile
+}
+
+func (s *Session) nextRequestID() string {
+	return s.ID + "-" + strconv.FormatUint(s.requestCounter.Add(1), 10)
+}
+
+func SessionIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(sessionIDContextKey{}).(string)
+	return id, ok
+}
+
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey{}).(string)
+	return id, ok
+}
+
+// LoggerFromContext returns the per-request logger, falling back to the
+// default logger when the context was not built from a Session.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerContextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// ContextHandler decorates a slog.Handler adding the session and request IDs
+// found in the context, so the use cases logging through slog.*Context get
+// correlated per computation without knowing about sessions.
+type ContextHandler struct {
+	slog.Handler
+}
+
+var _ slog.Handler = (*ContextHandler)(nil)
+
+func NewContextHandler(next slog.Handler) *ContextHandler {
+	return &ContextHandler{Handler: next}
+}
+
+// Handle implements slog.Handler.
+func (h *ContextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id, ok := SessionIDFromContext(ctx); ok {
+		record.AddAttrs(slog.String("session_id", id))
+	}
+	if id, ok := RequestIDFromContext(ctx); ok {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+func generateID() string {
+	buf := make([]byte, sessionIDLength)
+	if _, err := rand.Read(buf); err != nil {
+		slog.Error("failed to generate random session id", slog.Any("error", err))
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+func TestAccurateProfileRaisesTheTabDefaults(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	balanced := NewSessionWithProfile("gabrigas", precision.Balanced)
+	accurate := NewSessionWithProfile("gabrigas", precision.Accurate)
+
+	// Act
+	balancedEigen, accurateEigen := NewEigenModel(newTestTheme(), balanced), NewEigenModel(newTestTheme(), accurate)
+	balancedSystem := NewLinearSystemModel(newTestTheme(), balanced)
+	accurateSystem := NewLinearSystemModel(newTestTheme(), accurate)
+	balancedIntegral := NewIntegralModel(newTestTheme(), balanced)
+	accurateIntegral := NewIntegralModel(newTestTheme(), accurate)
+
+	// Assert
+	assert.Less(t, accurateEigen.epsilon, balancedEigen.epsilon)
+	assert.Greater(t, accurateEigen.maxIterations, balancedEigen.maxIterations)
+	assert.Less(t, accurateSystem.epsilon, balancedSystem.epsilon)
+	assert.Greater(t, accurateSystem.maxIterations, balancedSystem.maxIterations)
+	assert.Less(t, accurateIntegral.tolerance, balancedIntegral.tolerance)
+	assert.Greater(t, accurateIntegral.partitions, balancedIntegral.partitions)
+
+	assert.Equal(t, "1e-10", accurateEigen.epsilonInput.Value(), "inputs show the profile defaults")
+	assert.Equal(t, "1000", accurateSystem.maxIterationsInput.Value())
+	assert.Equal(t, "1e-11", accurateIntegral.toleranceInput.Value())
+	assert.Equal(t, "64", accurateIntegral.partitionsInput.Value())
+}
+
+func TestNewSessionUsesTheBalancedProfile(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	session := NewSession("gabrigas")
+
+	// Assert
+	assert.Equal(t, precision.Balanced.Defaults(), session.Defaults)
+}
+
+func TestRequestLoggerHasEachIDOnce(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	var output bytes.Buffer
+	session := NewSession("gabrigas")
+	session.Logger = slog.New(NewContextHandler(slog.NewJSONHandler(&output, nil))).
+		With(slog.String("user", session.User))
+	ctx, cancel := session.NewRequestContext(context.Background())
+	defer cancel()
+
+	// Act
+	LoggerFromContext(ctx).InfoContext(ctx, "computing")
+
+	// Assert
+	requestID, _ := RequestIDFromContext(ctx)
+	line := output.String()
+	assert.Equal(t, 1, strings.Count(line, `"session_id":"`+session.ID+`"`))
+	assert.Equal(t, 1, strings.Count(line, `"request_id":"`+requestID+`"`))
+	assert.Equal(t, 1, strings.Count(line, `"user":"gabrigas"`))
+}
@@ -7,29 +7,75 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/taldoflemis/nume/internal/precision"
 )
 
 type WelcomeModel struct {
-	text      string
+	text      []rune
 	textIndex int
 	finished  bool
 	size      tea.WindowSizeMsg
 	term      string
 	profile   string
 	user      string
+	session   *Session
+	timing    WelcomeTiming
+	branding  Branding
 	*Theme
 }
 
+// WelcomeTiming controls the welcome animation, the delay between each typed
+// letter and how long the finished screen stays before the main model.
+type WelcomeTiming struct {
+	AnimationDelay  time.Duration
+	TransitionDelay time.Duration
+}
+
+// DefaultWelcomeTiming returns the timing used when none is configured.
+func DefaultWelcomeTiming() WelcomeTiming {
+	return WelcomeTiming{
+		AnimationDelay:  AnimationDelay * time.Millisecond,
+		TransitionDelay: TransitionDelay * time.Millisecond,
+	}
+}
+
+// Branding names the application, Title heads the main screen and
+// WelcomeText is typed on the welcome screen.
+type Branding struct {
+	Title       string
+	WelcomeText string
+}
+
+// DefaultBranding returns the branding used when none is configured.
+func DefaultBranding() Branding {
+	return Branding{
+		Title:       "NUME - Numerical Methods Calculator",
+		WelcomeText: "nume",
+	}
+}
+
 type tickMsg time.Time
 
-func NewWelcomeModel(theme *Theme, term, profile, user string) WelcomeModel {
+// NewWelcomeModel starts a session whose tabs use the defaults of
+// precisionProfile, profile being the color profile of the terminal.
+func NewWelcomeModel(
+	theme *Theme,
+	term, profile, user string,
+	timing WelcomeTiming,
+	branding Branding,
+	precisionProfile precision.Profile,
+) WelcomeModel {
 	return WelcomeModel{
-		text:      "nume",
+		text:      []rune(branding.WelcomeText),
 		textIndex: 0,
 		finished:  false,
 		term:      term,
 		profile:   profile,
 		user:      user,
+		session:   NewSessionWithProfile(user, precisionProfile),
+		timing:    timing,
+		branding:  branding,
 		size: tea.WindowSizeMsg{
 			Width:  MinimalWidth,
 			Height: MinimalHeight,
@@ -38,8 +84,15 @@ func NewWelcomeModel(theme *Theme, term, profile, user string) WelcomeModel {
 	}
 }
 
-func (WelcomeModel) Init() tea.Cmd {
-	return tick()
+// Remote marks the session as served over SSH, so results are exported to
+// the client instead of to files on the host.
+func (m WelcomeModel) Remote() WelcomeModel {
+	m.session.Remote = true
+	return m
+}
+
+func (m WelcomeModel) Init() tea.Cmd {
+	return m.tick()
 }
 
 func (m WelcomeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -48,16 +101,19 @@ func (m WelcomeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.String() == "ctrl+c" {
 			return m, tea.Quit
 		}
+
+		// Any other key skips the animation
+		return m.skipToMain(), nil
 	case tea.WindowSizeMsg:
 		m.size = msg
 
 	case tickMsg:
 		if m.textIndex < len(m.text) {
 			m.textIndex++
-			return m, tick()
+			return m, m.tick()
 		} else if !m.finished {
 			m.finished = true
-			return m, tea.Tick(time.Millisecond*TransitionDelay, func(_ time.Time) tea.Msg {
+			return m, tea.Tick(m.timing.TransitionDelay, func(_ time.Time) tea.Msg {
 				return transitionMsg{}
 			})
 		}
@@ -93,7 +149,7 @@ func (m WelcomeModel) View() string {
 	}
 
 	// Show animated text
-	displayText := m.text[:m.textIndex]
+	displayText := string(m.text[:m.textIndex])
 
 	// Add blinking cursor if not finished
 	if !m.finished && m.textIndex < len(m.text) {
@@ -134,14 +190,15 @@ func (m WelcomeModel) View() string {
 }
 
 func (m WelcomeModel) skipToMain() tea.Model {
-	model := NewMainModel(m.Theme)
+	model := NewMainModel(m.Theme, m.session)
+	model.title = m.branding.Title
 	model.size.Height = m.size.Height
 	model.size.Width = m.size.Width
 	return model
 }
 
-func tick() tea.Cmd {
-	return tea.Tick(time.Millisecond*AnimationDelay, func(t time.Time) tea.Msg {
+func (m WelcomeModel) tick() tea.Cmd {
+	return tea.Tick(m.timing.AnimationDelay, func(t time.Time) tea.Msg {
 		return tickMsg(t)
 	})
 }
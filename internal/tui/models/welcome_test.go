@@ -0,0 +1,125 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+func TestWelcomeModelKeypressSkipsAnimation(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name string
+		msg  tea.KeyMsg
+	}{
+		{name: "Letter", msg: runes("a")},
+		{name: "Enter", msg: tea.KeyMsg{Type: tea.KeyEnter}},
+		{name: "Space", msg: tea.KeyMsg{Type: tea.KeySpace}},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			welcome := NewWelcomeModel(newTestTheme(), "xterm", "TrueColor", "gabrigas", DefaultWelcomeTiming(), DefaultBranding(), precision.Balanced)
+			welcome.size = tea.WindowSizeMsg{Width: 120, Height: 40}
+
+			// Act
+			model, cmd := welcome.Update(test.msg)
+
+			// Assert
+			assert.Nil(t, cmd)
+			main, ok := model.(MainModel)
+			require.True(t, ok, "expected MainModel, got %T", model)
+			assert.Equal(t, 120, main.size.Width)
+			assert.Equal(t, 40, main.size.Height)
+		})
+	}
+}
+
+func TestWelcomeModelCtrlCQuits(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	welcome := NewWelcomeModel(newTestTheme(), "xterm", "TrueColor", "gabrigas", DefaultWelcomeTiming(), DefaultBranding(), precision.Balanced)
+
+	// Act
+	model, cmd := welcome.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	// Assert
+	assert.IsType(t, WelcomeModel{}, model)
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+}
+
+func TestWelcomeModelUsesConfiguredTiming(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	welcome := NewWelcomeModel(newTestTheme(), "xterm", "TrueColor", "gabrigas", WelcomeTiming{
+		AnimationDelay:  time.Millisecond,
+		TransitionDelay: time.Millisecond,
+	}, DefaultBranding(), precision.Balanced)
+	welcome.textIndex = len(welcome.text)
+
+	// Act
+	start := time.Now()
+	model, cmd := welcome.Update(tickMsg(start))
+	msg := cmd()
+
+	// Assert
+	assert.Less(t, time.Since(start), TransitionDelay*time.Millisecond)
+	assert.IsType(t, transitionMsg{}, msg)
+	_, ok := model.(WelcomeModel)
+	assert.True(t, ok)
+}
+
+func TestWelcomeModelUsesConfiguredBranding(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	branding := Branding{Title: "Cálculo Numérico - UFC", WelcomeText: "cálculo"}
+	welcome := NewWelcomeModel(newTestTheme(), "xterm", "TrueColor", "gabrigas", DefaultWelcomeTiming(), branding, precision.Balanced)
+	welcome.size = tea.WindowSizeMsg{Width: 120, Height: 40}
+	welcome.textIndex = len(welcome.text)
+	welcome.finished = true
+
+	// Act
+	welcomeView := welcome.View()
+	model, _ := welcome.Update(transitionMsg{})
+
+	// Assert
+	assert.Contains(t, welcomeView, "CÁLCULO")
+	main, ok := model.(MainModel)
+	require.True(t, ok, "expected MainModel, got %T", model)
+	assert.Contains(t, main.View(), "Cálculo Numérico - UFC")
+	assert.NotContains(t, main.View(), DefaultBranding().Title)
+}
+
+func TestMainModelDefaultsToDefaultTitle(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	main := NewMainModel(newTestTheme(), NewSession("gabrigas"))
+	main.size = &tea.WindowSizeMsg{Width: 120, Height: 40}
+
+	// Act
+	view := main.View()
+
+	// Assert
+	assert.Contains(t, view, DefaultBranding().Title)
+}
+
+func TestWelcomeModelRemoteMarksTheSession(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	welcome := NewWelcomeModel(newTestTheme(), "xterm", "TrueColor", "gabrigas", DefaultWelcomeTiming(), DefaultBranding(), precision.Balanced)
+
+	// Act
+	remote := welcome.Remote()
+
+	// Assert
+	assert.True(t, remote.session.Remote)
+}
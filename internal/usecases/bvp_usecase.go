@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrTooFewSubintervals = numeerr.New(numeerr.CodeInvalidInput, "boundary value problem needs at least 2 subintervals")
+
+// BVPSample is the approximate solution Y at the mesh point X.
+type BVPSample struct {
+	X float64
+	Y float64
+}
+
+// SolveBVP solves y" + p(x) y' + q(x) y = r(x) on [a, b] with y(a) = alpha
+// and y(b) = beta. Central differences on a uniform mesh of n subintervals
+// turn it into a tridiagonal system for the n-1 interior values, so the
+// error is O(h²). The samples include both boundary points.
+func (u *ODEUseCase) SolveBVP(
+	ctx context.Context,
+	p, q, r expressions.SingleVariableExpr,
+	a, b float64,
+	alpha, beta float64,
+	n int,
+) ([]BVPSample, error) {
+	slog.DebugContext(ctx, "Starting the finite difference BVP solver",
+		slog.Float64("a", a),
+		slog.Float64("b", b),
+		slog.Float64("alpha", alpha),
+		slog.Float64("beta", beta),
+		slog.Int("n", n),
+	)
+
+	if n < 2 {
+		return nil, fmt.Errorf("%w: got %d", ErrTooFewSubintervals, n)
+	}
+	if a == b {
+		return nil, ErrZeroWidthInterval
+	}
+
+	h := (b - a) / float64(n)
+	interior := n - 1
+
+	lower := make([]float64, interior-1)
+	diagonal := make([]float64, interior)
+	upper := make([]float64, interior-1)
+	rhs := make([]float64, interior)
+
+	// Row i multiplies the discretized equation at x_i by h²
+	for i := range interior {
+		x := a + float64(i+1)*h
+		below := 1 - h*p(x)/2
+		above := 1 + h*p(x)/2
+
+		diagonal[i] = -2 + h*h*q(x)
+		rhs[i] = h * h * r(x)
+
+		if i > 0 {
+			lower[i-1] = below
+		} else {
+			rhs[i] -= below * alpha
+		}
+
+		if i < interior-1 {
+			upper[i] = above
+		} else {
+			rhs[i] -= above * beta
+		}
+	}
+
+	result, err := u.linearSystem.Thomas(ctx, lower, diagonal, upper, rhs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to solve the discretized BVP: %w", err)
+	}
+
+	samples := make([]BVPSample, 0, n+1)
+	samples = append(samples, BVPSample{X: a, Y: alpha})
+	for i, y := range result.Solution {
+		samples = append(samples, BVPSample{X: a + float64(i+1)*h, Y: y})
+	}
+	samples = append(samples, BVPSample{X: b, Y: beta})
+
+	slog.InfoContext(ctx, "Finished the finite difference BVP solver",
+		slog.Int("n", n),
+		slog.Float64("residual", result.Residual),
+	)
+
+	return samples, nil
+}
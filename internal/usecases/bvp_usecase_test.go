@@ -0,0 +1,142 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+func constant(value float64) expressions.SingleVariableExpr {
+	return func(float64) float64 { return value }
+}
+
+func TestSolveBVP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		p, q, r   expressions.SingleVariableExpr
+		a, b      float64
+		analytic  func(float64) float64
+		tolerance float64
+	}{
+		{
+			// Central differences are exact for cubics
+			name:      "Cubic",
+			p:         constant(0),
+			q:         constant(0),
+			r:         func(x float64) float64 { return 6 * x },
+			a:         0,
+			b:         2,
+			analytic:  func(x float64) float64 { return x * x * x },
+			tolerance: 1e-10,
+		},
+		{
+			name:      "Sine",
+			p:         constant(0),
+			q:         constant(1),
+			r:         constant(0),
+			a:         0,
+			b:         math.Pi / 2,
+			analytic:  math.Sin,
+			tolerance: 1e-4,
+		},
+		{
+			name:      "First derivative term",
+			p:         constant(-2),
+			q:         constant(1),
+			r:         constant(0),
+			a:         0,
+			b:         1,
+			analytic:  func(x float64) float64 { return x * math.Exp(x) },
+			tolerance: 1e-4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewODEUseCase()
+
+			// Act
+			samples, err := useCase.SolveBVP(context.Background(), test.p, test.q, test.r,
+				test.a, test.b, test.analytic(test.a), test.analytic(test.b), 100)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, samples, 101)
+			assert.InDelta(t, test.b, samples[100].X, 1e-12)
+			for _, sample := range samples {
+				assert.InDelta(t, test.analytic(sample.X), sample.Y, test.tolerance, "at x = %v", sample.X)
+			}
+		})
+	}
+}
+
+func TestSolveBVPIsSecondOrder(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewODEUseCase()
+	maxError := func(n int) float64 {
+		samples, err := useCase.SolveBVP(context.Background(), constant(0), constant(1), constant(0), 0, math.Pi/2, 0, 1, n)
+		require.NoError(t, err)
+
+		largest := 0.0
+		for _, sample := range samples {
+			largest = max(largest, math.Abs(sample.Y-math.Sin(sample.X)))
+		}
+		return largest
+	}
+
+	// Act
+	coarse, fine := maxError(20), maxError(40)
+
+	// Assert
+	assert.InDelta(t, 2, math.Log2(coarse/fine), 0.1)
+}
+
+func TestSolveBVPRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a, b     float64
+		n        int
+		expected error
+	}{
+		{
+			name:     "Single subinterval",
+			a:        0,
+			b:        1,
+			n:        1,
+			expected: ErrTooFewSubintervals,
+		},
+		{
+			name:     "Zero width interval",
+			a:        1,
+			b:        1,
+			n:        10,
+			expected: ErrZeroWidthInterval,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewODEUseCase()
+
+			// Act
+			_, err := useCase.SolveBVP(context.Background(), constant(0), constant(0), constant(0), test.a, test.b, 0, 0, test.n)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
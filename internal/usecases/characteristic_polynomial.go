@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+// MaxCharacteristicPolynomialDimension caps the matrices accepted by
+// CharacteristicPolynomial, as the Faddeev–LeVerrier coefficients lose
+// precision quickly as the dimension grows.
+const MaxCharacteristicPolynomialDimension = 10
+
+var ErrPolynomialMatrixTooLarge = numeerr.New(numeerr.CodeUnsupported, "matrix is too large for the characteristic polynomial")
+
+// CharacteristicPolynomial computes the coefficients of det(λI − A), highest
+// degree first, with the Faddeev–LeVerrier algorithm. The polynomial is monic
+// and its roots are the eigenvalues of the matrix; det(A − λI) is the same
+// polynomial multiplied by (−1)ⁿ.
+func (u *MatrixUseCase) CharacteristicPolynomial(ctx context.Context, matrix [][]float64) ([]float64, error) {
+	slog.DebugContext(ctx, "Starting the characteristic polynomial",
+		matrixAttr("matrix", matrix),
+	)
+
+	if err := validateSquareMatrix(matrix); err != nil {
+		slog.ErrorContext(ctx, "Invalid matrix for the characteristic polynomial", slog.Any("error", err))
+		return nil, err
+	}
+
+	n := len(matrix)
+	if n > MaxCharacteristicPolynomialDimension {
+		slog.ErrorContext(ctx, "Matrix is too large for the characteristic polynomial",
+			slog.Int("dimension", n),
+			slog.Int("maxDimension", MaxCharacteristicPolynomialDimension),
+		)
+		return nil, fmt.Errorf("%w: %d rows, the maximum is %d", ErrPolynomialMatrixTooLarge, n, MaxCharacteristicPolynomialDimension)
+	}
+
+	coefficients := make([]float64, n+1)
+	coefficients[0] = 1
+
+	// M₀ = 0, Mₖ = A Mₖ₋₁ + cₖ₋₁ I and cₖ = −tr(A Mₖ) / k
+	previous := make([][]float64, n)
+	for i := range previous {
+		previous[i] = make([]float64, n)
+	}
+
+	for k := 1; k <= n; k++ {
+		current := multiplySquare(matrix, previous)
+		for i := range current {
+			current[i][i] += coefficients[k-1]
+		}
+
+		product := multiplySquare(matrix, current)
+		trace := 0.0
+		for i := range product {
+			trace += product[i][i]
+		}
+
+		coefficients[k] = -trace / float64(k)
+		previous = current
+	}
+
+	slog.InfoContext(ctx, "Finished the characteristic polynomial",
+		vectorAttr("coefficients", coefficients),
+	)
+
+	return coefficients, nil
+}
+
+// FormatPolynomial writes coefficients, highest degree first, as a polynomial
+// in variable, such as λ^2 - 5λ + 6. Zero terms are left out.
+func FormatPolynomial(coefficients []float64, variable string) string {
+	var builder strings.Builder
+
+	degree := len(coefficients) - 1
+	for i, coefficient := range coefficients {
+		power := degree - i
+		if coefficient == 0 {
+			continue
+		}
+
+		magnitude := math.Abs(coefficient)
+		switch {
+		case builder.Len() == 0 && coefficient < 0:
+			builder.WriteString("-")
+		case builder.Len() > 0 && coefficient < 0:
+			builder.WriteString(" - ")
+		case builder.Len() > 0:
+			builder.WriteString(" + ")
+		}
+
+		if magnitude != 1 || power == 0 {
+			builder.WriteString(strconv.FormatFloat(magnitude, 'g', -1, 64))
+		}
+
+		switch power {
+		case 0:
+		case 1:
+			builder.WriteString(variable)
+		default:
+			builder.WriteString(variable + "^" + strconv.Itoa(power))
+		}
+	}
+
+	if builder.Len() == 0 {
+		return "0"
+	}
+
+	return builder.String()
+}
+
+// multiplySquare returns the product of two square matrices of the same
+// dimension.
+func multiplySquare(left, right [][]float64) [][]float64 {
+	n := len(left)
+	product := make([][]float64, n)
+	for i := range product {
+		product[i] = make([]float64, n)
+		for k := range n {
+			if left[i][k] == 0 {
+				continue
+			}
+			for j := range n {
+				product[i][j] += left[i][k] * right[k][j]
+			}
+		}
+	}
+
+	return product
+}
@@ -0,0 +1,160 @@
+package usecases
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCharacteristicPolynomialRootsAreTheEigenvalues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		matrix               [][]float64
+		expectedCoefficients []float64
+	}{
+		{
+			name:                 "2x2",
+			matrix:               [][]float64{{4, 1}, {2, 3}},
+			expectedCoefficients: []float64{1, -7, 10},
+		},
+		{
+			name:                 "2x2 symmetric",
+			matrix:               [][]float64{{2, 1}, {1, 2}},
+			expectedCoefficients: []float64{1, -4, 3},
+		},
+		{
+			name:                 "3x3 symmetric",
+			matrix:               [][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+			expectedCoefficients: []float64{1, -6, 10, -4},
+		},
+		{
+			name:                 "3x3 triangular",
+			matrix:               [][]float64{{1, 2, 3}, {0, 2, 4}, {0, 0, 3}},
+			expectedCoefficients: []float64{1, -6, 11, -6},
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			coefficients, err := useCase.CharacteristicPolynomial(t.Context(), test.matrix)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDeltaSlice(t, test.expectedCoefficients, coefficients, 1e-12)
+
+			roots := polynomialRoots(t, coefficients)
+			eigenvalues := realEigenvalues(t, test.matrix)
+			assert.InDeltaSlice(t, eigenvalues, roots, 1e-9)
+		})
+	}
+}
+
+func TestCharacteristicPolynomialRejectsInvalidMatrices(t *testing.T) {
+	t.Parallel()
+
+	tooLarge := make([][]float64, MaxCharacteristicPolynomialDimension+1)
+	for i := range tooLarge {
+		tooLarge[i] = make([]float64, len(tooLarge))
+		tooLarge[i][i] = 1
+	}
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		expectedErr error
+	}{
+		{name: "Empty", matrix: [][]float64{}, expectedErr: ErrEmptyMatrix},
+		{name: "NonSquare", matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}, expectedErr: ErrNonSquareMatrix},
+		{name: "TooLarge", matrix: tooLarge, expectedErr: ErrPolynomialMatrixTooLarge},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := useCase.CharacteristicPolynomial(t.Context(), test.matrix)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestFormatPolynomial(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		coefficients []float64
+		expected     string
+	}{
+		{coefficients: []float64{1, -6, 11, -6}, expected: "λ^3 - 6λ^2 + 11λ - 6"},
+		{coefficients: []float64{1, 0, -1}, expected: "λ^2 - 1"},
+		{coefficients: []float64{-1, 2.5, 0}, expected: "-λ^2 + 2.5λ"},
+		{coefficients: []float64{0}, expected: "0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			formatted := FormatPolynomial(test.coefficients, "λ")
+
+			// Assert
+			assert.Equal(t, test.expected, formatted)
+		})
+	}
+}
+
+// polynomialRoots finds the roots of a monic polynomial as the eigenvalues of
+// its companion matrix, sorted in increasing order.
+func polynomialRoots(t *testing.T, coefficients []float64) []float64 {
+	t.Helper()
+
+	n := len(coefficients) - 1
+	companion := make([][]float64, n)
+	for i := range companion {
+		companion[i] = make([]float64, n)
+		if i > 0 {
+			companion[i][i-1] = 1
+		}
+		companion[i][n-1] = -coefficients[n-i]
+	}
+
+	return realEigenvalues(t, companion)
+}
+
+// realEigenvalues returns the eigenvalues of a matrix with a real spectrum,
+// sorted in increasing order.
+func realEigenvalues(t *testing.T, matrix [][]float64) []float64 {
+	t.Helper()
+
+	var eigen mat.Eigen
+	require.True(t, eigen.Factorize(constructMatrix(matrix), mat.EigenNone))
+
+	values := make([]float64, 0, len(matrix))
+	for _, value := range eigen.Values(nil) {
+		require.InDelta(t, 0, imag(value), 1e-9)
+		values = append(values, real(value))
+	}
+	slices.SortFunc(values, cmp.Compare[float64])
+
+	return values
+}
@@ -0,0 +1,221 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrNoComparisonDeltas = numeerr.New(numeerr.CodeInvalidInput, "derivative comparison needs at least one delta")
+	ErrPointOutsideDomain = numeerr.New(numeerr.CodeDomain, "function is not finite at the comparison point")
+)
+
+// comparisonDomainSamples is the number of samples probing the domain around
+// the point, odd so the point itself is one of them
+const comparisonDomainSamples = 65
+
+// DerivativeComparisonDeltas are the deltas compared when none are given, a
+// few magnitudes apart so both the truncation and the round-off regimes show.
+var DerivativeComparisonDeltas = []float64{1e-1, 1e-2, 1e-3, 1e-4, 1e-5}
+
+// comparisonPhilosophies are the columns of a derivative comparison
+var comparisonPhilosophies = []struct {
+	name     string
+	strategy DifferenceStrategy
+}{
+	{name: "Forward", strategy: &ForwardDifferenceStrategy{}},
+	{name: "Backward", strategy: &BackwardDifferenceStrategy{}},
+	{name: "Central", strategy: &CentralDifferenceStrategy{}},
+}
+
+// DerivativeComparisonCell is a derivative computed with one philosophy and
+// delta, and its distance to the reference. OutsideDomain cells were skipped
+// because their stencil leaves the domain, and both values are NaN.
+type DerivativeComparisonCell struct {
+	Derivative    float64
+	AbsoluteError float64
+	OutsideDomain bool
+}
+
+// DerivativeComparisonRow holds a cell per philosophy, in the order of
+// DerivativeComparison.Philosophies, for a single delta.
+type DerivativeComparisonRow struct {
+	Delta float64
+	Cells []DerivativeComparisonCell
+}
+
+// DerivativeComparison is a table of derivatives with rows for deltas and
+// columns for philosophies, showing how both affect the error at once.
+// Summaries holds, per philosophy, the spread of its derivatives across the
+// deltas, a measure of how sensitive it is to the step size, and Domain is the
+// finite sub-interval around the point every computed stencil stays within.
+type DerivativeComparison struct {
+	Order        int
+	Point        float64
+	Reference    float64
+	Domain       expressions.Interval
+	Philosophies []string
+	Rows         []DerivativeComparisonRow
+	Summaries    []SampleSummary
+}
+
+// Best returns the row and column of the cell closest to the reference,
+// ignoring the cells outside the domain.
+func (c *DerivativeComparison) Best() (row int, column int) {
+	lowest := math.Inf(1)
+	for i, r := range c.Rows {
+		for j, cell := range r.Cells {
+			if !cell.OutsideDomain && cell.AbsoluteError < lowest {
+				lowest = cell.AbsoluteError
+				row, column = i, j
+			}
+		}
+	}
+
+	return row, column
+}
+
+// CompareMethods computes the derivative of the given order at point with
+// every philosophy and delta, measuring each against reference. Nil deltas
+// default to DerivativeComparisonDeltas. The domain around the point is
+// detected first, so singularities like x = 0 for 1/x or ln(x) flag the cells
+// whose stencil would cross them instead of filling the table with NaN.
+func (d *DerivativeUseCase) CompareMethods(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	point float64,
+	derivativeOrder int,
+	deltas []float64,
+	reference float64,
+) (*DerivativeComparison, error) {
+	if deltas == nil {
+		deltas = DerivativeComparisonDeltas
+	}
+
+	if len(deltas) == 0 {
+		return nil, ErrNoComparisonDeltas
+	}
+
+	slog.DebugContext(ctx, "Starting derivative methods comparison",
+		"point", point, "derivative_order", derivativeOrder, "deltas", deltas, "reference", reference,
+	)
+
+	domain, err := comparisonDomain(simpleExpr, point, derivativeOrder, deltas)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error detecting the domain around the comparison point", "error", err)
+		return nil, err
+	}
+
+	comparison := &DerivativeComparison{
+		Order:        derivativeOrder,
+		Point:        point,
+		Reference:    reference,
+		Domain:       domain,
+		Philosophies: make([]string, len(comparisonPhilosophies)),
+		Rows:         make([]DerivativeComparisonRow, len(deltas)),
+		Summaries:    make([]SampleSummary, len(comparisonPhilosophies)),
+	}
+	accumulators := make([]SampleAccumulator, len(comparisonPhilosophies))
+	for j, philosophy := range comparisonPhilosophies {
+		comparison.Philosophies[j] = philosophy.name
+	}
+
+	for i, delta := range deltas {
+		row := DerivativeComparisonRow{
+			Delta: delta,
+			Cells: make([]DerivativeComparisonCell, len(comparisonPhilosophies)),
+		}
+
+		for j, philosophy := range comparisonPhilosophies {
+			lowest, highest, err := stencilOffsets(philosophy.strategy, derivativeOrder)
+			if err != nil {
+				return nil, err
+			}
+
+			if !insideDomain(domain, point+float64(lowest)*delta, point+float64(highest)*delta) {
+				row.Cells[j] = DerivativeComparisonCell{
+					Derivative:    math.NaN(),
+					AbsoluteError: math.NaN(),
+					OutsideDomain: true,
+				}
+				continue
+			}
+
+			derivatives, err := d.DerivativesUpTo(ctx, simpleExpr, point, derivativeOrder, delta, philosophy.strategy)
+			if err != nil {
+				slog.ErrorContext(ctx, "Error comparing derivative methods",
+					"error", err, "philosophy", philosophy.name, "delta", delta,
+				)
+				return nil, err
+			}
+
+			derivative := derivatives[derivativeOrder-1]
+			accumulators[j].Add(derivative)
+			row.Cells[j] = DerivativeComparisonCell{
+				Derivative:    derivative,
+				AbsoluteError: math.Abs(derivative - reference),
+			}
+		}
+
+		comparison.Rows[i] = row
+	}
+
+	for j := range accumulators {
+		// A philosophy with every cell outside the domain keeps an empty
+		// summary, its Count telling it apart
+		comparison.Summaries[j], _ = accumulators[j].Summary()
+	}
+
+	bestRow, bestColumn := comparison.Best()
+	slog.InfoContext(ctx, "Derivative methods comparison completed",
+		"best_philosophy", comparison.Philosophies[bestColumn],
+		"best_delta", comparison.Rows[bestRow].Delta,
+	)
+
+	return comparison, nil
+}
+
+// comparisonDomain detects the finite sub-interval containing point within
+// the reach of the widest stencil of the comparison.
+func comparisonDomain(
+	simpleExpr expressions.SingleVariableExpr,
+	point float64,
+	derivativeOrder int,
+	deltas []float64,
+) (expressions.Interval, error) {
+	reach := 0.0
+	for _, delta := range deltas {
+		reach = max(reach, math.Abs(delta))
+	}
+
+	if reach == 0 {
+		return expressions.Interval{}, ErrDeltaIsZero
+	}
+
+	// No philosophy reaches further than the order in multiples of delta
+	reach *= float64(max(derivativeOrder, 1))
+
+	intervals, err := expressions.DetectDomain(simpleExpr, point-reach, point+reach, comparisonDomainSamples)
+	if err != nil {
+		return expressions.Interval{}, err
+	}
+
+	for _, interval := range intervals {
+		if interval.Left <= point && point <= interval.Right {
+			return interval, nil
+		}
+	}
+
+	return expressions.Interval{}, fmt.Errorf("%w: x = %g", ErrPointOutsideDomain, point)
+}
+
+// insideDomain tells whether the stencil spanning from a to b, in any order,
+// stays within the domain.
+func insideDomain(domain expressions.Interval, a, b float64) bool {
+	return domain.Left <= min(a, b) && max(a, b) <= domain.Right
+}
@@ -0,0 +1,188 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+func TestCompareMethods(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		function        expressions.SingleVariableExpr
+		point           float64
+		derivativeOrder int
+		reference       float64
+	}{
+		{
+			name:            "First derivative of e^x",
+			function:        math.Exp,
+			point:           1,
+			derivativeOrder: 1,
+			reference:       math.E,
+		},
+		{
+			name:            "First derivative of sin(x)",
+			function:        math.Sin,
+			point:           0.5,
+			derivativeOrder: 1,
+			reference:       math.Cos(0.5),
+		},
+		{
+			name:            "Second derivative of cosh(x)",
+			function:        math.Cosh,
+			point:           1,
+			derivativeOrder: 2,
+			reference:       math.Cosh(1),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+			deltas := DerivativeComparisonDeltas
+			if test.derivativeOrder == 2 {
+				// Round-off grows as 1/h² for the second derivative
+				deltas = []float64{1e-1, 1e-2, 1e-3}
+			}
+
+			// Act
+			comparison, err := useCase.CompareMethods(t.Context(), test.function, test.point, test.derivativeOrder, deltas, test.reference)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []string{"Forward", "Backward", "Central"}, comparison.Philosophies)
+			require.Len(t, comparison.Rows, len(deltas))
+			for i, row := range comparison.Rows {
+				assert.Equal(t, deltas[i], row.Delta)
+				assert.Len(t, row.Cells, 3)
+			}
+			require.Len(t, comparison.Summaries, 3)
+			for _, summary := range comparison.Summaries {
+				assert.Equal(t, len(deltas), summary.Count)
+				assert.InDelta(t, test.reference, summary.Mean, 1)
+			}
+
+			row, column := comparison.Best()
+			assert.Equal(t, len(deltas)-1, row, "the smallest delta is the most accurate")
+			assert.Equal(t, "Central", comparison.Philosophies[column])
+		})
+	}
+}
+
+func TestCompareMethodsErrorsShrinkWithDelta(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+
+	// Act
+	comparison, err := useCase.CompareMethods(t.Context(), math.Exp, 0, 1, []float64{1e-1, 1e-2, 1e-3}, 1)
+
+	// Assert
+	require.NoError(t, err)
+	for j := range comparison.Philosophies {
+		for i := 1; i < len(comparison.Rows); i++ {
+			assert.Less(t, comparison.Rows[i].Cells[j].AbsoluteError, comparison.Rows[i-1].Cells[j].AbsoluteError)
+		}
+	}
+}
+
+func TestCompareMethodsRejectsInvalidDeltas(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		deltas   []float64
+		expected error
+	}{
+		{name: "Empty", deltas: []float64{}, expected: ErrNoComparisonDeltas},
+		{name: "Zero", deltas: []float64{1e-2, 0}, expected: ErrDeltaIsZero},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+
+			// Act
+			_, err := useCase.CompareMethods(t.Context(), math.Exp, 1, 1, test.deltas, math.E)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
+
+func TestCompareMethodsSkipsStencilsOutsideDomain(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name      string
+		function  expressions.SingleVariableExpr
+		point     float64
+		reference float64
+	}{
+		{name: "ln(x) near 0", function: math.Log, point: 0.05, reference: 1 / 0.05},
+		{name: "1/x near its pole", function: func(x float64) float64 { return 1 / x }, point: 0.05, reference: -1 / (0.05 * 0.05)},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+
+			// Act
+			comparison, err := useCase.CompareMethods(t.Context(), test.function, test.point, 1, nil, test.reference)
+
+			// Assert
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, comparison.Domain.Left, 0.0)
+			assert.LessOrEqual(t, comparison.Domain.Left, test.point)
+
+			// Forward never crosses 0, backward and central do with h = 0.1
+			first := comparison.Rows[0]
+			assert.False(t, first.Cells[0].OutsideDomain)
+			assert.True(t, first.Cells[1].OutsideDomain)
+			assert.True(t, first.Cells[2].OutsideDomain)
+
+			for _, row := range comparison.Rows {
+				for _, cell := range row.Cells {
+					if !cell.OutsideDomain {
+						assert.False(t, math.IsNaN(cell.Derivative))
+					}
+				}
+			}
+
+			for _, summary := range comparison.Summaries {
+				assert.False(t, math.IsNaN(summary.Mean))
+			}
+			assert.Equal(t, len(comparison.Rows), comparison.Summaries[0].Count)
+			assert.Less(t, comparison.Summaries[1].Count, len(comparison.Rows))
+			assert.Less(t, comparison.Summaries[2].Count, len(comparison.Rows))
+
+			row, column := comparison.Best()
+			assert.False(t, comparison.Rows[row].Cells[column].OutsideDomain)
+		})
+	}
+}
+
+func TestCompareMethodsRejectsPointOutsideDomain(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+
+	// Act
+	_, err := useCase.CompareMethods(t.Context(), math.Log, -1, 1, nil, 0)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrPointOutsideDomain)
+}
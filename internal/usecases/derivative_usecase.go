@@ -2,12 +2,24 @@ package usecases
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"math"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
+var (
+	ErrUnsupportedDerivativeOrder = numeerr.New(numeerr.CodeUnsupported, "unsupported derivative order")
+	ErrUnsupportedPhilosophy      = numeerr.New(numeerr.CodeUnsupported, "unsupported difference philosophy")
+	ErrNoDerivativeIterations     = numeerr.New(numeerr.CodeInvalidInput, "at least one iteration is needed to estimate the derivative")
+	ErrNonFiniteDerivative        = numeerr.New(numeerr.CodeDomain, "derivative estimate is not finite")
+)
+
+// machineEpsilon is the gap between 1 and the next float64
+const machineEpsilon = 0x1p-52
+
 type DerivativeUseCase struct {
 	philosophyStrategy DifferenceStrategy
 }
@@ -18,6 +30,8 @@ func NewDerivativeUseCase(philosophyStrategy DifferenceStrategy) *DerivativeUseC
 	}
 }
 
+// Derivative improves the first derivative at value with ImproveDerivative,
+// the result telling whether it converged.
 func (d *DerivativeUseCase) Derivative(
 	ctx context.Context,
 	value float64,
@@ -25,7 +39,7 @@ func (d *DerivativeUseCase) Derivative(
 	initialDelta float64,
 	epsilon float64,
 	maxNumberOfIterations uint64,
-) (float64, error) {
+) (*ImprovedDerivative, error) {
 	slog.DebugContext(ctx, "Starting first derivative calculation",
 		"simplified_expression", simpleExpr, "value", value, "epsilon", epsilon, "max_iterations", maxNumberOfIterations,
 	)
@@ -41,13 +55,18 @@ func (d *DerivativeUseCase) Derivative(
 	)
 	if err != nil {
 		slog.ErrorContext(ctx, "Error calculating first derivative", "error", err)
-		return 0, err
+		return nil, err
+	}
+
+	if !result.Converged {
+		slog.WarnContext(ctx, "First derivative did not converge", "result", result.Derivative, "delta", result.Delta)
 	}
 
-	slog.InfoContext(ctx, "First derivative calculation completed", "result", result)
+	slog.InfoContext(ctx, "First derivative calculation completed", "result", result.Derivative)
 	return result, nil
 }
 
+// SecondDerivative is Derivative for the second derivative.
 func (d *DerivativeUseCase) SecondDerivative(
 	ctx context.Context,
 	value float64,
@@ -55,7 +74,7 @@ func (d *DerivativeUseCase) SecondDerivative(
 	initialDelta float64,
 	epsilon float64,
 	maxNumberOfIterations uint64,
-) (float64, error) {
+) (*ImprovedDerivative, error) {
 	slog.DebugContext(ctx, "Starting second derivative calculation",
 		"simplified_expression", simpleExpr, "value", value, "epsilon", epsilon, "max_iterations", maxNumberOfIterations,
 	)
@@ -71,10 +90,14 @@ func (d *DerivativeUseCase) SecondDerivative(
 	)
 	if err != nil {
 		slog.ErrorContext(ctx, "Error calculating second derivative", "error", err)
-		return 0, err
+		return nil, err
 	}
 
-	slog.InfoContext(ctx, "Second derivative calculation completed", "result", result)
+	if !result.Converged {
+		slog.WarnContext(ctx, "Second derivative did not converge", "result", result.Derivative, "delta", result.Delta)
+	}
+
+	slog.InfoContext(ctx, "Second derivative calculation completed", "result", result.Derivative)
 	return result, nil
 }
 
@@ -89,6 +112,21 @@ func (d *DerivativeUseCase) TripleDerivative(
 	panic("not implemented yet")
 }
 
+// ImprovedDerivative is the outcome of ImproveDerivative. Converged is false
+// when no two successive estimates agreed within epsilon, Derivative is then
+// the best effort, the estimate closest to its predecessor.
+type ImprovedDerivative struct {
+	Derivative float64
+	Delta      float64
+	Iterations uint64
+	Converged  bool
+}
+
+// ImproveDerivative halves delta until two successive estimates agree within
+// epsilon, relative to their magnitude or absolute for magnitudes below 1, so
+// a derivative near zero converges instead of chasing round-off noise. It
+// stops early once the estimates drift apart again, as round-off then
+// dominates the truncation error.
 func (d *DerivativeUseCase) ImproveDerivative(
 	ctx context.Context,
 	value float64,
@@ -97,51 +135,245 @@ func (d *DerivativeUseCase) ImproveDerivative(
 	initialDelta float64,
 	epsilon float64,
 	maxNumberOfIterations uint64,
-) (float64, error) {
+) (*ImprovedDerivative, error) {
 	slog.DebugContext(ctx, "Starting to improve derivative calculation",
 		"simplified_expression", simpleExpr, "value", value, "epsilon", epsilon, "max_iterations", maxNumberOfIterations,
 		"initial_delta", initialDelta,
 		"derivative_function", derivativeFn,
 	)
 
+	if maxNumberOfIterations == 0 {
+		return nil, ErrNoDerivativeIterations
+	}
+
 	currentDelta := initialDelta
-	currentError := math.Inf(1)
-	bestResult := 0.0
+	previous := 0.0
+	bestDifference := math.Inf(1)
+
+	var best *ImprovedDerivative
+	var iterations uint64
 
-	for i := 0; i < int(maxNumberOfIterations); i++ {
-		slog.DebugContext(ctx, "Current iteration", "iteration", i, "delta", currentDelta)
+	for ; iterations < maxNumberOfIterations; iterations++ {
+		slog.DebugContext(ctx, "Current iteration", "iteration", iterations, "delta", currentDelta)
 
 		derivative, err := derivativeFn(ctx, simpleExpr, currentDelta)
 		if err != nil {
-			slog.ErrorContext(ctx, "Error calculating derivative", "error", err, "iteration", i, "delta", currentDelta)
-			return 0, err
+			slog.ErrorContext(ctx, "Error calculating derivative", "error", err, "iteration", iterations, "delta", currentDelta)
+			return nil, err
 		}
 
 		result := derivative(value)
 
-		slog.DebugContext(ctx, "Current iteration result", "iteration", i, "result", result, "delta", currentDelta)
+		slog.DebugContext(ctx, "Current iteration result", "iteration", iterations, "result", result, "delta", currentDelta)
 
-		absDifference := math.Abs(result - bestResult)
-		denominator := max(math.Abs(result), math.Abs(bestResult), 1e-15)
-		relativeError := absDifference / denominator
+		if math.IsNaN(result) || math.IsInf(result, 0) {
+			if best == nil {
+				return nil, fmt.Errorf("%w: %v with delta %g", ErrNonFiniteDerivative, result, currentDelta)
+			}
+			slog.WarnContext(ctx, "Derivative is no longer finite, taking the best result", "delta", currentDelta)
+			break
+		}
 
-		if relativeError < epsilon {
-			slog.InfoContext(ctx, "Converged to result", "result", result, "delta", currentDelta)
-			return result, nil
+		// A single estimate has nothing to be compared with
+		if best == nil {
+			best = &ImprovedDerivative{Derivative: result, Delta: currentDelta}
+			previous = result
+			currentDelta /= 2.0
+			continue
+		}
+
+		difference := math.Abs(result - previous)
+		if difference > bestDifference {
+			slog.InfoContext(ctx, "Error increased, taking the previous result as best",
+				"result", best.Derivative, "difference", bestDifference, "next_difference", difference,
+			)
+			break
 		}
 
-		if relativeError > currentError {
-			slog.InfoContext(ctx, "Error increased, taking the current result as best", "result", result, "current_error", currentError, "relative_error", relativeError)
-			return result, nil
+		tolerance := epsilon * max(math.Abs(result), math.Abs(previous), 1)
+		best = &ImprovedDerivative{Derivative: result, Delta: currentDelta, Converged: difference <= tolerance}
+		bestDifference = difference
+
+		if best.Converged {
+			slog.InfoContext(ctx, "Converged to result", "result", result, "delta", currentDelta)
+			best.Iterations = iterations + 1
+			return best, nil
 		}
 
-		slog.DebugContext(ctx, "Result not converged and error is decreasing, adjusting delta", "result", result, "delta", currentDelta, "relative_error", relativeError)
+		slog.DebugContext(ctx, "Result not converged and error is decreasing, adjusting delta", "result", result, "delta", currentDelta, "difference", difference)
 
+		previous = result
 		currentDelta /= 2.0
-		bestResult = result
-		currentError = relativeError
 	}
 
-	slog.InfoContext(ctx, "Max iterations reached without convergence", "max_iterations", maxNumberOfIterations, "last_result", bestResult)
-	return bestResult, nil
+	best.Iterations = iterations
+	slog.WarnContext(ctx, "Derivative did not converge, taking the best result",
+		"max_iterations", maxNumberOfIterations, "iterations", iterations, "result", best.Derivative, "difference", bestDifference,
+	)
+	return best, nil
+}
+
+// AutoDeltaResult is a derivative computed with an automatically chosen delta.
+type AutoDeltaResult struct {
+	Derivative float64
+	Delta      float64
+}
+
+// OptimalDelta returns the step minimizing the combined truncation and
+// round-off errors, ε^(1/(p+n)) scaled by the point magnitude, where n is the
+// derivative order and p the accuracy order of the difference formula. For a
+// first derivative this is ε^(1/(p+1)).
+func OptimalDelta(value float64, derivativeOrder int, accuracyOrder int) float64 {
+	scale := max(1, math.Abs(value))
+	return math.Pow(machineEpsilon, 1/float64(accuracyOrder+derivativeOrder)) * scale
+}
+
+// AutoDelta computes the derivative of the given order at value, picking the
+// delta with OptimalDelta instead of requiring the caller to tune it.
+func (d *DerivativeUseCase) AutoDelta(
+	ctx context.Context,
+	value float64,
+	simpleExpr expressions.SingleVariableExpr,
+	derivativeOrder int,
+) (*AutoDeltaResult, error) {
+	var derivativeFn func(ctx context.Context, simpleExpresion expressions.SingleVariableExpr, delta float64) (expressions.SingleVariableExpr, error)
+
+	switch derivativeOrder {
+	case 1:
+		derivativeFn = d.philosophyStrategy.Derivative
+	case 2:
+		derivativeFn = d.philosophyStrategy.DoubleDerivative
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedDerivativeOrder, derivativeOrder)
+	}
+
+	delta := OptimalDelta(value, derivativeOrder, AccuracyOrder(d.philosophyStrategy))
+
+	slog.DebugContext(ctx, "Starting derivative calculation with automatic delta",
+		"value", value, "derivative_order", derivativeOrder, "delta", delta,
+	)
+
+	derivative, err := derivativeFn(ctx, simpleExpr, delta)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error calculating derivative", "error", err, "delta", delta)
+		return nil, err
+	}
+
+	result := &AutoDeltaResult{
+		Derivative: derivative(value),
+		Delta:      delta,
+	}
+
+	slog.InfoContext(ctx, "Derivative with automatic delta completed", "result", result.Derivative, "delta", delta)
+	return result, nil
+}
+
+// AccuracyOrder is the power of delta in the truncation error of the strategy
+// formulas.
+func AccuracyOrder(strategy DifferenceStrategy) int {
+	switch strategy.(type) {
+	case *CentralDifferenceStrategy:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// DerivativesUpTo returns the derivatives of orders 1 to maxOrder at point using the given
+// philosophy, or the use case one when nil. Every order is a finite difference
+// over the same grid of points, so f is evaluated once per grid point instead
+// of once per stencil.
+func (d *DerivativeUseCase) DerivativesUpTo(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	point float64,
+	maxOrder int,
+	delta float64,
+	philosophy DifferenceStrategy,
+) ([]float64, error) {
+	if philosophy == nil {
+		philosophy = d.philosophyStrategy
+	}
+
+	slog.DebugContext(ctx, "Starting derivatives calculation",
+		"point", point, "max_order", maxOrder, "delta", delta,
+	)
+
+	if delta == 0 {
+		return nil, ErrDeltaIsZero
+	}
+
+	if maxOrder < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedDerivativeOrder, maxOrder)
+	}
+
+	lowest, highest, err := stencilOffsets(philosophy, maxOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, highest-lowest+1)
+	for i := range values {
+		values[i] = simpleExpr(point + float64(lowest+i)*delta)
+	}
+
+	// forwardDifference is the n-th forward difference starting at the given
+	// grid offset, divided by delta^n
+	forwardDifference := func(start int, n int) float64 {
+		sum := 0.0
+		for i := 0; i <= n; i++ {
+			term := binomial(n, i) * values[start+i-lowest]
+			if (n-i)%2 == 1 {
+				term = -term
+			}
+			sum += term
+		}
+		return sum / math.Pow(delta, float64(n))
+	}
+
+	derivatives := make([]float64, maxOrder)
+	for n := 1; n <= maxOrder; n++ {
+		switch philosophy.(type) {
+		case *ForwardDifferenceStrategy:
+			derivatives[n-1] = forwardDifference(0, n)
+		case *BackwardDifferenceStrategy:
+			derivatives[n-1] = forwardDifference(-n, n)
+		case *CentralDifferenceStrategy:
+			// Even orders are centered on the point, odd ones average the
+			// two stencils surrounding it
+			if n%2 == 0 {
+				derivatives[n-1] = forwardDifference(-n/2, n)
+			} else {
+				derivatives[n-1] = (forwardDifference(-(n-1)/2, n) + forwardDifference(-(n+1)/2, n)) / 2
+			}
+		}
+	}
+
+	slog.InfoContext(ctx, "Derivatives calculation completed",
+		"derivatives", derivatives, "evaluations", len(values),
+	)
+	return derivatives, nil
+}
+
+// stencilOffsets returns the grid offsets, in multiples of delta, covering
+// every stencil DerivativesUpTo evaluates for the philosophy up to maxOrder.
+func stencilOffsets(philosophy DifferenceStrategy, maxOrder int) (lowest int, highest int, err error) {
+	switch philosophy.(type) {
+	case *ForwardDifferenceStrategy:
+		return 0, maxOrder, nil
+	case *BackwardDifferenceStrategy:
+		return -maxOrder, 0, nil
+	case *CentralDifferenceStrategy:
+		return -(maxOrder + 1) / 2, (maxOrder + 1) / 2, nil
+	default:
+		return 0, 0, fmt.Errorf("%w: %T", ErrUnsupportedPhilosophy, philosophy)
+	}
+}
+
+func binomial(n, k int) float64 {
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+	return result
 }
@@ -0,0 +1,476 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+func TestAutoDeltaIsMoreAccurateThanPoorDelta(t *testing.T) {
+	t.Parallel()
+
+	strategies := map[string]DifferenceStrategy{
+		"Forward":  &ForwardDifferenceStrategy{},
+		"Backward": &BackwardDifferenceStrategy{},
+		"Central":  &CentralDifferenceStrategy{},
+	}
+
+	tt := []struct {
+		name            string
+		function        expressions.SingleVariableExpr
+		point           float64
+		derivativeOrder int
+		expected        float64
+		poorDelta       float64
+	}{
+		{
+			name:            "First derivative of e^x",
+			function:        math.Exp,
+			point:           1,
+			derivativeOrder: 1,
+			expected:        math.E,
+			poorDelta:       1e-13,
+		},
+		{
+			name:            "First derivative of sin(x) far from the origin",
+			function:        math.Sin,
+			point:           100,
+			derivativeOrder: 1,
+			expected:        math.Cos(100),
+			poorDelta:       1e-12,
+		},
+		{
+			name: "First derivative of x^3",
+			function: func(x float64) float64 {
+				return x * x * x
+			},
+			point:           2,
+			derivativeOrder: 1,
+			expected:        12,
+			poorDelta:       1e-13,
+		},
+		{
+			name:            "Second derivative of cosh(x)",
+			function:        math.Cosh,
+			point:           0.5,
+			derivativeOrder: 2,
+			expected:        math.Cosh(0.5),
+			poorDelta:       1e-8,
+		},
+		{
+			name:            "Second derivative of ln(x)",
+			function:        math.Log,
+			point:           3,
+			derivativeOrder: 2,
+			expected:        -1.0 / 9,
+			poorDelta:       1e-8,
+		},
+	}
+
+	for strategyName, strategy := range strategies {
+		for _, test := range tt {
+			t.Run(fmt.Sprintf("%s %s", strategyName, test.name), func(t *testing.T) {
+				// Arrange
+				t.Parallel()
+				ctx := context.Background()
+				useCase := NewDerivativeUseCase(strategy)
+
+				poorFn := strategy.Derivative
+				if test.derivativeOrder == 2 {
+					poorFn = strategy.DoubleDerivative
+				}
+				poorDerivative, err := poorFn(ctx, test.function, test.poorDelta)
+				require.NoError(t, err)
+				poorError := math.Abs(poorDerivative(test.point) - test.expected)
+
+				// Act
+				result, err := useCase.AutoDelta(ctx, test.point, test.function, test.derivativeOrder)
+
+				// Assert
+				require.NoError(t, err)
+				autoError := math.Abs(result.Derivative - test.expected)
+				assert.Equal(t,
+					OptimalDelta(test.point, test.derivativeOrder, AccuracyOrder(strategy)),
+					result.Delta,
+				)
+				assert.Less(t, autoError, poorError)
+				assert.InDelta(t, test.expected, result.Derivative, 1e-4)
+			})
+		}
+	}
+}
+
+func TestOptimalDelta(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		value           float64
+		derivativeOrder int
+		accuracyOrder   int
+		expected        float64
+	}{
+		{
+			name:            "Forward first derivative is the square root of epsilon",
+			value:           0.5,
+			derivativeOrder: 1,
+			accuracyOrder:   1,
+			expected:        math.Sqrt(machineEpsilon),
+		},
+		{
+			name:            "Central first derivative is the cube root of epsilon",
+			value:           1,
+			derivativeOrder: 1,
+			accuracyOrder:   2,
+			expected:        math.Cbrt(machineEpsilon),
+		},
+		{
+			name:            "Scaled by the point magnitude",
+			value:           -100,
+			derivativeOrder: 1,
+			accuracyOrder:   1,
+			expected:        100 * math.Sqrt(machineEpsilon),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			delta := OptimalDelta(test.value, test.derivativeOrder, test.accuracyOrder)
+
+			// Assert
+			assert.InDelta(t, test.expected, delta, 1e-15*math.Abs(test.value)+1e-20)
+		})
+	}
+}
+
+func TestAutoDeltaUnsupportedOrder(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+
+	// Act
+	_, err := useCase.AutoDelta(context.Background(), 1, math.Exp, 3)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnsupportedDerivativeOrder)
+}
+
+func TestDerivativesUpTo(t *testing.T) {
+	t.Parallel()
+
+	// p(x) = x^4 - 2x² + 5x - 1
+	polynomial := func(x float64) float64 {
+		return x*x*x*x - 2*x*x + 5*x - 1
+	}
+	point := 1.5
+	expected := []float64{
+		4*point*point*point - 4*point + 5,
+		12*point*point - 4,
+		24 * point,
+		24,
+	}
+
+	tt := []struct {
+		name                string
+		philosophy          DifferenceStrategy
+		tolerance           float64
+		expectedEvaluations int
+	}{
+		{
+			name:                "Forward",
+			philosophy:          &ForwardDifferenceStrategy{},
+			tolerance:           0.1,
+			expectedEvaluations: 5,
+		},
+		{
+			name:                "Backward",
+			philosophy:          &BackwardDifferenceStrategy{},
+			tolerance:           0.1,
+			expectedEvaluations: 5,
+		},
+		{
+			name:                "Central",
+			philosophy:          &CentralDifferenceStrategy{},
+			tolerance:           1e-2,
+			expectedEvaluations: 5,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctx := context.Background()
+			useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+			evaluations := 0
+			counted := func(x float64) float64 {
+				evaluations++
+				return polynomial(x)
+			}
+
+			// Act
+			derivatives, err := useCase.DerivativesUpTo(ctx, counted, point, len(expected), 1e-3, test.philosophy)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, derivatives, len(expected))
+			for i, value := range expected {
+				assert.InDelta(t, value, derivatives[i], test.tolerance, "order %d", i+1)
+			}
+			assert.Equal(t, test.expectedEvaluations, evaluations)
+
+			first, err := test.philosophy.Derivative(ctx, polynomial, 1e-3)
+			require.NoError(t, err)
+			second, err := test.philosophy.DoubleDerivative(ctx, polynomial, 1e-3)
+			require.NoError(t, err)
+			assert.InDelta(t, first(point), derivatives[0], 1e-9)
+			assert.InDelta(t, second(point), derivatives[1], 1e-6)
+		})
+	}
+}
+
+func TestDerivativesUpToErrors(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		maxOrder    int
+		delta       float64
+		expectedErr error
+	}{
+		{
+			name:        "Zero delta",
+			maxOrder:    2,
+			delta:       0,
+			expectedErr: ErrDeltaIsZero,
+		},
+		{
+			name:        "Non positive order",
+			maxOrder:    0,
+			delta:       1e-3,
+			expectedErr: ErrUnsupportedDerivativeOrder,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+
+			// Act
+			_, err := useCase.DerivativesUpTo(context.Background(), math.Exp, 1, test.maxOrder, test.delta, nil)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestImproveDerivative(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		strategy      DifferenceStrategy
+		function      expressions.SingleVariableExpr
+		point         float64
+		second        bool
+		epsilon       float64
+		expected      float64
+		tolerance     float64
+		wantConverged bool
+	}{
+		{
+			name:          "Converges on e^x",
+			strategy:      &CentralDifferenceStrategy{},
+			function:      math.Exp,
+			point:         1,
+			epsilon:       1e-8,
+			expected:      math.E,
+			tolerance:     1e-7,
+			wantConverged: true,
+		},
+		{
+			// Successive estimates h² never agree relatively, each is a
+			// quarter of the previous one
+			name:          "Derivative near zero",
+			strategy:      &ForwardDifferenceStrategy{},
+			function:      func(x float64) float64 { return x * x * x },
+			point:         0,
+			epsilon:       1e-8,
+			expected:      0,
+			tolerance:     1e-7,
+			wantConverged: true,
+		},
+		{
+			name:          "Genuinely zero derivative",
+			strategy:      &CentralDifferenceStrategy{},
+			function:      math.Cos,
+			point:         0,
+			epsilon:       1e-8,
+			expected:      0,
+			tolerance:     0,
+			wantConverged: true,
+		},
+		{
+			// Round-off overtakes the truncation error before estimates agree
+			name:          "Unreachable epsilon",
+			strategy:      &CentralDifferenceStrategy{},
+			function:      math.Sin,
+			point:         1,
+			second:        true,
+			epsilon:       1e-15,
+			expected:      -math.Sin(1),
+			tolerance:     1e-7,
+			wantConverged: false,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDerivativeUseCase(test.strategy)
+			derivativeFn := test.strategy.Derivative
+			if test.second {
+				derivativeFn = test.strategy.DoubleDerivative
+			}
+
+			// Act
+			result, err := useCase.ImproveDerivative(context.Background(), test.point, test.function,
+				derivativeFn, 0.1, test.epsilon, 40)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.wantConverged, result.Converged)
+			assert.InDelta(t, test.expected, result.Derivative, test.tolerance)
+			assert.LessOrEqual(t, result.Iterations, uint64(40))
+		})
+	}
+}
+
+func TestImproveDerivativeKeepsTheBestEstimateWhenErrorGrows(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+	expected := -math.Sin(1)
+
+	// Act
+	result, err := useCase.ImproveDerivative(context.Background(), 1, math.Sin,
+		useCase.philosophyStrategy.DoubleDerivative, 0.1, 1e-15, 40)
+	require.NoError(t, err)
+	next, err := useCase.philosophyStrategy.DoubleDerivative(context.Background(), math.Sin, result.Delta/2)
+	require.NoError(t, err)
+
+	// Assert
+	assert.False(t, result.Converged)
+	assert.Less(t, result.Iterations, uint64(40), "stops once round-off dominates")
+	assert.Less(t, math.Abs(result.Derivative-expected), math.Abs(next(1)-expected))
+}
+
+func TestImproveDerivativeErrors(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		function      expressions.SingleVariableExpr
+		maxIterations uint64
+		expected      error
+	}{
+		{
+			name:          "No iterations",
+			function:      math.Exp,
+			maxIterations: 0,
+			expected:      ErrNoDerivativeIterations,
+		},
+		{
+			name:          "Not finite",
+			function:      func(x float64) float64 { return 1 / (x - 1.1) },
+			maxIterations: 10,
+			expected:      ErrNonFiniteDerivative,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDerivativeUseCase(&ForwardDifferenceStrategy{})
+
+			// Act
+			result, err := useCase.ImproveDerivative(context.Background(), 1, test.function,
+				useCase.philosophyStrategy.Derivative, 0.1, 1e-8, test.maxIterations)
+
+			// Assert
+			require.ErrorIs(t, err, test.expected)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestDerivativeReportsConvergence(t *testing.T) {
+	t.Parallel()
+
+	useCase := NewDerivativeUseCase(&CentralDifferenceStrategy{})
+
+	tt := []struct {
+		name              string
+		maxIterations     uint64
+		compute           func(ctx context.Context, maxIterations uint64) (*ImprovedDerivative, error)
+		expected          float64
+		expectedConverged bool
+	}{
+		{
+			name:          "FirstConverges",
+			maxIterations: 20,
+			compute: func(ctx context.Context, maxIterations uint64) (*ImprovedDerivative, error) {
+				return useCase.Derivative(ctx, 1, math.Sin, 0.1, 1e-8, maxIterations)
+			},
+			expected:          math.Cos(1),
+			expectedConverged: true,
+		},
+		{
+			name:          "SecondConverges",
+			maxIterations: 20,
+			compute: func(ctx context.Context, maxIterations uint64) (*ImprovedDerivative, error) {
+				return useCase.SecondDerivative(ctx, 1, math.Sin, 0.1, 1e-6, maxIterations)
+			},
+			expected:          -math.Sin(1),
+			expectedConverged: true,
+		},
+		{
+			name:          "SingleEstimate",
+			maxIterations: 1,
+			compute: func(ctx context.Context, maxIterations uint64) (*ImprovedDerivative, error) {
+				return useCase.Derivative(ctx, 1, math.Sin, 0.1, 1e-8, maxIterations)
+			},
+			expected: math.Cos(1),
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			result, err := test.compute(t.Context(), test.maxIterations)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedConverged, result.Converged)
+			assert.InDelta(t, test.expected, result.Derivative, 1e-2)
+			assert.LessOrEqual(t, result.Iterations, test.maxIterations)
+		})
+	}
+}
@@ -2,13 +2,15 @@ package usecases
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 var (
-	ErrDeltaIsZero = errors.New("delta is zero")
+	ErrDeltaIsZero           = numeerr.New(numeerr.CodeInvalidInput, "delta is zero")
+	ErrUnsupportedErrorOrder = numeerr.New(numeerr.CodeUnsupported, "unsupported error order")
 )
 
 type ErrorOrder uint8
@@ -45,6 +47,21 @@ var (
 	_ DifferenceStrategy = (*CentralDifferenceStrategy)(nil)
 )
 
+// ParseDifferenceStrategy returns the strategy of the philosophy named
+// forward, backward or central.
+func ParseDifferenceStrategy(name string) (DifferenceStrategy, error) {
+	switch name {
+	case "forward":
+		return &ForwardDifferenceStrategy{}, nil
+	case "backward":
+		return &BackwardDifferenceStrategy{}, nil
+	case "central":
+		return &CentralDifferenceStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedPhilosophy, name)
+	}
+}
+
 type ForwardDifferenceStrategy struct {
 }
 
@@ -109,7 +126,7 @@ func (f *ForwardDifferenceStrategy) TripleDerivative(
 			return numerator / denominator
 		}
 	default:
-		return nil, errors.New("unsupported error order for triple derivative in forward difference strategy")
+		return nil, fmt.Errorf("%w for triple derivative in forward difference strategy: %d", ErrUnsupportedErrorOrder, errorOrder)
 	}
 
 	return fn, nil
@@ -170,7 +187,7 @@ func (b *BackwardDifferenceStrategy) TripleDerivative(ctx context.Context, simpl
 			return numerator / denominator
 		}
 	default:
-		return nil, errors.New("unsupported error order for triple derivative in backward difference strategy")
+		return nil, fmt.Errorf("%w for triple derivative in backward difference strategy: %d", ErrUnsupportedErrorOrder, errorOrder)
 	}
 
 	return fn, nil
@@ -237,7 +254,7 @@ func (c *CentralDifferenceStrategy) TripleDerivative(ctx context.Context,
 			return numerator / denominator
 		}
 	default:
-		return nil, errors.New("unsupported error order for triple derivative in central difference strategy")
+		return nil, fmt.Errorf("%w for triple derivative in central difference strategy: %d", ErrUnsupportedErrorOrder, errorOrder)
 	}
 
 	return fn, nil
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+// MaxDoubleIntegralSamples bounds the grid CalculateAreaWithSamples returns,
+// a 256×256 grid, so a visualization can't ask for a huge payload
+const MaxDoubleIntegralSamples = 1 << 16
+
+var ErrTooManySamples = numeerr.New(numeerr.CodeInvalidInput, "sample grid exceeds the limit")
+
+// IntegrandSample is the integrand Value at the midpoint (MidX, MidY) of one
+// cell of the Riemann sum.
+type IntegrandSample struct {
+	MidX  float64
+	MidY  float64
+	Value float64
+}
+
+// SampledArea is the area of a double integral along with the integrand at
+// every cell midpoint, ordered by x and then by y.
+type SampledArea struct {
+	Area    float64
+	Samples []IntegrandSample
+}
+
+// CalculateAreaWithSamples computes the same midpoint Riemann sum as a
+// sequential CalculateArea, keeping the integrand value of each cell for the
+// caller to render where the function contributes. Grids of more than
+// MaxDoubleIntegralSamples cells are rejected.
+func (d *DoubleIntegralUseCase) CalculateAreaWithSamples(
+	ctx context.Context,
+	expr expressions.DualVariableExpr,
+	leftIntervalX, rightIntervalX,
+	leftIntervalY, rightIntervalY float64,
+	numberOfPartitions uint64,
+) (*SampledArea, error) {
+	if leftIntervalX == rightIntervalX || leftIntervalY == rightIntervalY {
+		return nil, ErrZeroWidthInterval
+	}
+
+	if numberOfPartitions == 0 {
+		slog.WarnContext(ctx, "Number of partitions is zero, using default value of 1")
+		numberOfPartitions = 1
+	}
+
+	// Compared by division, squaring the partitions could overflow
+	if numberOfPartitions > MaxDoubleIntegralSamples/numberOfPartitions {
+		slog.ErrorContext(ctx, "Sample grid is too large",
+			slog.Uint64("numberOfPartitions", numberOfPartitions),
+			slog.Int("maxSamples", MaxDoubleIntegralSamples),
+		)
+		return nil, fmt.Errorf("%w: %d×%d partitions, the maximum is %d samples",
+			ErrTooManySamples, numberOfPartitions, numberOfPartitions, MaxDoubleIntegralSamples)
+	}
+
+	deltaX := (rightIntervalX - leftIntervalX) / float64(numberOfPartitions)
+	deltaY := (rightIntervalY - leftIntervalY) / float64(numberOfPartitions)
+
+	sampled := &SampledArea{
+		Samples: make([]IntegrandSample, 0, numberOfPartitions*numberOfPartitions),
+	}
+
+	for i := uint64(0); i < numberOfPartitions; i++ {
+		for j := uint64(0); j < numberOfPartitions; j++ {
+			midX := leftIntervalX + (float64(i)+0.5)*deltaX
+			midY := leftIntervalY + (float64(j)+0.5)*deltaY
+
+			value := expr(midX, midY)
+			sampled.Samples = append(sampled.Samples, IntegrandSample{MidX: midX, MidY: midY, Value: value})
+			sampled.Area += value * deltaX * deltaY
+		}
+	}
+
+	slog.DebugContext(ctx, "Calculated double integral area with samples",
+		slog.Float64("area", sampled.Area),
+		slog.Int("samples", len(sampled.Samples)),
+	)
+
+	return sampled, nil
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoubleIntegralCalculateAreaWithSamples(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewDoubleIntegralUseCase()
+	expr := func(x, y float64) float64 { return math.Sin(x) * math.Exp(y) }
+	const partitions = 12
+
+	// Act
+	sampled, err := useCase.CalculateAreaWithSamples(t.Context(), expr, 0, math.Pi, -1, 2, partitions)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sampled.Samples, partitions*partitions)
+
+	for _, sample := range sampled.Samples {
+		assert.Equal(t, expr(sample.MidX, sample.MidY), sample.Value)
+	}
+
+	first, last := sampled.Samples[0], sampled.Samples[len(sampled.Samples)-1]
+	assert.InDelta(t, math.Pi/24, first.MidX, 1e-12)
+	assert.InDelta(t, -0.875, first.MidY, 1e-12)
+	assert.InDelta(t, math.Pi-math.Pi/24, last.MidX, 1e-12)
+	assert.InDelta(t, 1.875, last.MidY, 1e-12)
+
+	area, err := useCase.CalculateArea(t.Context(), expr, 0, math.Pi, -1, 2, partitions)
+	require.NoError(t, err)
+	assert.Equal(t, area, sampled.Area)
+}
+
+func TestDoubleIntegralCalculateAreaWithSamplesLimits(t *testing.T) {
+	t.Parallel()
+
+	constant := func(x, y float64) float64 { return 1 }
+
+	tt := []struct {
+		name          string
+		partitions    uint64
+		rightX        float64
+		expectedErr   error
+		expectedCount int
+	}{
+		{name: "At the limit", partitions: 256, rightX: 1, expectedCount: MaxDoubleIntegralSamples},
+		{name: "Past the limit", partitions: 257, rightX: 1, expectedErr: ErrTooManySamples},
+		{name: "Overflowing square", partitions: 1 << 40, rightX: 1, expectedErr: ErrTooManySamples},
+		{name: "Zero partitions", partitions: 0, rightX: 1, expectedCount: 1},
+		{name: "Zero width", partitions: 4, rightX: 0, expectedErr: ErrZeroWidthInterval},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDoubleIntegralUseCase()
+
+			// Act
+			sampled, err := useCase.CalculateAreaWithSamples(t.Context(), constant, 0, test.rightX, 0, 1, test.partitions)
+
+			// Assert
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+				assert.Nil(t, sampled)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, sampled.Samples, test.expectedCount)
+			assert.InDelta(t, 1, sampled.Area, 1e-9)
+		})
+	}
+}
@@ -2,20 +2,41 @@ package usecases
 
 import (
 	"context"
-	"errors"
 	"log/slog"
+	"sync"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 type DoubleIntegralUseCase struct {
+	options DoubleIntegralOptions
+}
+
+// DoubleIntegralOptions spreads the rows of the Riemann sum over Workers
+// goroutines. Without Deterministic each worker adds its rows as they come
+// and the partial sums are combined as workers finish, so the rounding, and
+// the last bits of the result, change from run to run. Deterministic keeps
+// every row sum and adds them pairwise in row order instead, which is
+// bit-reproducible at the cost of one float per row and of waiting for all
+// rows before reducing. A single worker always sums sequentially. A canceled
+// context stops the workers after the rows they are on.
+type DoubleIntegralOptions struct {
+	Workers       int
+	Deterministic bool
 }
 
 func NewDoubleIntegralUseCase() *DoubleIntegralUseCase {
 	return &DoubleIntegralUseCase{}
 }
 
-var ErrZeroWidthInterval = errors.New(
+func NewDoubleIntegralUseCaseWithOptions(options DoubleIntegralOptions) *DoubleIntegralUseCase {
+	return &DoubleIntegralUseCase{
+		options: options,
+	}
+}
+
+var ErrZeroWidthInterval = numeerr.New(numeerr.CodeInvalidInput,
 	"left and right intervals are equal, cannot perform double integral",
 )
 
@@ -50,6 +71,29 @@ func (d *DoubleIntegralUseCase) CalculateArea(
 	deltaX := (rightIntervalX - leftIntervalX) / float64(numberOfPartitions)
 	deltaY := (rightIntervalY - leftIntervalY) / float64(numberOfPartitions)
 
+	if d.options.Workers > 1 {
+		// Double Riemann sum using midpoint rule, one row of cells per task
+		row := func(i uint64) float64 {
+			rowArea := 0.0
+			for j := uint64(0); j < numberOfPartitions; j++ {
+				midX := leftIntervalX + (float64(i)+0.5)*deltaX
+				midY := leftIntervalY + (float64(j)+0.5)*deltaY
+				rowArea += expr(midX, midY) * deltaX * deltaY
+			}
+			return rowArea
+		}
+
+		slog.DebugContext(ctx, "Summing the rows in parallel",
+			slog.Int("workers", d.options.Workers),
+			slog.Bool("deterministic", d.options.Deterministic),
+		)
+
+		if d.options.Deterministic {
+			return deterministicRowSum(ctx, numberOfPartitions, d.options.Workers, row)
+		}
+		return concurrentRowSum(ctx, numberOfPartitions, d.options.Workers, row)
+	}
+
 	accumulatedArea := 0.0
 
 	// Double Riemann sum using midpoint rule
@@ -67,3 +111,92 @@ func (d *DoubleIntegralUseCase) CalculateArea(
 
 	return accumulatedArea, nil
 }
+
+// rowIndices sends the row indices in order, stopping early when ctx is
+// canceled.
+func rowIndices(ctx context.Context, rows uint64) <-chan uint64 {
+	indices := make(chan uint64)
+	go func() {
+		defer close(indices)
+		for i := uint64(0); i < rows; i++ {
+			select {
+			case indices <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return indices
+}
+
+// concurrentRowSum adds the rows in whatever order the workers reach them.
+func concurrentRowSum(ctx context.Context, rows uint64, workers int, row func(i uint64) float64) (float64, error) {
+	indices := rowIndices(ctx, rows)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	total := 0.0
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			partial := 0.0
+			for i := range indices {
+				partial += row(i)
+			}
+
+			mu.Lock()
+			total += partial
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// deterministicRowSum computes the rows concurrently but reduces them with
+// pairwiseSum, so the result doesn't depend on scheduling.
+func deterministicRowSum(ctx context.Context, rows uint64, workers int, row func(i uint64) float64) (float64, error) {
+	rowAreas := make([]float64, rows)
+	indices := rowIndices(ctx, rows)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				rowAreas[i] = row(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return pairwiseSum(rowAreas), nil
+}
+
+// pairwiseSum adds values as a balanced tree, a fixed order whose rounding
+// error grows with log n instead of n.
+func pairwiseSum(values []float64) float64 {
+	switch len(values) {
+	case 0:
+		return 0
+	case 1:
+		return values[0]
+	}
+
+	half := len(values) / 2
+	return pairwiseSum(values[:half]) + pairwiseSum(values[half:])
+}
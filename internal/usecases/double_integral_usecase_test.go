@@ -1,13 +1,16 @@
 package usecases
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"math"
 	"os"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/taldoflemis/nume/internal/expressions"
 )
 
@@ -300,3 +303,109 @@ func TestDoubleIntegralCalculateAreaBenchmark(t *testing.T) {
 		})
 	}
 }
+
+func TestDoubleIntegralDeterministicIsBitReproducible(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewDoubleIntegralUseCaseWithOptions(DoubleIntegralOptions{
+		Workers:       8,
+		Deterministic: true,
+	})
+	expr := func(x, y float64) float64 {
+		return math.Sin(x*math.Pi) * math.Cos(y*math.Pi) * math.Exp(-(x*x + y*y))
+	}
+	run := func() float64 {
+		result, err := useCase.CalculateArea(t.Context(), expr, -1, 1.3, -1, 1.7, 300)
+		require.NoError(t, err)
+		return result
+	}
+
+	// Act
+	first, second := run(), run()
+
+	// Assert
+	assert.Equal(t, math.Float64bits(first), math.Float64bits(second))
+
+	sequential, err := NewDoubleIntegralUseCase().CalculateArea(t.Context(), expr, -1, 1.3, -1, 1.7, 300)
+	require.NoError(t, err)
+	assert.InDelta(t, sequential, first, 1e-12)
+}
+
+func TestDoubleIntegralParallelMatchesSequential(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options DoubleIntegralOptions
+	}{
+		{
+			name:    "Concurrent reduction",
+			options: DoubleIntegralOptions{Workers: 4},
+		},
+		{
+			name:    "Deterministic reduction",
+			options: DoubleIntegralOptions{Workers: 4, Deterministic: true},
+		},
+		{
+			name:    "Single worker",
+			options: DoubleIntegralOptions{Workers: 1, Deterministic: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDoubleIntegralUseCaseWithOptions(test.options)
+
+			// Act
+			result, err := useCase.CalculateArea(t.Context(), func(x, y float64) float64 { return x * y }, 0, 2, 0, 3, 50)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, 9.0, result, 1e-10)
+		})
+	}
+}
+
+func TestDoubleIntegralParallelStopsWhenCanceled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options DoubleIntegralOptions
+	}{
+		{
+			name:    "Concurrent reduction",
+			options: DoubleIntegralOptions{Workers: 4},
+		},
+		{
+			name:    "Deterministic reduction",
+			options: DoubleIntegralOptions{Workers: 4, Deterministic: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewDoubleIntegralUseCaseWithOptions(test.options)
+			ctx, cancel := context.WithCancel(t.Context())
+			var evaluations atomic.Int64
+			expr := func(x, y float64) float64 {
+				// Cancel from inside the sum, as a client hanging up would
+				if evaluations.Add(1) == 1 {
+					cancel()
+				}
+				return x * y
+			}
+
+			// Act
+			_, err := useCase.CalculateArea(ctx, expr, 0, 2, 0, 3, 1000)
+
+			// Assert
+			require.ErrorIs(t, err, context.Canceled)
+			assert.Less(t, evaluations.Load(), int64(1000*1000))
+		})
+	}
+}
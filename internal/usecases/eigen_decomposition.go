@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"log/slog"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// EigenDecomposition is A = VΛVᵀ of a symmetric matrix, the columns of
+// Eigenvectors being the eigenvectors in the order of Eigenvalues. The
+// eigenvalues are ordered with CompareEigenvalues, so the order is the same
+// for every run.
+type EigenDecomposition struct {
+	Eigenvalues  []float64
+	Eigenvectors [][]float64
+	Iterations   int
+	// ReconstructionError is the Frobenius norm ‖A − VΛVᵀ‖, how well the
+	// whole decomposition describes the matrix
+	ReconstructionError float64
+}
+
+// DecomposeSymmetric runs CompleteEigenDecomposition and measures how well its
+// result reconstructs the matrix. Non-symmetric matrices are rejected, as
+// VΛVᵀ only holds when the eigenvectors are orthonormal.
+func (u *SimilarityTransformationUseCase) DecomposeSymmetric(
+	ctx context.Context,
+	matrix [][]float64,
+	maxIterations int,
+	tolerance float64,
+) (*EigenDecomposition, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return nil, err
+	}
+
+	if err := validateSymmetricMatrix(matrix); err != nil {
+		return nil, err
+	}
+
+	result, err := u.CompleteEigenDecomposition(ctx, matrix, maxIterations, tolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	eigenvalues, eigenvectors := sortEigenpairs(result.Eigenvalues, result.Eigenvectors, tolerance)
+	reconstructionError := ReconstructionError(matrix, eigenvalues, eigenvectors)
+
+	slog.DebugContext(ctx, "Measured the eigendecomposition reconstruction error",
+		slog.Float64("reconstructionError", reconstructionError),
+	)
+
+	return &EigenDecomposition{
+		Eigenvalues:         eigenvalues,
+		Eigenvectors:        denseToSliceOfSlices(eigenvectors),
+		Iterations:          result.Iterations,
+		ReconstructionError: reconstructionError,
+	}, nil
+}
+
+// ReconstructionError is the Frobenius norm ‖A − VΛVᵀ‖ of a decomposition
+// whose eigenvectors are the columns of V.
+func ReconstructionError(matrix [][]float64, eigenvalues []float64, eigenvectors mat.Matrix) float64 {
+	var scaled mat.Dense
+	scaled.Apply(func(_, j int, value float64) float64 {
+		return value * eigenvalues[j]
+	}, eigenvectors)
+
+	var reconstructed mat.Dense
+	reconstructed.Mul(&scaled, eigenvectors.T())
+
+	var residual mat.Dense
+	residual.Sub(constructMatrix(matrix), &reconstructed)
+
+	return mat.Norm(&residual, 2)
+}
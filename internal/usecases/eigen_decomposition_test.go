@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecomposeSymmetric(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		matrix [][]float64
+	}{
+		{
+			name:   "Tridiagonal",
+			matrix: [][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+		},
+		{
+			name:   "Dense",
+			matrix: [][]float64{{6, 1, 2, 0, 0}, {1, 5, 1, 1, 0}, {2, 1, 4, 1, 1}, {0, 1, 1, 3, 1}, {0, 0, 1, 1, 2}},
+		},
+		{
+			name:   "Diagonal",
+			matrix: [][]float64{{3, 0}, {0, -1}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			uc := NewSimilarityTransformationUseCase()
+
+			// Act
+			result, err := uc.DecomposeSymmetric(context.Background(), tc.matrix, 1000, 1e-12)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Len(t, result.Eigenvalues, len(tc.matrix))
+			assert.Len(t, result.Eigenvectors, len(tc.matrix))
+			assert.Less(t, result.ReconstructionError, 1e-8)
+		})
+	}
+}
+
+func TestDecomposeSymmetricRejectsInvalidMatrices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		expectedErr error
+	}{
+		{name: "Empty", matrix: [][]float64{}, expectedErr: ErrEmptyMatrix},
+		{name: "Rectangular", matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}, expectedErr: ErrNonSquareMatrix},
+		{name: "NonSymmetric", matrix: [][]float64{{2, 3}, {5, 4}}, expectedErr: ErrNonSymmetricMatrix},
+		{name: "Triangular", matrix: [][]float64{{1, 2}, {0, 3}}, expectedErr: ErrNonSymmetricMatrix},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			uc := NewSimilarityTransformationUseCase()
+
+			// Act
+			_, err := uc.DecomposeSymmetric(context.Background(), tc.matrix, 1000, 1e-12)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestReconstructionErrorDetectsWrongEigenvectors(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	matrix := [][]float64{{2, 0}, {0, 1}}
+	swapped := constructMatrix([][]float64{{0, 1}, {1, 0}})
+
+	// Act
+	reconstructionError := ReconstructionError(matrix, []float64{2, 1}, swapped)
+
+	// Assert
+	assert.InDelta(t, math.Sqrt2, reconstructionError, 1e-12)
+}
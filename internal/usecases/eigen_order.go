@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"cmp"
+	"math"
+	"math/cmplx"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// CompareEigenvalues orders eigenvalues by decreasing magnitude, the dominant
+// one first. Magnitudes within tolerance, relative to the larger one, are
+// equal, so ±λ pairs found with rounding errors are ordered by decreasing
+// real part and then decreasing imaginary part instead of by whichever
+// rounding error is larger.
+func CompareEigenvalues(a, b complex128, tolerance float64) int {
+	magnitudeA, magnitudeB := cmplx.Abs(a), cmplx.Abs(b)
+	if math.Abs(magnitudeA-magnitudeB) > tolerance*max(1, magnitudeA, magnitudeB) {
+		return cmp.Compare(magnitudeB, magnitudeA)
+	}
+
+	if byReal := compareWithin(real(b), real(a), tolerance); byReal != 0 {
+		return byReal
+	}
+
+	return compareWithin(imag(b), imag(a), tolerance)
+}
+
+// compareWithin compares a and b, equal when within tolerance relative to the
+// larger of them.
+func compareWithin(a, b, tolerance float64) int {
+	if math.Abs(a-b) <= tolerance*max(1, math.Abs(a), math.Abs(b)) {
+		return 0
+	}
+
+	return cmp.Compare(a, b)
+}
+
+// sortEigenpairs orders eigenvalues with CompareEigenvalues, permuting the
+// columns of eigenvectors along with them. The sort is stable, so equal
+// eigenvalues keep the order the decomposition found them in.
+func sortEigenpairs(eigenvalues []float64, eigenvectors mat.Matrix, tolerance float64) ([]float64, *mat.Dense) {
+	order := make([]int, len(eigenvalues))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		return CompareEigenvalues(complex(eigenvalues[i], 0), complex(eigenvalues[j], 0), tolerance)
+	})
+
+	rows, _ := eigenvectors.Dims()
+	sortedValues := make([]float64, len(eigenvalues))
+	sortedVectors := mat.NewDense(rows, len(eigenvalues), nil)
+	for column, source := range order {
+		sortedValues[column] = eigenvalues[source]
+		for row := range rows {
+			sortedVectors.Set(row, column, eigenvectors.At(row, source))
+		}
+	}
+
+	return sortedValues, sortedVectors
+}
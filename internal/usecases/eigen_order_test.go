@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareEigenvalues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a        complex128
+		b        complex128
+		expected int
+	}{
+		{name: "LargerMagnitudeFirst", a: -3, b: 2, expected: -1},
+		{name: "SmallerMagnitudeLast", a: 1, b: -2, expected: 1},
+		{name: "PositiveBeforeNegative", a: 2, b: -2, expected: -1},
+		{name: "NegativeAfterPositive", a: -2, b: 2, expected: 1},
+		{name: "RoundingErrorsIgnored", a: -2.0000000000001, b: 1.9999999999999, expected: 1},
+		{name: "ImaginaryPartBreaksTies", a: complex(1, -1), b: complex(1, 1), expected: 1},
+		{name: "Equal", a: complex(0, 2), b: complex(0, 2), expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			order := CompareEigenvalues(tc.a, tc.b, 1e-9)
+
+			// Assert
+			assert.Equal(t, tc.expected, order)
+		})
+	}
+}
+
+func TestDecomposeSymmetricOrdersOppositeEigenvalues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		matrix   [][]float64
+		expected []float64
+	}{
+		{
+			name:     "PlusMinusTwo",
+			matrix:   [][]float64{{0, 2}, {2, 0}},
+			expected: []float64{2, -2},
+		},
+		{
+			name:     "PlusMinusTwoAndOne",
+			matrix:   [][]float64{{1, 0, 0}, {0, 0, 2}, {0, 2, 0}},
+			expected: []float64{2, -2, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			uc := NewSimilarityTransformationUseCase()
+			first, err := uc.DecomposeSymmetric(context.Background(), tc.matrix, 1000, 1e-12)
+			require.NoError(t, err)
+
+			for range 10 {
+				// Act
+				result, err := uc.DecomposeSymmetric(context.Background(), tc.matrix, 1000, 1e-12)
+
+				// Assert
+				require.NoError(t, err)
+				assert.InDeltaSlice(t, tc.expected, result.Eigenvalues, 1e-9)
+				assert.Equal(t, first.Eigenvalues, result.Eigenvalues)
+				assert.Equal(t, first.Eigenvectors, result.Eigenvectors)
+				assert.Less(t, result.ReconstructionError, 1e-8)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrEigenvectorDimension = numeerr.New(numeerr.CodeInvalidInput, "eigenvector dimension does not match the matrix")
+	ErrZeroEigenvector      = numeerr.New(numeerr.CodeInvalidInput, "eigenvector cannot be zero")
+	ErrPolishFailed         = numeerr.New(numeerr.CodeFailed, "eigenvector polishing failed")
+)
+
+// EigenResidual is ‖Av − λv‖₂ / ‖v‖₂, how far v is from being an eigenvector
+// of matrix for eigenvalue, independent of the scale of v.
+func EigenResidual(matrix [][]float64, eigenvalue float64, eigenvector []float64) float64 {
+	v := constructVector(eigenvector)
+
+	var residual mat.VecDense
+	residual.MulVec(constructMatrix(matrix), v)
+	residual.AddScaledVec(&residual, -eigenvalue, v)
+
+	return residual.Norm(2) / v.Norm(2)
+}
+
+// PolishEigenvector refines an approximate eigenpair with one step of inverse
+// iteration, solving (A − λI + εI)x = v and normalizing x. Components of v
+// along the other eigenvectors shrink by about |λ − λᵢ| / ε, so a single step
+// removes most of the error left by the power methods. ε keeps the system
+// invertible when λ is exact.
+func (u *PowerUseCase) PolishEigenvector(
+	ctx context.Context,
+	matrix [][]float64,
+	eigenvalue float64,
+	eigenvector []float64,
+) ([]float64, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return nil, err
+	}
+
+	if len(eigenvector) != len(matrix) {
+		return nil, fmt.Errorf("%w: %d != %d", ErrEigenvectorDimension, len(eigenvector), len(matrix))
+	}
+
+	if all(eigenvector, func(value float64) bool { return value == 0 }) {
+		return nil, ErrZeroEigenvector
+	}
+
+	epsilon := inverseIterationShift * math.Max(1, math.Abs(eigenvalue))
+
+	shifted := constructMatrix(matrix)
+	for i := range matrix {
+		shifted.Set(i, i, shifted.At(i, i)-eigenvalue+epsilon)
+	}
+
+	var lu mat.LU
+	lu.Factorize(shifted)
+
+	v := constructVector(eigenvector)
+	var x mat.VecDense
+	if err := lu.SolveVecTo(&x, false, v); err != nil {
+		// An ill-conditioned system is expected, as λ is nearly an eigenvalue
+		var condition mat.Condition
+		if !errors.As(err, &condition) {
+			return nil, fmt.Errorf("%w near eigenvalue %g: %w", ErrPolishFailed, eigenvalue, err)
+		}
+	}
+
+	norm := x.Norm(2)
+	if norm == 0 || math.IsNaN(norm) || math.IsInf(norm, 0) {
+		return nil, fmt.Errorf("%w near eigenvalue %g: solution norm is %v", ErrPolishFailed, eigenvalue, norm)
+	}
+	x.ScaleVec(1/norm, &x)
+
+	// Keep the orientation of the input vector
+	if mat.Dot(&x, v) < 0 {
+		x.ScaleVec(-1, &x)
+	}
+
+	polished := x.RawVector().Data
+
+	slog.DebugContext(ctx, "Polished the eigenvector",
+		slog.Float64("eigenvalue", eigenvalue),
+		slog.Float64("residualBefore", EigenResidual(matrix, eigenvalue, eigenvector)),
+		slog.Float64("residualAfter", EigenResidual(matrix, eigenvalue, polished)),
+	)
+
+	return polished, nil
+}
@@ -0,0 +1,155 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolishEigenvectorReducesResidual(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		eigenvalue  float64
+		eigenvector []float64
+	}{
+		{
+			name:        "Perturbed",
+			matrix:      [][]float64{{2, 3}, {5, 4}},
+			eigenvalue:  7,
+			eigenvector: []float64{0.62, 0.98},
+		},
+		{
+			name:        "ApproximateEigenvalue",
+			matrix:      [][]float64{{10, 6, 7}, {1, 7, -2}, {2, 2, 2}},
+			eigenvalue:  6.0001,
+			eigenvector: []float64{9.3, -7.6, 1.2},
+		},
+		{
+			name:        "Symmetric",
+			matrix:      [][]float64{{4, 1, 0, 0}, {1, 3, 1, 0}, {0, 1, 3, 1}, {0, 0, 1, 2}},
+			eigenvalue:  4.8477591,
+			eigenvector: []float64{0.7, 0.6, 0.3, 0.1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			before := EigenResidual(tc.matrix, tc.eigenvalue, tc.eigenvector)
+
+			// Act
+			polished, err := useCase.PolishEigenvector(t.Context(), tc.matrix, tc.eigenvalue, tc.eigenvector)
+
+			// Assert
+			require.NoError(t, err)
+			after := EigenResidual(tc.matrix, tc.eigenvalue, polished)
+			assert.Less(t, after, before/10, "residual %g should shrink from %g", after, before)
+			assert.InDelta(t, 1, vectorNorm(polished), 1e-12)
+		})
+	}
+}
+
+func TestSolvePolishesEigenvector(t *testing.T) {
+	t.Parallel()
+
+	matrix := [][]float64{{10, 6, 7}, {1, 7, -2}, {2, 2, 2}}
+
+	for _, method := range PowerMethods() {
+		t.Run(method.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			params := PowerParams{Shift: 5, Epsilon: 1e-3, MaxIterations: 1000}
+			reference, err := useCase.Solve(t.Context(), method, matrix, PowerParams{Shift: 5, Epsilon: 1e-12, MaxIterations: 10000})
+			require.NoError(t, err)
+			rough, err := useCase.Solve(t.Context(), method, matrix, params)
+			require.NoError(t, err)
+
+			// Act
+			params.Polish = true
+			polished, err := useCase.Solve(t.Context(), method, matrix, params)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, rough.Eigenvalue, polished.Eigenvalue)
+			assert.LessOrEqual(t,
+				directionError(polished.Eigenvector, reference.Eigenvector),
+				directionError(rough.Eigenvector, reference.Eigenvector)+1e-12,
+			)
+		})
+	}
+}
+
+func TestPolishEigenvectorWithExactEigenvalue(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+	matrix := [][]float64{{2, 0}, {0, 3}}
+
+	// Act
+	polished, err := useCase.PolishEigenvector(t.Context(), matrix, 3, []float64{0.1, -1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 0, polished[0], 1e-8)
+	assert.InDelta(t, -1, polished[1], 1e-8, "the orientation of the input is kept")
+}
+
+func TestPolishEigenvectorRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		eigenvector []float64
+		expectedErr error
+	}{
+		{name: "NonSquare", matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}, eigenvector: []float64{1, 1}, expectedErr: ErrNonSquareMatrix},
+		{name: "Dimension", matrix: [][]float64{{1, 2}, {3, 4}}, eigenvector: []float64{1, 1, 1}, expectedErr: ErrEigenvectorDimension},
+		{name: "ZeroVector", matrix: [][]float64{{1, 2}, {3, 4}}, eigenvector: []float64{0, 0}, expectedErr: ErrZeroEigenvector},
+		{name: "NonFinite", matrix: [][]float64{{math.NaN(), 2}, {3, 4}}, eigenvector: []float64{1, 1}, expectedErr: ErrPolishFailed},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+
+			// Act
+			_, err := useCase.PolishEigenvector(t.Context(), tc.matrix, 1, tc.eigenvector)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+// directionError is the distance between the unit vectors along v and
+// reference, ignoring their sign.
+func directionError(v, reference []float64) float64 {
+	vNorm, referenceNorm := vectorNorm(v), vectorNorm(reference)
+
+	same, opposite := 0.0, 0.0
+	for i := range v {
+		same += math.Pow(v[i]/vNorm-reference[i]/referenceNorm, 2)
+		opposite += math.Pow(v[i]/vNorm+reference[i]/referenceNorm, 2)
+	}
+
+	return math.Sqrt(min(same, opposite))
+}
+
+func vectorNorm(v []float64) float64 {
+	sum := 0.0
+	for _, value := range v {
+		sum += value * value
+	}
+	return math.Sqrt(sum)
+}
@@ -0,0 +1,79 @@
+package gaussianquadratures
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrInexactRule         = numeerr.New(numeerr.CodeFailed, "quadrature rule is not exact up to its degree")
+	ErrNoReferenceMoments  = numeerr.New(numeerr.CodeUnsupported, "quadrature rule has no reference moments")
+	ErrMismatchedRuleSizes = numeerr.New(numeerr.CodeInvalidInput, "quadrature rule has different numbers of nodes and weights")
+)
+
+// exactnessTolerance bounds the error on each monomial relative to the sum of
+// the absolute terms, leaving room for tables typed with 15 digits.
+const exactnessTolerance = 1e-12
+
+// VerifyRulesOnConstruction makes every constructor check its rule with
+// VerifyExactness, a debug mode guarding the hand-typed node and weight tables.
+var VerifyRulesOnConstruction = false
+
+// momentRule is a rule that knows the moments ∫ xᵏ w(x) dx of its weight.
+type momentRule interface {
+	GaussianQuadrature
+	moment(k int) float64
+}
+
+// VerifyExactness checks that an n-point rule integrates every monomial up to
+// xⁿ⁺ⁿ⁻¹ against its weight function, the degree of exactness of Gaussian
+// quadrature.
+func VerifyExactness(rule GaussianQuadrature) error {
+	withMoments, ok := rule.(momentRule)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoReferenceMoments, rule.Describe())
+	}
+
+	nodes, weights := rule.GetNodes(), rule.GetWeights()
+	if len(nodes) != len(weights) {
+		return fmt.Errorf("%w: %s has %d nodes and %d weights",
+			ErrMismatchedRuleSizes, rule.Describe(), len(nodes), len(weights))
+	}
+
+	for k := range 2 * len(nodes) {
+		sum, magnitude := 0.0, 0.0
+		for i, node := range nodes {
+			term := weights[i] * math.Pow(node, float64(k))
+			sum += term
+			magnitude += math.Abs(term)
+		}
+
+		expected := withMoments.moment(k)
+		if math.Abs(sum-expected) > exactnessTolerance*math.Max(magnitude, math.Abs(expected)) {
+			return fmt.Errorf("%w: %s of order %d integrates x^%d to %.17g instead of %.17g",
+				ErrInexactRule, rule.Describe(), rule.Order(), k, sum, expected)
+		}
+	}
+
+	return nil
+}
+
+func verifyOnConstruction(rule GaussianQuadrature) error {
+	if !VerifyRulesOnConstruction {
+		return nil
+	}
+
+	return VerifyExactness(rule)
+}
+
+// evenMoment is the moment of a weight symmetric around zero, where every odd
+// moment vanishes.
+func evenMoment(k int, even func(k int) float64) float64 {
+	if k%2 == 1 {
+		return 0
+	}
+
+	return even(k)
+}
@@ -0,0 +1,141 @@
+package gaussianquadratures
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyExactnessOfEveryRule(t *testing.T) {
+	t.Parallel()
+
+	var rules []GaussianQuadrature
+	for order := 2; order <= 4; order++ {
+		legendre, err := NewGaussLegendre(order)
+		require.NoError(t, err)
+		chebyshev, err := NewGaussChebyshev(order)
+		require.NoError(t, err)
+		hermite, err := NewGaussHermite(order)
+		require.NoError(t, err)
+		laguerre, err := NewGaussLaguerre(order)
+		require.NoError(t, err)
+		rules = append(rules, legendre, chebyshev, hermite, laguerre)
+	}
+	for _, order := range []int{1, 5, 16, 32} {
+		for _, exponents := range [][2]float64{{0, 0}, {-0.5, -0.5}, {0.5, -0.5}, {2, 1}} {
+			jacobi, err := NewGaussJacobi(order, exponents[0], exponents[1])
+			require.NoError(t, err)
+			rules = append(rules, jacobi)
+		}
+	}
+
+	for _, rule := range rules {
+		t.Run(fmt.Sprintf("%s order %d", rule.Describe(), rule.Order()), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			err := VerifyExactness(rule)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyExactnessCatchesTypos(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		corrupt     func(rule *GaussLaguerre)
+		expectedErr error
+	}{
+		{
+			name:        "Wrong digit in a node",
+			corrupt:     func(rule *GaussLaguerre) { rule.nodes[3][1] = 2.294280361279042 },
+			expectedErr: ErrInexactRule,
+		},
+		{
+			name:        "Truncated weight",
+			corrupt:     func(rule *GaussLaguerre) { rule.weights[3][2] = 0.0103892565 },
+			expectedErr: ErrInexactRule,
+		},
+		{
+			name:        "Missing weight",
+			corrupt:     func(rule *GaussLaguerre) { rule.weights[3] = rule.weights[3][:2] },
+			expectedErr: ErrMismatchedRuleSizes,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			rule, err := NewGaussLaguerre(3)
+			require.NoError(t, err)
+			test.corrupt(rule)
+
+			// Act
+			err = VerifyExactness(rule)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+// TestVerifyRulesOnConstruction isn't parallel since it toggles the package
+// debug flag, parallel tests only resume after it finishes.
+func TestVerifyRulesOnConstruction(t *testing.T) {
+	// Arrange
+	VerifyRulesOnConstruction = true
+	t.Cleanup(func() { VerifyRulesOnConstruction = false })
+
+	// Act
+	_, legendreErr := NewGaussLegendre(4)
+	_, jacobiErr := NewGaussJacobi(8, 0.5, 1.5)
+
+	// Assert
+	assert.NoError(t, legendreErr)
+	assert.NoError(t, jacobiErr)
+}
+
+func TestErrorOrderMatchesTheDegreeOfExactness(t *testing.T) {
+	t.Parallel()
+
+	var rules []GaussianQuadrature
+	for order := 1; order <= 4; order++ {
+		legendre, err := NewGaussLegendre(max(order, 2))
+		require.NoError(t, err)
+		chebyshev, err := NewGaussChebyshev(max(order, 2))
+		require.NoError(t, err)
+		jacobi, err := NewGaussJacobi(order, 2, 1)
+		require.NoError(t, err)
+		rules = append(rules, legendre, chebyshev, jacobi)
+	}
+
+	for _, rule := range rules {
+		t.Run(fmt.Sprintf("%s order %d", rule.Describe(), rule.Order()), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			withMoments, ok := rule.(momentRule)
+			require.True(t, ok)
+			nodes, weights := rule.GetNodes(), rule.GetWeights()
+
+			// Act
+			errorOrder := rule.ErrorOrder()
+
+			// Assert, exact below xᵖ and not at it
+			assert.Equal(t, 2*len(nodes), errorOrder)
+			sum := 0.0
+			for i, node := range nodes {
+				sum += weights[i] * math.Pow(node, float64(errorOrder))
+			}
+			assert.Greater(t, math.Abs(sum-withMoments.moment(errorOrder)), 1e-10)
+		})
+	}
+}
@@ -2,14 +2,16 @@ package gaussianquadratures
 
 import (
 	"context"
-	"errors"
 	"log/slog"
 	"math"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 type GaussChebyshev struct {
+	noTransformQuadrature
+
 	order   int
 	nodes   map[int][]float64
 	weights map[int][]float64
@@ -20,7 +22,7 @@ const (
 	chebyshevMinimumOrder = 2
 )
 
-var ErrChebyshevIntervalsMustBeMinusOneToOne = errors.New("chebyshev quadrature requires interval [-1, 1]")
+var ErrChebyshevIntervalsMustBeMinusOneToOne = numeerr.New(numeerr.CodeInvalidInput, "chebyshev quadrature requires interval [-1, 1]")
 
 var _ GaussianQuadrature = (*GaussChebyshev)(nil)
 
@@ -71,11 +73,18 @@ func NewGaussChebyshev(order int) (*GaussChebyshev, error) {
 		math.Pi / 4.0,
 	}
 
-	return &GaussChebyshev{
+	rule := &GaussChebyshev{
 		order:   order,
 		nodes:   nodes,
 		weights: weights,
-	}, nil
+	}
+
+	if err := verifyOnConstruction(rule); err != nil {
+		slog.Error("Invalid GaussChebyshev rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return rule, nil
 }
 
 // Describe implements GaussianQuadrature.
@@ -98,6 +107,11 @@ func (g *GaussChebyshev) Order() int {
 	return g.order
 }
 
+// ErrorOrder implements GaussianQuadrature.
+func (g *GaussChebyshev) ErrorOrder() int {
+	return 2 * g.order
+}
+
 // Validate implements GaussianQuadrature.
 func (g *GaussChebyshev) Validate(ctx context.Context, leftInterval, rightInterval float64) error {
 	if leftInterval != -1.0 || rightInterval != 1.0 {
@@ -118,20 +132,13 @@ func (g *GaussChebyshev) GetWeights() []float64 {
 	return g.weights[g.order]
 }
 
-// GetOffset implements GaussianQuadrature.
-func (g *GaussChebyshev) GetOffset(leftInterval, rightInterval float64) float64 {
-	// Gauss-Chebyshev quadrature doesn't need offset transformation
-	return 0.0
-}
-
-// GetScalingFactor implements GaussianQuadrature.
-func (g *GaussChebyshev) GetScalingFactor(leftInterval, rightInterval float64) float64 {
-	// Gauss-Chebyshev quadrature doesn't need scaling transformation
-	return 1.0
-}
-
-// AllowPartitioning implements GaussianQuadrature.
-func (g *GaussChebyshev) AllowPartitioning() bool {
-	// Gauss-Chebyshev quadrature is for [-1, 1] interval and doesn't support partitioning
-	return false
+// moment is ∫ xᵏ / √(1 - x²) dx over [-1, 1], π (k-1)!! / k!! for even k.
+func (g *GaussChebyshev) moment(k int) float64 {
+	return evenMoment(k, func(k int) float64 {
+		moment := math.Pi
+		for j := 1; j <= k/2; j++ {
+			moment *= float64(2*j-1) / float64(2*j)
+		}
+		return moment
+	})
 }
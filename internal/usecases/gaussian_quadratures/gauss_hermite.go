@@ -2,14 +2,16 @@ package gaussianquadratures
 
 import (
 	"context"
-	"errors"
 	"log/slog"
 	"math"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 type GaussHermite struct {
+	noTransformQuadrature
+
 	order   int
 	nodes   map[int][]float64
 	weights map[int][]float64
@@ -20,7 +22,7 @@ const (
 	hermiteMinimumOrder = 2
 )
 
-var ErrHermiteIntervalsMustBeInfinite = errors.New("hermite quadrature requires infinite intervals")
+var ErrHermiteIntervalsMustBeInfinite = numeerr.New(numeerr.CodeInvalidInput, "hermite quadrature requires infinite intervals")
 
 var _ GaussianQuadrature = (*GaussHermite)(nil)
 
@@ -65,11 +67,18 @@ func NewGaussHermite(order int) (*GaussHermite, error) {
 		0.081312835447245, 0.804914090005513, 0.804914090005513, 0.081312835447245,
 	}
 
-	return &GaussHermite{
+	rule := &GaussHermite{
 		order:   order,
 		nodes:   nodes,
 		weights: weights,
-	}, nil
+	}
+
+	if err := verifyOnConstruction(rule); err != nil {
+		slog.Error("Invalid GaussHermite rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return rule, nil
 }
 
 // Describe implements GaussianQuadrature.
@@ -92,6 +101,11 @@ func (g *GaussHermite) Order() int {
 	return g.order
 }
 
+// ErrorOrder implements GaussianQuadrature.
+func (g *GaussHermite) ErrorOrder() int {
+	return 2 * g.order
+}
+
 // Validate implements GaussianQuadrature.
 func (g *GaussHermite) Validate(ctx context.Context, leftInterval, rightInterval float64) error {
 	if leftInterval != math.Inf(-1) || rightInterval != math.Inf(1) {
@@ -111,20 +125,7 @@ func (g *GaussHermite) GetWeights() []float64 {
 	return g.weights[g.order]
 }
 
-// GetOffset implements GaussianQuadrature.
-func (g *GaussHermite) GetOffset(leftInterval, rightInterval float64) float64 {
-	// Gauss-Hermite quadrature doesn't need offset transformation
-	return 0.0
-}
-
-// GetScalingFactor implements GaussianQuadrature.
-func (g *GaussHermite) GetScalingFactor(leftInterval, rightInterval float64) float64 {
-	// Gauss-Hermite quadrature doesn't need scaling transformation
-	return 1.0
-}
-
-// AllowPartitioning implements GaussianQuadrature.
-func (g *GaussHermite) AllowPartitioning() bool {
-	// Gauss-Hermite quadrature is for (-∞, +∞) interval and doesn't support partitioning
-	return false
+// moment is ∫ xᵏ e^(-x²) dx over the real line, Γ((k+1)/2) for even k.
+func (g *GaussHermite) moment(k int) float64 {
+	return evenMoment(k, func(k int) float64 { return math.Gamma(float64(k+1) / 2) })
 }
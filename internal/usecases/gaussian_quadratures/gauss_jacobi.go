@@ -0,0 +1,168 @@
+package gaussianquadratures
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+// GaussJacobi integrates f(x)(1-x)^α(1+x)^β over [-1, 1], handling integrands
+// with algebraic endpoint singularities such as 1/√(1+x).
+type GaussJacobi struct {
+	// Partitions would move the singularities away from the endpoints
+	noTransformQuadrature
+
+	order   int
+	alpha   float64
+	beta    float64
+	nodes   []float64
+	weights []float64
+}
+
+const (
+	jacobiMaximumOrder = 32
+	jacobiMinimumOrder = 1
+)
+
+var (
+	ErrJacobiIntervalsMustBeMinusOneToOne = numeerr.New(numeerr.CodeInvalidInput, "jacobi quadrature requires interval [-1, 1]")
+	ErrInvalidJacobiOrder                 = numeerr.New(numeerr.CodeInvalidInput, "invalid order for jacobi quadrature, must be between 1 and 32")
+	ErrInvalidJacobiExponents             = numeerr.New(numeerr.CodeInvalidInput, "jacobi exponents must be greater than -1")
+)
+
+var _ GaussianQuadrature = (*GaussJacobi)(nil)
+
+func NewGaussJacobi(order int, alpha, beta float64) (*GaussJacobi, error) {
+	if order < jacobiMinimumOrder || order > jacobiMaximumOrder {
+		slog.Error("Invalid order for Gauss-Jacobi quadrature", slog.Int("order", order))
+		return nil, ErrInvalidJacobiOrder
+	}
+
+	if alpha <= -1 || beta <= -1 {
+		slog.Error("Invalid exponents for Gauss-Jacobi quadrature",
+			slog.Float64("alpha", alpha),
+			slog.Float64("beta", beta),
+		)
+		return nil, ErrInvalidJacobiExponents
+	}
+
+	a, b := jacobiRecurrence(order, alpha, beta)
+
+	nodes, weights, err := golubWelsch(a, b, jacobiWeightIntegral(alpha, beta))
+	if err != nil {
+		slog.Error("Error computing Gauss-Jacobi nodes", slog.Any("error", err))
+		return nil, fmt.Errorf("error computing gauss-jacobi nodes: %w", err)
+	}
+
+	rule := &GaussJacobi{
+		order:   order,
+		alpha:   alpha,
+		beta:    beta,
+		nodes:   nodes,
+		weights: weights,
+	}
+
+	if err := verifyOnConstruction(rule); err != nil {
+		slog.Error("Invalid GaussJacobi rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// jacobiWeightIntegral is the integral of (1-x)^α (1+x)^β over [-1, 1],
+// 2^(α+β+1) B(α+1, β+1).
+func jacobiWeightIntegral(alpha, beta float64) float64 {
+	lgammaAlpha, _ := math.Lgamma(alpha + 1)
+	lgammaBeta, _ := math.Lgamma(beta + 1)
+	lgammaSum, _ := math.Lgamma(alpha + beta + 2)
+
+	return math.Exp((alpha+beta+1)*math.Ln2 + lgammaAlpha + lgammaBeta - lgammaSum)
+}
+
+// jacobiRecurrence returns the monic recurrence coefficients of the Jacobi
+// polynomials P_k^(α,β).
+func jacobiRecurrence(order int, alpha, beta float64) ([]float64, []float64) {
+	a := make([]float64, order)
+	b := make([]float64, order)
+
+	sum := alpha + beta
+	a[0] = (beta - alpha) / (sum + 2)
+
+	for k := 1; k < order; k++ {
+		kf := float64(k)
+		s := 2*kf + sum
+		a[k] = (beta*beta - alpha*alpha) / (s * (s + 2))
+
+		if k == 1 {
+			// The general formula has a removable 0/0 when α+β = -1
+			b[k] = 4 * (alpha + 1) * (beta + 1) / ((sum + 2) * (sum + 2) * (sum + 3))
+			continue
+		}
+		b[k] = 4 * kf * (kf + alpha) * (kf + beta) * (kf + sum) / (s * s * (s + 1) * (s - 1))
+	}
+
+	return a, b
+}
+
+// Describe implements GaussianQuadrature.
+func (g *GaussJacobi) Describe() string {
+	return fmt.Sprintf("Gauss-Jacobi Quadrature (α=%g, β=%g)", g.alpha, g.beta)
+}
+
+// Integrate implements GaussianQuadrature.
+func (g *GaussJacobi) Integrate(
+	ctx context.Context,
+	expr expressions.SingleVariableExpr,
+	leftInterval,
+	rightInterval float64,
+) (float64, error) {
+	return calculatePartition(ctx, g, expr, leftInterval, rightInterval)
+}
+
+// Order implements GaussianQuadrature.
+func (g *GaussJacobi) Order() int {
+	return g.order
+}
+
+// ErrorOrder implements GaussianQuadrature.
+func (g *GaussJacobi) ErrorOrder() int {
+	return 2 * g.order
+}
+
+// Validate implements GaussianQuadrature.
+func (g *GaussJacobi) Validate(ctx context.Context, leftInterval, rightInterval float64) error {
+	if leftInterval != -1.0 || rightInterval != 1.0 {
+		slog.ErrorContext(ctx, "Left interval must be -1 and right interval must be 1, "+
+			"cannot perform Gauss-Jacobi quadrature. Use another quadrature method.")
+		return ErrJacobiIntervalsMustBeMinusOneToOne
+	}
+	return nil
+}
+
+// GetNodes implements GaussianQuadrature.
+func (g *GaussJacobi) GetNodes() []float64 {
+	return g.nodes
+}
+
+// GetWeights implements GaussianQuadrature.
+func (g *GaussJacobi) GetWeights() []float64 {
+	return g.weights
+}
+
+// moment is ∫ xᵏ (1-x)^α (1+x)^β dx over [-1, 1]. Integrating the derivative
+// of (1-x)^(α+1) (1+x)^(β+1) xᵏ by parts gives the recurrence
+// (α+β+k+2) mₖ₊₁ = (β-α) mₖ + k mₖ₋₁.
+func (g *GaussJacobi) moment(k int) float64 {
+	previous, current := 0.0, jacobiWeightIntegral(g.alpha, g.beta)
+	for j := range k {
+		jf := float64(j)
+		previous, current = current, ((g.beta-g.alpha)*current+jf*previous)/(g.alpha+g.beta+jf+2)
+	}
+
+	return current
+}
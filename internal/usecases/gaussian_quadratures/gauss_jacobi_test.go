@@ -0,0 +1,163 @@
+package gaussianquadratures
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+func TestGaussJacobi(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		alpha        float64
+		beta         float64
+		order        int
+		expr         expressions.SingleVariableExpr
+		expectedArea float64
+		tolerance    float64
+	}{
+		{
+			name:         "Legendre weight, e^x",
+			alpha:        0,
+			beta:         0,
+			order:        8,
+			expr:         math.Exp,
+			expectedArea: math.E - 1/math.E,
+			tolerance:    1e-12,
+		},
+		{
+			name:         "Chebyshev weight, cos(x)/√(1-x²)",
+			alpha:        -0.5,
+			beta:         -0.5,
+			order:        8,
+			expr:         math.Cos,
+			expectedArea: math.Pi * math.J0(1),
+			tolerance:    1e-12,
+		},
+		{
+			name:  "1/√(1+x) singularity, x/√(1+x)",
+			alpha: 0,
+			beta:  -0.5,
+			order: 2,
+			expr: func(x float64) float64 {
+				return x
+			},
+			expectedArea: -2 * math.Sqrt2 / 3,
+			tolerance:    1e-12,
+		},
+		{
+			// ∫₀¹ (1 + u + u²)/√u du through u = (1+x)/2
+			name:  "∫₀¹ (1 + u + u²)/√u du",
+			alpha: 0,
+			beta:  -0.5,
+			order: 3,
+			expr: func(x float64) float64 {
+				u := (1 + x) / 2
+				return (1 + u + u*u) / math.Sqrt2
+			},
+			expectedArea: 2 + 2.0/3 + 2.0/5,
+			tolerance:    1e-12,
+		},
+		{
+			name:  "Semicircle √(1-x²)",
+			alpha: 0.5,
+			beta:  0.5,
+			order: 1,
+			expr: func(x float64) float64 {
+				return 1
+			},
+			expectedArea: math.Pi / 2,
+			tolerance:    1e-12,
+		},
+		{
+			// Substituting 1-x = v² gives 2∫₀^√2 e^(1-v²) dv = e √π erf(√2)
+			name:         "1/√(1-x) singularity, e^x",
+			alpha:        -0.5,
+			beta:         0,
+			order:        10,
+			expr:         math.Exp,
+			expectedArea: math.E * math.Sqrt(math.Pi) * math.Erf(math.Sqrt2),
+			tolerance:    1e-10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s (order %d)", tc.name, tc.order), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			strategy, err := NewGaussJacobi(tc.order, tc.alpha, tc.beta)
+			require.NoError(t, err)
+			useCase := NewGaussCalculatorUseCase(strategy)
+
+			// Act
+			area, err := useCase.Calculate(context.Background(), tc.expr, -1, 1, 1)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedArea, area, tc.tolerance)
+		})
+	}
+}
+
+func TestGaussJacobiMatchesChebyshev(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	jacobi, err := NewGaussJacobi(4, -0.5, -0.5)
+	require.NoError(t, err)
+	chebyshev, err := NewGaussChebyshev(4)
+	require.NoError(t, err)
+
+	// Act
+	nodes := jacobi.GetNodes()
+	weights := jacobi.GetWeights()
+
+	// Assert
+	assert.InDeltaSlice(t, chebyshev.GetNodes(), nodes, 1e-12)
+	assert.InDeltaSlice(t, chebyshev.GetWeights(), weights, 1e-12)
+}
+
+func TestGaussJacobiErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Invalid order", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+
+		// Act
+		_, err := NewGaussJacobi(0, 0, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidJacobiOrder)
+	})
+
+	t.Run("Non integrable weight", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+
+		// Act
+		_, err := NewGaussJacobi(3, -1, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidJacobiExponents)
+	})
+
+	t.Run("Interval other than [-1, 1]", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+		strategy, err := NewGaussJacobi(3, 0, -0.5)
+		require.NoError(t, err)
+
+		// Act
+		_, err = strategy.Integrate(context.Background(), math.Exp, 0, 1)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrJacobiIntervalsMustBeMinusOneToOne)
+	})
+}
@@ -2,14 +2,16 @@ package gaussianquadratures
 
 import (
 	"context"
-	"errors"
 	"log/slog"
 	"math"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 type GaussLaguerre struct {
+	noTransformQuadrature
+
 	order   int
 	nodes   map[int][]float64
 	weights map[int][]float64
@@ -20,7 +22,7 @@ const (
 	laguerreMinimumOrder = 2
 )
 
-var ErrLaguerreIntervalsMustBePositiveInfinite = errors.New(
+var ErrLaguerreIntervalsMustBePositiveInfinite = numeerr.New(numeerr.CodeInvalidInput,
 	"laguerre quadrature requires interval [0, +∞)",
 )
 
@@ -69,11 +71,18 @@ func NewGaussLaguerre(order int) (*GaussLaguerre, error) {
 		5.392947055613296e-04,
 	}
 
-	return &GaussLaguerre{
+	rule := &GaussLaguerre{
 		order:   order,
 		nodes:   nodes,
 		weights: weights,
-	}, nil
+	}
+
+	if err := verifyOnConstruction(rule); err != nil {
+		slog.Error("Invalid GaussLaguerre rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return rule, nil
 }
 
 // Describe implements GaussianQuadrature.
@@ -96,6 +105,11 @@ func (g *GaussLaguerre) Order() int {
 	return g.order
 }
 
+// ErrorOrder implements GaussianQuadrature.
+func (g *GaussLaguerre) ErrorOrder() int {
+	return 2 * g.order
+}
+
 // Validate implements GaussianQuadrature.
 func (g *GaussLaguerre) Validate(ctx context.Context, leftInterval, rightInterval float64) error {
 	if leftInterval != 0.0 || rightInterval != math.Inf(1) {
@@ -116,20 +130,7 @@ func (g *GaussLaguerre) GetWeights() []float64 {
 	return g.weights[g.order]
 }
 
-// GetOffset implements GaussianQuadrature.
-func (g *GaussLaguerre) GetOffset(leftInterval, rightInterval float64) float64 {
-	// Gauss-Laguerre quadrature doesn't need offset transformation
-	return 0.0
-}
-
-// GetScalingFactor implements GaussianQuadrature.
-func (g *GaussLaguerre) GetScalingFactor(leftInterval, rightInterval float64) float64 {
-	// Gauss-Laguerre quadrature doesn't need scaling transformation
-	return 1.0
-}
-
-// AllowPartitioning implements GaussianQuadrature.
-func (g *GaussLaguerre) AllowPartitioning() bool {
-	// Gauss-Laguerre quadrature is for [0, +∞) interval and doesn't support partitioning
-	return false
+// moment is ∫ xᵏ e^(-x) dx over [0, +∞), k!.
+func (g *GaussLaguerre) moment(k int) float64 {
+	return math.Gamma(float64(k + 1))
 }
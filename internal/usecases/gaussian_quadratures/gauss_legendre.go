@@ -2,11 +2,11 @@ package gaussianquadratures
 
 import (
 	"context"
-	"errors"
 	"log/slog"
 	"math"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 type GaussLegendre struct {
@@ -20,7 +20,7 @@ const (
 	minimumOrder = 2
 )
 
-var ErrInvalidOrder = errors.New("invalid order for quadrature, must be between 2 and 4")
+var ErrInvalidOrder = numeerr.New(numeerr.CodeInvalidInput, "invalid order for quadrature, must be between 2 and 4")
 
 var _ GaussianQuadrature = (*GaussLegendre)(nil)
 
@@ -54,16 +54,23 @@ func NewGaussLegendre(order int) (*GaussLegendre, error) {
 		((18.0 - math.Sqrt(30.0)) / 36.0),
 	}
 
-	return &GaussLegendre{
+	rule := &GaussLegendre{
 		order:   order,
 		nodes:   nodes,
 		weights: weights,
-	}, nil
+	}
+
+	if err := verifyOnConstruction(rule); err != nil {
+		slog.Error("Invalid GaussLegendre rule", slog.Any("error", err))
+		return nil, err
+	}
+
+	return rule, nil
 }
 
 var (
-	ErrInfiniteLeftInterval  = errors.New("left interval is infinite")
-	ErrInfiniteRightInterval = errors.New("right interval is infinite")
+	ErrInfiniteLeftInterval  = numeerr.New(numeerr.CodeInvalidInput, "left interval is infinite")
+	ErrInfiniteRightInterval = numeerr.New(numeerr.CodeInvalidInput, "right interval is infinite")
 )
 
 // Integrate implements GaussianQuadrature.
@@ -86,6 +93,11 @@ func (g *GaussLegendre) Order() int {
 	return g.order
 }
 
+// ErrorOrder implements GaussianQuadrature.
+func (g *GaussLegendre) ErrorOrder() int {
+	return 2 * g.order
+}
+
 // Validate implements GaussianQuadrature.
 func (g *GaussLegendre) Validate(ctx context.Context, leftInterval, rightInterval float64) error {
 	if leftInterval == math.Inf(-1) {
@@ -132,3 +144,8 @@ func (g *GaussLegendre) AllowPartitioning() bool {
 	// Gauss-Legendre quadrature supports partitioning for arbitrary intervals
 	return true
 }
+
+// moment is ∫ xᵏ dx over [-1, 1].
+func (g *GaussLegendre) moment(k int) float64 {
+	return evenMoment(k, func(k int) float64 { return 2 / float64(k+1) })
+}
@@ -2,13 +2,18 @@ package gaussianquadratures
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log/slog"
+	"math"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
-var ErrZeroWidthInterval = errors.New("interval width is zero")
+var (
+	ErrZeroWidthInterval       = numeerr.New(numeerr.CodeInvalidInput, "interval width is zero")
+	ErrInfiniteAverageInterval = numeerr.New(numeerr.CodeInvalidInput, "average is undefined over an infinite interval")
+)
 
 type GaussianQuadrature interface {
 	Integrate(
@@ -24,6 +29,30 @@ type GaussianQuadrature interface {
 	AllowPartitioning() bool
 	Describe() string
 	Order() int
+	// ErrorOrder is 2n for an n-point rule, exact up to degree 2n - 1: the
+	// error involves the 2n-th derivative of the integrand and, for rules
+	// that allow partitioning, shrinks as O(h²ⁿ) with the partition width
+	ErrorOrder() int
+}
+
+// noTransformQuadrature is embedded by the rules whose weight function fixes
+// the interval, so the nodes are used as they are and the interval is never
+// partitioned. Rules mapping [-1, 1] onto the interval override its methods.
+type noTransformQuadrature struct{}
+
+// GetOffset implements GaussianQuadrature.
+func (noTransformQuadrature) GetOffset(leftInterval, rightInterval float64) float64 {
+	return 0.0
+}
+
+// GetScalingFactor implements GaussianQuadrature.
+func (noTransformQuadrature) GetScalingFactor(leftInterval, rightInterval float64) float64 {
+	return 1.0
+}
+
+// AllowPartitioning implements GaussianQuadrature.
+func (noTransformQuadrature) AllowPartitioning() bool {
+	return false
 }
 
 type GaussCalculatorUseCase struct {
@@ -62,25 +91,30 @@ func (u *GaussCalculatorUseCase) Calculate(
 		return u.strategy.Integrate(ctx, expr, leftInterval, rightInterval)
 	}
 
+	// Like the double integral, zero partitions integrate the whole interval
+	// at once instead of failing
 	if numberOfPartitions == 0 {
-		slog.ErrorContext(ctx, "Max number of partitions is zero")
-		return 0.0, errors.New("max number of partitions must be greater than zero")
+		slog.WarnContext(ctx, "Number of partitions is zero, using default value of 1")
+		numberOfPartitions = 1
 	}
 
 	delta := (rightInterval - leftInterval) / float64(numberOfPartitions)
 
 	accumulatedArea := 0.0
 
-	for i := leftInterval; i <= rightInterval; i += delta {
+	for partition := range numberOfPartitions {
+		left := leftInterval + float64(partition)*delta
+		right := left + delta
+
 		slog.DebugContext(ctx, "Calculating area for partition",
-			slog.Float64("left", i),
-			slog.Float64("right", i+delta),
-			slog.Uint64("partition", uint64(i/delta)),
+			slog.Float64("left", left),
+			slog.Float64("right", right),
+			slog.Uint64("partition", partition),
 		)
-		partitionArea, err := u.strategy.Integrate(ctx, expr, i, i+delta)
+		partitionArea, err := u.strategy.Integrate(ctx, expr, left, right)
 		if err != nil {
 			slog.ErrorContext(ctx, "Error integrating partition", slog.Any("error", err))
-			return 0.0, errors.New("error integrating partition: " + err.Error())
+			return 0.0, fmt.Errorf("error integrating partition: %w", err)
 		}
 
 		slog.DebugContext(ctx, "Calculated area for partition",
@@ -97,6 +131,28 @@ func (u *GaussCalculatorUseCase) Calculate(
 	return accumulatedArea, nil
 }
 
+// Average returns the mean value of expr over the interval, its integral
+// divided by the interval width. Infinite intervals have no mean value.
+func (u *GaussCalculatorUseCase) Average(
+	ctx context.Context,
+	expr expressions.SingleVariableExpr,
+	leftInterval,
+	rightInterval float64,
+	numberOfPartitions uint64,
+) (float64, error) {
+	if math.IsInf(leftInterval, 0) || math.IsInf(rightInterval, 0) {
+		slog.ErrorContext(ctx, "Cannot average over an infinite interval")
+		return 0, ErrInfiniteAverageInterval
+	}
+
+	area, err := u.Calculate(ctx, expr, leftInterval, rightInterval, numberOfPartitions)
+	if err != nil {
+		return 0, err
+	}
+
+	return area / (rightInterval - leftInterval), nil
+}
+
 func calculatePartition(
 	ctx context.Context,
 	strategy GaussianQuadrature,
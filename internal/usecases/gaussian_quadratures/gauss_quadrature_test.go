@@ -0,0 +1,214 @@
+package gaussianquadratures
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+func TestGaussCalculatorAverage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		expr            expressions.SingleVariableExpr
+		leftInterval    float64
+		rightInterval   float64
+		expectedAverage float64
+	}{
+		{
+			name:            "Constant",
+			expr:            func(float64) float64 { return 3 },
+			leftInterval:    -1,
+			rightInterval:   4,
+			expectedAverage: 3,
+		},
+		{
+			name:            "x over [0, 2]",
+			expr:            func(x float64) float64 { return x },
+			leftInterval:    0,
+			rightInterval:   2,
+			expectedAverage: 1,
+		},
+		{
+			name:            "sin over a full period",
+			expr:            math.Sin,
+			leftInterval:    0,
+			rightInterval:   2 * math.Pi,
+			expectedAverage: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			strategy, err := NewGaussLegendre(4)
+			require.NoError(t, err)
+			useCase := NewGaussCalculatorUseCase(strategy)
+
+			// Act
+			average, err := useCase.Average(t.Context(), tc.expr, tc.leftInterval, tc.rightInterval, 10)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedAverage, average, 1e-9)
+		})
+	}
+}
+
+func TestGaussCalculatorAverageRejectsInvalidIntervals(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		leftInterval  float64
+		rightInterval float64
+		expectedErr   error
+	}{
+		{
+			name:          "Zero width",
+			leftInterval:  1,
+			rightInterval: 1,
+			expectedErr:   ErrZeroWidthInterval,
+		},
+		{
+			name:          "Infinite",
+			leftInterval:  0,
+			rightInterval: math.Inf(1),
+			expectedErr:   ErrInfiniteAverageInterval,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			strategy, err := NewGaussLegendre(2)
+			require.NoError(t, err)
+			useCase := NewGaussCalculatorUseCase(strategy)
+
+			// Act
+			_, err = useCase.Average(t.Context(), math.Exp, tc.leftInterval, tc.rightInterval, 10)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestGaussianStrategiesTransformations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                      string
+		newStrategy               func() (GaussianQuadrature, error)
+		leftInterval              float64
+		rightInterval             float64
+		expectedOffset            float64
+		expectedScalingFactor     float64
+		expectedAllowPartitioning bool
+		expr                      expressions.SingleVariableExpr
+		expectedIntegral          float64
+	}{
+		{
+			name:                      "Legendre",
+			newStrategy:               func() (GaussianQuadrature, error) { return NewGaussLegendre(3) },
+			leftInterval:              0,
+			rightInterval:             2,
+			expectedOffset:            1,
+			expectedScalingFactor:     1,
+			expectedAllowPartitioning: true,
+			expr:                      func(x float64) float64 { return x * x },
+			expectedIntegral:          8.0 / 3.0,
+		},
+		{
+			name:                  "Chebyshev",
+			newStrategy:           func() (GaussianQuadrature, error) { return NewGaussChebyshev(3) },
+			leftInterval:          -1,
+			rightInterval:         1,
+			expectedOffset:        0,
+			expectedScalingFactor: 1,
+			expr:                  func(x float64) float64 { return x * x },
+			expectedIntegral:      math.Pi / 2,
+		},
+		{
+			name:                  "Hermite",
+			newStrategy:           func() (GaussianQuadrature, error) { return NewGaussHermite(3) },
+			leftInterval:          math.Inf(-1),
+			rightInterval:         math.Inf(1),
+			expectedOffset:        0,
+			expectedScalingFactor: 1,
+			expr:                  func(x float64) float64 { return x * x },
+			expectedIntegral:      math.Sqrt(math.Pi) / 2,
+		},
+		{
+			name:                  "Laguerre",
+			newStrategy:           func() (GaussianQuadrature, error) { return NewGaussLaguerre(3) },
+			leftInterval:          0,
+			rightInterval:         math.Inf(1),
+			expectedOffset:        0,
+			expectedScalingFactor: 1,
+			expr:                  func(x float64) float64 { return x },
+			expectedIntegral:      1,
+		},
+		{
+			name:                  "Jacobi",
+			newStrategy:           func() (GaussianQuadrature, error) { return NewGaussJacobi(3, 0, 0) },
+			leftInterval:          -1,
+			rightInterval:         1,
+			expectedOffset:        0,
+			expectedScalingFactor: 1,
+			expr:                  func(x float64) float64 { return x * x },
+			expectedIntegral:      2.0 / 3.0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			strategy, err := tc.newStrategy()
+			require.NoError(t, err)
+			useCase := NewGaussCalculatorUseCase(strategy)
+
+			// Act
+			integral, err := useCase.Calculate(t.Context(), tc.expr, tc.leftInterval, tc.rightInterval, 1)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedIntegral, integral, 1e-9)
+			assert.InDelta(t, tc.expectedOffset, strategy.GetOffset(tc.leftInterval, tc.rightInterval), 1e-15)
+			assert.InDelta(t, tc.expectedScalingFactor, strategy.GetScalingFactor(tc.leftInterval, tc.rightInterval), 1e-15)
+			assert.Equal(t, tc.expectedAllowPartitioning, strategy.AllowPartitioning())
+		})
+	}
+}
+
+func TestGaussCalculatorTreatsZeroPartitionsAsOne(t *testing.T) {
+	t.Parallel()
+
+	for order := 2; order <= 4; order++ {
+		t.Run(fmt.Sprintf("Order %d", order), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			strategy, err := NewGaussLegendre(order)
+			require.NoError(t, err)
+			useCase := NewGaussCalculatorUseCase(strategy)
+			expected, err := useCase.Calculate(t.Context(), math.Exp, 0, 1, 1)
+			require.NoError(t, err)
+
+			// Act
+			result, err := useCase.Calculate(t.Context(), math.Exp, 0, 1, 0)
+
+			// Assert
+			require.NoError(t, err)
+			assert.False(t, math.IsNaN(result) || math.IsInf(result, 0))
+			assert.Equal(t, expected, result)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package gaussianquadratures
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrEigenDecompositionFailed = numeerr.New(numeerr.CodeFailed, "could not diagonalize the Jacobi matrix")
+
+// golubWelsch computes the nodes and weights of the Gauss quadrature for the
+// orthogonal polynomials with monic three-term recurrence
+//
+//	p_{k+1}(x) = (x - a_k) p_k(x) - b_k p_{k-1}(x)
+//
+// where mu0 is the integral of the weight function. The nodes are the
+// eigenvalues of the symmetric tridiagonal Jacobi matrix, and each weight is
+// mu0 times the squared first component of the normalized eigenvector.
+func golubWelsch(a, b []float64, mu0 float64) ([]float64, []float64, error) {
+	n := len(a)
+
+	jacobi := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		jacobi.SetSym(i, i, a[i])
+		if i > 0 {
+			jacobi.SetSym(i-1, i, math.Sqrt(b[i]))
+		}
+	}
+
+	var eigen mat.EigenSym
+	if ok := eigen.Factorize(jacobi, true); !ok {
+		return nil, nil, ErrEigenDecompositionFailed
+	}
+
+	nodes := eigen.Values(nil)
+
+	var vectors mat.Dense
+	eigen.VectorsTo(&vectors)
+
+	weights := make([]float64, n)
+	for i := range weights {
+		first := vectors.At(0, i)
+		weights[i] = mu0 * first * first
+	}
+
+	return nodes, weights, nil
+}
@@ -0,0 +1,124 @@
+package gaussianquadratures
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/integrate/quad"
+)
+
+// tableTolerance is the accepted gap between a hardcoded table and its
+// reference, the tables being written with about 15 significant digits
+const tableTolerance = 1e-13
+
+// referenceRule computes the nodes and weights of a rule independently from
+// its hardcoded table.
+type referenceRule func(order int) ([]float64, []float64, error)
+
+// gonumReference uses the gonum quadrature on [min, max].
+func gonumReference(rule quad.FixedLocationer, min, max float64) referenceRule {
+	return func(order int) ([]float64, []float64, error) {
+		nodes := make([]float64, order)
+		weights := make([]float64, order)
+		rule.FixedLocations(nodes, weights, min, max)
+		return nodes, weights, nil
+	}
+}
+
+// laguerreReference uses Golub-Welsch on the monic Laguerre recurrence,
+// aₖ = 2k + 1 and bₖ = k², gonum having no Laguerre rule.
+func laguerreReference(order int) ([]float64, []float64, error) {
+	a := make([]float64, order)
+	b := make([]float64, order)
+	for k := range order {
+		a[k] = float64(2*k + 1)
+		b[k] = float64(k * k)
+	}
+	return golubWelsch(a, b, 1)
+}
+
+// chebyshevReference uses the closed form xₖ = cos((2k - 1)π / 2n) and
+// wₖ = π / n, gonum having no Chebyshev rule.
+func chebyshevReference(order int) ([]float64, []float64, error) {
+	nodes := make([]float64, order)
+	weights := make([]float64, order)
+	for k := range order {
+		nodes[k] = math.Cos(float64(2*k+1) * math.Pi / float64(2*order))
+		weights[k] = math.Pi / float64(order)
+	}
+	return nodes, weights, nil
+}
+
+func TestTablesMatchReferenceRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		newRule   func(order int) (GaussianQuadrature, error)
+		reference referenceRule
+	}{
+		{
+			name:      "Legendre",
+			newRule:   func(order int) (GaussianQuadrature, error) { return NewGaussLegendre(order) },
+			reference: gonumReference(quad.Legendre{}, -1, 1),
+		},
+		{
+			name:      "Hermite",
+			newRule:   func(order int) (GaussianQuadrature, error) { return NewGaussHermite(order) },
+			reference: gonumReference(quad.Hermite{}, math.Inf(-1), math.Inf(1)),
+		},
+		{
+			name:      "Laguerre",
+			newRule:   func(order int) (GaussianQuadrature, error) { return NewGaussLaguerre(order) },
+			reference: laguerreReference,
+		},
+		{
+			name:      "Chebyshev",
+			newRule:   func(order int) (GaussianQuadrature, error) { return NewGaussChebyshev(order) },
+			reference: chebyshevReference,
+		},
+	}
+
+	for _, tc := range testCases {
+		for order := 2; order <= 4; order++ {
+			t.Run(fmt.Sprintf("%s order %d", tc.name, order), func(t *testing.T) {
+				// Arrange
+				t.Parallel()
+				rule, err := tc.newRule(order)
+				require.NoError(t, err)
+
+				// Act
+				nodes, weights, err := tc.reference(order)
+
+				// Assert
+				require.NoError(t, err)
+				expected := sortedPairs(nodes, weights)
+				actual := sortedPairs(rule.GetNodes(), rule.GetWeights())
+				require.Len(t, actual, len(expected))
+				for i := range expected {
+					assert.InDelta(t, expected[i][0], actual[i][0], tableTolerance*max(1, math.Abs(expected[i][0])),
+						"node %d", i)
+					assert.InDelta(t, expected[i][1], actual[i][1], tableTolerance, "weight %d", i)
+				}
+			})
+		}
+	}
+}
+
+// sortedPairs pairs each node with its weight, sorted by node, as the tables
+// and the references list the nodes in different orders.
+func sortedPairs(nodes, weights []float64) [][2]float64 {
+	pairs := make([][2]float64, len(nodes))
+	for i := range nodes {
+		pairs[i] = [2]float64{nodes[i], weights[i]}
+	}
+	slices.SortFunc(pairs, func(a, b [2]float64) int {
+		return cmp.Compare(a[0], b[0])
+	})
+	return pairs
+}
@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrMatrixDimensionMismatch = numeerr.New(numeerr.CodeInvalidInput, "matrices have different dimensions")
+	ErrNonSymmetricMatrix      = numeerr.New(numeerr.CodeDomain, "matrix is not symmetric")
+)
+
+// symmetryTolerance is the largest difference between mirrored entries still
+// accepted as symmetric, relative to the largest entry of the matrix.
+const symmetryTolerance = 1e-10
+
+// GeneralizedEigen solves A x = λ B x for a symmetric A and a symmetric
+// positive definite B. With the Cholesky factorization B = L Lᵀ the problem
+// becomes the standard symmetric one C y = λ y, C = L⁻¹ A L⁻ᵀ, whose
+// eigenvectors map back as x = L⁻ᵀ y. The returned eigenvectors are the
+// columns of Eigenvectors, normalized so xᵀ B x = 1.
+func (u *SimilarityTransformationUseCase) GeneralizedEigen(
+	ctx context.Context,
+	a, b [][]float64,
+	maxIterations int,
+	tolerance float64,
+) (*QRMethodResult, error) {
+	slog.InfoContext(ctx, "Starting the generalized eigenvalue decomposition",
+		matrixAttr("a", a),
+		matrixAttr("b", b),
+	)
+
+	if err := validateGeneralizedEigenInput(a, b); err != nil {
+		slog.ErrorContext(ctx, "Invalid matrices for the generalized eigenvalue problem", slog.Any("error", err))
+		return nil, err
+	}
+
+	n := len(a)
+
+	lower, err := choleskyFactor(b)
+	if err != nil {
+		slog.ErrorContext(ctx, "Cholesky factorization of B failed", slog.Any("error", err))
+		return nil, fmt.Errorf("B: %w", err)
+	}
+
+	var lowerInverse mat.TriDense
+	if err := lowerInverse.InverseTri(lower); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSingularMatrix, err)
+	}
+
+	// C = L⁻¹ A L⁻ᵀ, symmetrized so rounding doesn't leak into the
+	// Householder reduction
+	var reduced mat.Dense
+	reduced.Mul(&lowerInverse, constructMatrix(a))
+	reduced.Mul(&reduced, lowerInverse.T())
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			mean := (reduced.At(i, j) + reduced.At(j, i)) / 2
+			reduced.Set(i, j, mean)
+			reduced.Set(j, i, mean)
+		}
+	}
+
+	result, err := u.CompleteEigenDecomposition(ctx, denseToSliceOfSlices(&reduced), maxIterations, tolerance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompose the reduced problem: %w", err)
+	}
+
+	var eigenvectors mat.Dense
+	eigenvectors.Mul(lowerInverse.T(), result.Eigenvectors)
+	result.Eigenvectors = &eigenvectors
+
+	slog.InfoContext(ctx, "Finished the generalized eigenvalue decomposition",
+		vectorAttr("eigenvalues", result.Eigenvalues),
+	)
+
+	return result, nil
+}
+
+func validateGeneralizedEigenInput(a, b [][]float64) error {
+	if err := validateSquareMatrix(a); err != nil {
+		return fmt.Errorf("A: %w", err)
+	}
+	if err := validateSquareMatrix(b); err != nil {
+		return fmt.Errorf("B: %w", err)
+	}
+
+	if len(a) != len(b) {
+		return fmt.Errorf("%w: A is %dx%d, B is %dx%d", ErrMatrixDimensionMismatch, len(a), len(a), len(b), len(b))
+	}
+
+	if err := validateSymmetricMatrix(a); err != nil {
+		return fmt.Errorf("A: %w", err)
+	}
+	if err := validateSymmetricMatrix(b); err != nil {
+		return fmt.Errorf("B: %w", err)
+	}
+
+	return nil
+}
+
+// IsSymmetric reports whether matrix is square and equal to its transpose,
+// within the tolerance the symmetric solvers accept.
+func IsSymmetric(matrix [][]float64) bool {
+	if validateSquareMatrix(matrix) != nil {
+		return false
+	}
+
+	return validateSymmetricMatrix(matrix) == nil
+}
+
+func validateSymmetricMatrix(matrix [][]float64) error {
+	scale := 1.0
+	for _, row := range matrix {
+		for _, value := range row {
+			scale = math.Max(scale, math.Abs(value))
+		}
+	}
+
+	for i := range matrix {
+		for j := i + 1; j < len(matrix); j++ {
+			if math.Abs(matrix[i][j]-matrix[j][i]) > symmetryTolerance*scale {
+				return fmt.Errorf("%w: entries (%d,%d) and (%d,%d) differ", ErrNonSymmetricMatrix, i, j, j, i)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestGeneralizedEigen(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                string
+		a                   [][]float64
+		b                   [][]float64
+		expectedEigenvalues []float64
+	}{
+		{
+			name: "Two masses on springs",
+			a:    [][]float64{{2, -1}, {-1, 2}},
+			b:    [][]float64{{2, 0}, {0, 1}},
+			// 2λ² - 6λ + 3 = 0
+			expectedEigenvalues: []float64{(3 - math.Sqrt(3)) / 2, (3 + math.Sqrt(3)) / 2},
+		},
+		{
+			name:                "Uniform mass chain",
+			a:                   [][]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}},
+			b:                   [][]float64{{2, 0, 0}, {0, 2, 0}, {0, 0, 2}},
+			expectedEigenvalues: []float64{(2 - math.Sqrt2) / 2, 1, (2 + math.Sqrt2) / 2},
+		},
+		{
+			name:                "Coupled mass matrix",
+			a:                   [][]float64{{4, 1, 0}, {1, 3, 1}, {0, 1, 2}},
+			b:                   [][]float64{{4, 1, 0}, {1, 3, 1}, {0, 1, 2}},
+			expectedEigenvalues: []float64{1, 1, 1},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewSimilarityTransformationUseCase()
+
+			// Act
+			result, err := useCase.GeneralizedEigen(context.Background(), test.a, test.b, 1000, 1e-12)
+
+			// Assert
+			require.NoError(t, err)
+
+			eigenvalues := slices.Clone(result.Eigenvalues)
+			slices.Sort(eigenvalues)
+			assert.InDeltaSlice(t, test.expectedEigenvalues, eigenvalues, 1e-8)
+
+			a, b := constructMatrix(test.a), constructMatrix(test.b)
+			for k, lambda := range result.Eigenvalues {
+				x := result.Eigenvectors.ColView(k)
+
+				var ax, bx mat.VecDense
+				ax.MulVec(a, x)
+				bx.MulVec(b, x)
+				bx.ScaleVec(lambda, &bx)
+				ax.SubVec(&ax, &bx)
+				assert.InDelta(t, 0, ax.Norm(2), 1e-8, "residual of eigenpair %d", k)
+
+				var bNorm mat.VecDense
+				bNorm.MulVec(b, x)
+				assert.InDelta(t, 1, mat.Dot(x, &bNorm), 1e-8, "B-norm of eigenvector %d", k)
+			}
+		})
+	}
+}
+
+func TestGeneralizedEigenRejectsInvalidMatrices(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		a           [][]float64
+		b           [][]float64
+		expectedErr error
+	}{
+		{
+			name:        "Different dimensions",
+			a:           [][]float64{{2, -1}, {-1, 2}},
+			b:           [][]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+			expectedErr: ErrMatrixDimensionMismatch,
+		},
+		{
+			name:        "Non square B",
+			a:           [][]float64{{2, -1}, {-1, 2}},
+			b:           [][]float64{{1, 0}, {0}},
+			expectedErr: ErrNonSquareMatrix,
+		},
+		{
+			name:        "Non symmetric A",
+			a:           [][]float64{{2, 3}, {5, 4}},
+			b:           [][]float64{{1, 0}, {0, 1}},
+			expectedErr: ErrNonSymmetricMatrix,
+		},
+		{
+			name:        "Non symmetric B",
+			a:           [][]float64{{2, -1}, {-1, 2}},
+			b:           [][]float64{{2, 1}, {0, 2}},
+			expectedErr: ErrNonSymmetricMatrix,
+		},
+		{
+			name:        "Indefinite B",
+			a:           [][]float64{{2, -1}, {-1, 2}},
+			b:           [][]float64{{1, 2}, {2, 1}},
+			expectedErr: ErrNotPositiveDefinite,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewSimilarityTransformationUseCase()
+
+			// Act
+			_, err := useCase.GeneralizedEigen(context.Background(), test.a, test.b, 1000, 1e-12)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// GershgorinDisc is the disc of the complex plane centered on a diagonal
+// element a_ii, with the sum of the absolute values of the rest of row i as
+// its radius. Every eigenvalue lies in the union of the discs of a matrix.
+type GershgorinDisc struct {
+	Center float64
+	Radius float64
+}
+
+// Contains reports whether z lies in the disc, borders included.
+func (d GershgorinDisc) Contains(z complex128) bool {
+	return cmplx.Abs(z-complex(d.Center, 0)) <= d.Radius
+}
+
+// GershgorinDiscs returns the disc of each row of a square matrix, locating
+// its eigenvalues before computing any of them.
+func GershgorinDiscs(matrix [][]float64) ([]GershgorinDisc, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return nil, err
+	}
+
+	discs := make([]GershgorinDisc, len(matrix))
+	for i, row := range matrix {
+		discs[i].Center = row[i]
+		for j, value := range row {
+			if j != i {
+				discs[i].Radius += math.Abs(value)
+			}
+		}
+	}
+
+	return discs, nil
+}
+
+// GershgorinInterval returns the smallest interval of the real line holding
+// every disc, and so the real part of every eigenvalue.
+func GershgorinInterval(discs []GershgorinDisc) (lower, upper float64) {
+	lower, upper = math.Inf(1), math.Inf(-1)
+	for _, disc := range discs {
+		lower = math.Min(lower, disc.Center-disc.Radius)
+		upper = math.Max(upper, disc.Center+disc.Radius)
+	}
+
+	return lower, upper
+}
@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestGershgorinDiscs(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	matrix := [][]float64{{10, -1, 0}, {0.2, 8, 0.2}, {1, 1, 2}}
+
+	// Act
+	discs, err := GershgorinDiscs(matrix)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []GershgorinDisc{{Center: 10, Radius: 1}, {Center: 8, Radius: 0.4}, {Center: 2, Radius: 2}}, discs)
+
+	lower, upper := GershgorinInterval(discs)
+	assert.InDelta(t, 0.0, lower, 1e-15)
+	assert.InDelta(t, 11.0, upper, 1e-15)
+}
+
+func TestGershgorinDiscsHoldEveryEigenvalue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		matrix [][]float64
+	}{
+		{name: "Diagonally dominant", matrix: [][]float64{{10, -1, 0}, {0.2, 8, 0.2}, {1, 1, 2}}},
+		{name: "Complex eigenvalues", matrix: [][]float64{{0, -1}, {1, 0}}},
+		{name: "Non symmetric", matrix: [][]float64{{10, 6, 7}, {1, 7, -2}, {2, 2, 2}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			var eigen mat.Eigen
+			require.True(t, eigen.Factorize(constructMatrix(test.matrix), mat.EigenNone))
+
+			// Act
+			discs, err := GershgorinDiscs(test.matrix)
+
+			// Assert
+			require.NoError(t, err)
+			for _, eigenvalue := range eigen.Values(nil) {
+				assert.True(t, inAnyDisc(discs, eigenvalue), "eigenvalue %v is outside every disc", eigenvalue)
+			}
+		})
+	}
+}
+
+func TestGershgorinDiscsRejectInvalidMatrices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		expectedErr error
+	}{
+		{name: "Empty", matrix: [][]float64{}, expectedErr: ErrEmptyMatrix},
+		{name: "NonSquare", matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}, expectedErr: ErrNonSquareMatrix},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := GershgorinDiscs(test.matrix)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func inAnyDisc(discs []GershgorinDisc, eigenvalue complex128) bool {
+	for _, disc := range discs {
+		// Rounding can put an eigenvalue on a border a hair outside
+		widened := GershgorinDisc{Center: disc.Center, Radius: disc.Radius + 1e-9}
+		if widened.Contains(eigenvalue) {
+			return true
+		}
+	}
+
+	return false
+}
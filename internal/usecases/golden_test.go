@@ -0,0 +1,252 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	gaussianquadratures "github.com/taldoflemis/nume/internal/usecases/gaussian_quadratures"
+	newtoncotes "github.com/taldoflemis/nume/internal/usecases/newton_cotes"
+)
+
+// Run `go test ./internal/usecases -run TestGolden -update-golden` after an
+// intended change of the numerics, and review the diff of the golden file.
+var updateGolden = flag.Bool("update-golden", false, "rewrite the golden file with the current results")
+
+var goldenPath = filepath.Join("testdata", "golden.json")
+
+// goldenCase computes the values stored in the golden file under name, which
+// must stay within Tolerance, relative to the magnitude of each value when it
+// exceeds one.
+type goldenCase struct {
+	name      string
+	tolerance float64
+	compute   func(ctx context.Context) ([]float64, error)
+}
+
+func goldenCases() []goldenCase {
+	powerMatrix := [][]float64{{2, 3}, {5, 4}}
+	symmetric := [][]float64{{4, 1, 2}, {1, 3, 0}, {2, 0, 5}}
+	integrand := func(x float64) float64 { return math.Exp(-x) * math.Sin(3*x) }
+
+	cases := []goldenCase{
+		{
+			name:      "eigen/power/regular",
+			tolerance: 1e-8,
+			compute: func(ctx context.Context) ([]float64, error) {
+				result, err := NewPowerUseCase().RegularPower(ctx, powerMatrix, []float64{1, 1}, 1e-10, 1000)
+				if err != nil {
+					return nil, err
+				}
+				return []float64{result.Eigenvalue}, nil
+			},
+		},
+		{
+			name:      "eigen/power/inverse",
+			tolerance: 1e-8,
+			compute: func(ctx context.Context) ([]float64, error) {
+				result, err := NewPowerUseCase().InversePower(ctx, powerMatrix, []float64{1, 1}, 1e-10, 1000)
+				if err != nil {
+					return nil, err
+				}
+				return []float64{result.Eigenvalue}, nil
+			},
+		},
+		{
+			name:      "eigen/qr/complete",
+			tolerance: 1e-9,
+			compute: func(ctx context.Context) ([]float64, error) {
+				result, err := NewSimilarityTransformationUseCase().CompleteEigenDecomposition(ctx, symmetric, 1000, 1e-12)
+				if err != nil {
+					return nil, err
+				}
+				return sorted(result.Eigenvalues), nil
+			},
+		},
+		{
+			name:      "eigen/generalized",
+			tolerance: 1e-9,
+			compute: func(ctx context.Context) ([]float64, error) {
+				b := [][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}}
+				result, err := NewSimilarityTransformationUseCase().GeneralizedEigen(ctx, symmetric, b, 1000, 1e-12)
+				if err != nil {
+					return nil, err
+				}
+				return sorted(result.Eigenvalues), nil
+			},
+		},
+	}
+
+	for _, formula := range []newtoncotes.FormulaType{newtoncotes.ClosedFormulaType, newtoncotes.OpenFormulaType} {
+		for _, order := range []newtoncotes.NewtonCotesOrder{newtoncotes.FirstOrder, newtoncotes.SecondOrder, newtoncotes.ThirdOrder} {
+			cases = append(cases, goldenCase{
+				name:      "integral/newton-cotes/" + string(formula) + "/" + strconv.Itoa(int(order)),
+				tolerance: 1e-12,
+				compute: func(ctx context.Context) ([]float64, error) {
+					strategy, err := newtoncotes.NewStrategy(formula, order)
+					if err != nil {
+						return nil, err
+					}
+					result, err := newtoncotes.NewNewtonCotesUseCase(strategy).Calculate(ctx, integrand, 0, math.Pi, 16)
+					return []float64{result}, err
+				},
+			})
+		}
+	}
+
+	gaussian := []struct {
+		name        string
+		quadrature  func() (gaussianquadratures.GaussianQuadrature, error)
+		left, right float64
+		expr        expressions.SingleVariableExpr
+	}{
+		{
+			name: "legendre",
+			quadrature: func() (gaussianquadratures.GaussianQuadrature, error) {
+				return gaussianquadratures.NewGaussLegendre(4)
+			},
+			left:  0,
+			right: math.Pi,
+			expr:  integrand,
+		},
+		{
+			name: "chebyshev",
+			quadrature: func() (gaussianquadratures.GaussianQuadrature, error) {
+				return gaussianquadratures.NewGaussChebyshev(4)
+			},
+			left:  -1,
+			right: 1,
+			expr:  math.Exp,
+		},
+		{
+			name: "hermite",
+			quadrature: func() (gaussianquadratures.GaussianQuadrature, error) {
+				return gaussianquadratures.NewGaussHermite(4)
+			},
+			left:  math.Inf(-1),
+			right: math.Inf(1),
+			expr:  math.Cos,
+		},
+		{
+			name: "laguerre",
+			quadrature: func() (gaussianquadratures.GaussianQuadrature, error) {
+				return gaussianquadratures.NewGaussLaguerre(4)
+			},
+			left:  0,
+			right: math.Inf(1),
+			expr:  func(x float64) float64 { return 1 / (1 + x) },
+		},
+		{
+			name: "jacobi",
+			quadrature: func() (gaussianquadratures.GaussianQuadrature, error) {
+				return gaussianquadratures.NewGaussJacobi(5, 0.5, -0.5)
+			},
+			left:  -1,
+			right: 1,
+			expr:  math.Exp,
+		},
+	}
+	for _, g := range gaussian {
+		cases = append(cases, goldenCase{
+			name:      "integral/gauss/" + g.name,
+			tolerance: 1e-12,
+			compute: func(ctx context.Context) ([]float64, error) {
+				quadrature, err := g.quadrature()
+				if err != nil {
+					return nil, err
+				}
+				result, err := gaussianquadratures.NewGaussCalculatorUseCase(quadrature).Calculate(ctx, g.expr, g.left, g.right, 1)
+				return []float64{result}, err
+			},
+		})
+	}
+
+	philosophies := []struct {
+		name     string
+		strategy DifferenceStrategy
+	}{
+		{name: "forward", strategy: &ForwardDifferenceStrategy{}},
+		{name: "backward", strategy: &BackwardDifferenceStrategy{}},
+		{name: "central", strategy: &CentralDifferenceStrategy{}},
+	}
+	for _, philosophy := range philosophies {
+		cases = append(cases, goldenCase{
+			name:      "derivative/" + philosophy.name,
+			tolerance: 1e-9,
+			compute: func(ctx context.Context) ([]float64, error) {
+				useCase := NewDerivativeUseCase(philosophy.strategy)
+				first, err := useCase.Derivative(ctx, 1, math.Sin, 0.1, 1e-8, 20)
+				if err != nil {
+					return nil, err
+				}
+				second, err := useCase.SecondDerivative(ctx, 1, math.Sin, 0.1, 1e-8, 20)
+				if err != nil {
+					return nil, err
+				}
+				return []float64{first.Derivative, second.Derivative}, nil
+			},
+		})
+	}
+
+	return cases
+}
+
+func TestGolden(t *testing.T) {
+	ctx := context.Background()
+	cases := goldenCases()
+
+	results := make(map[string][]float64, len(cases))
+	for _, c := range cases {
+		values, err := c.compute(ctx)
+		require.NoError(t, err, c.name)
+		results[c.name] = values
+	}
+
+	if *updateGolden {
+		contents, err := json.MarshalIndent(results, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(goldenPath, append(contents, '\n'), 0o644))
+		t.Logf("Rewrote %s with %d cases", goldenPath, len(results))
+		return
+	}
+
+	contents, err := os.ReadFile(goldenPath)
+	require.NoError(t, err, "run with -update-golden to create the golden file")
+
+	var golden map[string][]float64
+	require.NoError(t, json.Unmarshal(contents, &golden))
+
+	for name := range golden {
+		_, ok := results[name]
+		assert.True(t, ok, "golden case %q is no longer computed, run with -update-golden", name)
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expected, ok := golden[c.name]
+			require.True(t, ok, "no golden values, run with -update-golden")
+			require.Len(t, results[c.name], len(expected))
+
+			for i, value := range results[c.name] {
+				tolerance := c.tolerance * max(1, math.Abs(expected[i]))
+				assert.InDelta(t, expected[i], value, tolerance, "value %d", i)
+			}
+		})
+	}
+}
+
+func sorted(values []float64) []float64 {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	return values
+}
@@ -0,0 +1,227 @@
+package usecases
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+	gaussianquadratures "github.com/taldoflemis/nume/internal/usecases/gaussian_quadratures"
+	newtoncotes "github.com/taldoflemis/nume/internal/usecases/newton_cotes"
+)
+
+var (
+	ErrZeroEvaluationBudget   = numeerr.New(numeerr.CodeInvalidInput, "benchmark needs a positive evaluation budget")
+	ErrInvalidBenchmarkBounds = numeerr.New(numeerr.CodeInvalidInput, "benchmark needs a finite interval of positive width")
+)
+
+// maxCorrectDigits caps the digits of an exact result, as float64 holds
+// about 16 significant digits.
+const maxCorrectDigits = 16
+
+// IntegrationBenchmarkEntry is one method run within the evaluation budget.
+type IntegrationBenchmarkEntry struct {
+	Method      string
+	Partitions  uint64
+	Evaluations uint64
+	Area        float64
+	// AbsoluteError is the distance from the analytic value
+	AbsoluteError float64
+	// CorrectDigits is −log₁₀ of the relative error, or of the absolute one
+	// when the analytic value is zero
+	CorrectDigits float64
+	// DigitsPerEvaluation is CorrectDigits per function evaluation, how much
+	// accuracy each evaluation bought
+	DigitsPerEvaluation float64
+}
+
+// IntegrationBenchmark compares the methods integrating the same expression
+// at matched cost. Entries are sorted from the most to the least accurate.
+type IntegrationBenchmark struct {
+	Left    float64
+	Right   float64
+	Exact   float64
+	Budget  uint64
+	Entries []IntegrationBenchmarkEntry
+}
+
+// Best returns the most accurate entry.
+func (b *IntegrationBenchmark) Best() IntegrationBenchmarkEntry {
+	return b.Entries[0]
+}
+
+// Markdown renders the benchmark as a table, ready for the TUI renderer.
+func (b *IntegrationBenchmark) Markdown() string {
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "Integral over [%g, %g], exact value %g, about %d evaluations per method\n\n",
+		b.Left, b.Right, b.Exact, b.Budget)
+	out.WriteString("| Method | Partitions | Evaluations | Area | Absolute error | Digits |\n")
+	out.WriteString("|---|---|---|---|---|---|\n")
+	for _, entry := range b.Entries {
+		fmt.Fprintf(&out, "| %s | %d | %d | %.12g | %.3e | %.1f |\n",
+			entry.Method, entry.Partitions, entry.Evaluations, entry.Area, entry.AbsoluteError, entry.CorrectDigits)
+	}
+
+	return out.String()
+}
+
+// benchmarkedMethod integrates with a fixed number of partitions.
+type benchmarkedMethod struct {
+	name      string
+	calculate func(ctx context.Context, expr expressions.SingleVariableExpr, left, right float64, partitions uint64) (float64, error)
+}
+
+// benchmarkedMethods are the composite rules integrating an unweighted
+// integrand over a finite interval. The other Gaussian rules have a weight
+// function or an infinite interval, so they compute another integral.
+func benchmarkedMethods() ([]benchmarkedMethod, error) {
+	var methods []benchmarkedMethod
+
+	for _, formula := range []newtoncotes.FormulaType{newtoncotes.ClosedFormulaType, newtoncotes.OpenFormulaType} {
+		for _, order := range []newtoncotes.NewtonCotesOrder{newtoncotes.FirstOrder, newtoncotes.SecondOrder, newtoncotes.ThirdOrder} {
+			strategy, err := newtoncotes.NewStrategy(formula, order)
+			if err != nil {
+				return nil, err
+			}
+
+			methods = append(methods, benchmarkedMethod{
+				name:      strategy.Description(),
+				calculate: newtoncotes.NewNewtonCotesUseCase(strategy).Calculate,
+			})
+		}
+	}
+
+	for order := 2; order <= 4; order++ {
+		rule, err := gaussianquadratures.NewGaussLegendre(order)
+		if err != nil {
+			return nil, err
+		}
+
+		methods = append(methods, benchmarkedMethod{
+			name:      fmt.Sprintf("%s (%d points)", rule.Describe(), order),
+			calculate: gaussianquadratures.NewGaussCalculatorUseCase(rule).Calculate,
+		})
+	}
+
+	return methods, nil
+}
+
+// BenchmarkIntegration integrates expr over [left, right] with every
+// composite Newton-Cotes formula and Gauss-Legendre rule, giving each about
+// budget evaluations, and measures them against the analytic value exact.
+// A method costing more than budget in a single partition still runs once.
+// The evaluations are counted, as the composite formulas evaluate the
+// shared ends of adjacent partitions twice.
+func BenchmarkIntegration(
+	ctx context.Context,
+	expr expressions.SingleVariableExpr,
+	left, right, exact float64,
+	budget uint64,
+) (*IntegrationBenchmark, error) {
+	if budget == 0 {
+		return nil, ErrZeroEvaluationBudget
+	}
+
+	if !(left < right) || math.IsInf(left, 0) || math.IsInf(right, 0) {
+		return nil, fmt.Errorf("%w: [%g, %g]", ErrInvalidBenchmarkBounds, left, right)
+	}
+
+	methods, err := benchmarkedMethods()
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Starting the integration benchmark",
+		slog.Float64("left", left),
+		slog.Float64("right", right),
+		slog.Float64("exact", exact),
+		slog.Uint64("budget", budget),
+		slog.Int("methods", len(methods)),
+	)
+
+	benchmark := &IntegrationBenchmark{Left: left, Right: right, Exact: exact, Budget: budget}
+	for _, method := range methods {
+		entry, err := benchmarkMethod(ctx, method, expr, left, right, exact, budget)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error benchmarking the integration method",
+				slog.String("method", method.name),
+				slog.Any("error", err),
+			)
+			return nil, fmt.Errorf("%s: %w", method.name, err)
+		}
+
+		benchmark.Entries = append(benchmark.Entries, entry)
+	}
+
+	slices.SortStableFunc(benchmark.Entries, func(a, b IntegrationBenchmarkEntry) int {
+		return cmp.Compare(a.AbsoluteError, b.AbsoluteError)
+	})
+
+	slog.InfoContext(ctx, "Integration benchmark completed",
+		slog.String("best", benchmark.Best().Method),
+		slog.Float64("bestError", benchmark.Best().AbsoluteError),
+	)
+
+	return benchmark, nil
+}
+
+// benchmarkMethod measures the cost of one partition, then integrates with
+// as many partitions as the budget pays for.
+func benchmarkMethod(
+	ctx context.Context,
+	method benchmarkedMethod,
+	expr expressions.SingleVariableExpr,
+	left, right, exact float64,
+	budget uint64,
+) (IntegrationBenchmarkEntry, error) {
+	var evaluations uint64
+	counted := func(x float64) float64 {
+		evaluations++
+		return expr(x)
+	}
+
+	if _, err := method.calculate(ctx, counted, left, right, 1); err != nil {
+		return IntegrationBenchmarkEntry{}, err
+	}
+	partitions := max(1, budget/max(1, evaluations))
+
+	evaluations = 0
+	area, err := method.calculate(ctx, counted, left, right, partitions)
+	if err != nil {
+		return IntegrationBenchmarkEntry{}, err
+	}
+
+	absoluteError := math.Abs(area - exact)
+	digits := correctDigits(absoluteError, exact)
+
+	return IntegrationBenchmarkEntry{
+		Method:              method.name,
+		Partitions:          partitions,
+		Evaluations:         evaluations,
+		Area:                area,
+		AbsoluteError:       absoluteError,
+		CorrectDigits:       digits,
+		DigitsPerEvaluation: digits / float64(evaluations),
+	}, nil
+}
+
+// correctDigits is −log₁₀ of the error relative to exact, between zero and
+// maxCorrectDigits.
+func correctDigits(absoluteError, exact float64) float64 {
+	relative := absoluteError
+	if exact != 0 {
+		relative /= math.Abs(exact)
+	}
+
+	if relative == 0 {
+		return maxCorrectDigits
+	}
+
+	return math.Min(maxCorrectDigits, math.Max(0, -math.Log10(relative)))
+}
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// benchmarkEntry returns the entry of the method whose name starts with prefix.
+func benchmarkEntry(t *testing.T, benchmark *IntegrationBenchmark, prefix string) IntegrationBenchmarkEntry {
+	t.Helper()
+
+	for _, entry := range benchmark.Entries {
+		if strings.HasPrefix(entry.Method, prefix) {
+			return entry
+		}
+	}
+	require.Failf(t, "missing benchmark entry", "no method starts with %q", prefix)
+
+	return IntegrationBenchmarkEntry{}
+}
+
+func TestBenchmarkIntegrationGaussLegendreBeatsTrapezoidal(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	benchmark, err := BenchmarkIntegration(context.Background(), math.Exp, 0, 1, math.E-1, 24)
+
+	// Assert
+	require.NoError(t, err)
+	trapezoidal := benchmarkEntry(t, benchmark, "Trapezoidal Rule")
+	gaussLegendre := benchmarkEntry(t, benchmark, "Gauss-Legendre (4 points)")
+	assert.Equal(t, trapezoidal.Evaluations, gaussLegendre.Evaluations, "both get the same budget")
+	assert.Less(t, gaussLegendre.AbsoluteError, trapezoidal.AbsoluteError)
+	assert.Greater(t, gaussLegendre.CorrectDigits, trapezoidal.CorrectDigits+5)
+	assert.Greater(t, gaussLegendre.DigitsPerEvaluation, trapezoidal.DigitsPerEvaluation)
+}
+
+func TestBenchmarkIntegrationMatchesTheBudget(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	const budget = 60
+
+	// Act
+	benchmark, err := BenchmarkIntegration(context.Background(), math.Sin, 0, math.Pi, 2, budget)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, benchmark.Entries, 9, "six Newton-Cotes formulas and three Gauss-Legendre rules")
+	for _, entry := range benchmark.Entries {
+		assert.LessOrEqual(t, entry.Evaluations, uint64(budget), entry.Method)
+		assert.Greater(t, entry.Evaluations, uint64(budget/2), entry.Method)
+	}
+	for i := 1; i < len(benchmark.Entries); i++ {
+		assert.LessOrEqual(t, benchmark.Entries[i-1].AbsoluteError, benchmark.Entries[i].AbsoluteError)
+	}
+	assert.Equal(t, benchmark.Entries[0], benchmark.Best())
+	assert.Contains(t, benchmark.Markdown(), "| "+benchmark.Best().Method+" |")
+}
+
+func TestBenchmarkIntegrationRejectsInvalidInputs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		left        float64
+		right       float64
+		budget      uint64
+		expectedErr error
+	}{
+		{name: "ZeroBudget", left: 0, right: 1, budget: 0, expectedErr: ErrZeroEvaluationBudget},
+		{name: "ZeroWidth", left: 1, right: 1, budget: 10, expectedErr: ErrInvalidBenchmarkBounds},
+		{name: "Reversed", left: 1, right: 0, budget: 10, expectedErr: ErrInvalidBenchmarkBounds},
+		{name: "Infinite", left: 0, right: math.Inf(1), budget: 10, expectedErr: ErrInvalidBenchmarkBounds},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := BenchmarkIntegration(context.Background(), math.Exp, tc.left, tc.right, 0, tc.budget)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
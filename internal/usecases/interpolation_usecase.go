@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+type InterpolationUseCase struct{}
+
+func NewInterpolationUseCase() *InterpolationUseCase {
+	return &InterpolationUseCase{}
+}
+
+// HermitePoint is a node where both the value and the first derivative of the
+// interpolated function are known.
+type HermitePoint struct {
+	X  float64
+	Y  float64
+	Dy float64
+}
+
+// HermiteInterpolation builds the osculating polynomial of degree 2n-1 that
+// matches both Y and Dy at each of the n points. The points must have
+// distinct X.
+func (u *InterpolationUseCase) HermiteInterpolation(points []HermitePoint) expressions.SingleVariableExpr {
+	n := 2 * len(points)
+
+	// Each node is repeated twice, the divided difference over a repeated
+	// node is the derivative itself
+	z := make([]float64, n)
+	table := make([][]float64, n)
+	for i, p := range points {
+		z[2*i] = p.X
+		z[2*i+1] = p.X
+		table[2*i] = make([]float64, n)
+		table[2*i+1] = make([]float64, n)
+		table[2*i][0] = p.Y
+		table[2*i+1][0] = p.Y
+		table[2*i+1][1] = p.Dy
+		if i > 0 {
+			table[2*i][1] = (table[2*i][0] - table[2*i-1][0]) / (z[2*i] - z[2*i-1])
+		}
+	}
+
+	for j := 2; j < n; j++ {
+		for i := j; i < n; i++ {
+			table[i][j] = (table[i][j-1] - table[i-1][j-1]) / (z[i] - z[i-j])
+		}
+	}
+
+	coefficients := make([]float64, n)
+	for i := range n {
+		coefficients[i] = table[i][i]
+	}
+
+	return func(x float64) float64 {
+		// Horner's scheme over the Newton form
+		result := 0.0
+		for i := n - 1; i >= 0; i-- {
+			result = result*(x-z[i]) + coefficients[i]
+		}
+		return result
+	}
+}
@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHermiteInterpolation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		points    []HermitePoint
+		tolerance float64
+	}{
+		{
+			name: "Single point gives the tangent line",
+			points: []HermitePoint{
+				{X: 1, Y: 2, Dy: 3},
+			},
+			tolerance: 1e-6,
+		},
+		{
+			name: "Two points of x³",
+			points: []HermitePoint{
+				{X: 0, Y: 0, Dy: 0},
+				{X: 2, Y: 8, Dy: 12},
+			},
+			tolerance: 1e-6,
+		},
+		{
+			name: "Three points of sin(x)",
+			points: []HermitePoint{
+				{X: 0, Y: math.Sin(0), Dy: math.Cos(0)},
+				{X: 1, Y: math.Sin(1), Dy: math.Cos(1)},
+				{X: 2.5, Y: math.Sin(2.5), Dy: math.Cos(2.5)},
+			},
+			tolerance: 1e-6,
+		},
+	}
+
+	useCase := NewInterpolationUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			interpolant := useCase.HermiteInterpolation(test.points)
+
+			// Assert
+			const h = 1e-6
+			for _, p := range test.points {
+				assert.InDelta(t, p.Y, interpolant(p.X), 1e-12, "value at x=%f", p.X)
+
+				slope := (interpolant(p.X+h) - interpolant(p.X-h)) / (2 * h)
+				assert.InDelta(t, p.Dy, slope, test.tolerance, "slope at x=%f", p.X)
+			}
+		})
+	}
+}
+
+func TestHermiteInterpolationReproducesCubic(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cubic := func(x float64) float64 { return x*x*x - 2*x + 1 }
+	cubicDerivative := func(x float64) float64 { return 3*x*x - 2 }
+
+	points := []HermitePoint{
+		{X: -1, Y: cubic(-1), Dy: cubicDerivative(-1)},
+		{X: 1, Y: cubic(1), Dy: cubicDerivative(1)},
+	}
+
+	// Act
+	interpolant := NewInterpolationUseCase().HermiteInterpolation(points)
+
+	// Assert
+	for _, x := range []float64{-0.5, 0, 0.3, 2} {
+		assert.InDelta(t, cubic(x), interpolant(x), 1e-12, "value at x=%f", x)
+	}
+}
@@ -0,0 +1,340 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrSystemDimensionMismatch = numeerr.New(numeerr.CodeInvalidInput, "matrix and right-hand side dimensions do not match")
+	ErrZeroDiagonal            = numeerr.New(numeerr.CodeDomain, "matrix has a zero on the diagonal")
+	ErrZeroPivot               = numeerr.New(numeerr.CodeDomain, "elimination without pivoting hit a zero pivot")
+	ErrInvalidRelaxation       = numeerr.New(numeerr.CodeInvalidInput, "relaxation factor must be strictly between 0 and 2")
+)
+
+type LinearSystemUseCase struct{}
+
+func NewLinearSystemUseCase() *LinearSystemUseCase {
+	return &LinearSystemUseCase{}
+}
+
+// LinearSystemResult is the solution x of Ax = b. Iterations is zero for
+// direct methods, and Residual is ‖Ax - b‖₂ to judge the solution quality.
+// Iterative methods also report Residuals, the residual after each
+// iteration, and whether the iterates Converged before the iterations ran
+// out.
+type LinearSystemResult struct {
+	Solution   []float64
+	Iterations uint64
+	Residual   float64
+	Residuals  []float64
+	Converged  bool
+}
+
+// Thomas solves the tridiagonal system with sub-diagonal lower, main diagonal
+// diagonal and super-diagonal upper in O(n), eliminating without pivoting.
+// It is stable for diagonally dominant matrices, such as the ones from finite
+// difference discretizations.
+func (u *LinearSystemUseCase) Thomas(
+	ctx context.Context,
+	lower, diagonal, upper, b []float64,
+) (*LinearSystemResult, error) {
+	slog.DebugContext(ctx, "Starting the Thomas solver",
+		vectorAttr("lower", lower),
+		vectorAttr("diagonal", diagonal),
+		vectorAttr("upper", upper),
+		vectorAttr("b", b),
+	)
+
+	if err := validateTridiagonalSystem(lower, diagonal, upper, b); err != nil {
+		slog.ErrorContext(ctx, "Invalid tridiagonal system", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to solve the tridiagonal system: %w", err)
+	}
+
+	n := len(diagonal)
+
+	// Forward sweep, normalizing each row so its diagonal becomes 1
+	upperPrime := make([]float64, n)
+	bPrime := make([]float64, n)
+	for i := range n {
+		pivot := diagonal[i]
+		if i > 0 {
+			pivot -= lower[i-1] * upperPrime[i-1]
+		}
+		if pivot == 0 {
+			slog.ErrorContext(ctx, "Zero pivot in the Thomas solver", slog.Int("row", i))
+			return nil, fmt.Errorf("%w: row %d", ErrZeroPivot, i)
+		}
+
+		if i < n-1 {
+			upperPrime[i] = upper[i] / pivot
+		}
+		bPrime[i] = b[i]
+		if i > 0 {
+			bPrime[i] -= lower[i-1] * bPrime[i-1]
+		}
+		bPrime[i] /= pivot
+	}
+
+	solution := make([]float64, n)
+	solution[n-1] = bPrime[n-1]
+	for i := n - 2; i >= 0; i-- {
+		solution[i] = bPrime[i] - upperPrime[i]*solution[i+1]
+	}
+
+	residual := tridiagonalResidualNorm(lower, diagonal, upper, solution, b)
+
+	slog.InfoContext(ctx, "Finished the Thomas solver",
+		vectorAttr("solution", solution),
+		slog.Float64("residual", residual),
+	)
+
+	return &LinearSystemResult{
+		Solution: solution,
+		Residual: residual,
+	}, nil
+}
+
+// Jacobi solves Ax = b iterating x_i = (b_i - Σ_{j≠i} a_ij x_j) / a_ii on the
+// previous iterate, until the relative change is below epsilon.
+func (u *LinearSystemUseCase) Jacobi(
+	ctx context.Context,
+	matrix [][]float64,
+	b []float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+) (*LinearSystemResult, error) {
+	return u.iterate(ctx, "Jacobi", matrix, b, epsilon, maxNumberOfIterations,
+		func(x []float64) []float64 {
+			next := make([]float64, len(x))
+			for i, row := range matrix {
+				sum := b[i]
+				for j, a := range row {
+					if j != i {
+						sum -= a * x[j]
+					}
+				}
+				next[i] = sum / row[i]
+			}
+			return next
+		},
+	)
+}
+
+// GaussSeidel solves Ax = b like Jacobi, but using each updated component as
+// soon as it is available.
+func (u *LinearSystemUseCase) GaussSeidel(
+	ctx context.Context,
+	matrix [][]float64,
+	b []float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+) (*LinearSystemResult, error) {
+	return u.iterate(ctx, "Gauss-Seidel", matrix, b, epsilon, maxNumberOfIterations,
+		func(x []float64) []float64 {
+			next := make([]float64, len(x))
+			copy(next, x)
+			for i, row := range matrix {
+				sum := b[i]
+				for j, a := range row {
+					if j != i {
+						sum -= a * next[j]
+					}
+				}
+				next[i] = sum / row[i]
+			}
+			return next
+		},
+	)
+}
+
+// SOR solves Ax = b by successive over-relaxation, moving each component of
+// the Gauss-Seidel step by omega times its update. An omega of 1 is
+// Gauss-Seidel, omegas above 1 can converge much faster and omegas below 1
+// damp oscillating iterates. It only converges for 0 < omega < 2.
+func (u *LinearSystemUseCase) SOR(
+	ctx context.Context,
+	matrix [][]float64,
+	b []float64,
+	omega float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+) (*LinearSystemResult, error) {
+	if !(omega > 0 && omega < 2) {
+		slog.ErrorContext(ctx, "Invalid relaxation factor", slog.Float64("omega", omega))
+		return nil, fmt.Errorf("%w: got %v", ErrInvalidRelaxation, omega)
+	}
+
+	return u.iterate(ctx, "SOR", matrix, b, epsilon, maxNumberOfIterations,
+		func(x []float64) []float64 {
+			next := make([]float64, len(x))
+			copy(next, x)
+			for i, row := range matrix {
+				sum := b[i]
+				for j, a := range row {
+					if j != i {
+						sum -= a * next[j]
+					}
+				}
+				next[i] = (1-omega)*next[i] + omega*sum/row[i]
+			}
+			return next
+		},
+	)
+}
+
+func (u *LinearSystemUseCase) iterate(
+	ctx context.Context,
+	method string,
+	matrix [][]float64,
+	b []float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+	step func(x []float64) []float64,
+) (*LinearSystemResult, error) {
+	slog.DebugContext(ctx, "Starting the iterative solver",
+		slog.String("method", method),
+		matrixAttr("matrix", matrix),
+		vectorAttr("b", b),
+		slog.Float64("epsilon", epsilon),
+		slog.Uint64("maxNumberOfIterations", maxNumberOfIterations),
+	)
+
+	if err := ValidateLinearSystem(matrix, b); err != nil {
+		slog.ErrorContext(ctx, "Invalid linear system", slog.Any("error", err))
+		return nil, err
+	}
+
+	for i := range matrix {
+		if matrix[i][i] == 0 {
+			slog.ErrorContext(ctx, "Zero diagonal entry", slog.Int("row", i))
+			return nil, fmt.Errorf("%w: row %d", ErrZeroDiagonal, i)
+		}
+	}
+
+	x := make([]float64, len(b))
+	residuals := make([]float64, 0, min(maxNumberOfIterations, maxPreallocatedResiduals))
+	converged := false
+
+	var iteration uint64
+	for iteration = 1; iteration <= maxNumberOfIterations; iteration++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		next := step(x)
+
+		difference, norm := 0.0, 0.0
+		for i := range next {
+			difference = max(difference, math.Abs(next[i]-x[i]))
+			norm = max(norm, math.Abs(next[i]))
+		}
+		x = next
+
+		residuals = append(residuals, ResidualNorm(matrix, x, b))
+
+		slog.DebugContext(ctx, "Iterative solver step",
+			slog.Uint64("iteration", iteration),
+			vectorAttr("x", x),
+			slog.Float64("difference", difference),
+			slog.Float64("residual", residuals[len(residuals)-1]),
+		)
+
+		if difference <= epsilon*max(norm, 1) {
+			converged = true
+			break
+		}
+	}
+
+	iterations := min(iteration, maxNumberOfIterations)
+	residual := ResidualNorm(matrix, x, b)
+	if !converged {
+		slog.WarnContext(ctx, "Iterative solver did not converge",
+			slog.String("method", method),
+			slog.Uint64("numIterations", iterations),
+			slog.Float64("residual", residual),
+		)
+	}
+
+	slog.InfoContext(ctx, "Finished the iterative solver",
+		slog.String("method", method),
+		vectorAttr("solution", x),
+		slog.Uint64("numIterations", iterations),
+		slog.Float64("residual", residual),
+	)
+
+	return &LinearSystemResult{
+		Solution:   x,
+		Iterations: iterations,
+		Residual:   residual,
+		Residuals:  residuals,
+		Converged:  converged,
+	}, nil
+}
+
+// maxPreallocatedResiduals caps the residual history allocated up front, as
+// the iteration budget is usually far above the iterations actually run.
+const maxPreallocatedResiduals = 1024
+
+// ValidateLinearSystem rejects the systems Ax = b no solver can work with, an
+// empty or non-square A or a b of another length.
+func ValidateLinearSystem(matrix [][]float64, b []float64) error {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return err
+	}
+
+	if len(b) != len(matrix) {
+		return fmt.Errorf("%w: %d rows, %d entries", ErrSystemDimensionMismatch, len(matrix), len(b))
+	}
+
+	return nil
+}
+
+func validateTridiagonalSystem(lower, diagonal, upper, b []float64) error {
+	n := len(diagonal)
+	if n == 0 {
+		return ErrEmptyMatrix
+	}
+
+	if len(lower) != n-1 || len(upper) != n-1 {
+		return fmt.Errorf("%w: %d diagonal entries need %d off-diagonal ones, got %d and %d",
+			ErrSystemDimensionMismatch, n, n-1, len(lower), len(upper))
+	}
+
+	if len(b) != n {
+		return fmt.Errorf("%w: %d rows, %d entries", ErrSystemDimensionMismatch, n, len(b))
+	}
+
+	return nil
+}
+
+// tridiagonalResidualNorm is ‖Ax - b‖₂ without building A.
+func tridiagonalResidualNorm(lower, diagonal, upper, x, b []float64) float64 {
+	sum := 0.0
+	for i := range diagonal {
+		row := diagonal[i]*x[i] - b[i]
+		if i > 0 {
+			row += lower[i-1] * x[i-1]
+		}
+		if i < len(diagonal)-1 {
+			row += upper[i] * x[i+1]
+		}
+		sum += row * row
+	}
+
+	return math.Sqrt(sum)
+}
+
+// residualNorm is ‖Ax - b‖₂.
+func ResidualNorm(matrix [][]float64, x []float64, b []float64) float64 {
+	var residual mat.VecDense
+	residual.MulVec(constructMatrix(matrix), constructVector(x))
+	residual.SubVec(&residual, constructVector(b))
+	return residual.Norm(2)
+}
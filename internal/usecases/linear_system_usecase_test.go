@@ -0,0 +1,380 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinearSystemSolvers(t *testing.T) {
+	t.Parallel()
+
+	// Diagonally dominant, so both iterative methods converge
+	matrix := [][]float64{
+		{4, -1, 0},
+		{-1, 4, -1},
+		{0, -1, 4},
+	}
+	b := []float64{2, 4, 10}
+	expected := []float64{1, 2, 3}
+
+	useCase := NewLinearSystemUseCase()
+
+	tt := []struct {
+		name  string
+		solve func(ctx context.Context) (*LinearSystemResult, error)
+	}{
+		{
+			name: "Jacobi",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.Jacobi(ctx, matrix, b, 1e-12, 200)
+			},
+		},
+		{
+			name: "Gauss-Seidel",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.GaussSeidel(ctx, matrix, b, 1e-12, 200)
+			},
+		},
+		{
+			name: "SOR",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.SOR(ctx, matrix, b, 1.1, 1e-12, 200)
+			},
+		},
+		{
+			name: "Thomas",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.Thomas(ctx, []float64{-1, -1}, []float64{4, 4, 4}, []float64{-1, -1}, b)
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			result, err := test.solve(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDeltaSlice(t, expected, result.Solution, 1e-10)
+		})
+	}
+}
+
+func TestGaussSeidelConvergesFasterThanJacobi(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewLinearSystemUseCase()
+	matrix := [][]float64{
+		{4, -1, 0},
+		{-1, 4, -1},
+		{0, -1, 4},
+	}
+	b := []float64{2, 4, 10}
+
+	// Act
+	jacobi, err := useCase.Jacobi(context.Background(), matrix, b, 1e-10, 200)
+	require.NoError(t, err)
+	gaussSeidel, err := useCase.GaussSeidel(context.Background(), matrix, b, 1e-10, 200)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Less(t, gaussSeidel.Iterations, jacobi.Iterations)
+}
+
+func TestSORConvergesFasterThanGaussSeidel(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewLinearSystemUseCase()
+	matrix := [][]float64{
+		{4, -1, 0, 0},
+		{-1, 4, -1, 0},
+		{0, -1, 4, -1},
+		{0, 0, -1, 4},
+	}
+	b := []float64{3, 2, 2, 3}
+
+	// Act
+	gaussSeidel, err := useCase.GaussSeidel(context.Background(), matrix, b, 1e-12, 200)
+	require.NoError(t, err)
+	relaxed, err := useCase.SOR(context.Background(), matrix, b, 1.07, 1e-12, 200)
+	require.NoError(t, err)
+	unrelaxed, err := useCase.SOR(context.Background(), matrix, b, 1, 1e-12, 200)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Less(t, relaxed.Iterations, gaussSeidel.Iterations)
+	assert.Equal(t, gaussSeidel.Solution, unrelaxed.Solution, "SOR with omega 1 is Gauss-Seidel")
+	assert.Equal(t, gaussSeidel.Residuals, unrelaxed.Residuals)
+}
+
+func TestIterativeSolversReportResiduals(t *testing.T) {
+	t.Parallel()
+
+	useCase := NewLinearSystemUseCase()
+	matrix := [][]float64{
+		{4, -1, 0},
+		{-1, 4, -1},
+		{0, -1, 4},
+	}
+	b := []float64{2, 4, 10}
+
+	tt := []struct {
+		name          string
+		maxIterations uint64
+		converged     bool
+		solve         func(ctx context.Context, maxIterations uint64) (*LinearSystemResult, error)
+	}{
+		{
+			name:          "Jacobi",
+			maxIterations: 200,
+			converged:     true,
+			solve: func(ctx context.Context, maxIterations uint64) (*LinearSystemResult, error) {
+				return useCase.Jacobi(ctx, matrix, b, 1e-12, maxIterations)
+			},
+		},
+		{
+			name:          "Gauss-Seidel",
+			maxIterations: 200,
+			converged:     true,
+			solve: func(ctx context.Context, maxIterations uint64) (*LinearSystemResult, error) {
+				return useCase.GaussSeidel(ctx, matrix, b, 1e-12, maxIterations)
+			},
+		},
+		{
+			name:          "SOR out of iterations",
+			maxIterations: 3,
+			converged:     false,
+			solve: func(ctx context.Context, maxIterations uint64) (*LinearSystemResult, error) {
+				return useCase.SOR(ctx, matrix, b, 1.2, 1e-12, maxIterations)
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			result, err := test.solve(context.Background(), test.maxIterations)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.converged, result.Converged)
+			require.Len(t, result.Residuals, int(result.Iterations))
+			assert.InDelta(t, result.Residual, result.Residuals[len(result.Residuals)-1], 1e-15)
+			for i := 1; i < len(result.Residuals); i++ {
+				assert.LessOrEqual(t, result.Residuals[i], result.Residuals[i-1], "residual grew at iteration %d", i+1)
+			}
+		})
+	}
+}
+
+func TestIterativeSolversRejectInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		omega       float64
+		cancelled   bool
+		expectedErr error
+	}{
+		{name: "Zero omega", omega: 0, expectedErr: ErrInvalidRelaxation},
+		{name: "Omega of two", omega: 2, expectedErr: ErrInvalidRelaxation},
+		{name: "NaN omega", omega: math.NaN(), expectedErr: ErrInvalidRelaxation},
+		{name: "Cancelled", omega: 1.2, cancelled: true, expectedErr: context.Canceled},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewLinearSystemUseCase()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.cancelled {
+				cancel()
+			}
+
+			// Act
+			_, err := useCase.SOR(ctx, [][]float64{{2, 1}, {1, 2}}, []float64{3, 3}, test.omega, 1e-10, 10)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestLinearSystemErrors(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		matrix      [][]float64
+		b           []float64
+		expectedErr error
+	}{
+		{
+			name:        "Dimension mismatch",
+			matrix:      [][]float64{{1, 0}, {0, 1}},
+			b:           []float64{1, 2, 3},
+			expectedErr: ErrSystemDimensionMismatch,
+		},
+		{
+			name:        "Non square matrix",
+			matrix:      [][]float64{{1, 0, 1}, {0, 1, 1}},
+			b:           []float64{1, 2},
+			expectedErr: ErrNonSquareMatrix,
+		},
+		{
+			name:        "Zero diagonal on iterative method",
+			matrix:      [][]float64{{0, 1}, {1, 0}},
+			b:           []float64{1, 2},
+			expectedErr: ErrZeroDiagonal,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewLinearSystemUseCase()
+
+			// Act
+			_, err := useCase.Jacobi(context.Background(), test.matrix, test.b, 1e-10, 10)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestLinearSystemResidual(t *testing.T) {
+	t.Parallel()
+
+	matrix := [][]float64{
+		{10, -1, 2, 0},
+		{-1, 11, -1, 3},
+		{2, -1, 10, -1},
+		{0, 3, -1, 8},
+	}
+	b := []float64{6, 25, -11, 15}
+	useCase := NewLinearSystemUseCase()
+
+	tt := []struct {
+		name        string
+		solve       func(ctx context.Context) (*LinearSystemResult, error)
+		minResidual float64
+		maxResidual float64
+	}{
+		{
+			name: "Converged Jacobi",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.Jacobi(ctx, matrix, b, 1e-12, 500)
+			},
+			maxResidual: 1e-9,
+		},
+		{
+			name: "Converged Gauss-Seidel",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.GaussSeidel(ctx, matrix, b, 1e-12, 500)
+			},
+			maxResidual: 1e-9,
+		},
+		{
+			name: "Jacobi capped at two iterations",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.Jacobi(ctx, matrix, b, 1e-12, 2)
+			},
+			minResidual: 1e-1,
+			maxResidual: 100,
+		},
+		{
+			name: "Gauss-Seidel capped at one iteration",
+			solve: func(ctx context.Context) (*LinearSystemResult, error) {
+				return useCase.GaussSeidel(ctx, matrix, b, 1e-12, 1)
+			},
+			minResidual: 1e-1,
+			maxResidual: 100,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			result, err := test.solve(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, result.Residual, test.minResidual)
+			assert.LessOrEqual(t, result.Residual, test.maxResidual)
+		})
+	}
+}
+
+func TestThomasErrors(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		lower    []float64
+		diagonal []float64
+		upper    []float64
+		b        []float64
+		expected error
+	}{
+		{
+			name:     "Empty system",
+			expected: ErrEmptyMatrix,
+		},
+		{
+			name:     "Off-diagonal length mismatch",
+			lower:    []float64{1},
+			diagonal: []float64{2, 2, 2},
+			upper:    []float64{1, 1},
+			b:        []float64{1, 1, 1},
+			expected: ErrSystemDimensionMismatch,
+		},
+		{
+			name:     "Right-hand side length mismatch",
+			lower:    []float64{1},
+			diagonal: []float64{2, 2},
+			upper:    []float64{1},
+			b:        []float64{1},
+			expected: ErrSystemDimensionMismatch,
+		},
+		{
+			name:     "Zero pivot",
+			lower:    []float64{1},
+			diagonal: []float64{1, 1},
+			upper:    []float64{1},
+			b:        []float64{1, 2},
+			expected: ErrZeroPivot,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewLinearSystemUseCase()
+
+			// Act
+			_, err := useCase.Thomas(context.Background(), test.lower, test.diagonal, test.upper, test.b)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Package linearsystems holds the direct solvers of Ax = b, which return the
+// factors they went through besides the solution.
+package linearsystems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+// DirectSolution is the solution of Ax = b by a direct method, with the
+// factors it went through so they can be shown. Lower and Upper are
+// triangular with PA = Lower·Upper, Lower having a unit diagonal for the
+// elimination methods and Upper being Lowerᵀ for Cholesky. Forward is y, the
+// solution of Ly = Pb that back substitution turns into x. Permutation[i] is
+// the row of A moved to row i by pivoting, the identity when none happened.
+type DirectSolution struct {
+	usecases.LinearSystemResult
+	Lower       [][]float64
+	Upper       [][]float64
+	Forward     []float64
+	Permutation []int
+}
+
+type DirectUseCase struct{}
+
+func NewDirectUseCase() *DirectUseCase {
+	return &DirectUseCase{}
+}
+
+// GaussianElimination solves Ax = b eliminating below each pivot on the
+// augmented matrix [A | b], swapping in the largest remaining entry of the
+// column as the pivot, then back substituting. The elimination is what this
+// method shows, so it is written out rather than left to gonum, keeping the
+// multipliers as Lower and the reduced right-hand side as Forward.
+func (u *DirectUseCase) GaussianElimination(ctx context.Context, matrix [][]float64, b []float64) (*DirectSolution, error) {
+	slog.DebugContext(ctx, "Starting the Gaussian elimination",
+		usecases.MatrixAttr("matrix", matrix),
+		usecases.VectorAttr("b", b),
+	)
+
+	if err := usecases.ValidateLinearSystem(matrix, b); err != nil {
+		slog.ErrorContext(ctx, "Invalid linear system", slog.Any("error", err))
+		return nil, err
+	}
+
+	n := len(matrix)
+	upper := cloneMatrix(matrix)
+	forward := append([]float64(nil), b...)
+	lower := identityMatrix(n)
+	permutation := identityPermutation(n)
+
+	for k := range n {
+		pivot := pivotRow(upper, k)
+		if upper[pivot][k] == 0 {
+			slog.ErrorContext(ctx, "Zero column below the diagonal", slog.Int("column", k))
+			return nil, fmt.Errorf("%w: column %d has no nonzero pivot", usecases.ErrSingularMatrix, k)
+		}
+
+		upper[k], upper[pivot] = upper[pivot], upper[k]
+		forward[k], forward[pivot] = forward[pivot], forward[k]
+		permutation[k], permutation[pivot] = permutation[pivot], permutation[k]
+		// Multipliers of the rows already eliminated follow their rows
+		for j := range k {
+			lower[k][j], lower[pivot][j] = lower[pivot][j], lower[k][j]
+		}
+
+		for i := k + 1; i < n; i++ {
+			multiplier := upper[i][k] / upper[k][k]
+			lower[i][k] = multiplier
+			upper[i][k] = 0
+			for j := k + 1; j < n; j++ {
+				upper[i][j] -= multiplier * upper[k][j]
+			}
+			forward[i] -= multiplier * forward[k]
+		}
+
+		slog.DebugContext(ctx, "Eliminated column",
+			slog.Int("column", k),
+			slog.Int("pivotRow", pivot),
+			usecases.MatrixAttr("upper", upper),
+		)
+	}
+
+	solution := backSubstitution(upper, forward)
+
+	return u.directSolution(ctx, "Gaussian elimination", matrix, b, &DirectSolution{
+		LinearSystemResult: usecases.LinearSystemResult{Solution: solution},
+		Lower:              lower,
+		Upper:              upper,
+		Forward:            forward,
+		Permutation:        permutation,
+	}), nil
+}
+
+// LU solves Ax = b through gonum's LU decomposition with partial pivoting,
+// PA = LU, returning the factors, which can be reused for other right-hand
+// sides, and y from Ly = Pb.
+func (u *DirectUseCase) LU(ctx context.Context, matrix [][]float64, b []float64) (*DirectSolution, error) {
+	slog.DebugContext(ctx, "Starting the LU decomposition",
+		usecases.MatrixAttr("matrix", matrix),
+		usecases.VectorAttr("b", b),
+	)
+
+	if err := usecases.ValidateLinearSystem(matrix, b); err != nil {
+		slog.ErrorContext(ctx, "Invalid linear system", slog.Any("error", err))
+		return nil, err
+	}
+
+	n := len(matrix)
+	var lu mat.LU
+	lu.Factorize(denseMatrix(matrix))
+
+	var x mat.VecDense
+	if err := lu.SolveVecTo(&x, false, mat.NewVecDense(n, append([]float64(nil), b...))); err != nil {
+		slog.ErrorContext(ctx, "Failed to solve the LU system", slog.Any("error", err))
+
+		var condition mat.Condition
+		if errors.As(err, &condition) || errors.Is(err, mat.ErrSingular) {
+			return nil, fmt.Errorf("%w: %w", usecases.ErrSingularMatrix, err)
+		}
+		return nil, fmt.Errorf("failed to solve the LU system: %w", err)
+	}
+
+	var lower, upper mat.TriDense
+	lu.LTo(&lower)
+	lu.UTo(&upper)
+
+	// RowPivots gives the row of LU each row of A went to, Permutation is
+	// the other way around
+	permutation := make([]int, n)
+	for row, moved := range lu.RowPivots(nil) {
+		permutation[moved] = row
+	}
+
+	permuted := make([]float64, n)
+	for i, row := range permutation {
+		permuted[i] = b[row]
+	}
+	lowerRows := triangularRows(&lower)
+
+	return u.directSolution(ctx, "LU decomposition", matrix, b, &DirectSolution{
+		LinearSystemResult: usecases.LinearSystemResult{Solution: append([]float64(nil), x.RawVector().Data...)},
+		Lower:              lowerRows,
+		Upper:              triangularRows(&upper),
+		Forward:            forwardSubstitution(lowerRows, permuted),
+		Permutation:        permutation,
+	}), nil
+}
+
+// Cholesky solves Ax = b for a symmetric positive definite A through its
+// factorization A = L Lᵀ, about half the work of LU and needing no pivoting.
+func (u *DirectUseCase) Cholesky(ctx context.Context, matrix [][]float64, b []float64) (*DirectSolution, error) {
+	slog.DebugContext(ctx, "Starting the Cholesky solver",
+		usecases.MatrixAttr("matrix", matrix),
+		usecases.VectorAttr("b", b),
+	)
+
+	if err := usecases.ValidateLinearSystem(matrix, b); err != nil {
+		slog.ErrorContext(ctx, "Invalid linear system", slog.Any("error", err))
+		return nil, err
+	}
+
+	lower, err := usecases.NewMatrixUseCase().Cholesky(ctx, matrix)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(matrix)
+	upper := make([][]float64, n)
+	for i := range n {
+		upper[i] = make([]float64, n)
+		for j := i; j < n; j++ {
+			upper[i][j] = lower[j][i]
+		}
+	}
+
+	forward := forwardSubstitution(lower, b)
+	solution := backSubstitution(upper, forward)
+
+	return u.directSolution(ctx, "Cholesky", matrix, b, &DirectSolution{
+		LinearSystemResult: usecases.LinearSystemResult{Solution: solution},
+		Lower:              lower,
+		Upper:              upper,
+		Forward:            forward,
+		Permutation:        identityPermutation(n),
+	}), nil
+}
+
+// directSolution fills in the residual of a direct solution and logs it.
+func (u *DirectUseCase) directSolution(
+	ctx context.Context,
+	method string,
+	matrix [][]float64,
+	b []float64,
+	solution *DirectSolution,
+) *DirectSolution {
+	solution.Residual = usecases.ResidualNorm(matrix, solution.Solution, b)
+
+	slog.InfoContext(ctx, "Finished the direct solver",
+		slog.String("method", method),
+		usecases.VectorAttr("solution", solution.Solution),
+		slog.Float64("residual", solution.Residual),
+	)
+
+	return solution
+}
+
+// pivotRow returns the row, at or below k, with the largest absolute entry in
+// column k.
+func pivotRow(matrix [][]float64, k int) int {
+	pivot := k
+	for i := k + 1; i < len(matrix); i++ {
+		if math.Abs(matrix[i][k]) > math.Abs(matrix[pivot][k]) {
+			pivot = i
+		}
+	}
+
+	return pivot
+}
+
+// forwardSubstitution solves Ly = b for a lower triangular L with a nonzero
+// diagonal.
+func forwardSubstitution(lower [][]float64, b []float64) []float64 {
+	y := make([]float64, len(b))
+	for i, row := range lower {
+		sum := b[i]
+		for j := range i {
+			sum -= row[j] * y[j]
+		}
+		y[i] = sum / row[i]
+	}
+
+	return y
+}
+
+// backSubstitution solves Ux = y for an upper triangular U with a nonzero
+// diagonal.
+func backSubstitution(upper [][]float64, y []float64) []float64 {
+	x := make([]float64, len(y))
+	for i := len(upper) - 1; i >= 0; i-- {
+		sum := y[i]
+		for j := i + 1; j < len(upper); j++ {
+			sum -= upper[i][j] * x[j]
+		}
+		x[i] = sum / upper[i][i]
+	}
+
+	return x
+}
+
+func denseMatrix(matrix [][]float64) *mat.Dense {
+	dense := mat.NewDense(len(matrix), len(matrix), nil)
+	for i, row := range matrix {
+		dense.SetRow(i, row)
+	}
+
+	return dense
+}
+
+func triangularRows(triangular *mat.TriDense) [][]float64 {
+	n, _ := triangular.Dims()
+	rows := make([][]float64, n)
+	for i := range rows {
+		rows[i] = make([]float64, n)
+		for j := range rows[i] {
+			rows[i][j] = triangular.At(i, j)
+		}
+	}
+
+	return rows
+}
+
+func cloneMatrix(matrix [][]float64) [][]float64 {
+	clone := make([][]float64, len(matrix))
+	for i, row := range matrix {
+		clone[i] = append([]float64(nil), row...)
+	}
+
+	return clone
+}
+
+func identityMatrix(n int) [][]float64 {
+	identity := make([][]float64, n)
+	for i := range identity {
+		identity[i] = make([]float64, n)
+		identity[i][i] = 1
+	}
+
+	return identity
+}
+
+func identityPermutation(n int) []int {
+	permutation := make([]int, n)
+	for i := range permutation {
+		permutation[i] = i
+	}
+
+	return permutation
+}
@@ -0,0 +1,163 @@
+package linearsystems
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestDirectSolversFactorTheMatrix(t *testing.T) {
+	t.Parallel()
+
+	useCase := NewDirectUseCase()
+
+	tt := []struct {
+		name     string
+		matrix   [][]float64
+		b        []float64
+		expected []float64
+		solve    func(ctx context.Context, matrix [][]float64, b []float64) (*DirectSolution, error)
+	}{
+		{
+			name:     "GaussianElimination",
+			matrix:   [][]float64{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}},
+			b:        []float64{8, -11, -3},
+			expected: []float64{2, 3, -1},
+			solve:    useCase.GaussianElimination,
+		},
+		{
+			name:     "GaussianEliminationZeroLeadingEntry",
+			matrix:   [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}},
+			b:        []float64{7, 6, 4},
+			expected: []float64{1, 2, 3},
+			solve:    useCase.GaussianElimination,
+		},
+		{
+			name:     "LU",
+			matrix:   [][]float64{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}},
+			b:        []float64{8, -11, -3},
+			expected: []float64{2, 3, -1},
+			solve:    useCase.LU,
+		},
+		{
+			name:     "LUZeroLeadingEntry",
+			matrix:   [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}},
+			b:        []float64{7, 6, 4},
+			expected: []float64{1, 2, 3},
+			solve:    useCase.LU,
+		},
+		{
+			name:     "Cholesky",
+			matrix:   [][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}},
+			b:        []float64{-20, -43, 192},
+			expected: []float64{1, 2, 3},
+			solve:    useCase.Cholesky,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			result, err := test.solve(t.Context(), test.matrix, test.b)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDeltaSlice(t, test.expected, result.Solution, 1e-12)
+			assert.Less(t, result.Residual, 1e-12)
+
+			n := len(test.matrix)
+			permuted := mat.NewDense(n, n, nil)
+			for i, row := range result.Permutation {
+				permuted.SetRow(i, test.matrix[row])
+			}
+
+			var product mat.Dense
+			product.Mul(denseMatrix(result.Lower), denseMatrix(result.Upper))
+			assert.True(t, mat.EqualApprox(permuted, &product, 1e-12), "PA = LU, got\n%v", mat.Formatted(&product))
+
+			for i := range n {
+				for j := range n {
+					if j > i {
+						assert.Zero(t, result.Lower[i][j], "lower (%d,%d)", i, j)
+					}
+					if j < i {
+						assert.Zero(t, result.Upper[i][j], "upper (%d,%d)", i, j)
+					}
+				}
+			}
+
+			var forward mat.VecDense
+			forward.MulVec(denseMatrix(result.Upper), mat.NewVecDense(n, result.Solution))
+			assert.InDeltaSlice(t, result.Forward, forward.RawVector().Data, 1e-12, "Ux = y")
+		})
+	}
+}
+
+func TestDirectSolversPivot(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewDirectUseCase()
+	matrix := [][]float64{{1, 2}, {3, 4}}
+	b := []float64{5, 6}
+
+	// Act
+	gauss, gaussErr := useCase.GaussianElimination(t.Context(), matrix, b)
+	lu, luErr := useCase.LU(t.Context(), matrix, b)
+
+	// Assert
+	require.NoError(t, gaussErr)
+	require.NoError(t, luErr)
+	assert.Equal(t, []int{1, 0}, gauss.Permutation)
+	assert.Equal(t, []int{1, 0}, lu.Permutation)
+	assert.InDeltaSlice(t, []float64{1, 0, 1.0 / 3, 1}, append(lu.Lower[0], lu.Lower[1]...), 1e-15)
+	assert.Equal(t, lu.Lower, gauss.Lower)
+	assert.InDeltaSlice(t, lu.Forward, gauss.Forward, 1e-15)
+}
+
+func TestDirectSolverErrors(t *testing.T) {
+	t.Parallel()
+
+	useCase := NewDirectUseCase()
+	solvers := map[string]func(ctx context.Context, matrix [][]float64, b []float64) (*DirectSolution, error){
+		"GaussianElimination": useCase.GaussianElimination,
+		"LU":                  useCase.LU,
+		"Cholesky":            useCase.Cholesky,
+	}
+
+	tt := []struct {
+		name        string
+		solver      string
+		matrix      [][]float64
+		b           []float64
+		expectedErr error
+	}{
+		{name: "GaussEmpty", solver: "GaussianElimination", matrix: [][]float64{}, b: []float64{}, expectedErr: usecases.ErrEmptyMatrix},
+		{name: "GaussMismatch", solver: "GaussianElimination", matrix: [][]float64{{1, 0}, {0, 1}}, b: []float64{1}, expectedErr: usecases.ErrSystemDimensionMismatch},
+		{name: "GaussSingular", solver: "GaussianElimination", matrix: [][]float64{{1, 2}, {2, 4}}, b: []float64{1, 2}, expectedErr: usecases.ErrSingularMatrix},
+		{name: "LUNonSquare", solver: "LU", matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}, b: []float64{1, 2}, expectedErr: usecases.ErrNonSquareMatrix},
+		{name: "LUSingular", solver: "LU", matrix: [][]float64{{0, 1}, {0, 1}}, b: []float64{1, 2}, expectedErr: usecases.ErrSingularMatrix},
+		{name: "CholeskyNonSymmetric", solver: "Cholesky", matrix: [][]float64{{4, 1}, {2, 3}}, b: []float64{1, 2}, expectedErr: usecases.ErrNonSymmetricMatrix},
+		{name: "CholeskyIndefinite", solver: "Cholesky", matrix: [][]float64{{1, 2}, {2, 1}}, b: []float64{1, 2}, expectedErr: usecases.ErrNotPositiveDefinite},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := solvers[test.solver](t.Context(), test.matrix, test.b)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
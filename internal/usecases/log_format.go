@@ -0,0 +1,157 @@
+package usecases
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// LogFormat limits how matrices and vectors are written to the logs, so big
+// inputs don't turn into enormous log lines.
+type LogFormat struct {
+	// Precision is the number of significant digits kept for each entry
+	Precision int
+	// MaxElements is how many rows, columns or vector entries are written
+	// before the rest is elided
+	MaxElements int
+}
+
+func DefaultLogFormat() LogFormat {
+	return LogFormat{
+		Precision:   6,
+		MaxElements: 8,
+	}
+}
+
+var logFormat atomic.Pointer[LogFormat]
+
+// SetLogFormat changes the format of every matrix and vector logged by the
+// use cases, non-positive fields keep their defaults.
+func SetLogFormat(format LogFormat) {
+	defaults := DefaultLogFormat()
+	if format.Precision <= 0 {
+		format.Precision = defaults.Precision
+	}
+	if format.MaxElements <= 0 {
+		format.MaxElements = defaults.MaxElements
+	}
+
+	logFormat.Store(&format)
+}
+
+func currentLogFormat() LogFormat {
+	if format := logFormat.Load(); format != nil {
+		return *format
+	}
+
+	return DefaultLogFormat()
+}
+
+// matrixAttr logs matrix lazily, only formatting it when the record is kept.
+func matrixAttr(key string, matrix [][]float64) slog.Attr {
+	return slog.Any(key, loggedMatrix{rows: matrix})
+}
+
+// denseAttr logs a gonum matrix or vector, see matrixAttr.
+func denseAttr(key string, matrix mat.Matrix) slog.Attr {
+	return slog.Any(key, loggedMatrix{matrix: matrix})
+}
+
+// vectorAttr logs vector lazily, only formatting it when the record is kept.
+func vectorAttr(key string, vector []float64) slog.Attr {
+	return slog.Any(key, loggedVector(vector))
+}
+
+// MatrixAttr is matrixAttr for the use cases in the subpackages.
+func MatrixAttr(key string, matrix [][]float64) slog.Attr {
+	return matrixAttr(key, matrix)
+}
+
+// VectorAttr is vectorAttr for the use cases in the subpackages.
+func VectorAttr(key string, vector []float64) slog.Attr {
+	return vectorAttr(key, vector)
+}
+
+type loggedMatrix struct {
+	matrix mat.Matrix
+	rows   [][]float64
+}
+
+// LogValue implements slog.LogValuer.
+func (m loggedMatrix) LogValue() slog.Value {
+	if m.matrix == nil && m.rows == nil {
+		return slog.StringValue("[]")
+	}
+
+	at, rows, cols := m.accessor()
+	format := currentLogFormat()
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "%dx%d [", rows, cols)
+	for i := range min(rows, format.MaxElements) {
+		if i > 0 {
+			out.WriteByte(' ')
+		}
+
+		rowLength := cols
+		if m.rows != nil {
+			rowLength = len(m.rows[i])
+		}
+		writeEntries(&out, rowLength, format, func(j int) float64 { return at(i, j) })
+	}
+	if rows > format.MaxElements {
+		out.WriteString(" …")
+	}
+	out.WriteByte(']')
+
+	return slog.StringValue(out.String())
+}
+
+// accessor reads either representation, ragged rows report their own length
+// and the widest row as the column count.
+func (m loggedMatrix) accessor() (func(i, j int) float64, int, int) {
+	if m.matrix != nil {
+		rows, cols := m.matrix.Dims()
+		return m.matrix.At, rows, cols
+	}
+
+	cols := 0
+	for _, row := range m.rows {
+		cols = max(cols, len(row))
+	}
+
+	return func(i, j int) float64 { return m.rows[i][j] }, len(m.rows), cols
+}
+
+type loggedVector []float64
+
+// LogValue implements slog.LogValuer.
+func (v loggedVector) LogValue() slog.Value {
+	format := currentLogFormat()
+
+	var out strings.Builder
+	writeEntries(&out, len(v), format, func(i int) float64 { return v[i] })
+	if len(v) > format.MaxElements {
+		fmt.Fprintf(&out, " (%d entries)", len(v))
+	}
+
+	return slog.StringValue(out.String())
+}
+
+func writeEntries(out *strings.Builder, length int, format LogFormat, at func(int) float64) {
+	out.WriteByte('[')
+	for i := range min(length, format.MaxElements) {
+		if i > 0 {
+			out.WriteByte(' ')
+		}
+		out.WriteString(strconv.FormatFloat(at(i), 'g', format.Precision, 64))
+	}
+	if length > format.MaxElements {
+		out.WriteString(" …")
+	}
+	out.WriteByte(']')
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestLargeMatrixLogsTruncatedSummary(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	matrix := make([][]float64, 200)
+	for i := range matrix {
+		matrix[i] = make([]float64, 200)
+		for j := range matrix[i] {
+			matrix[i][j] = float64(i*200+j) / 3
+		}
+	}
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	// Act
+	logger.Info("solving", matrixAttr("matrix", matrix))
+
+	// Assert
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &record))
+	logged, ok := record["matrix"].(string)
+	require.True(t, ok)
+	assert.Regexp(t, `^200x200 \[\[0 0\.333333 0\.666667 1 `, logged)
+	assert.Contains(t, logged, "…")
+	assert.Less(t, buffer.Len(), 1024)
+}
+
+func TestLogValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		attr     slog.Attr
+		expected string
+	}{
+		{
+			name:     "Small matrix",
+			attr:     matrixAttr("matrix", [][]float64{{1, 2}, {3, 4}}),
+			expected: "2x2 [[1 2] [3 4]]",
+		},
+		{
+			name:     "Ragged matrix",
+			attr:     matrixAttr("matrix", [][]float64{{1, 2}, {3}}),
+			expected: "2x2 [[1 2] [3]]",
+		},
+		{
+			name:     "Gonum matrix",
+			attr:     denseAttr("matrix", mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})),
+			expected: "2x3 [[1 2 3] [4 5 6]]",
+		},
+		{
+			name:     "Tall matrix",
+			attr:     denseAttr("matrix", mat.NewDense(9, 1, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9})),
+			expected: "9x1 [[1] [2] [3] [4] [5] [6] [7] [8] …]",
+		},
+		{
+			name:     "Small vector",
+			attr:     vectorAttr("vector", []float64{1.5, -2}),
+			expected: "[1.5 -2]",
+		},
+		{
+			name:     "Long vector",
+			attr:     vectorAttr("vector", make([]float64, 10)),
+			expected: "[0 0 0 0 0 0 0 0 …] (10 entries)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			value := test.attr.Value.Resolve()
+
+			// Assert
+			assert.Equal(t, test.expected, value.String())
+		})
+	}
+}
+
+// TestSetLogFormat isn't parallel since the format is shared by the package.
+func TestSetLogFormat(t *testing.T) {
+	// Arrange
+	t.Cleanup(func() { SetLogFormat(DefaultLogFormat()) })
+	SetLogFormat(LogFormat{Precision: 3, MaxElements: 2})
+
+	// Act
+	matrix := matrixAttr("matrix", [][]float64{{1.23456, 2, 3}, {4, 5, 6}, {7, 8, 9}}).Value.Resolve()
+	vector := vectorAttr("vector", []float64{1, 2, 3}).Value.Resolve()
+
+	// Assert
+	assert.Equal(t, "3x3 [[1.23 2 …] [4 5 …] …]", matrix.String())
+	assert.Equal(t, "[1 2 …] (3 entries)", vector.String())
+}
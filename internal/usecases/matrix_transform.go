@@ -0,0 +1,33 @@
+package usecases
+
+// Transpose returns the transpose of matrix, leaving matrix untouched. The
+// transpose has the same eigenvalues.
+func Transpose(matrix [][]float64) [][]float64 {
+	if len(matrix) == 0 {
+		return [][]float64{}
+	}
+
+	transposed := make([][]float64, len(matrix[0]))
+	for j := range transposed {
+		transposed[j] = make([]float64, len(matrix))
+		for i, row := range matrix {
+			transposed[j][i] = row[j]
+		}
+	}
+
+	return transposed
+}
+
+// Scale returns matrix with every entry multiplied by factor, leaving matrix
+// untouched. Its eigenvalues are multiplied by factor as well.
+func Scale(matrix [][]float64, factor float64) [][]float64 {
+	scaled := make([][]float64, len(matrix))
+	for i, row := range matrix {
+		scaled[i] = make([]float64, len(row))
+		for j, value := range row {
+			scaled[i][j] = factor * value
+		}
+	}
+
+	return scaled
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranspose(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		matrix   [][]float64
+		expected [][]float64
+	}{
+		{name: "Square", matrix: [][]float64{{1, 2}, {3, 4}}, expected: [][]float64{{1, 3}, {2, 4}}},
+		{name: "Rectangular", matrix: [][]float64{{1, 2, 3}}, expected: [][]float64{{1}, {2}, {3}}},
+		{name: "Empty", matrix: [][]float64{}, expected: [][]float64{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			transposed := Transpose(testCase.matrix)
+
+			// Assert
+			assert.Equal(t, testCase.expected, transposed)
+		})
+	}
+}
+
+func TestTransformsLeaveTheMatrixUntouched(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	matrix := [][]float64{{1, 2}, {3, 4}}
+
+	// Act
+	Transpose(matrix)[0][1] = 10
+	Scale(matrix, 2)[1][0] = 10
+
+	// Assert
+	assert.Equal(t, [][]float64{{1, 2}, {3, 4}}, matrix)
+}
+
+func TestScale(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	matrix := [][]float64{{1, -2}, {0.5, 4}}
+
+	// Act
+	scaled := Scale(matrix, -3)
+
+	// Assert
+	assert.Equal(t, [][]float64{{-3, 6}, {-1.5, -12}}, scaled)
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrEmptyMatrix     = numeerr.New(numeerr.CodeInvalidInput, "empty matrix")
+	ErrNonSquareMatrix = numeerr.New(numeerr.CodeInvalidInput, "matrix is not square")
+	ErrSingularMatrix  = numeerr.New(numeerr.CodeDomain, "matrix is singular")
+	// ErrNotPositiveDefinite is reported by the Cholesky factorization when a
+	// pivot isn't positive
+	ErrNotPositiveDefinite = numeerr.New(numeerr.CodeDomain, "matrix is not positive definite")
+)
+
+type MatrixUseCase struct{}
+
+func NewMatrixUseCase() *MatrixUseCase {
+	return &MatrixUseCase{}
+}
+
+// MatrixInverse computes the inverse of a square matrix, returning
+// ErrSingularMatrix when it is singular or too ill-conditioned to invert.
+func (u *MatrixUseCase) MatrixInverse(ctx context.Context, matrix [][]float64) ([][]float64, error) {
+	slog.DebugContext(ctx, "Starting the matrix inversion",
+		matrixAttr("matrix", matrix),
+	)
+
+	if err := validateSquareMatrix(matrix); err != nil {
+		slog.ErrorContext(ctx, "Invalid matrix for inversion", slog.Any("error", err))
+		return nil, err
+	}
+
+	var inverseMatrix mat.Dense
+
+	err := inverseMatrix.Inverse(constructMatrix(matrix))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to compute the inverse of the matrix", slog.Any("error", err))
+
+		var condition mat.Condition
+		if errors.As(err, &condition) || errors.Is(err, mat.ErrSingular) {
+			return nil, fmt.Errorf("%w: %w", ErrSingularMatrix, err)
+		}
+		return nil, fmt.Errorf("failed to compute the inverse of the matrix: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Finished the matrix inversion")
+
+	return denseToSliceOfSlices(&inverseMatrix), nil
+}
+
+// Cholesky factors a symmetric positive definite matrix as A = L Lᵀ, returning
+// the lower triangular L.
+func (u *MatrixUseCase) Cholesky(ctx context.Context, matrix [][]float64) ([][]float64, error) {
+	slog.DebugContext(ctx, "Starting the Cholesky factorization",
+		matrixAttr("matrix", matrix),
+	)
+
+	if err := validateSquareMatrix(matrix); err != nil {
+		slog.ErrorContext(ctx, "Invalid matrix for the Cholesky factorization", slog.Any("error", err))
+		return nil, err
+	}
+
+	if err := validateSymmetricMatrix(matrix); err != nil {
+		slog.ErrorContext(ctx, "Invalid matrix for the Cholesky factorization", slog.Any("error", err))
+		return nil, err
+	}
+
+	lower, err := choleskyFactor(matrix)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to compute the Cholesky factorization", slog.Any("error", err))
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Finished the Cholesky factorization")
+
+	var dense mat.Dense
+	dense.CloneFrom(lower)
+
+	return denseToSliceOfSlices(&dense), nil
+}
+
+// choleskyFactor runs the Cholesky–Banachiewicz algorithm row by row. The
+// matrix is assumed square and symmetric, only its lower half is read.
+func choleskyFactor(matrix [][]float64) (*mat.TriDense, error) {
+	n := len(matrix)
+	lower := mat.NewTriDense(n, mat.Lower, nil)
+
+	for i := range n {
+		for j := 0; j <= i; j++ {
+			sum := matrix[i][j]
+			for k := range j {
+				sum -= lower.At(i, k) * lower.At(j, k)
+			}
+
+			if i != j {
+				lower.SetTri(i, j, sum/lower.At(j, j))
+				continue
+			}
+
+			if sum <= 0 {
+				return nil, fmt.Errorf("%w: pivot %d is %g", ErrNotPositiveDefinite, i, sum)
+			}
+			lower.SetTri(i, i, math.Sqrt(sum))
+		}
+	}
+
+	return lower, nil
+}
+
+func validateSquareMatrix(matrix [][]float64) error {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return ErrEmptyMatrix
+	}
+
+	for i, row := range matrix {
+		if len(row) != len(matrix) {
+			return fmt.Errorf("%w: row %d has %d columns, expected %d", ErrNonSquareMatrix, i, len(row), len(matrix))
+		}
+	}
+
+	return nil
+}
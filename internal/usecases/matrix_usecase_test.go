@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestMatrixInverse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		matrix [][]float64
+	}{
+		{
+			name:   "2x2",
+			matrix: [][]float64{{4, 7}, {2, 6}},
+		},
+		{
+			name:   "3x3 symmetric",
+			matrix: [][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+		},
+		{
+			name:   "4x4 non symmetric",
+			matrix: [][]float64{{1, 2, 0, 1}, {0, 1, 3, 0}, {2, 0, 1, 4}, {1, 1, 1, 1}},
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			inverse, err := useCase.MatrixInverse(t.Context(), test.matrix)
+
+			// Assert
+			require.NoError(t, err)
+
+			var product mat.Dense
+			product.Mul(constructMatrix(test.matrix), constructMatrix(inverse))
+
+			n := len(test.matrix)
+			for i := range n {
+				for j := range n {
+					expected := 0.0
+					if i == j {
+						expected = 1.0
+					}
+					assert.InDelta(t, expected, product.At(i, j), 1e-10, "A·A⁻¹ at (%d, %d)", i, j)
+				}
+			}
+		})
+	}
+}
+
+func TestMatrixInverseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		matrix        [][]float64
+		expectedError error
+	}{
+		{
+			name:          "Singular",
+			matrix:        [][]float64{{1, 2}, {2, 4}},
+			expectedError: ErrSingularMatrix,
+		},
+		{
+			name:          "Singular 3x3",
+			matrix:        [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			expectedError: ErrSingularMatrix,
+		},
+		{
+			name:          "Non square",
+			matrix:        [][]float64{{1, 2, 3}, {4, 5, 6}},
+			expectedError: ErrNonSquareMatrix,
+		},
+		{
+			name:          "Empty",
+			matrix:        [][]float64{},
+			expectedError: ErrEmptyMatrix,
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := useCase.MatrixInverse(t.Context(), test.matrix)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		matrix        [][]float64
+		expectedLower [][]float64
+	}{
+		{
+			name:          "2x2",
+			matrix:        [][]float64{{4, 2}, {2, 3}},
+			expectedLower: [][]float64{{2, 0}, {1, 1.4142135623730951}},
+		},
+		{
+			name:          "3x3",
+			matrix:        [][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}},
+			expectedLower: [][]float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}},
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			lower, err := useCase.Cholesky(t.Context(), test.matrix)
+
+			// Assert
+			require.NoError(t, err)
+
+			var product mat.Dense
+			product.Mul(constructMatrix(lower), constructMatrix(lower).T())
+
+			n := len(test.matrix)
+			for i := range n {
+				assert.InDeltaSlice(t, test.expectedLower[i], lower[i], 1e-12, "L row %d", i)
+				for j := range n {
+					assert.InDelta(t, test.matrix[i][j], product.At(i, j), 1e-10, "L·Lᵀ at (%d, %d)", i, j)
+				}
+			}
+		})
+	}
+}
+
+func TestCholeskyRejectsInvalidMatrices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		expectedErr error
+	}{
+		{
+			name:        "Indefinite",
+			matrix:      [][]float64{{1, 2}, {2, 1}},
+			expectedErr: ErrNotPositiveDefinite,
+		},
+		{
+			name:        "Positive semidefinite",
+			matrix:      [][]float64{{1, 1}, {1, 1}},
+			expectedErr: ErrNotPositiveDefinite,
+		},
+		{
+			name:        "Non symmetric",
+			matrix:      [][]float64{{4, 1}, {0, 3}},
+			expectedErr: ErrNonSymmetricMatrix,
+		},
+		{
+			name:        "Non square",
+			matrix:      [][]float64{{4, 1, 0}, {1, 3, 0}},
+			expectedErr: ErrNonSquareMatrix,
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := useCase.Cholesky(t.Context(), test.matrix)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
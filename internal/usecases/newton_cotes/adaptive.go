@@ -0,0 +1,232 @@
+package newtoncotes
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrNonPositiveTolerance = numeerr.New(numeerr.CodeInvalidInput, "tolerance must be positive")
+	ErrZeroPartitionBudget  = numeerr.New(numeerr.CodeInvalidInput, "partition budget must allow at least two partitions")
+)
+
+// AdaptiveResult is the area found by Adaptive, with the number of partitions
+// it ended up using and the estimated absolute error of the area.
+type AdaptiveResult struct {
+	Area          float64
+	Partitions    uint64
+	ErrorEstimate float64
+}
+
+// Adaptive integrates simpleExpr by repeatedly halving the partition with the
+// largest error, estimated as the difference between the formula over the
+// partition and over its two halves, until the total estimate is below
+// tolerance or the partitions reach maxPartitions. Smooth regions stay coarse
+// while the partitions concentrate where the integrand varies.
+func (u *NewtonCotesUseCase) Adaptive(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	tolerance float64,
+	maxPartitions uint64,
+) (*AdaptiveResult, error) {
+	slog.DebugContext(ctx, "Starting adaptive Newton-Cotes integration",
+		slog.Float64("leftInterval", leftInterval),
+		slog.Float64("rightInterval", rightInterval),
+		slog.Float64("tolerance", tolerance),
+		slog.Uint64("maxPartitions", maxPartitions),
+		slog.String("strategy", u.strategy.Description()),
+	)
+
+	switch {
+	case leftInterval == rightInterval:
+		return nil, ErrZeroWidthInterval
+	case !(tolerance > 0):
+		return nil, fmt.Errorf("%w: got %v", ErrNonPositiveTolerance, tolerance)
+	case maxPartitions < 2:
+		return nil, fmt.Errorf("%w: got %d", ErrZeroPartitionBudget, maxPartitions)
+	}
+
+	first, err := u.refine(ctx, simpleExpr, leftInterval, rightInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := segmentQueue{first}
+	area, estimate := first.area, first.estimate
+
+	// Each segment counts as the two halves its area comes from, so a split
+	// adds two partitions
+	partitions := uint64(2)
+	for estimate > tolerance && partitions+2 <= maxPartitions {
+		worst := heap.Pop(&queue).(segment)
+
+		left, err := u.refine(ctx, simpleExpr, worst.left, worst.mid())
+		if err != nil {
+			return nil, err
+		}
+		right, err := u.refine(ctx, simpleExpr, worst.mid(), worst.right)
+		if err != nil {
+			return nil, err
+		}
+
+		area += left.area + right.area - worst.area
+		estimate += left.estimate + right.estimate - worst.estimate
+		partitions += 2
+
+		heap.Push(&queue, left)
+		heap.Push(&queue, right)
+	}
+
+	// The running sums drift, recompute them once
+	area, estimate = 0, 0
+	for _, s := range queue {
+		area += s.area
+		estimate += s.estimate
+	}
+
+	if estimate > tolerance {
+		slog.WarnContext(ctx, "Adaptive integration ran out of partitions before reaching the tolerance",
+			slog.Float64("errorEstimate", estimate),
+			slog.Uint64("partitions", partitions),
+		)
+	}
+
+	slog.InfoContext(ctx, "Adaptive Newton-Cotes integration completed",
+		slog.Float64("totalArea", area),
+		slog.Float64("errorEstimate", estimate),
+		slog.Uint64("partitions", partitions),
+	)
+
+	return &AdaptiveResult{
+		Area:          area,
+		Partitions:    partitions,
+		ErrorEstimate: estimate,
+	}, nil
+}
+
+// refine integrates [left, right] as two halves, comparing it against the
+// whole interval to estimate the error.
+func (u *NewtonCotesUseCase) refine(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	left, right float64,
+) (segment, error) {
+	s := segment{left: left, right: right}
+
+	whole, err := u.strategy.Integrate(ctx, simpleExpr, left, right)
+	if err != nil {
+		return s, fmt.Errorf("error integrating partition [%f, %f]: %w", left, right, err)
+	}
+	leftHalf, err := u.strategy.Integrate(ctx, simpleExpr, left, s.mid())
+	if err != nil {
+		return s, fmt.Errorf("error integrating partition [%f, %f]: %w", left, s.mid(), err)
+	}
+	rightHalf, err := u.strategy.Integrate(ctx, simpleExpr, s.mid(), right)
+	if err != nil {
+		return s, fmt.Errorf("error integrating partition [%f, %f]: %w", s.mid(), right, err)
+	}
+
+	s.area = leftHalf + rightHalf
+	s.estimate = math.Abs(s.area - whole)
+
+	return s, nil
+}
+
+type segment struct {
+	left, right float64
+	area        float64
+	estimate    float64
+}
+
+func (s segment) mid() float64 {
+	return s.left + (s.right-s.left)/2
+}
+
+// segmentQueue is a max-heap of segments by error estimate.
+type segmentQueue []segment
+
+func (q segmentQueue) Len() int           { return len(q) }
+func (q segmentQueue) Less(i, j int) bool { return q[i].estimate > q[j].estimate }
+func (q segmentQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *segmentQueue) Push(x any)        { *q = append(*q, x.(segment)) }
+func (q *segmentQueue) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
+
+type IntegrationMethod string
+
+const (
+	UniformIntegration  IntegrationMethod = "uniform"
+	AdaptiveIntegration IntegrationMethod = "adaptive"
+)
+
+// cappedRelativeTolerance is the accuracy the adaptive fallback of
+// CalculateCapped aims for, relative to the magnitude of the area. A grid
+// above the cap is only requested for results this accurate.
+const cappedRelativeTolerance = 1e-10
+
+// CappedResult is the area found by CalculateCapped and how it was found.
+type CappedResult struct {
+	Area       float64
+	Partitions uint64
+	Method     IntegrationMethod
+}
+
+// CalculateCapped integrates on the uniform grid of numberOfPartitions when it
+// is within maxPartitions, and otherwise falls back to Adaptive limited to
+// maxPartitions, which usually reaches the accuracy of the larger grid with
+// far fewer evaluations. A zero maxPartitions disables the cap.
+func (u *NewtonCotesUseCase) CalculateCapped(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	numberOfPartitions uint64,
+	maxPartitions uint64,
+) (*CappedResult, error) {
+	if maxPartitions == 0 || numberOfPartitions <= maxPartitions {
+		area, err := u.Calculate(ctx, simpleExpr, leftInterval, rightInterval, numberOfPartitions)
+		if err != nil {
+			return nil, err
+		}
+
+		return &CappedResult{
+			Area:       area,
+			Partitions: numberOfPartitions,
+			Method:     UniformIntegration,
+		}, nil
+	}
+
+	slog.InfoContext(ctx, "Requested partitions exceed the cap, integrating adaptively",
+		slog.Uint64("numberOfPartitions", numberOfPartitions),
+		slog.Uint64("maxPartitions", maxPartitions),
+	)
+
+	scale, err := u.strategy.Integrate(ctx, simpleExpr, leftInterval, rightInterval)
+	if err != nil {
+		return nil, fmt.Errorf("error integrating partition [%f, %f]: %w", leftInterval, rightInterval, err)
+	}
+
+	result, err := u.Adaptive(ctx, simpleExpr, leftInterval, rightInterval,
+		cappedRelativeTolerance*max(1, math.Abs(scale)), maxPartitions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CappedResult{
+		Area:       result.Area,
+		Partitions: result.Partitions,
+		Method:     AdaptiveIntegration,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package newtoncotes
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateCapped(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		strategy           NewtonCotesStrategy
+		expr               func(float64) float64
+		left, right        float64
+		numberOfPartitions uint64
+		expected           float64
+		tolerance          float64
+		expectedMethod     IntegrationMethod
+	}{
+		{
+			name:               "Within the cap",
+			strategy:           &SimpsonsOneThirdRule{},
+			expr:               math.Exp,
+			left:               0,
+			right:              2,
+			numberOfPartitions: 100,
+			expected:           math.Exp(2) - 1,
+			tolerance:          1e-8,
+			expectedMethod:     UniformIntegration,
+		},
+		{
+			name:               "Smooth integrand above the cap",
+			strategy:           &SimpsonsOneThirdRule{},
+			expr:               math.Exp,
+			left:               0,
+			right:              2,
+			numberOfPartitions: 1_000_000_000,
+			expected:           math.Exp(2) - 1,
+			tolerance:          1e-9,
+			expectedMethod:     AdaptiveIntegration,
+		},
+		{
+			name:               "Singular derivative above the cap",
+			strategy:           &SimpsonsOneThirdRule{},
+			expr:               math.Sqrt,
+			left:               0,
+			right:              1,
+			numberOfPartitions: 1_000_000_000,
+			expected:           2.0 / 3,
+			tolerance:          1e-8,
+			expectedMethod:     AdaptiveIntegration,
+		},
+		{
+			name:               "Open formula above the cap",
+			strategy:           &MilneRule{},
+			expr:               func(x float64) float64 { return 1 / x },
+			left:               1,
+			right:              10,
+			numberOfPartitions: 1_000_000_000,
+			expected:           math.Log(10),
+			tolerance:          1e-9,
+			expectedMethod:     AdaptiveIntegration,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			const maxPartitions = 2000
+			useCase := NewNewtonCotesUseCase(test.strategy)
+
+			// Act
+			result, err := useCase.CalculateCapped(context.Background(), test.expr, test.left, test.right,
+				test.numberOfPartitions, maxPartitions)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedMethod, result.Method)
+			assert.LessOrEqual(t, result.Partitions, uint64(maxPartitions))
+			assert.InDelta(t, test.expected, result.Area, test.tolerance)
+		})
+	}
+}
+
+func TestAdaptiveBeatsTheUniformGridOnTheSameBudget(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewNewtonCotesUseCase(&TrapezoidalRule{})
+	expected := 2.0 / 3
+
+	// Act
+	adaptive, err := useCase.Adaptive(context.Background(), math.Sqrt, 0, 1, 1e-9, 500)
+	require.NoError(t, err)
+	uniform, err := useCase.Calculate(context.Background(), math.Sqrt, 0, 1, adaptive.Partitions)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Less(t, math.Abs(adaptive.Area-expected), math.Abs(uniform-expected))
+}
+
+func TestAdaptiveRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		left, right   float64
+		tolerance     float64
+		maxPartitions uint64
+		expected      error
+	}{
+		{
+			name:          "Zero width interval",
+			left:          1,
+			right:         1,
+			tolerance:     1e-6,
+			maxPartitions: 10,
+			expected:      ErrZeroWidthInterval,
+		},
+		{
+			name:          "Zero tolerance",
+			left:          0,
+			right:         1,
+			maxPartitions: 10,
+			expected:      ErrNonPositiveTolerance,
+		},
+		{
+			name:          "Budget below two partitions",
+			left:          0,
+			right:         1,
+			tolerance:     1e-6,
+			maxPartitions: 1,
+			expected:      ErrZeroPartitionBudget,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(&TrapezoidalRule{})
+
+			// Act
+			_, err := useCase.Adaptive(context.Background(), math.Exp, test.left, test.right, test.tolerance, test.maxPartitions)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package newtoncotes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+// NewNewtonCotesUseCaseWithArithmetic is NewNewtonCotesUseCase accumulating
+// the weighted sum of Calculate in arithmetic. precision.BigFloat needs a
+// WeightedStrategy and is much slower, as every term is summed in software.
+func NewNewtonCotesUseCaseWithArithmetic(strategy NewtonCotesStrategy, arithmetic precision.Arithmetic) *NewtonCotesUseCase {
+	return &NewtonCotesUseCase{
+		strategy:   strategy,
+		arithmetic: arithmetic,
+	}
+}
+
+// calculateExtended integrates like Calculate, summing Weight·f(x) over every
+// node of every partition in one precision.BigFloat accumulator and scaling
+// the sum by Factor·h once, so the partial areas are never rounded.
+func (u *NewtonCotesUseCase) calculateExtended(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	numberOfPartitions uint64,
+) (float64, error) {
+	strategy, ok := u.strategy.(WeightedStrategy)
+	if !ok {
+		slog.ErrorContext(ctx, "Strategy does not expose its weights", slog.String("strategy", u.strategy.Description()))
+		return 0, fmt.Errorf("%w: %s", ErrNoWeights, u.strategy.Description())
+	}
+
+	weights := strategy.Weights()
+	delta := (rightInterval - leftInterval) / float64(numberOfPartitions)
+	h := delta / float64(weights.Intervals)
+
+	sum := precision.NewAccumulator(precision.BigFloat)
+	for partition := range numberOfPartitions {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		left := leftInterval + float64(partition)*delta
+		for i, node := range weights.Nodes {
+			x := left + float64(node)*h
+			// The last closed node is the right end itself, not a drifted sum
+			if node == weights.Intervals {
+				x = left + delta
+			}
+
+			sum.AddProduct(weights.Coefficients[i], simpleExpr(x))
+		}
+	}
+
+	area := weights.Factor * h * sum.Float64()
+
+	slog.InfoContext(ctx, "Newton-Cotes integration completed in extended precision",
+		slog.Float64("totalArea", area),
+	)
+
+	return area, nil
+}
@@ -0,0 +1,104 @@
+package newtoncotes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+// cancellingIntegrand is 2e16 at 0, -2e16 at 2 and 0.75 in between, so the
+// large samples cancel in the weighted sum and leave only the small ones,
+// which float64 rounds away when adding them to a large sample first.
+func cancellingIntegrand(x float64) float64 {
+	switch x {
+	case 0:
+		return 2e16
+	case 2:
+		return -2e16
+	default:
+		return 0.75
+	}
+}
+
+func TestExtendedArithmeticKeepsCancellingSamples(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name       string
+		strategy   NewtonCotesStrategy
+		partitions uint64
+		expected   float64
+	}{
+		// h/2 (f(0) + 2 f(1) + f(2)) = 0.75
+		{name: "Trapezoidal", strategy: &TrapezoidalRule{}, partitions: 2, expected: 0.75},
+		// h/3 (f(0) + 4 f(1) + f(2)) = 1
+		{name: "Simpson", strategy: &SimpsonsOneThirdRule{}, partitions: 1, expected: 1},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			float64UseCase := NewNewtonCotesUseCaseWithArithmetic(test.strategy, precision.Float64)
+			extendedUseCase := NewNewtonCotesUseCaseWithArithmetic(test.strategy, precision.BigFloat)
+
+			// Act
+			rounded, err := float64UseCase.Calculate(t.Context(), cancellingIntegrand, 0, 2, test.partitions)
+			require.NoError(t, err)
+			extended, err := extendedUseCase.Calculate(t.Context(), cancellingIntegrand, 0, 2, test.partitions)
+			require.NoError(t, err)
+
+			// Assert
+			assert.InDelta(t, test.expected, extended, 1e-15)
+			assert.Greater(t, math.Abs(rounded-test.expected), 0.1)
+		})
+	}
+}
+
+func TestExtendedArithmeticMatchesFloat64OnSmoothIntegrands(t *testing.T) {
+	t.Parallel()
+
+	strategies := []NewtonCotesStrategy{
+		&TrapezoidalRule{},
+		&SimpsonsOneThirdRule{},
+		&SimpsonsThreeEighthsRule{},
+		&OpenTrapezoidalRule{},
+		&MilneRule{},
+		&ThirdDegreeOpenNewtonCotesStrategy{},
+	}
+
+	for _, strategy := range strategies {
+		t.Run(strategy.Description(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			float64UseCase := NewNewtonCotesUseCase(strategy)
+			extendedUseCase := NewNewtonCotesUseCaseWithArithmetic(strategy, precision.BigFloat)
+
+			// Act
+			rounded, err := float64UseCase.Calculate(t.Context(), math.Exp, 0, 1, 16)
+			require.NoError(t, err)
+			extended, err := extendedUseCase.Calculate(t.Context(), math.Exp, 0, 1, 16)
+			require.NoError(t, err)
+
+			// Assert
+			assert.InDelta(t, rounded, extended, 1e-13)
+		})
+	}
+}
+
+func TestExtendedArithmeticRequiresWeights(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	strategy := &struct{ NewtonCotesStrategy }{&TrapezoidalRule{}}
+	useCase := NewNewtonCotesUseCaseWithArithmetic(strategy, precision.BigFloat)
+
+	// Act
+	_, err := useCase.Calculate(t.Context(), math.Exp, 0, 1, 4)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNoWeights)
+}
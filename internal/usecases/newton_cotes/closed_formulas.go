@@ -36,6 +36,11 @@ func (t *TrapezoidalRule) Order() NewtonCotesOrder {
 	return FirstOrder
 }
 
+// ErrorOrder implements NewtonCotesStrategy.
+func (t *TrapezoidalRule) ErrorOrder() int {
+	return 2
+}
+
 // Type implements NewtonCotesStrategy.
 func (t *TrapezoidalRule) Type() FormulaType {
 	return ClosedFormulaType
@@ -70,6 +75,11 @@ func (s *SimpsonsOneThirdRule) Order() NewtonCotesOrder {
 	return SecondOrder
 }
 
+// ErrorOrder implements NewtonCotesStrategy.
+func (s *SimpsonsOneThirdRule) ErrorOrder() int {
+	return 4
+}
+
 // Type implements NewtonCotesStrategy.
 func (s *SimpsonsOneThirdRule) Type() FormulaType {
 	return ClosedFormulaType
@@ -104,6 +114,11 @@ func (s *SimpsonsThreeEighthsRule) Order() NewtonCotesOrder {
 	return ThirdOrder
 }
 
+// ErrorOrder implements NewtonCotesStrategy.
+func (s *SimpsonsThreeEighthsRule) ErrorOrder() int {
+	return 4
+}
+
 // Type implements NewtonCotesStrategy.
 func (s *SimpsonsThreeEighthsRule) Type() FormulaType {
 	return ClosedFormulaType
@@ -0,0 +1,61 @@
+package newtoncotes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrOrderUndetermined = numeerr.New(numeerr.CodeFailed, "convergence order cannot be estimated")
+
+// EstimateOrder integrates simpleExpr with numberOfPartitions, twice and four
+// times as many partitions, estimating the empirical convergence order p from
+// the successive differences: each doubling divides the error by 2ᵖ, so
+// p ≈ log₂((I(N) - I(2N)) / (I(2N) - I(4N))). A rule integrating the function
+// exactly, or rounding error swamping the differences, leaves p undetermined.
+func (u *NewtonCotesUseCase) EstimateOrder(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	numberOfPartitions uint64,
+) (float64, error) {
+	if numberOfPartitions == 0 {
+		slog.WarnContext(ctx, "Number of partitions is zero, using default value of 1")
+		numberOfPartitions = 1
+	}
+
+	var areas [3]float64
+	for i := range areas {
+		area, err := u.Calculate(ctx, simpleExpr, leftInterval, rightInterval, numberOfPartitions<<i)
+		if err != nil {
+			return 0, err
+		}
+		areas[i] = area
+	}
+
+	coarse, fine := areas[0]-areas[1], areas[1]-areas[2]
+	ratio := coarse / fine
+	if fine == 0 || !(ratio > 0) || math.IsInf(ratio, 0) {
+		slog.WarnContext(ctx, "Successive differences do not shrink geometrically",
+			slog.Float64("coarseDifference", coarse),
+			slog.Float64("fineDifference", fine),
+		)
+		return 0, fmt.Errorf("%w: successive differences %g and %g", ErrOrderUndetermined, coarse, fine)
+	}
+
+	order := math.Log2(ratio)
+
+	slog.InfoContext(ctx, "Estimated the empirical convergence order",
+		slog.String("strategy", u.strategy.Description()),
+		slog.Uint64("numberOfPartitions", numberOfPartitions),
+		slog.Float64("order", order),
+		slog.Int("theoreticalOrder", u.strategy.ErrorOrder()),
+	)
+
+	return order, nil
+}
@@ -0,0 +1,77 @@
+package newtoncotes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateOrderMatchesTheory(t *testing.T) {
+	t.Parallel()
+
+	strategies := []NewtonCotesStrategy{
+		&TrapezoidalRule{},
+		&SimpsonsOneThirdRule{},
+		&SimpsonsThreeEighthsRule{},
+		&OpenTrapezoidalRule{},
+		&MilneRule{},
+	}
+
+	for _, strategy := range strategies {
+		t.Run(strategy.Description(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(strategy)
+
+			// Act
+			order, err := useCase.EstimateOrder(t.Context(), math.Exp, 0, 1, 4)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, float64(strategy.ErrorOrder()), order, 0.1)
+		})
+	}
+}
+
+func TestEstimateOrderOfSimpsonAndTrapezoidal(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		strategy NewtonCotesStrategy
+		expected float64
+	}{
+		{name: "Simpson", strategy: &SimpsonsOneThirdRule{}, expected: 4},
+		{name: "Trapezoidal", strategy: &TrapezoidalRule{}, expected: 2},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(test.strategy)
+
+			// Act
+			order, err := useCase.EstimateOrder(t.Context(), math.Sin, 0, math.Pi/2, 8)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, test.expected, order, 0.05)
+		})
+	}
+}
+
+func TestEstimateOrderOfExactIntegration(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewNewtonCotesUseCase(&SimpsonsOneThirdRule{})
+	cubic := func(x float64) float64 { return x * x * x }
+
+	// Act
+	_, err := useCase.EstimateOrder(t.Context(), cubic, 0, 1, 4)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrOrderUndetermined)
+}
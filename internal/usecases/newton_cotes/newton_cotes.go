@@ -6,6 +6,8 @@ import (
 	"log/slog"
 
 	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+	"github.com/taldoflemis/nume/internal/precision"
 )
 
 type FormulaType string
@@ -33,10 +35,37 @@ type NewtonCotesStrategy interface {
 	Description() string     // Returns a description of the strategy (e.g., "Trapezoidal Rule")
 	Order() NewtonCotesOrder // Returns the polynomial order of the strategy
 	Type() FormulaType       // Returns the type of formula ("closed" or "open")
+	ErrorOrder() int         // Returns p in the O(hᵖ) error of the composite formula
+}
+
+var (
+	ErrUnknownStrategy   = numeerr.New(numeerr.CodeUnsupported, "unknown newton-cotes strategy")
+	ErrZeroWidthInterval = numeerr.New(numeerr.CodeInvalidInput, "interval width is zero")
+)
+
+// NewStrategy returns the Newton-Cotes formula of the given type and order.
+func NewStrategy(formulaType FormulaType, order NewtonCotesOrder) (NewtonCotesStrategy, error) {
+	switch {
+	case formulaType == ClosedFormulaType && order == FirstOrder:
+		return &TrapezoidalRule{}, nil
+	case formulaType == ClosedFormulaType && order == SecondOrder:
+		return &SimpsonsOneThirdRule{}, nil
+	case formulaType == ClosedFormulaType && order == ThirdOrder:
+		return &SimpsonsThreeEighthsRule{}, nil
+	case formulaType == OpenFormulaType && order == FirstOrder:
+		return &OpenTrapezoidalRule{}, nil
+	case formulaType == OpenFormulaType && order == SecondOrder:
+		return &MilneRule{}, nil
+	case formulaType == OpenFormulaType && order == ThirdOrder:
+		return &ThirdDegreeOpenNewtonCotesStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s formula of order %d", ErrUnknownStrategy, formulaType, order)
+	}
 }
 
 type NewtonCotesUseCase struct {
-	strategy NewtonCotesStrategy
+	strategy   NewtonCotesStrategy
+	arithmetic precision.Arithmetic
 }
 
 func NewNewtonCotesUseCase(strategy NewtonCotesStrategy) *NewtonCotesUseCase {
@@ -62,35 +91,129 @@ func (u *NewtonCotesUseCase) Calculate(
 		slog.String("type", string(u.strategy.Type())),
 	)
 
+	// Like the double integral, zero partitions integrate the whole interval
+	// at once instead of dividing it by zero
+	if numberOfPartitions == 0 {
+		slog.WarnContext(ctx, "Number of partitions is zero, using default value of 1")
+		numberOfPartitions = 1
+	}
+
+	if u.arithmetic == precision.BigFloat {
+		return u.calculateExtended(ctx, simpleExpr, leftInterval, rightInterval, numberOfPartitions)
+	}
+
 	acumulatedArea := 0.0
+	err := u.integratePartitions(ctx, simpleExpr, leftInterval, rightInterval, numberOfPartitions,
+		func(partition PartitionArea) {
+			acumulatedArea += partition.Area
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "Newton-Cotes integration completed",
+		slog.Float64("totalArea", acumulatedArea),
+	)
+
+	return acumulatedArea, nil
+}
+
+// PartitionArea is the area of one partition of a composite integration.
+type PartitionArea struct {
+	Left  float64
+	Right float64
+	Area  float64
+}
+
+// CalculatePartitions integrates like Calculate, returning the area of each
+// partition, from left to right, instead of their sum. Summed in order, they
+// give the area Calculate returns.
+func (u *NewtonCotesUseCase) CalculatePartitions(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	numberOfPartitions uint64,
+) ([]PartitionArea, error) {
+	if numberOfPartitions == 0 {
+		slog.WarnContext(ctx, "Number of partitions is zero, using default value of 1")
+		numberOfPartitions = 1
+	}
+
+	partitions := make([]PartitionArea, 0, numberOfPartitions)
+	err := u.integratePartitions(ctx, simpleExpr, leftInterval, rightInterval, numberOfPartitions,
+		func(partition PartitionArea) {
+			partitions = append(partitions, partition)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return partitions, nil
+}
+
+// integratePartitions splits the interval in numberOfPartitions equal
+// partitions, handing the area of each to visit.
+func (u *NewtonCotesUseCase) integratePartitions(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	numberOfPartitions uint64,
+	visit func(PartitionArea),
+) error {
 	delta := (rightInterval - leftInterval) / float64(numberOfPartitions)
 
 	slog.DebugContext(ctx, "Calculated delta for integration", slog.Float64("delta", delta))
 
-	for i := leftInterval; i <= rightInterval; i += delta {
+	// Partition bounds come from the index, accumulating delta drifts and
+	// integrated an extra partition past the right end
+	for partition := range numberOfPartitions {
+		left := leftInterval + float64(partition)*delta
+		right := left + delta
+
 		slog.DebugContext(ctx, "Calculating area for partition",
-			slog.Float64("left", i),
-			slog.Float64("right", i+delta),
-			slog.Uint64("partition", uint64(i/delta)),
-			slog.Float64("currentArea", acumulatedArea),
+			slog.Float64("left", left),
+			slog.Float64("right", right),
+			slog.Uint64("partition", partition),
 		)
 
-		partitionArea, err := u.strategy.Integrate(ctx, simpleExpr, i, i+delta)
+		partitionArea, err := u.strategy.Integrate(ctx, simpleExpr, left, right)
 		if err != nil {
 			slog.ErrorContext(ctx, "Error integrating partition", "err", err)
-			return 0, fmt.Errorf("error integrating partition [%f, %f]: %w", i, i+delta, err)
+			return fmt.Errorf("error integrating partition [%f, %f]: %w", left, right, err)
 		}
 
 		slog.DebugContext(ctx, "Calculated area for partition",
 			slog.Float64("partitionArea", partitionArea),
 		)
 
-		acumulatedArea += partitionArea
+		visit(PartitionArea{Left: left, Right: right, Area: partitionArea})
 	}
 
-	slog.InfoContext(ctx, "Newton-Cotes integration completed",
-		slog.Float64("totalArea", acumulatedArea),
-	)
+	return nil
+}
 
-	return acumulatedArea, nil
+// Average returns the mean value of simpleExpr over the interval, its integral
+// divided by the interval width.
+func (u *NewtonCotesUseCase) Average(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	numberOfPartitions uint64,
+) (float64, error) {
+	if leftInterval == rightInterval {
+		slog.ErrorContext(ctx, "Left and right intervals are equal")
+		return 0, ErrZeroWidthInterval
+	}
+
+	area, err := u.Calculate(ctx, simpleExpr, leftInterval, rightInterval, numberOfPartitions)
+	if err != nil {
+		return 0, err
+	}
+
+	return area / (rightInterval - leftInterval), nil
 }
@@ -1,11 +1,13 @@
 package newtoncotes
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/taldoflemis/nume/internal/expressions"
 )
 
@@ -93,3 +95,177 @@ func TestNewtonCotes(t *testing.T) {
 		}
 	}
 }
+
+func TestNewtonCotesAverage(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	strategies := []NewtonCotesStrategy{
+		&OpenTrapezoidalRule{},
+		&MilneRule{},
+		&ThirdDegreeOpenNewtonCotesStrategy{},
+		&TrapezoidalRule{},
+		&SimpsonsOneThirdRule{},
+		&SimpsonsThreeEighthsRule{},
+	}
+
+	testCases := []struct {
+		name            string
+		simpleExpr      expressions.SingleVariableExpr
+		leftInterval    float64
+		rightInterval   float64
+		expectedAverage float64
+	}{
+		{
+			name:            "constant",
+			simpleExpr:      func(float64) float64 { return 3 },
+			leftInterval:    -1,
+			rightInterval:   4,
+			expectedAverage: 3,
+		},
+		{
+			name:            "x",
+			simpleExpr:      func(x float64) float64 { return x },
+			leftInterval:    0,
+			rightInterval:   2,
+			expectedAverage: 1,
+		},
+	}
+
+	for _, strategy := range strategies {
+		for _, testCase := range testCases {
+			testName := fmt.Sprintf("%s - average of %s from %.2f to %.2f",
+				strategy.Description(), testCase.name, testCase.leftInterval, testCase.rightInterval)
+
+			t.Run(testName, func(t *testing.T) {
+				// Act
+				useCase := NewNewtonCotesUseCase(strategy)
+
+				average, err := useCase.Average(
+					t.Context(),
+					testCase.simpleExpr,
+					testCase.leftInterval,
+					testCase.rightInterval,
+					10,
+				)
+
+				// Assert
+				assert.NoError(t, err)
+				assert.InDelta(t, testCase.expectedAverage, average, 1e-12)
+			})
+		}
+	}
+}
+
+func TestNewtonCotesAverageRejectsZeroWidthInterval(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewNewtonCotesUseCase(&TrapezoidalRule{})
+
+	// Act
+	_, err := useCase.Average(t.Context(), math.Sin, 1, 1, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrZeroWidthInterval)
+}
+
+func TestErrorOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		strategy   NewtonCotesStrategy
+		errorOrder int
+	}{
+		{strategy: &TrapezoidalRule{}, errorOrder: 2},
+		{strategy: &SimpsonsOneThirdRule{}, errorOrder: 4},
+		{strategy: &SimpsonsThreeEighthsRule{}, errorOrder: 4},
+		{strategy: &OpenTrapezoidalRule{}, errorOrder: 2},
+		{strategy: &MilneRule{}, errorOrder: 4},
+		{strategy: &ThirdDegreeOpenNewtonCotesStrategy{}, errorOrder: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %d", tt.strategy.Type(), tt.strategy.Order()), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(tt.strategy)
+			exact := math.E - 1
+
+			// Act
+			coarse, err := useCase.Calculate(context.Background(), math.Exp, 0, 1, 8)
+			require.NoError(t, err)
+			fine, err := useCase.Calculate(context.Background(), math.Exp, 0, 1, 16)
+			require.NoError(t, err)
+
+			// Assert, halving h divides the error by 2ᵖ
+			assert.Equal(t, tt.errorOrder, tt.strategy.ErrorOrder())
+			observed := math.Log2(math.Abs(coarse-exact) / math.Abs(fine-exact))
+			assert.InDelta(t, float64(tt.errorOrder), observed, 0.1)
+		})
+	}
+}
+
+func TestNewtonCotesTreatsZeroPartitionsAsOne(t *testing.T) {
+	t.Parallel()
+
+	for _, formula := range []FormulaType{ClosedFormulaType, OpenFormulaType} {
+		for _, order := range []NewtonCotesOrder{FirstOrder, SecondOrder, ThirdOrder} {
+			strategy, err := NewStrategy(formula, order)
+			require.NoError(t, err)
+
+			t.Run(strategy.Description(), func(t *testing.T) {
+				// Arrange
+				t.Parallel()
+				useCase := NewNewtonCotesUseCase(strategy)
+				expected, err := useCase.Calculate(t.Context(), math.Exp, 0, 1, 1)
+				require.NoError(t, err)
+
+				// Act
+				result, err := useCase.Calculate(t.Context(), math.Exp, 0, 1, 0)
+
+				// Assert
+				require.NoError(t, err)
+				assert.False(t, math.IsNaN(result) || math.IsInf(result, 0))
+				assert.Equal(t, expected, result)
+			})
+		}
+	}
+}
+
+func TestCalculatePartitionsSumToTheArea(t *testing.T) {
+	t.Parallel()
+
+	for _, formula := range []FormulaType{ClosedFormulaType, OpenFormulaType} {
+		for _, order := range []NewtonCotesOrder{FirstOrder, SecondOrder, ThirdOrder} {
+			strategy, err := NewStrategy(formula, order)
+			require.NoError(t, err)
+
+			t.Run(strategy.Description(), func(t *testing.T) {
+				// Arrange
+				t.Parallel()
+				useCase := NewNewtonCotesUseCase(strategy)
+				const partitions = 7
+				expected, err := useCase.Calculate(t.Context(), math.Sin, 0, math.Pi, partitions)
+				require.NoError(t, err)
+
+				// Act
+				areas, err := useCase.CalculatePartitions(t.Context(), math.Sin, 0, math.Pi, partitions)
+
+				// Assert
+				require.NoError(t, err)
+				require.Len(t, areas, partitions)
+
+				sum := 0.0
+				for i, area := range areas {
+					sum += area.Area
+					if i > 0 {
+						assert.InDelta(t, areas[i-1].Right, area.Left, 1e-12)
+					}
+				}
+				assert.Equal(t, expected, sum)
+				assert.Equal(t, 0.0, areas[0].Left)
+				assert.InDelta(t, math.Pi, areas[partitions-1].Right, 1e-12)
+			})
+		}
+	}
+}
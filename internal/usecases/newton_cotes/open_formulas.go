@@ -40,6 +40,11 @@ func (o *OpenTrapezoidalRule) Order() NewtonCotesOrder {
 	return FirstOrder
 }
 
+// ErrorOrder implements NewtonCotesStrategy.
+func (o *OpenTrapezoidalRule) ErrorOrder() int {
+	return 2
+}
+
 // Type implements NewtonCotesStrategy.
 func (o *OpenTrapezoidalRule) Type() FormulaType {
 	return OpenFormulaType
@@ -70,6 +75,11 @@ func (m *MilneRule) Order() NewtonCotesOrder {
 	return SecondOrder
 }
 
+// ErrorOrder implements NewtonCotesStrategy.
+func (m *MilneRule) ErrorOrder() int {
+	return 4
+}
+
 // Type implements NewtonCotesStrategy.
 func (m *MilneRule) Type() FormulaType {
 	return OpenFormulaType
@@ -101,6 +111,11 @@ func (t *ThirdDegreeOpenNewtonCotesStrategy) Order() NewtonCotesOrder {
 	return ThirdOrder
 }
 
+// ErrorOrder implements NewtonCotesStrategy.
+func (t *ThirdDegreeOpenNewtonCotesStrategy) ErrorOrder() int {
+	return 4
+}
+
 // Type implements NewtonCotesStrategy.
 func (t *ThirdDegreeOpenNewtonCotesStrategy) Type() FormulaType {
 	return OpenFormulaType
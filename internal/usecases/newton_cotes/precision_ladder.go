@@ -0,0 +1,100 @@
+package newtoncotes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+// LadderRung is the area on one grid of the ladder and its distance from the
+// analytic value.
+type LadderRung struct {
+	Partitions    uint64
+	Area          float64
+	AbsoluteError float64
+}
+
+// PrecisionLadder is the sweep of PrecisionLadder, one rung per grid from the
+// coarsest to the finest. Reached is false when the partition budget ran out
+// before the tolerance was met.
+type PrecisionLadder struct {
+	Exact     float64
+	Tolerance float64
+	Rungs     []LadderRung
+	Reached   bool
+}
+
+// Markdown renders the ladder as a table, ready for the TUI renderer.
+func (l *PrecisionLadder) Markdown() string {
+	var out strings.Builder
+
+	out.WriteString("| Partitions | Area | Absolute error |\n")
+	out.WriteString("|---|---|---|\n")
+	for _, rung := range l.Rungs {
+		fmt.Fprintf(&out, "| %d | %.12g | %.3e |\n", rung.Partitions, rung.Area, rung.AbsoluteError)
+	}
+
+	return out.String()
+}
+
+// PrecisionLadder integrates simpleExpr on grids of 1, 2, 4, ... partitions,
+// measuring each area against the analytic value exact, until the absolute
+// error falls below tolerance or doubling again would exceed maxPartitions.
+func (u *NewtonCotesUseCase) PrecisionLadder(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	exact float64,
+	tolerance float64,
+	maxPartitions uint64,
+) (*PrecisionLadder, error) {
+	slog.DebugContext(ctx, "Starting the precision ladder",
+		slog.String("strategy", u.strategy.Description()),
+		slog.Float64("exact", exact),
+		slog.Float64("tolerance", tolerance),
+		slog.Uint64("maxPartitions", maxPartitions),
+	)
+
+	switch {
+	case !(tolerance > 0):
+		return nil, fmt.Errorf("%w: got %v", ErrNonPositiveTolerance, tolerance)
+	case maxPartitions == 0:
+		return nil, fmt.Errorf("%w: got %d", ErrZeroPartitionBudget, maxPartitions)
+	}
+
+	ladder := &PrecisionLadder{Exact: exact, Tolerance: tolerance}
+	for partitions := uint64(1); ; partitions *= 2 {
+		area, err := u.Calculate(ctx, simpleExpr, leftInterval, rightInterval, partitions)
+		if err != nil {
+			return nil, err
+		}
+
+		rung := LadderRung{Partitions: partitions, Area: area, AbsoluteError: math.Abs(area - exact)}
+		ladder.Rungs = append(ladder.Rungs, rung)
+
+		if rung.AbsoluteError <= tolerance {
+			ladder.Reached = true
+			break
+		}
+
+		// Comparing against half the budget keeps the doubling from overflowing
+		if partitions > maxPartitions/2 {
+			break
+		}
+	}
+
+	last := ladder.Rungs[len(ladder.Rungs)-1]
+	slog.InfoContext(ctx, "Finished the precision ladder",
+		slog.Int("rungs", len(ladder.Rungs)),
+		slog.Uint64("partitions", last.Partitions),
+		slog.Float64("absoluteError", last.AbsoluteError),
+		slog.Bool("reached", ladder.Reached),
+	)
+
+	return ladder, nil
+}
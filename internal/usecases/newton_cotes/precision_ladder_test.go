@@ -0,0 +1,87 @@
+package newtoncotes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecisionLadderConvergesMonotonically(t *testing.T) {
+	t.Parallel()
+
+	strategies := []NewtonCotesStrategy{
+		&TrapezoidalRule{},
+		&SimpsonsOneThirdRule{},
+		&OpenTrapezoidalRule{},
+		&MilneRule{},
+	}
+
+	for _, strategy := range strategies {
+		t.Run(strategy.Description(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(strategy)
+			exact := math.E - 1
+
+			// Act
+			ladder, err := useCase.PrecisionLadder(t.Context(), math.Exp, 0, 1, exact, 1e-8, 1<<16)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, ladder.Reached)
+			require.NotEmpty(t, ladder.Rungs)
+			for i := 1; i < len(ladder.Rungs); i++ {
+				assert.Equal(t, 2*ladder.Rungs[i-1].Partitions, ladder.Rungs[i].Partitions)
+				assert.Less(t, ladder.Rungs[i].AbsoluteError, ladder.Rungs[i-1].AbsoluteError)
+			}
+			assert.LessOrEqual(t, ladder.Rungs[len(ladder.Rungs)-1].AbsoluteError, 1e-8)
+		})
+	}
+}
+
+func TestPrecisionLadderStopsAtTheBudget(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewNewtonCotesUseCase(&TrapezoidalRule{})
+
+	// Act
+	ladder, err := useCase.PrecisionLadder(t.Context(), math.Exp, 0, 1, math.E-1, 1e-14, 100)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, ladder.Reached)
+	require.Len(t, ladder.Rungs, 7)
+	assert.Equal(t, uint64(64), ladder.Rungs[6].Partitions)
+	assert.Contains(t, ladder.Markdown(), "| 64 |")
+}
+
+func TestPrecisionLadderRejectsInvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		tolerance     float64
+		maxPartitions uint64
+		expectedErr   error
+	}{
+		{name: "ZeroTolerance", tolerance: 0, maxPartitions: 16, expectedErr: ErrNonPositiveTolerance},
+		{name: "NaNTolerance", tolerance: math.NaN(), maxPartitions: 16, expectedErr: ErrNonPositiveTolerance},
+		{name: "ZeroBudget", tolerance: 1e-6, maxPartitions: 0, expectedErr: ErrZeroPartitionBudget},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(&TrapezoidalRule{})
+
+			// Act
+			_, err := useCase.PrecisionLadder(t.Context(), math.Exp, 0, 1, math.E-1, test.tolerance, test.maxPartitions)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
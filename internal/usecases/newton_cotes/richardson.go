@@ -0,0 +1,115 @@
+package newtoncotes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrNonFiniteArea = numeerr.New(numeerr.CodeDomain, "integrand is not finite on the interval")
+
+// trapezoidalRichardsonFactor is 2^p - 1 for the O(h²) composite trapezoidal
+// rule: halving h divides its error by 4, so T(h/2) - T(h) is three times the
+// error left in T(h/2).
+const trapezoidalRichardsonFactor = 3
+
+// minRichardsonPartitions keeps coarse grids, whose trapezoids can agree by
+// coincidence, from being accepted as converged
+const minRichardsonPartitions = 8
+
+// RichardsonResult is the area found by RichardsonTrapezoidal, the partitions
+// of its last grid and the Richardson estimate of its absolute error.
+// Converged is false when the partition budget ran out first.
+type RichardsonResult struct {
+	Area          float64
+	Partitions    uint64
+	ErrorEstimate float64
+	Converged     bool
+}
+
+// RichardsonTrapezoidal integrates simpleExpr with the composite trapezoidal
+// rule on grids of 1, 2, 4, ... partitions, until the Richardson estimate
+// |T(n) - T(n/2)| / 3 falls below tolerance or doubling again would exceed
+// maxPartitions. Each grid reuses the evaluations of the previous one, so
+// only the new midpoints are evaluated.
+func RichardsonTrapezoidal(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+	tolerance float64,
+	maxPartitions uint64,
+) (*RichardsonResult, error) {
+	slog.DebugContext(ctx, "Starting trapezoidal integration with Richardson error estimates",
+		slog.Float64("leftInterval", leftInterval),
+		slog.Float64("rightInterval", rightInterval),
+		slog.Float64("tolerance", tolerance),
+		slog.Uint64("maxPartitions", maxPartitions),
+	)
+
+	switch {
+	case leftInterval == rightInterval:
+		return nil, ErrZeroWidthInterval
+	case !(tolerance > 0):
+		return nil, fmt.Errorf("%w: got %v", ErrNonPositiveTolerance, tolerance)
+	case maxPartitions < 2:
+		return nil, fmt.Errorf("%w: got %d", ErrZeroPartitionBudget, maxPartitions)
+	}
+
+	width := rightInterval - leftInterval
+	area := width / 2 * (simpleExpr(leftInterval) + simpleExpr(rightInterval))
+	result := &RichardsonResult{Area: area, Partitions: 1, ErrorEstimate: math.Inf(1)}
+
+	for result.Partitions*2 <= maxPartitions {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// T(2n) = T(n) / 2 + h Σ f(midpoints), h the new partition width
+		partitions := result.Partitions * 2
+		step := width / float64(partitions)
+		midpoints := 0.0
+		for i := uint64(1); i < partitions; i += 2 {
+			midpoints += simpleExpr(leftInterval + float64(i)*step)
+		}
+		refined := result.Area/2 + step*midpoints
+
+		result.ErrorEstimate = math.Abs(refined-result.Area) / trapezoidalRichardsonFactor
+		result.Area = refined
+		result.Partitions = partitions
+
+		if math.IsNaN(result.Area) || math.IsInf(result.Area, 0) {
+			return nil, fmt.Errorf("%w: area is %v with %d partitions", ErrNonFiniteArea, result.Area, partitions)
+		}
+
+		slog.DebugContext(ctx, "Refined the trapezoidal grid",
+			slog.Uint64("partitions", partitions),
+			slog.Float64("area", result.Area),
+			slog.Float64("errorEstimate", result.ErrorEstimate),
+		)
+
+		if result.ErrorEstimate <= tolerance && partitions >= minRichardsonPartitions {
+			result.Converged = true
+			break
+		}
+	}
+
+	if !result.Converged {
+		slog.WarnContext(ctx, "Trapezoidal integration ran out of partitions before reaching the tolerance",
+			slog.Float64("errorEstimate", result.ErrorEstimate),
+			slog.Uint64("partitions", result.Partitions),
+		)
+	}
+
+	slog.InfoContext(ctx, "Trapezoidal integration with Richardson error estimates completed",
+		slog.Float64("totalArea", result.Area),
+		slog.Float64("errorEstimate", result.ErrorEstimate),
+		slog.Uint64("partitions", result.Partitions),
+	)
+
+	return result, nil
+}
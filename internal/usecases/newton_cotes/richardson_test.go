@@ -0,0 +1,167 @@
+package newtoncotes
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRichardsonTrapezoidalReachesTolerance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		expr        func(float64) float64
+		left, right float64
+		expected    float64
+		tolerance   float64
+	}{
+		{
+			name:      "Exponential",
+			expr:      math.Exp,
+			left:      0,
+			right:     2,
+			expected:  math.Exp(2) - 1,
+			tolerance: 1e-8,
+		},
+		{
+			name:      "Sine over a half period",
+			expr:      math.Sin,
+			left:      0,
+			right:     math.Pi,
+			expected:  2,
+			tolerance: 1e-10,
+		},
+		{
+			name:      "Cubic",
+			expr:      func(x float64) float64 { return x*x*x - 2*x + 1 },
+			left:      -1,
+			right:     3,
+			expected:  16,
+			tolerance: 1e-6,
+		},
+		{
+			name:      "Reversed interval",
+			expr:      math.Cosh,
+			left:      1,
+			right:     -1,
+			expected:  -2 * math.Sinh(1),
+			tolerance: 1e-9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			result, err := RichardsonTrapezoidal(context.Background(), tt.expr, tt.left, tt.right, tt.tolerance, 1<<24)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, result.Converged)
+			assert.LessOrEqual(t, result.ErrorEstimate, tt.tolerance)
+			// The estimate is asymptotically exact, allow it to be off by a
+			// small factor
+			assert.InDelta(t, tt.expected, result.Area, 10*tt.tolerance)
+			assert.Zero(t, result.Partitions&(result.Partitions-1), "partitions should be a power of two")
+		})
+	}
+}
+
+func TestRichardsonTrapezoidalStopsAtTheBudget(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	result, err := RichardsonTrapezoidal(context.Background(), math.Exp, 0, 2, 1e-14, 64)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.Converged)
+	assert.Equal(t, uint64(64), result.Partitions)
+	assert.Greater(t, result.ErrorEstimate, 1e-14)
+	assert.InDelta(t, math.Exp(2)-1, result.Area, 1e-3)
+}
+
+func TestRichardsonTrapezoidalErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expr          func(float64) float64
+		left, right   float64
+		tolerance     float64
+		maxPartitions uint64
+		expectedErr   error
+	}{
+		{
+			name:          "Zero width",
+			expr:          math.Exp,
+			left:          1,
+			right:         1,
+			tolerance:     1e-6,
+			maxPartitions: 64,
+			expectedErr:   ErrZeroWidthInterval,
+		},
+		{
+			name:          "Non-positive tolerance",
+			expr:          math.Exp,
+			left:          0,
+			right:         1,
+			maxPartitions: 64,
+			expectedErr:   ErrNonPositiveTolerance,
+		},
+		{
+			name:          "No budget",
+			expr:          math.Exp,
+			left:          0,
+			right:         1,
+			tolerance:     1e-6,
+			maxPartitions: 1,
+			expectedErr:   ErrZeroPartitionBudget,
+		},
+		{
+			name:          "Singular integrand",
+			expr:          func(x float64) float64 { return 1 / (x - 0.5) },
+			left:          0,
+			right:         1,
+			tolerance:     1e-6,
+			maxPartitions: 64,
+			expectedErr:   ErrNonFiniteArea,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, err := RichardsonTrapezoidal(context.Background(), tt.expr, tt.left, tt.right, tt.tolerance, tt.maxPartitions)
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestRichardsonTrapezoidalIgnoresCoincidentalAgreement(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	// Vanishes at 0, 1/2 and 1, so the one and two partition trapezoids are
+	// both zero while the integral is -1/120
+	expr := func(x float64) float64 { return x * (x - 1) * (x - 2) * (x - 0.5) }
+
+	// Act
+	result, err := RichardsonTrapezoidal(context.Background(), expr, 0, 1, 1e-8, 1<<24)
+
+	// Assert
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, result.Partitions, uint64(minRichardsonPartitions))
+	assert.InDelta(t, -1.0/120, result.Area, 1e-7)
+}
@@ -0,0 +1,163 @@
+package newtoncotes
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inverseSqrtDistance is 1/√|x - c|, integrable but unbounded at c.
+func inverseSqrtDistance(c float64) func(float64) float64 {
+	return func(x float64) float64 {
+		return 1 / math.Sqrt(math.Abs(x-c))
+	}
+}
+
+func TestIntegrateAroundSingularity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		strategy    NewtonCotesStrategy
+		expr        func(float64) float64
+		left, right float64
+		singularity float64
+		expected    float64
+	}{
+		{
+			name:        "Centered singularity",
+			strategy:    &MilneRule{},
+			expr:        inverseSqrtDistance(0.5),
+			left:        0,
+			right:       1,
+			singularity: 0.5,
+			expected:    2 * math.Sqrt2,
+		},
+		{
+			name:        "Off center singularity",
+			strategy:    &OpenTrapezoidalRule{},
+			expr:        inverseSqrtDistance(0),
+			left:        -1,
+			right:       2,
+			singularity: 0,
+			expected:    2 + 2*math.Sqrt2,
+		},
+		{
+			name:        "Singularity at the left end",
+			strategy:    &ThirdDegreeOpenNewtonCotesStrategy{},
+			expr:        inverseSqrtDistance(1),
+			left:        1,
+			right:       5,
+			singularity: 1,
+			expected:    4,
+		},
+		{
+			name:        "Reversed interval",
+			strategy:    &MilneRule{},
+			expr:        inverseSqrtDistance(0.5),
+			left:        1,
+			right:       0,
+			singularity: 0.5,
+			expected:    -2 * math.Sqrt2,
+		},
+		{
+			name:        "Removable singularity",
+			strategy:    &MilneRule{},
+			expr:        func(x float64) float64 { return math.Sin(x) / x },
+			left:        -1,
+			right:       1,
+			singularity: 0,
+			expected:    2 * 0.9460830703671830,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(test.strategy)
+
+			// Act
+			area, err := useCase.IntegrateAroundSingularity(context.Background(), test.expr,
+				test.left, test.right, test.singularity)
+
+			// Assert
+			require.NoError(t, err)
+			// A singularity away from zero can only be approached to a few
+			// ulps, leaving O(√ulp) of area to the open formula
+			assert.InDelta(t, test.expected, area, 1e-7)
+		})
+	}
+}
+
+func TestIntegrateAroundSingularityBeatsTheUniformGrid(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewNewtonCotesUseCase(&MilneRule{})
+	expr := inverseSqrtDistance(0.5)
+	expected := 2 * math.Sqrt2
+
+	// Act
+	split, err := useCase.IntegrateAroundSingularity(context.Background(), expr, 0, 1, 0.5)
+	require.NoError(t, err)
+	uniform, err := useCase.Calculate(context.Background(), expr, 0, 1, 1001)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Less(t, math.Abs(split-expected), math.Abs(uniform-expected))
+}
+
+func TestIntegrateAroundSingularityRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		strategy    NewtonCotesStrategy
+		left, right float64
+		singularity float64
+		expected    error
+	}{
+		{
+			name:        "Zero width interval",
+			strategy:    &MilneRule{},
+			left:        1,
+			right:       1,
+			singularity: 1,
+			expected:    ErrZeroWidthInterval,
+		},
+		{
+			name:        "Closed formula",
+			strategy:    &SimpsonsOneThirdRule{},
+			left:        0,
+			right:       1,
+			singularity: 0.5,
+			expected:    ErrClosedFormulaAtSingularity,
+		},
+		{
+			name:        "Singularity outside the interval",
+			strategy:    &MilneRule{},
+			left:        0,
+			right:       1,
+			singularity: 2,
+			expected:    ErrSingularityOutsideInterval,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(test.strategy)
+
+			// Act
+			_, err := useCase.IntegrateAroundSingularity(context.Background(), inverseSqrtDistance(test.singularity),
+				test.left, test.right, test.singularity)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
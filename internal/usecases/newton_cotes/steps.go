@@ -0,0 +1,126 @@
+package newtoncotes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrNoWeights = numeerr.New(numeerr.CodeUnsupported, "strategy does not expose its weights")
+
+// RuleWeights describes a single-panel rule as a weighted sum: the panel is
+// split in Intervals steps of width h, and the rule evaluates
+// Factor·h·Σ Coefficients[i]·f(left + Nodes[i]·h).
+type RuleWeights struct {
+	Intervals    int
+	Factor       float64
+	Nodes        []int
+	Coefficients []float64
+}
+
+// WeightedStrategy is a NewtonCotesStrategy that can show how its weighted
+// sum is assembled.
+type WeightedStrategy interface {
+	NewtonCotesStrategy
+	Weights() RuleWeights
+}
+
+// WeightedSample is one term of the weighted sum, Weight·f(X).
+type WeightedSample struct {
+	X      float64
+	Value  float64
+	Weight float64
+}
+
+// PanelSteps is the weighted sum a single-panel rule evaluates over
+// [Left, Right], Factor·H·Σ Weight·Value, along with its result.
+type PanelSteps struct {
+	Left    float64
+	Right   float64
+	H       float64
+	Factor  float64
+	Samples []WeightedSample
+	Area    float64
+}
+
+// Steps evaluates the strategy over a single panel, returning the sample
+// points, function values and weights that make up the area.
+func (u *NewtonCotesUseCase) Steps(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	leftInterval float64,
+	rightInterval float64,
+) (*PanelSteps, error) {
+	strategy, ok := u.strategy.(WeightedStrategy)
+	if !ok {
+		slog.ErrorContext(ctx, "Strategy does not expose its weights", slog.String("strategy", u.strategy.Description()))
+		return nil, fmt.Errorf("%w: %s", ErrNoWeights, u.strategy.Description())
+	}
+
+	weights := strategy.Weights()
+	h := (rightInterval - leftInterval) / float64(weights.Intervals)
+
+	steps := &PanelSteps{
+		Left:    leftInterval,
+		Right:   rightInterval,
+		H:       h,
+		Factor:  weights.Factor,
+		Samples: make([]WeightedSample, 0, len(weights.Nodes)),
+	}
+
+	sum := 0.0
+	for i, node := range weights.Nodes {
+		x := leftInterval + float64(node)*h
+		// The last closed node is the right end itself, not a drifted sum
+		if node == weights.Intervals {
+			x = rightInterval
+		}
+
+		sample := WeightedSample{X: x, Value: simpleExpr(x), Weight: weights.Coefficients[i]}
+		steps.Samples = append(steps.Samples, sample)
+		sum += sample.Weight * sample.Value
+	}
+
+	steps.Area = weights.Factor * h * sum
+
+	slog.DebugContext(ctx, "Assembled single-panel weighted sum",
+		slog.String("strategy", u.strategy.Description()),
+		slog.Float64("h", h),
+		slog.Float64("area", steps.Area),
+	)
+
+	return steps, nil
+}
+
+// Weights implements WeightedStrategy.
+func (t *TrapezoidalRule) Weights() RuleWeights {
+	return RuleWeights{Intervals: 1, Factor: 1.0 / 2.0, Nodes: []int{0, 1}, Coefficients: []float64{1, 1}}
+}
+
+// Weights implements WeightedStrategy.
+func (s *SimpsonsOneThirdRule) Weights() RuleWeights {
+	return RuleWeights{Intervals: 2, Factor: 1.0 / 3.0, Nodes: []int{0, 1, 2}, Coefficients: []float64{1, 4, 1}}
+}
+
+// Weights implements WeightedStrategy.
+func (s *SimpsonsThreeEighthsRule) Weights() RuleWeights {
+	return RuleWeights{Intervals: 3, Factor: 3.0 / 8.0, Nodes: []int{0, 1, 2, 3}, Coefficients: []float64{1, 3, 3, 1}}
+}
+
+// Weights implements WeightedStrategy.
+func (o *OpenTrapezoidalRule) Weights() RuleWeights {
+	return RuleWeights{Intervals: 3, Factor: 3.0 / 2.0, Nodes: []int{1, 2}, Coefficients: []float64{1, 1}}
+}
+
+// Weights implements WeightedStrategy.
+func (m *MilneRule) Weights() RuleWeights {
+	return RuleWeights{Intervals: 4, Factor: 4.0 / 3.0, Nodes: []int{1, 2, 3}, Coefficients: []float64{2, -1, 2}}
+}
+
+// Weights implements WeightedStrategy.
+func (t *ThirdDegreeOpenNewtonCotesStrategy) Weights() RuleWeights {
+	return RuleWeights{Intervals: 5, Factor: 5.0 / 24.0, Nodes: []int{1, 2, 3, 4}, Coefficients: []float64{11, 1, 1, 11}}
+}
@@ -0,0 +1,103 @@
+package newtoncotes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepsWeightsMatchTheRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		strategy        NewtonCotesStrategy
+		expectedFactor  float64
+		expectedX       []float64
+		expectedWeights []float64
+	}{
+		{
+			name:            "Trapezoidal",
+			strategy:        &TrapezoidalRule{},
+			expectedFactor:  1.0 / 2.0,
+			expectedX:       []float64{0, 6},
+			expectedWeights: []float64{1, 1},
+		},
+		{
+			name:            "Simpson's one-third",
+			strategy:        &SimpsonsOneThirdRule{},
+			expectedFactor:  1.0 / 3.0,
+			expectedX:       []float64{0, 3, 6},
+			expectedWeights: []float64{1, 4, 1},
+		},
+		{
+			name:            "Simpson's three-eighths",
+			strategy:        &SimpsonsThreeEighthsRule{},
+			expectedFactor:  3.0 / 8.0,
+			expectedX:       []float64{0, 2, 4, 6},
+			expectedWeights: []float64{1, 3, 3, 1},
+		},
+		{
+			name:            "Open trapezoidal",
+			strategy:        &OpenTrapezoidalRule{},
+			expectedFactor:  3.0 / 2.0,
+			expectedX:       []float64{2, 4},
+			expectedWeights: []float64{1, 1},
+		},
+		{
+			name:            "Milne",
+			strategy:        &MilneRule{},
+			expectedFactor:  4.0 / 3.0,
+			expectedX:       []float64{1.5, 3, 4.5},
+			expectedWeights: []float64{2, -1, 2},
+		},
+		{
+			name:            "Third degree open",
+			strategy:        &ThirdDegreeOpenNewtonCotesStrategy{},
+			expectedFactor:  5.0 / 24.0,
+			expectedX:       []float64{1.2, 2.4, 3.6, 4.8},
+			expectedWeights: []float64{11, 1, 1, 11},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewNewtonCotesUseCase(testCase.strategy)
+
+			// Act
+			steps, err := useCase.Steps(t.Context(), math.Exp, 0, 6)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, testCase.expectedFactor, steps.Factor, 1e-15)
+			require.Len(t, steps.Samples, len(testCase.expectedWeights))
+			for i, sample := range steps.Samples {
+				assert.InDelta(t, testCase.expectedX[i], sample.X, 1e-12)
+				assert.InDelta(t, math.Exp(sample.X), sample.Value, 1e-12)
+				assert.Equal(t, testCase.expectedWeights[i], sample.Weight)
+			}
+
+			area, err := testCase.strategy.Integrate(t.Context(), math.Exp, 0, 6)
+			require.NoError(t, err)
+			assert.InDelta(t, area, steps.Area, 1e-9)
+		})
+	}
+}
+
+func TestStepsRequiresWeights(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	// Embedding the interface hides every method but NewtonCotesStrategy's
+	strategy := &struct{ NewtonCotesStrategy }{&TrapezoidalRule{}}
+	useCase := NewNewtonCotesUseCase(strategy)
+
+	// Act
+	_, err := useCase.Steps(t.Context(), math.Exp, 0, 1)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNoWeights)
+}
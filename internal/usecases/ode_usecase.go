@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrNonPositiveStepSize    = numeerr.New(numeerr.CodeInvalidInput, "step size must be positive")
+	ErrInvalidTimeInterval    = numeerr.New(numeerr.CodeInvalidInput, "end time must be after the start time")
+	ErrEmptyInitialState      = numeerr.New(numeerr.CodeInvalidInput, "initial state is empty")
+	ErrStateDimensionMismatch = numeerr.New(numeerr.CodeInvalidInput, "derivative has a different dimension than the state")
+)
+
+// ODESystem is the right-hand side of y' = f(t, y) for a system of first
+// order equations. Higher order equations are rewritten as systems, an equation
+// d²y/dt² = g(t, y) becomes (y, v)' = (v, g).
+type ODESystem func(t float64, y []float64) []float64
+
+// ODESample is the state Y of the system at time T.
+type ODESample struct {
+	T float64
+	Y []float64
+}
+
+type ODEUseCase struct {
+	linearSystem *LinearSystemUseCase
+}
+
+func NewODEUseCase() *ODEUseCase {
+	return &ODEUseCase{
+		linearSystem: NewLinearSystemUseCase(),
+	}
+}
+
+// RK4System integrates f from t0 to tEnd with the classic fourth order
+// Runge-Kutta method, returning the trajectory including both ends. The last
+// step is shortened when stepSize doesn't divide the interval.
+func (u *ODEUseCase) RK4System(
+	ctx context.Context,
+	f ODESystem,
+	t0 float64,
+	y0 []float64,
+	tEnd float64,
+	stepSize float64,
+) ([]ODESample, error) {
+	slog.DebugContext(ctx, "Starting the RK4 system solver",
+		slog.Float64("t0", t0),
+		vectorAttr("y0", y0),
+		slog.Float64("tEnd", tEnd),
+		slog.Float64("stepSize", stepSize),
+	)
+
+	if err := validateODESystem(t0, y0, tEnd, stepSize); err != nil {
+		slog.ErrorContext(ctx, "Invalid ODE system", slog.Any("error", err))
+		return nil, err
+	}
+
+	// The tolerance keeps rounding in the division from adding a tiny step
+	steps := int(math.Ceil((tEnd-t0)/stepSize - 1e-9))
+
+	trajectory := make([]ODESample, 0, steps+1)
+	trajectory = append(trajectory, ODESample{T: t0, Y: append([]float64(nil), y0...)})
+
+	y := trajectory[0].Y
+	for i := range steps {
+		t := t0 + float64(i)*stepSize
+		h := min(stepSize, tEnd-t)
+
+		next, err := rk4Step(f, t, y, h)
+		if err != nil {
+			slog.ErrorContext(ctx, "RK4 step failed", slog.Float64("t", t), slog.Any("error", err))
+			return nil, err
+		}
+
+		y = next
+		trajectory = append(trajectory, ODESample{T: t + h, Y: y})
+	}
+
+	slog.InfoContext(ctx, "Finished the RK4 system solver",
+		slog.Int("steps", steps),
+		vectorAttr("y", y),
+	)
+
+	return trajectory, nil
+}
+
+// rk4Step advances y by one step of size h.
+func rk4Step(f ODESystem, t float64, y []float64, h float64) ([]float64, error) {
+	n := len(y)
+
+	stage := func(t float64, base []float64, slope []float64, scale float64) ([]float64, error) {
+		point := make([]float64, n)
+		for i := range point {
+			point[i] = base[i]
+			if slope != nil {
+				point[i] += scale * slope[i]
+			}
+		}
+
+		k := f(t, point)
+		if len(k) != n {
+			return nil, fmt.Errorf("%w: expected %d, got %d", ErrStateDimensionMismatch, n, len(k))
+		}
+
+		return k, nil
+	}
+
+	k1, err := stage(t, y, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	k2, err := stage(t+h/2, y, k1, h/2)
+	if err != nil {
+		return nil, err
+	}
+	k3, err := stage(t+h/2, y, k2, h/2)
+	if err != nil {
+		return nil, err
+	}
+	k4, err := stage(t+h, y, k3, h)
+	if err != nil {
+		return nil, err
+	}
+
+	next := make([]float64, n)
+	for i := range next {
+		next[i] = y[i] + h/6*(k1[i]+2*k2[i]+2*k3[i]+k4[i])
+	}
+
+	return next, nil
+}
+
+func validateODESystem(t0 float64, y0 []float64, tEnd, stepSize float64) error {
+	if len(y0) == 0 {
+		return ErrEmptyInitialState
+	}
+
+	if stepSize <= 0 {
+		return fmt.Errorf("%w: got %v", ErrNonPositiveStepSize, stepSize)
+	}
+
+	if tEnd <= t0 {
+		return fmt.Errorf("%w: [%v, %v]", ErrInvalidTimeInterval, t0, tEnd)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// harmonicOscillator writes y = -(d²y/dt²) as (y, v)' = (v, -y), with
+// y(0) = 0 and v(0) = 1 it is solved by (sin t, cos t).
+func harmonicOscillator(_ float64, y []float64) []float64 {
+	return []float64{y[1], -y[0]}
+}
+
+func TestRK4SystemHarmonicOscillator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tEnd     float64
+		stepSize float64
+		steps    int
+	}{
+		{
+			name:     "Full period",
+			tEnd:     2 * math.Pi,
+			stepSize: 0.01,
+			steps:    629,
+		},
+		{
+			name:     "Step divides the interval",
+			tEnd:     1,
+			stepSize: 0.1,
+			steps:    10,
+		},
+		{
+			name:     "Shortened last step",
+			tEnd:     1.05,
+			stepSize: 0.1,
+			steps:    11,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewODEUseCase()
+
+			// Act
+			trajectory, err := useCase.RK4System(context.Background(), harmonicOscillator, 0, []float64{0, 1}, test.tEnd, test.stepSize)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, trajectory, test.steps+1)
+			assert.InDelta(t, test.tEnd, trajectory[len(trajectory)-1].T, 1e-12)
+			for _, sample := range trajectory {
+				assert.InDelta(t, math.Sin(sample.T), sample.Y[0], 1e-6, "at t = %v", sample.T)
+				assert.InDelta(t, math.Cos(sample.T), sample.Y[1], 1e-6, "at t = %v", sample.T)
+			}
+		})
+	}
+}
+
+func TestRK4SystemIsFourthOrder(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewODEUseCase()
+	finalError := func(stepSize float64) float64 {
+		trajectory, err := useCase.RK4System(context.Background(), harmonicOscillator, 0, []float64{0, 1}, 2, stepSize)
+		require.NoError(t, err)
+		last := trajectory[len(trajectory)-1].Y
+		return math.Hypot(last[0]-math.Sin(2), last[1]-math.Cos(2))
+	}
+
+	// Act
+	coarse, fine := finalError(0.1), finalError(0.05)
+
+	// Assert
+	assert.InDelta(t, 4, math.Log2(coarse/fine), 0.2)
+}
+
+func TestRK4SystemRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		f        ODESystem
+		y0       []float64
+		tEnd     float64
+		stepSize float64
+		expected error
+	}{
+		{
+			name:     "Empty state",
+			f:        harmonicOscillator,
+			tEnd:     1,
+			stepSize: 0.1,
+			expected: ErrEmptyInitialState,
+		},
+		{
+			name:     "Zero step",
+			f:        harmonicOscillator,
+			y0:       []float64{0, 1},
+			tEnd:     1,
+			expected: ErrNonPositiveStepSize,
+		},
+		{
+			name:     "End before start",
+			f:        harmonicOscillator,
+			y0:       []float64{0, 1},
+			tEnd:     -1,
+			stepSize: 0.1,
+			expected: ErrInvalidTimeInterval,
+		},
+		{
+			name:     "Derivative of the wrong size",
+			f:        func(float64, []float64) []float64 { return []float64{1} },
+			y0:       []float64{0, 1},
+			tEnd:     1,
+			stepSize: 0.1,
+			expected: ErrStateDimensionMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewODEUseCase()
+
+			// Act
+			_, err := useCase.RK4System(context.Background(), test.f, 0, test.y0, test.tEnd, test.stepSize)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
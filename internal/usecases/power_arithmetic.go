@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+// WithArithmetic makes the iterations accumulate the products A·v and the
+// Rayleigh quotients in arithmetic. precision.BigFloat makes each iteration
+// one to two orders of magnitude slower, as every product is summed in
+// software.
+func WithArithmetic(arithmetic precision.Arithmetic) PowerOption {
+	return func(u *PowerUseCase) {
+		u.arithmetic = arithmetic
+	}
+}
+
+// Arithmetic returns the arithmetic the iterations accumulate in.
+func (u *PowerUseCase) Arithmetic() precision.Arithmetic {
+	return u.arithmetic
+}
+
+// mulVec stores matrix·vector in dst, each element being a dot product in
+// the arithmetic of the use case.
+func (u *PowerUseCase) mulVec(dst *mat.VecDense, matrix mat.Matrix, vector *mat.VecDense) {
+	if u.arithmetic != precision.BigFloat {
+		dst.MulVec(matrix, vector)
+		return
+	}
+
+	rows, cols := matrix.Dims()
+	for i := range rows {
+		sum := precision.NewAccumulator(u.arithmetic)
+		for j := range cols {
+			sum.AddProduct(matrix.At(i, j), vector.AtVec(j))
+		}
+		dst.SetVec(i, sum.Float64())
+	}
+}
+
+// dot returns a·b in the arithmetic of the use case.
+func (u *PowerUseCase) dot(a, b mat.Vector) float64 {
+	if u.arithmetic != precision.BigFloat {
+		return mat.Dot(a, b)
+	}
+
+	sum := precision.NewAccumulator(u.arithmetic)
+	for i := range a.Len() {
+		sum.AddProduct(a.AtVec(i), b.AtVec(i))
+	}
+
+	return sum.Float64()
+}
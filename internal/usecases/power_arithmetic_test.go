@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+func TestPowerDotProductsKeepCancellingTerms(t *testing.T) {
+	t.Parallel()
+
+	// 1e16 + 1 rounds back to 1e16, so float64 loses the ones before the
+	// large terms cancel
+	a := mat.NewVecDense(4, []float64{1e16, 1, 1, -1e16})
+	b := mat.NewVecDense(4, []float64{1, 1, 1, 1})
+
+	tt := []struct {
+		arithmetic precision.Arithmetic
+		expected   float64
+	}{
+		{arithmetic: precision.Float64, expected: 0},
+		{arithmetic: precision.BigFloat, expected: 2},
+	}
+
+	for _, test := range tt {
+		t.Run(string(test.arithmetic), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase(WithArithmetic(test.arithmetic))
+			product := mat.NewVecDense(1, nil)
+
+			// Act
+			dot := useCase.dot(a, b)
+			useCase.mulVec(product, a.T(), b)
+
+			// Assert
+			assert.Equal(t, test.expected, dot)
+			assert.Equal(t, test.expected, product.AtVec(0))
+		})
+	}
+}
+
+func TestPowerMethodsAgreeAcrossArithmetics(t *testing.T) {
+	t.Parallel()
+
+	matrix := [][]float64{{4, 1, 0, 0}, {1, 3, 1, 0}, {0, 1, 3, 1}, {0, 0, 1, 2}}
+
+	for _, method := range PowerMethods() {
+		t.Run(method.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			params := PowerParams{Shift: 3, Epsilon: 1e-12, MaxIterations: 1000}
+
+			// Act
+			rounded, err := NewPowerUseCase().Solve(t.Context(), method, matrix, params)
+			require.NoError(t, err)
+			extended, err := NewPowerUseCase(WithArithmetic(precision.BigFloat)).Solve(t.Context(), method, matrix, params)
+			require.NoError(t, err)
+
+			// Assert
+			assert.InDelta(t, rounded.Eigenvalue, extended.Eigenvalue, 1e-9)
+			matchVectorsWithTolerance(t, rounded.Eigenvector, extended.Eigenvector, 1e-6)
+		})
+	}
+}
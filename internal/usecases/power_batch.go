@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"sync"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrBatchEigenMatrixCancelled = numeerr.New(numeerr.CodeFailed, "batch was cancelled before the matrix was computed")
+
+// BatchEigenResult is the outcome of one matrix of a batch, Err is set
+// instead of Result when that matrix failed.
+type BatchEigenResult struct {
+	Result *PowerResult
+	Err    error
+}
+
+// BatchEigen runs method on every matrix with up to GOMAXPROCS workers. The
+// results are in the order of matrices and a failing matrix only sets its own
+// Err, so one bad matrix never fails the batch.
+func (u *PowerUseCase) BatchEigen(
+	ctx context.Context,
+	matrices [][][]float64,
+	method PowerMethod,
+	params PowerParams,
+) []BatchEigenResult {
+	workers := min(runtime.GOMAXPROCS(0), len(matrices))
+
+	slog.DebugContext(ctx, "Starting the batch eigenvalue computation",
+		slog.Int("matrices", len(matrices)),
+		slog.String("method", method.String()),
+		slog.Int("workers", workers),
+	)
+
+	results := make([]BatchEigenResult, len(matrices))
+
+	indices := make(chan int)
+	go func() {
+		defer close(indices)
+		for i := range matrices {
+			indices <- i
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				if ctx.Err() != nil {
+					results[i].Err = fmt.Errorf("%w: %w", ErrBatchEigenMatrixCancelled, ctx.Err())
+					continue
+				}
+
+				results[i].Result, results[i].Err = u.batchEigenItem(ctx, matrices[i], method, params)
+			}
+		}()
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	slog.InfoContext(ctx, "Finished the batch eigenvalue computation",
+		slog.Int("matrices", len(matrices)),
+		slog.Int("failed", failed),
+	)
+
+	return results
+}
+
+// batchEigenItem validates a single matrix of a batch before solving it, as
+// the power methods assume a square matrix matching the initial guess.
+func (u *PowerUseCase) batchEigenItem(
+	ctx context.Context,
+	matrix [][]float64,
+	method PowerMethod,
+	params PowerParams,
+) (*PowerResult, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return nil, err
+	}
+
+	if params.InitialGuess != nil && len(params.InitialGuess) != len(matrix) {
+		return nil, fmt.Errorf("%w: %d != %d", ErrGuessDimensionMismatch, len(params.InitialGuess), len(matrix))
+	}
+
+	return u.Solve(ctx, method, matrix, params)
+}
@@ -0,0 +1,157 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchEigenIsolatesErrorsAndPreservesOrder(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+
+	tests := []struct {
+		matrix             [][]float64
+		expectedEigenvalue float64
+		expectedErr        error
+	}{
+		{matrix: [][]float64{{2, 3}, {5, 4}}, expectedEigenvalue: 7},
+		{matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}, expectedErr: ErrNonSquareMatrix},
+		{matrix: [][]float64{{4, 1}, {2, 3}}, expectedEigenvalue: 5},
+		{matrix: [][]float64{}, expectedErr: ErrEmptyMatrix},
+		{matrix: [][]float64{{2, 0, 0}, {0, 3, 0}, {0, 0, 9}}, expectedEigenvalue: 9},
+		{matrix: [][]float64{{1, 2}, {3}}, expectedErr: ErrNonSquareMatrix},
+		{matrix: [][]float64{{5}}, expectedEigenvalue: 5},
+	}
+
+	// Repeat the cases so there are more matrices than workers
+	var matrices [][][]float64
+	for range 8 {
+		for _, tc := range tests {
+			matrices = append(matrices, tc.matrix)
+		}
+	}
+
+	// Act
+	results := useCase.BatchEigen(t.Context(), matrices, PowerMethodRegular, PowerParams{Epsilon: 1e-10, MaxIterations: 1000})
+
+	// Assert
+	require.Len(t, results, len(matrices))
+	for i, result := range results {
+		tc := tests[i%len(tests)]
+		if tc.expectedErr != nil {
+			assert.ErrorIs(t, result.Err, tc.expectedErr, "matrix %d", i)
+			assert.Nil(t, result.Result, "matrix %d", i)
+			continue
+		}
+
+		require.NoError(t, result.Err, "matrix %d", i)
+		assert.InDelta(t, tc.expectedEigenvalue, result.Result.Eigenvalue, 1e-6, "matrix %d", i)
+	}
+}
+
+func TestBatchEigenMethods(t *testing.T) {
+	t.Parallel()
+
+	matrices := [][][]float64{
+		{{2, 3}, {5, 4}},
+		{{10, 6, 7}, {1, 7, -2}, {2, 2, 2}},
+	}
+
+	tests := []struct {
+		method   PowerMethod
+		params   PowerParams
+		expected []float64
+	}{
+		{method: PowerMethodRegular, expected: []float64{7, (13 + math.Sqrt(129)) / 2}},
+		{method: PowerMethodInverse, expected: []float64{-1, (13 - math.Sqrt(129)) / 2}},
+		{method: PowerMethodFarthest, params: PowerParams{Shift: 6}, expected: []float64{-1, (13 + math.Sqrt(129)) / 2}},
+		{method: PowerMethodNearest, params: PowerParams{Shift: 5}, expected: []float64{7, 6}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			tc.params.Epsilon = 1e-10
+			tc.params.MaxIterations = 1000
+
+			// Act
+			results := useCase.BatchEigen(t.Context(), matrices, tc.method, tc.params)
+
+			// Assert
+			require.Len(t, results, len(tc.expected))
+			for i, result := range results {
+				require.NoError(t, result.Err)
+				assert.InDelta(t, tc.expected[i], result.Result.Eigenvalue, 1e-6)
+			}
+		})
+	}
+}
+
+func TestBatchEigenRejectsPerMatrixInputs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		method      PowerMethod
+		params      PowerParams
+		expectedErr error
+	}{
+		{name: "UnknownMethod", method: PowerMethod(42), expectedErr: ErrUnknownPowerMethod},
+		{name: "InitialGuessDimension", method: PowerMethodRegular, params: PowerParams{InitialGuess: []float64{1, 1, 1}}, expectedErr: ErrGuessDimensionMismatch},
+		{name: "SingularInverse", method: PowerMethodInverse, expectedErr: ErrSingularMatrix},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			tc.params.Epsilon = 1e-6
+			tc.params.MaxIterations = 100
+
+			// Act
+			results := useCase.BatchEigen(t.Context(), [][][]float64{{{1, 2}, {2, 4}}}, tc.method, tc.params)
+
+			// Assert
+			require.Len(t, results, 1)
+			assert.ErrorIs(t, results[0].Err, tc.expectedErr)
+		})
+	}
+}
+
+func TestBatchEigenCancelled(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	// Act
+	results := useCase.BatchEigen(ctx, [][][]float64{{{2, 3}, {5, 4}}, {{5}}}, PowerMethodRegular, PowerParams{Epsilon: 1e-6, MaxIterations: 100})
+
+	// Assert
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, ErrBatchEigenMatrixCancelled)
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+}
+
+func TestBatchEigenEmpty(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+
+	// Act
+	results := useCase.BatchEigen(t.Context(), nil, PowerMethodRegular, PowerParams{})
+
+	// Assert
+	assert.Empty(t, results)
+}
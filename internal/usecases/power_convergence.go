@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/cmplx"
+	"slices"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// PowerConvergence predicts how fast the regular power method converges on a
+// matrix, the eigenvector error shrinks by ConvergenceRatio each iteration.
+type PowerConvergence struct {
+	// SpectralRadius is |λ₁|, the largest eigenvalue modulus
+	SpectralRadius float64
+	// SubdominantModulus is |λ₂|, the second largest eigenvalue modulus
+	SubdominantModulus float64
+	// ConvergenceRatio is |λ₂/λ₁|. A ratio of 1 means there's no single
+	// dominant eigenvalue and the power method won't converge
+	ConvergenceRatio float64
+}
+
+// NewPowerConvergence derives the convergence of the power method from the
+// eigenvalues of a decomposition, in any order.
+func NewPowerConvergence(eigenvalues []complex128) (*PowerConvergence, error) {
+	if len(eigenvalues) == 0 {
+		return nil, ErrEmptyMatrix
+	}
+
+	moduli := make([]float64, len(eigenvalues))
+	for i, eigenvalue := range eigenvalues {
+		moduli[i] = cmplx.Abs(eigenvalue)
+	}
+	slices.SortFunc(moduli, func(a, b float64) int { return cmp.Compare(b, a) })
+
+	convergence := &PowerConvergence{SpectralRadius: moduli[0]}
+	if len(moduli) == 1 {
+		return convergence, nil
+	}
+
+	convergence.SubdominantModulus = moduli[1]
+	convergence.ConvergenceRatio = 1
+	if convergence.SpectralRadius > 0 {
+		convergence.ConvergenceRatio = moduli[1] / moduli[0]
+	}
+
+	return convergence, nil
+}
+
+// PredictedIterations estimates the iterations needed to shrink the initial
+// error by a factor of epsilon, infinite when the method doesn't converge.
+func (c *PowerConvergence) PredictedIterations(epsilon float64) float64 {
+	if c.ConvergenceRatio >= 1 {
+		return math.Inf(1)
+	}
+	if c.ConvergenceRatio == 0 {
+		return 1
+	}
+
+	return math.Ceil(math.Log(epsilon) / math.Log(c.ConvergenceRatio))
+}
+
+// PredictConvergence decomposes matrix to predict how the regular power method
+// will converge on it.
+func (u *PowerUseCase) PredictConvergence(ctx context.Context, matrix [][]float64) (*PowerConvergence, error) {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return nil, err
+	}
+
+	var eigen mat.Eigen
+	if ok := eigen.Factorize(constructMatrix(matrix), mat.EigenNone); !ok {
+		slog.ErrorContext(ctx, "Failed to decompose the matrix to predict the convergence")
+		return nil, fmt.Errorf("%w: %dx%d matrix", ErrEigenDecompositionFailed, len(matrix), len(matrix))
+	}
+
+	convergence, err := NewPowerConvergence(eigen.Values(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Predicted the power method convergence",
+		slog.Float64("spectralRadius", convergence.SpectralRadius),
+		slog.Float64("convergenceRatio", convergence.ConvergenceRatio),
+	)
+
+	return convergence, nil
+}
@@ -0,0 +1,183 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestPredictConvergenceMatchesObservedDecay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		matrix                 [][]float64
+		expectedSpectralRadius float64
+		expectedRatio          float64
+	}{
+		{
+			name:                   "Fast symmetric",
+			matrix:                 [][]float64{{2, 1}, {1, 2}},
+			expectedSpectralRadius: 3,
+			expectedRatio:          1.0 / 3,
+		},
+		{
+			name:                   "Non symmetric",
+			matrix:                 [][]float64{{5, 4}, {1, 2}},
+			expectedSpectralRadius: 6,
+			expectedRatio:          1.0 / 6,
+		},
+		{
+			name:                   "Slow close eigenvalues",
+			matrix:                 [][]float64{{10, 0, 0}, {0, 8, 1}, {0, 1, 8}},
+			expectedSpectralRadius: 10,
+			expectedRatio:          0.9,
+		},
+	}
+
+	useCase := NewPowerUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			convergence, err := useCase.PredictConvergence(t.Context(), test.matrix)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, test.expectedSpectralRadius, convergence.SpectralRadius, 1e-10)
+			assert.InDelta(t, test.expectedRatio, convergence.ConvergenceRatio, 1e-10)
+			assert.InDelta(t, test.expectedRatio, observedDecayRatio(t, test.matrix), 1e-3)
+		})
+	}
+}
+
+func TestNewPowerConvergence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                       string
+		eigenvalues                []complex128
+		expectedSpectralRadius     float64
+		expectedRatio              float64
+		expectedPredictedIteration float64
+	}{
+		{
+			name:                       "Dominant negative eigenvalue",
+			eigenvalues:                []complex128{1, -4, 2},
+			expectedSpectralRadius:     4,
+			expectedRatio:              0.5,
+			expectedPredictedIteration: 20,
+		},
+		{
+			name:                       "Opposite eigenvalues never converge",
+			eigenvalues:                []complex128{3, -3},
+			expectedSpectralRadius:     3,
+			expectedRatio:              1,
+			expectedPredictedIteration: math.Inf(1),
+		},
+		{
+			name:                       "Complex pair",
+			eigenvalues:                []complex128{complex(0, 2), complex(0, -2), 1},
+			expectedSpectralRadius:     2,
+			expectedRatio:              1,
+			expectedPredictedIteration: math.Inf(1),
+		},
+		{
+			name:                       "Single eigenvalue",
+			eigenvalues:                []complex128{7},
+			expectedSpectralRadius:     7,
+			expectedRatio:              0,
+			expectedPredictedIteration: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			convergence, err := NewPowerConvergence(test.eigenvalues)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, test.expectedSpectralRadius, convergence.SpectralRadius, 1e-12)
+			assert.InDelta(t, test.expectedRatio, convergence.ConvergenceRatio, 1e-12)
+			assert.Equal(t, test.expectedPredictedIteration, convergence.PredictedIterations(1e-6))
+		})
+	}
+}
+
+func TestNewPowerConvergenceRejectsEmptyEigenvalues(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	_, err := NewPowerConvergence(nil)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrEmptyMatrix)
+}
+
+// observedDecayRatio runs plain power iterations and measures how much the
+// distance to the dominant eigenvector shrinks per iteration once the
+// subdominant component dominates the error.
+func observedDecayRatio(t *testing.T, matrix [][]float64) float64 {
+	t.Helper()
+
+	a := constructMatrix(matrix)
+	n := len(matrix)
+
+	var eigen mat.Eigen
+	require.True(t, eigen.Factorize(a, mat.EigenRight))
+
+	values := eigen.Values(nil)
+	var vectors mat.CDense
+	eigen.VectorsTo(&vectors)
+
+	dominant := 0
+	for i, value := range values {
+		if math.Abs(real(value)) > math.Abs(real(values[dominant])) {
+			dominant = i
+		}
+	}
+	target := mat.NewVecDense(n, nil)
+	for i := range n {
+		target.SetVec(i, real(vectors.At(i, dominant)))
+	}
+	target.ScaleVec(1/target.Norm(2), target)
+
+	x := mat.NewVecDense(n, nil)
+	for i := range n {
+		x.SetVec(i, 1+float64(i))
+	}
+
+	distance := func() float64 {
+		var difference mat.VecDense
+		if mat.Dot(x, target) < 0 {
+			difference.AddVec(x, target)
+		} else {
+			difference.SubVec(x, target)
+		}
+		return difference.Norm(2)
+	}
+
+	// Stop well above round-off, where the measured distances lose accuracy
+	previous, current := 0.0, distance()
+	for range 500 {
+		if current < 1e-8 {
+			break
+		}
+		x.MulVec(a, x)
+		x.ScaleVec(1/x.Norm(2), x)
+		previous, current = current, distance()
+	}
+
+	return current / previous
+}
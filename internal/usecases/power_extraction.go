@@ -0,0 +1,38 @@
+package usecases
+
+// EigenvectorExtraction selects how the farthest and nearest power methods
+// recover the eigenvector of the original matrix once they know its
+// eigenvalue.
+type EigenvectorExtraction int
+
+const (
+	// ExtractionDecomposition picks the vector from gonum's full eigenvalue
+	// decomposition, falling back to inverse iteration when it fails
+	ExtractionDecomposition EigenvectorExtraction = iota
+	// ExtractionInverseIteration only runs the package's own shifted inverse
+	// iteration, so the power methods don't rely on a second eigensolver. It
+	// only avoids mat.Eigen: each step still solves the shifted system with
+	// gonum's mat.LU, as the hand-written elimination of the linearsystems
+	// package imports this one
+	ExtractionInverseIteration
+)
+
+// String implements fmt.Stringer.
+func (e EigenvectorExtraction) String() string {
+	switch e {
+	case ExtractionDecomposition:
+		return "decomposition"
+	case ExtractionInverseIteration:
+		return "inverse-iteration"
+	default:
+		return "unknown"
+	}
+}
+
+// WithExtraction makes the shifted methods extract their eigenvector with
+// extraction.
+func WithExtraction(extraction EigenvectorExtraction) PowerOption {
+	return func(u *PowerUseCase) {
+		u.extraction = extraction
+	}
+}
@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+var extractionMatrices = map[string][][]float64{
+	"Non-symmetric 2x2": {{2, 3}, {5, 4}},
+	"Non-symmetric 3x3": {{10, 6, 7}, {1, 7, -2}, {2, 2, 2}},
+	"Tridiagonal 3x3":   {{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+	"Tridiagonal 4x4":   {{4, 1, 0, 0}, {1, 3, 1, 0}, {0, 1, 3, 1}, {0, 0, 1, 2}},
+	"Pentadiagonal 5x5": {
+		{6, 1, 2, 0, 0}, {1, 5, 1, 1, 0}, {2, 1, 4, 1, 1}, {0, 1, 1, 3, 1}, {0, 0, 1, 1, 2},
+	},
+}
+
+func TestInverseIterationExtractionMatchesDecomposition(t *testing.T) {
+	t.Parallel()
+
+	for name, matrix := range extractionMatrices {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			A := constructMatrix(matrix)
+
+			var eigen mat.Eigen
+			require.True(t, eigen.Factorize(A, mat.EigenNone))
+
+			decomposition := NewPowerUseCase()
+			inverseIteration := NewPowerUseCase(WithExtraction(ExtractionInverseIteration))
+
+			for _, eigenvalue := range eigen.Values(nil) {
+				require.Zero(t, imag(eigenvalue))
+
+				// Act
+				expected, err := decomposition.extractEigenvectorFromMatrix(t.Context(), A, real(eigenvalue))
+				require.NoError(t, err)
+				actual, err := inverseIteration.extractEigenvectorFromMatrix(t.Context(), A, real(eigenvalue))
+				require.NoError(t, err)
+
+				// Assert
+				assert.InDelta(t, 1, math.Abs(cosine(expected, actual)), 1e-9,
+					"eigenvectors for %g point in different directions", real(eigenvalue))
+				assert.Less(t, EigenResidual(matrix, real(eigenvalue), actual), 1e-8)
+			}
+		})
+	}
+}
+
+func TestShiftedMethodsAgreeAcrossExtractions(t *testing.T) {
+	t.Parallel()
+
+	matrix := extractionMatrices["Non-symmetric 3x3"]
+	guess := []float64{1, 1, 1}
+
+	tt := []struct {
+		name  string
+		solve func(u *PowerUseCase) (*PowerResult, error)
+	}{
+		{
+			name: "Farthest",
+			solve: func(u *PowerUseCase) (*PowerResult, error) {
+				return u.FarthestEigenvaluePower(t.Context(), matrix, guess, 0, 1e-10, 100)
+			},
+		},
+		{
+			name: "Nearest",
+			solve: func(u *PowerUseCase) (*PowerResult, error) {
+				return u.NearestEigenvaluePower(t.Context(), matrix, guess, 5, 1e-10, 100)
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			expected, err := test.solve(NewPowerUseCase())
+			require.NoError(t, err)
+			actual, err := test.solve(NewPowerUseCase(WithExtraction(ExtractionInverseIteration)))
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, expected.Eigenvalue, actual.Eigenvalue)
+			assert.InDelta(t, 1, math.Abs(cosine(expected.Eigenvector, actual.Eigenvector)), 1e-9)
+		})
+	}
+}
+
+func TestEigenvectorExtractionNames(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act & Assert
+	assert.Equal(t, "decomposition", ExtractionDecomposition.String())
+	assert.Equal(t, "inverse-iteration", ExtractionInverseIteration.String())
+	assert.Equal(t, "unknown", EigenvectorExtraction(-1).String())
+}
+
+// cosine is the cosine of the angle between a and b, ±1 when they are
+// parallel.
+func cosine(a, b []float64) float64 {
+	u, v := constructVector(a), constructVector(b)
+	return mat.Dot(u, v) / (u.Norm(2) * v.Norm(2))
+}
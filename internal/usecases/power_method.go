@@ -0,0 +1,195 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrUnknownPowerMethod   = numeerr.New(numeerr.CodeUnsupported, "unknown power method")
+	ErrInvalidRandomRetries = numeerr.New(numeerr.CodeInvalidInput, "invalid number of random retries")
+	ErrPowerMethodStalled   = numeerr.New(numeerr.CodeFailed, "power method stalled")
+)
+
+// MaxRandomRetries caps PowerParams.RandomRetries, each retry running the
+// whole method again.
+const MaxRandomRetries = 20
+
+// PowerMethod selects one of the power method variants of PowerUseCase.
+type PowerMethod int
+
+const (
+	PowerMethodRegular PowerMethod = iota
+	PowerMethodInverse
+	PowerMethodFarthest
+	PowerMethodNearest
+)
+
+// PowerMethods lists every power method, in the order they are offered.
+func PowerMethods() []PowerMethod {
+	return []PowerMethod{PowerMethodRegular, PowerMethodInverse, PowerMethodFarthest, PowerMethodNearest}
+}
+
+// ParsePowerMethod returns the power method whose String is name.
+func ParsePowerMethod(name string) (PowerMethod, error) {
+	for _, method := range PowerMethods() {
+		if method.String() == name {
+			return method, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownPowerMethod, name)
+}
+
+// String is the identifier of the method, as accepted by the API.
+func (m PowerMethod) String() string {
+	switch m {
+	case PowerMethodRegular:
+		return "regular"
+	case PowerMethodInverse:
+		return "inverse"
+	case PowerMethodFarthest:
+		return "farthest"
+	case PowerMethodNearest:
+		return "nearest"
+	default:
+		return fmt.Sprintf("PowerMethod(%d)", int(m))
+	}
+}
+
+// Describe is the label of the method shown to users.
+func (m PowerMethod) Describe() string {
+	switch m {
+	case PowerMethodRegular:
+		return "Regular Power Method"
+	case PowerMethodInverse:
+		return "Inverse Power Method"
+	case PowerMethodFarthest:
+		return "Farthest Eigenvalue Power"
+	case PowerMethodNearest:
+		return "Nearest Eigenvalue Power"
+	default:
+		return m.String()
+	}
+}
+
+// UsesShift reports whether the method reads PowerParams.Shift.
+func (m PowerMethod) UsesShift() bool {
+	return m == PowerMethodFarthest || m == PowerMethodNearest
+}
+
+// PowerParams are the settings of a power method run. A nil InitialGuess
+// starts from the all ones vector of the matrix size, and Shift is only used
+// by the methods whose UsesShift is true. Polish refines the eigenvector with
+// PolishEigenvector once the method finishes, and Normalization scales the
+// returned eigenvector, L2 being the zero value.
+//
+// RandomRetries is how many random initial guesses are tried, one after the
+// other, when a run stalls, as a guess orthogonal to the dominant eigenvector
+// never converges. The guesses come from Seed, so reruns are reproducible.
+type PowerParams struct {
+	InitialGuess  []float64
+	Shift         float64
+	Epsilon       float64
+	MaxIterations uint64
+	Polish        bool
+	Normalization NormalizationMode
+	RandomRetries int
+	Seed          uint64
+}
+
+// Solve runs method on matrix, the single dispatch the TUI and the API share
+// so they can't disagree on which method an option runs.
+func (u *PowerUseCase) Solve(
+	ctx context.Context,
+	method PowerMethod,
+	matrix [][]float64,
+	params PowerParams,
+) (*PowerResult, error) {
+	if params.RandomRetries < 0 || params.RandomRetries > MaxRandomRetries {
+		return nil, fmt.Errorf("%w: %d, the maximum is %d", ErrInvalidRandomRetries, params.RandomRetries, MaxRandomRetries)
+	}
+
+	initialGuess := params.InitialGuess
+	if initialGuess == nil {
+		initialGuess = make([]float64, len(matrix))
+		for i := range initialGuess {
+			initialGuess[i] = 1
+		}
+	}
+
+	result, err := u.solveFrom(ctx, method, matrix, initialGuess, params)
+	if err != nil {
+		return nil, err
+	}
+
+	random := rand.New(rand.NewPCG(params.Seed, params.Seed))
+	for retry := 1; params.RandomRetries > 0 && stalled(result); retry++ {
+		if retry > params.RandomRetries {
+			return nil, fmt.Errorf("%w after %d random initial guesses", ErrPowerMethodStalled, params.RandomRetries)
+		}
+
+		guess := make([]float64, len(initialGuess))
+		for i := range guess {
+			guess[i] = 2*random.Float64() - 1
+		}
+
+		slog.WarnContext(ctx, "Power method stalled, retrying with a random initial guess",
+			slog.String("method", method.String()),
+			slog.Int("retry", retry),
+			vectorAttr("initialGuess", guess),
+		)
+
+		result, err = u.solveFrom(ctx, method, matrix, guess, params)
+		if err != nil {
+			return nil, err
+		}
+		result.Retries = retry
+	}
+
+	if params.Polish {
+		polished, err := u.PolishEigenvector(ctx, matrix, result.Eigenvalue, result.Eigenvector)
+		if err != nil {
+			return nil, err
+		}
+		result.Eigenvector = polished
+	}
+
+	result.Eigenvector = params.Normalization.Normalize(result.Eigenvector)
+
+	return result, nil
+}
+
+// solveFrom runs method once, starting from initialGuess.
+func (u *PowerUseCase) solveFrom(
+	ctx context.Context,
+	method PowerMethod,
+	matrix [][]float64,
+	initialGuess []float64,
+	params PowerParams,
+) (*PowerResult, error) {
+	switch method {
+	case PowerMethodRegular:
+		return u.RegularPower(ctx, matrix, initialGuess, params.Epsilon, params.MaxIterations)
+	case PowerMethodInverse:
+		return u.InversePower(ctx, matrix, initialGuess, params.Epsilon, params.MaxIterations)
+	case PowerMethodFarthest:
+		return u.FarthestEigenvaluePower(ctx, matrix, initialGuess, params.Shift, params.Epsilon, params.MaxIterations)
+	case PowerMethodNearest:
+		return u.NearestEigenvaluePower(ctx, matrix, initialGuess, params.Shift, params.Epsilon, params.MaxIterations)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownPowerMethod, method)
+	}
+}
+
+// stalled reports a run that didn't converge or whose eigenvalue estimate
+// stayed at zero or isn't finite.
+func stalled(result *PowerResult) bool {
+	eigenvalue := result.Eigenvalue
+	return !result.Converged || eigenvalue == 0 || math.IsNaN(eigenvalue) || math.IsInf(eigenvalue, 0)
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrUnknownNormalization = numeerr.New(numeerr.CodeUnsupported, "unknown normalization mode")
+
+// NormalizationMode is the norm the eigenvector of a power method is scaled
+// to. The iterations always normalize with L2, as the Rayleigh quotient
+// needs a unit vector, so the mode only changes the returned eigenvector.
+type NormalizationMode int
+
+const (
+	// NormalizationL2 scales the eigenvector to unit Euclidean length
+	NormalizationL2 NormalizationMode = iota
+	// NormalizationL1 scales the eigenvector so its absolute values sum to
+	// one
+	NormalizationL1
+	// NormalizationMax scales the eigenvector so its largest element in
+	// absolute value is one, the convention of most textbooks
+	NormalizationMax
+)
+
+// NormalizationModes lists every normalization mode, in the order they are
+// offered.
+func NormalizationModes() []NormalizationMode {
+	return []NormalizationMode{NormalizationL2, NormalizationL1, NormalizationMax}
+}
+
+// ParseNormalizationMode returns the normalization mode whose String is name.
+func ParseNormalizationMode(name string) (NormalizationMode, error) {
+	for _, mode := range NormalizationModes() {
+		if mode.String() == name {
+			return mode, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnknownNormalization, name)
+}
+
+// String is the identifier of the mode, as accepted by the API.
+func (m NormalizationMode) String() string {
+	switch m {
+	case NormalizationL2:
+		return "l2"
+	case NormalizationL1:
+		return "l1"
+	case NormalizationMax:
+		return "max"
+	default:
+		return fmt.Sprintf("NormalizationMode(%d)", int(m))
+	}
+}
+
+// Normalize returns vector scaled to the norm of the mode. L1 and L2 keep the
+// orientation of vector, while Max makes its largest element exactly 1. The
+// zero vector is returned unchanged.
+func (m NormalizationMode) Normalize(vector []float64) []float64 {
+	var scale float64
+
+	switch m {
+	case NormalizationL1:
+		for _, value := range vector {
+			scale += math.Abs(value)
+		}
+	case NormalizationMax:
+		for _, value := range vector {
+			if math.Abs(value) > math.Abs(scale) {
+				scale = value
+			}
+		}
+	default:
+		for _, value := range vector {
+			scale = math.Hypot(scale, value)
+		}
+	}
+
+	normalized := make([]float64, len(vector))
+	for i, value := range vector {
+		if scale == 0 {
+			normalized[i] = value
+		} else {
+			normalized[i] = value / scale
+		}
+	}
+
+	return normalized
+}
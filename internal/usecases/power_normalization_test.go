@@ -0,0 +1,142 @@
+package usecases
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolveNormalizesTheEigenvector(t *testing.T) {
+	t.Parallel()
+
+	// The regular power method cases, with the eigenvector scaled so its
+	// largest element is one
+	tests := []struct {
+		matrix       [][]float64
+		initialGuess []float64
+		expectedMax  []float64
+	}{
+		{
+			matrix:       [][]float64{{2, 3}, {5, 4}},
+			initialGuess: []float64{1, 1},
+			expectedMax:  []float64{3.0 / 5, 1},
+		},
+		{
+			matrix:       [][]float64{{0, 2, 4}, {1, 1, -2}, {-2, 0, 5}},
+			initialGuess: []float64{1, 1, 1},
+			expectedMax:  []float64{1, -0.5, 1},
+		},
+		{
+			matrix:       [][]float64{{10, 6, 7}, {1, 7, -2}, {2, 2, 2}},
+			initialGuess: []float64{1, 1, 1},
+			expectedMax:  []float64{1, 2 / (math.Sqrt(129) + 7), 4 / (math.Sqrt(129) + 7)},
+		},
+		{
+			matrix:       [][]float64{{1, -1, 0}, {-1, 2, -1}, {0, -1, 1}},
+			initialGuess: []float64{1, -1, 1},
+			expectedMax:  []float64{-0.5, 1, -0.5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%v", tc.matrix), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			params := PowerParams{InitialGuess: tc.initialGuess, Epsilon: 1e-12, MaxIterations: 1000}
+
+			// Act
+			results := make(map[NormalizationMode][]float64)
+			for _, mode := range NormalizationModes() {
+				params.Normalization = mode
+				result, err := useCase.Solve(t.Context(), PowerMethodRegular, tc.matrix, params)
+				require.NoError(t, err)
+				results[mode] = result.Eigenvector
+			}
+
+			// Assert
+			assert.InDeltaSlice(t, tc.expectedMax, results[NormalizationMax], 1e-6)
+
+			var l1, l2 float64
+			for i, value := range results[NormalizationL2] {
+				l1 += math.Abs(results[NormalizationL1][i])
+				l2 += value * value
+			}
+			assert.InDelta(t, 1, l1, 1e-12)
+			assert.InDelta(t, 1, l2, 1e-12)
+
+			// Every mode scales the same eigenvector
+			for _, mode := range []NormalizationMode{NormalizationL1, NormalizationL2} {
+				scale := results[mode][0] / results[NormalizationMax][0]
+				for i, value := range results[NormalizationMax] {
+					assert.InDelta(t, value*scale, results[mode][i], 1e-9, mode.String())
+				}
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		mode     NormalizationMode
+		vector   []float64
+		expected []float64
+	}{
+		{name: "L2", mode: NormalizationL2, vector: []float64{3, -4}, expected: []float64{0.6, -0.8}},
+		{name: "L1", mode: NormalizationL1, vector: []float64{3, -1}, expected: []float64{0.75, -0.25}},
+		{name: "MaxPositive", mode: NormalizationMax, vector: []float64{2, 4}, expected: []float64{0.5, 1}},
+		{name: "MaxNegative", mode: NormalizationMax, vector: []float64{1, -4}, expected: []float64{-0.25, 1}},
+		{name: "Zero", mode: NormalizationMax, vector: []float64{0, 0}, expected: []float64{0, 0}},
+		{name: "Empty", mode: NormalizationL2, vector: []float64{}, expected: []float64{}},
+		{name: "SingleL1", mode: NormalizationL1, vector: []float64{-2}, expected: []float64{-1}},
+		{name: "SingleMax", mode: NormalizationMax, vector: []float64{-2}, expected: []float64{1}},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			normalized := test.mode.Normalize(test.vector)
+
+			// Assert
+			assert.InDeltaSlice(t, test.expected, normalized, 1e-12)
+		})
+	}
+}
+
+func TestParseNormalizationMode(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range NormalizationModes() {
+		t.Run(mode.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			parsed, err := ParseNormalizationMode(mode.String())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, mode, parsed)
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		// Arrange
+		t.Parallel()
+
+		// Act
+		_, err := ParseNormalizationMode("l3")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnknownNormalization)
+	})
+}
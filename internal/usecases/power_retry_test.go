@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orthogonalGuessMatrix has 3 as its dominant eigenvalue, with eigenvector
+// e₁. Starting orthogonal to it, the iterates swap between (0, 1, 1) and
+// (0, 1, −1), so the eigenvalue estimate stays at zero.
+var orthogonalGuessMatrix = [][]float64{{3, 0, 0}, {0, 1, 0}, {0, 0, -1}}
+
+func TestSolveStallsOnAnOrthogonalInitialGuess(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+	params := PowerParams{InitialGuess: []float64{0, 1, 1}, Epsilon: 1e-10, MaxIterations: 100}
+
+	// Act
+	result, err := useCase.Solve(t.Context(), PowerMethodRegular, orthogonalGuessMatrix, params)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.Converged)
+	assert.Zero(t, result.Eigenvalue)
+	assert.Zero(t, result.Retries)
+}
+
+func TestSolveRetriesWithRandomInitialGuesses(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+	params := PowerParams{
+		InitialGuess:  []float64{0, 1, 1},
+		Epsilon:       1e-10,
+		MaxIterations: 100,
+		RandomRetries: 5,
+		Seed:          42,
+	}
+
+	// Act
+	result, err := useCase.Solve(t.Context(), PowerMethodRegular, orthogonalGuessMatrix, params)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Converged)
+	assert.InDelta(t, 3, result.Eigenvalue, 1e-8)
+	assert.InDeltaSlice(t, []float64{1, 0, 0}, NormalizationMax.Normalize(result.Eigenvector), 1e-6)
+	assert.Positive(t, result.Retries)
+	assert.LessOrEqual(t, result.Retries, params.RandomRetries)
+}
+
+func TestSolveRetriesAreReproducible(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+	params := PowerParams{
+		InitialGuess:  []float64{0, 1, 1},
+		Epsilon:       1e-10,
+		MaxIterations: 100,
+		RandomRetries: 5,
+		Seed:          7,
+	}
+
+	// Act
+	first, err := useCase.Solve(t.Context(), PowerMethodRegular, orthogonalGuessMatrix, params)
+	require.NoError(t, err)
+	second, err := useCase.Solve(t.Context(), PowerMethodRegular, orthogonalGuessMatrix, params)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, first, second)
+}
+
+func TestSolveDoesNotRetryAConvergedRun(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+	params := PowerParams{Epsilon: 1e-10, MaxIterations: 1000, RandomRetries: 5}
+
+	// Act
+	result, err := useCase.Solve(t.Context(), PowerMethodRegular, [][]float64{{2, 3}, {5, 4}}, params)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Zero(t, result.Retries)
+	assert.InDelta(t, 7, result.Eigenvalue, 1e-8)
+}
+
+func TestSolveRetryErrors(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		matrix   [][]float64
+		retries  int
+		expected error
+	}{
+		{
+			name:     "EveryGuessStalls",
+			matrix:   [][]float64{{0, 0}, {0, 0}},
+			retries:  3,
+			expected: ErrPowerMethodStalled,
+		},
+		{
+			name:     "NegativeRetries",
+			matrix:   [][]float64{{2, 0}, {0, 1}},
+			retries:  -1,
+			expected: ErrInvalidRandomRetries,
+		},
+		{
+			name:     "TooManyRetries",
+			matrix:   [][]float64{{2, 0}, {0, 1}},
+			retries:  MaxRandomRetries + 1,
+			expected: ErrInvalidRandomRetries,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			params := PowerParams{Epsilon: 1e-10, MaxIterations: 100, RandomRetries: test.retries}
+
+			// Act
+			_, err := useCase.Solve(t.Context(), PowerMethodRegular, test.matrix, params)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
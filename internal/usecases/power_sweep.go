@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrNoSweepEpsilons     = numeerr.New(numeerr.CodeInvalidInput, "epsilon sweep needs at least one epsilon")
+	ErrInvalidSweepEpsilon = numeerr.New(numeerr.CodeInvalidInput, "sweep epsilons must be positive and finite")
+	ErrInvalidDecadeCount  = numeerr.New(numeerr.CodeInvalidInput, "epsilon decades need a positive count")
+)
+
+// EpsilonSweepPoint is the outcome of a power method run at one epsilon of a
+// sweep.
+type EpsilonSweepPoint struct {
+	Epsilon    float64
+	Iterations uint64
+	Eigenvalue float64
+}
+
+// EpsilonDecades returns the epsilons 10⁻¹, 10⁻², … 10⁻ᶜᵒᵘⁿᵗ, from the
+// loosest to the tightest.
+func EpsilonDecades(count int) ([]float64, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidDecadeCount, count)
+	}
+
+	epsilons := make([]float64, count)
+	for i := range epsilons {
+		epsilons[i] = math.Pow(10, -float64(i+1))
+	}
+
+	return epsilons, nil
+}
+
+// SweepEpsilon runs method once per epsilon, in their order, overriding
+// params.Epsilon, to show how tightening the tolerance trades iterations for
+// accuracy. A failing run fails the whole sweep.
+func (u *PowerUseCase) SweepEpsilon(
+	ctx context.Context,
+	method PowerMethod,
+	matrix [][]float64,
+	params PowerParams,
+	epsilons []float64,
+) ([]EpsilonSweepPoint, error) {
+	if len(epsilons) == 0 {
+		return nil, ErrNoSweepEpsilons
+	}
+
+	for _, epsilon := range epsilons {
+		if epsilon <= 0 || math.IsInf(epsilon, 0) || math.IsNaN(epsilon) {
+			return nil, fmt.Errorf("%w: %g", ErrInvalidSweepEpsilon, epsilon)
+		}
+	}
+
+	slog.DebugContext(ctx, "Starting the epsilon sweep",
+		slog.String("method", method.String()),
+		slog.Int("epsilons", len(epsilons)),
+	)
+
+	points := make([]EpsilonSweepPoint, len(epsilons))
+	for i, epsilon := range epsilons {
+		params.Epsilon = epsilon
+
+		result, err := u.Solve(ctx, method, matrix, params)
+		if err != nil {
+			return nil, fmt.Errorf("epsilon %g: %w", epsilon, err)
+		}
+
+		points[i] = EpsilonSweepPoint{
+			Epsilon:    epsilon,
+			Iterations: result.NumIterations,
+			Eigenvalue: result.Eigenvalue,
+		}
+	}
+
+	slog.InfoContext(ctx, "Finished the epsilon sweep",
+		slog.String("method", method.String()),
+		slog.Uint64("loosestIterations", points[0].Iterations),
+		slog.Uint64("tightestIterations", points[len(points)-1].Iterations),
+	)
+
+	return points, nil
+}
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSweepEpsilonTighterEpsilonTakesMoreIterations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		method             PowerMethod
+		matrix             [][]float64
+		expectedEigenvalue float64
+	}{
+		{
+			name:               "Regular",
+			method:             PowerMethodRegular,
+			matrix:             [][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+			expectedEigenvalue: 2 + math.Sqrt2,
+		},
+		{
+			name:               "Inverse",
+			method:             PowerMethodInverse,
+			matrix:             [][]float64{{2, 1, 0}, {1, 2, 1}, {0, 1, 2}},
+			expectedEigenvalue: 2 - math.Sqrt2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			epsilons, err := EpsilonDecades(10)
+			require.NoError(t, err)
+
+			// Act
+			points, err := NewPowerUseCase().SweepEpsilon(t.Context(), tc.method, tc.matrix,
+				PowerParams{MaxIterations: 1000}, epsilons)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, points, len(epsilons))
+			for i := 1; i < len(points); i++ {
+				assert.Equal(t, epsilons[i], points[i].Epsilon)
+				assert.GreaterOrEqual(t, points[i].Iterations, points[i-1].Iterations,
+					"epsilon %g took fewer iterations than %g", points[i].Epsilon, points[i-1].Epsilon)
+			}
+			assert.Greater(t, points[len(points)-1].Iterations, points[0].Iterations)
+			assert.InDelta(t, tc.expectedEigenvalue, points[len(points)-1].Eigenvalue, 1e-8)
+		})
+	}
+}
+
+func TestSweepEpsilonErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		epsilons    []float64
+		expectedErr error
+	}{
+		{name: "NoEpsilons", matrix: [][]float64{{2, 3}, {5, 4}}, expectedErr: ErrNoSweepEpsilons},
+		{name: "ZeroEpsilon", matrix: [][]float64{{2, 3}, {5, 4}}, epsilons: []float64{1e-3, 0}, expectedErr: ErrInvalidSweepEpsilon},
+		{name: "NaNEpsilon", matrix: [][]float64{{2, 3}, {5, 4}}, epsilons: []float64{math.NaN()}, expectedErr: ErrInvalidSweepEpsilon},
+		{name: "NonSquareMatrix", matrix: [][]float64{{1, 2, 3}, {4, 5, 6}}, epsilons: []float64{1e-3}, expectedErr: ErrNonSquareMatrix},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			points, err := NewPowerUseCase().SweepEpsilon(t.Context(), PowerMethodInverse, tc.matrix,
+				PowerParams{MaxIterations: 100}, tc.epsilons)
+
+			// Assert
+			require.ErrorIs(t, err, tc.expectedErr)
+			assert.Nil(t, points)
+		})
+	}
+}
+
+func TestEpsilonDecades(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	epsilons, err := EpsilonDecades(3)
+	_, invalidErr := EpsilonDecades(0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1e-1, 1e-2, 1e-3}, epsilons, 1e-18)
+	assert.ErrorIs(t, invalidErr, ErrInvalidDecadeCount)
+}
@@ -8,18 +8,67 @@ import (
 	"math"
 
 	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+	"github.com/taldoflemis/nume/internal/precision"
+)
+
+var (
+	ErrEigenDecompositionFailed = numeerr.New(numeerr.CodeFailed, "eigenvalue decomposition failed")
+	ErrInverseIterationFailed   = numeerr.New(numeerr.CodeFailed, "inverse iteration did not converge")
+	ErrGuessDimensionMismatch   = numeerr.New(numeerr.CodeInvalidInput, "matrix and initial guess dimensions do not match")
+	ErrZeroInitialGuess         = numeerr.New(numeerr.CodeInvalidInput, "initial guess cannot be zero")
+	ErrEmptyInitialGuess        = numeerr.New(numeerr.CodeInvalidInput, "initial guess cannot be empty")
 )
 
-type PowerUseCase struct{}
+// Inverse iteration settings for the eigenvector fallback
+const (
+	inverseIterationMaxIterations = 100
+	inverseIterationTolerance     = 1e-12
+	// inverseIterationShift keeps A - σI invertible when σ is an exact
+	// eigenvalue, relative to the eigenvalue magnitude
+	inverseIterationShift = 1e-10
+	// decompositionResidualTolerance bounds ||Av - λv|| relative to ||A|| for
+	// an eigenvector from the decomposition to be accepted
+	decompositionResidualTolerance = 1e-8
+	// nearestShiftNudge moves a shift sitting on an eigenvalue, relative to
+	// ||A||, far enough for A - kI to be invertible and close enough for the
+	// nudged eigenvalue to match it within float64 precision
+	nearestShiftNudge = 1e-9
+)
 
-func NewPowerUseCase() *PowerUseCase {
-	return &PowerUseCase{}
+type PowerUseCase struct {
+	extraction EigenvectorExtraction
+	arithmetic precision.Arithmetic
+}
+
+// PowerOption configures a PowerUseCase built by NewPowerUseCase.
+type PowerOption func(*PowerUseCase)
+
+// NewPowerUseCase returns a PowerUseCase extracting eigenvectors by
+// decomposition in float64 arithmetic, unless opts say otherwise.
+func NewPowerUseCase(opts ...PowerOption) *PowerUseCase {
+	u := &PowerUseCase{arithmetic: precision.Float64}
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
 }
 
 type PowerResult struct {
 	Eigenvalue    float64
 	Eigenvector   []float64
 	NumIterations uint64
+	// ShiftAtEigenvalue reports that the shift of the nearest eigenvalue
+	// power method was an eigenvalue, so it was nudged to invert A - kI
+	ShiftAtEigenvalue bool
+	// Converged reports that the eigenvalue estimate changed by less than
+	// epsilon before the iterations ran out
+	Converged bool
+	// Retries is how many random initial guesses Solve needed after the
+	// given one stalled
+	Retries int
 }
 
 func (u *PowerUseCase) RegularPower(
@@ -30,20 +79,20 @@ func (u *PowerUseCase) RegularPower(
 	maxNumberOfIterations uint64,
 ) (*PowerResult, error) {
 	slog.DebugContext(ctx, "Starting the regular power method",
-		slog.Any("matrix", matrix),
-		slog.Any("initialGuess", initialGuess),
+		matrixAttr("matrix", matrix),
+		vectorAttr("initialGuess", initialGuess),
 		slog.Float64("epsilon", epsilon),
 		slog.Uint64("maxNumberOfIterations", maxNumberOfIterations),
 	)
 
-	if all(initialGuess, func(value float64) bool { return value == 0 }) {
-		slog.ErrorContext(ctx, "Initial guess cannot be zero")
-		return nil, errors.New("zero initial guess")
+	if err := validateInitialGuess(initialGuess); err != nil {
+		slog.ErrorContext(ctx, "Invalid initial guess for the regular power method", slog.Any("error", err))
+		return nil, err
 	}
 
 	if len(matrix) == 0 || len(matrix[0]) == 0 {
 		slog.ErrorContext(ctx, "Matrix cannot be empty")
-		return nil, errors.New("empty matrix")
+		return nil, ErrEmptyMatrix
 	}
 
 	if len(matrix[0]) != len(initialGuess) {
@@ -51,7 +100,7 @@ func (u *PowerUseCase) RegularPower(
 			slog.Int("matrixRows", len(matrix)),
 			slog.Int("matrixCols", len(matrix[0])),
 		)
-		return nil, errors.New("matrix and initial guess dimensions do not match")
+		return nil, ErrGuessDimensionMismatch
 	}
 
 	A := constructMatrix(matrix)
@@ -81,14 +130,24 @@ func (u *PowerUseCase) InversePower(
 	maxNumberOfIterations uint64,
 ) (*PowerResult, error) {
 	slog.DebugContext(ctx, "Starting the inverse power method",
-		slog.Any("matrix", matrix),
-		slog.Any("initialGuess", initialGuess),
+		matrixAttr("matrix", matrix),
+		vectorAttr("initialGuess", initialGuess),
 		slog.Float64("epsilon", epsilon),
 		slog.Uint64("maxNumberOfIterations", maxNumberOfIterations),
 	)
 
+	if err := validateShiftedPowerInput(matrix, initialGuess); err != nil {
+		slog.ErrorContext(ctx, "Invalid input for the inverse power method", slog.Any("error", err))
+		return nil, err
+	}
+
 	originalMatrix := constructMatrix(matrix)
 
+	if err := checkInvertible(originalMatrix); err != nil {
+		slog.ErrorContext(ctx, "Matrix is singular, the inverse power method cannot run", slog.Any("error", err))
+		return nil, err
+	}
+
 	var inverseMatrix mat.Dense
 
 	slog.DebugContext(ctx, "Computing the inverse of the matrix")
@@ -99,7 +158,7 @@ func (u *PowerUseCase) InversePower(
 	}
 
 	slog.DebugContext(ctx, "Inverse matrix computed successfully",
-		slog.Any("inverseMatrix", inverseMatrix.RawMatrix().Data),
+		denseAttr("inverseMatrix", &inverseMatrix),
 	)
 
 	result, err := u.innerRegularPower(ctx, &inverseMatrix, constructVector(initialGuess), epsilon, maxNumberOfIterations)
@@ -122,6 +181,7 @@ func (u *PowerUseCase) InversePower(
 		Eigenvector:   result.Eigenvector,
 		Eigenvalue:    eigenvalue,
 		NumIterations: result.NumIterations,
+		Converged:     result.Converged,
 	}, nil
 }
 
@@ -134,13 +194,18 @@ func (u *PowerUseCase) FarthestEigenvaluePower(
 	maxNumberOfIterations uint64,
 ) (*PowerResult, error) {
 	slog.DebugContext(ctx, "Starting the Farthest power method",
-		slog.Any("matrix", matrix),
-		slog.Any("initialGuess", initialGuess),
+		matrixAttr("matrix", matrix),
+		vectorAttr("initialGuess", initialGuess),
 		slog.Float64("epsilon", epsilon),
 		slog.Uint64("maxNumberOfIterations", maxNumberOfIterations),
 		slog.Float64("scalarToGoFarthest", scalarToGoFarthest),
 	)
 
+	if err := validateShiftedPowerInput(matrix, initialGuess); err != nil {
+		slog.ErrorContext(ctx, "Invalid input for the farthest power method", slog.Any("error", err))
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "Creating matrix and scalar farthest matrix")
 
 	A := constructMatrix(matrix)
@@ -150,14 +215,14 @@ func (u *PowerUseCase) FarthestEigenvaluePower(
 	}
 
 	slog.DebugContext(ctx, "Scalar farthest matrix created",
-		slog.Any("scalarFarthestMatrix", scalarFarthestMatrix.RawMatrix().Data),
+		denseAttr("scalarFarthestMatrix", scalarFarthestMatrix),
 	)
 
 	var matrixToFindLargestPowerResult mat.Dense
 	matrixToFindLargestPowerResult.Add(A, scalarFarthestMatrix)
 
 	slog.DebugContext(ctx, "Matrix to find largest power result",
-		slog.Any("matrixToFindLargestPowerResult", matrixToFindLargestPowerResult.RawMatrix().Data),
+		denseAttr("matrixToFindLargestPowerResult", &matrixToFindLargestPowerResult),
 	)
 
 	initialGuessVector := constructVector(initialGuess)
@@ -187,6 +252,7 @@ func (u *PowerUseCase) FarthestEigenvaluePower(
 		Eigenvalue:    farthestEigenvalue,
 		Eigenvector:   eigenvector,
 		NumIterations: result.NumIterations,
+		Converged:     result.Converged,
 	}, nil
 }
 
@@ -199,13 +265,18 @@ func (u *PowerUseCase) NearestEigenvaluePower(
 	maxNumberOfIterations uint64,
 ) (*PowerResult, error) {
 	slog.DebugContext(ctx, "Starting the NearestEigenvaluePower method",
-		slog.Any("matrix", matrix),
-		slog.Any("initialGuess", initialGuess),
+		matrixAttr("matrix", matrix),
+		vectorAttr("initialGuess", initialGuess),
 		slog.Float64("epsilon", epsilon),
 		slog.Uint64("maxNumberOfIterations", maxNumberOfIterations),
 		slog.Float64("scalarToGoNearest", scalarToGoNearest),
 	)
 
+	if err := validateShiftedPowerInput(matrix, initialGuess); err != nil {
+		slog.ErrorContext(ctx, "Invalid input for the nearest eigenvalue power method", slog.Any("error", err))
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "Creating matrix and scalar nearest matrix")
 
 	A := constructMatrix(matrix)
@@ -215,16 +286,33 @@ func (u *PowerUseCase) NearestEigenvaluePower(
 	}
 
 	slog.DebugContext(ctx, "Scalar nearest matrix created",
-		slog.Any("scalarNearestMatrix", scalarNearestMatrix.RawMatrix().Data),
+		denseAttr("scalarNearestMatrix", scalarNearestMatrix),
 	)
 
 	var matrixToFindSmallestPowerResult mat.Dense
 	matrixToFindSmallestPowerResult.Add(A, scalarNearestMatrix)
 
 	slog.DebugContext(ctx, "Matrix to find smallest power result",
-		slog.Any("matrixToFindSmallestPowerResult", matrixToFindSmallestPowerResult.RawMatrix().Data),
+		denseAttr("matrixToFindSmallestPowerResult", &matrixToFindSmallestPowerResult),
 	)
 
+	shiftAtEigenvalue := false
+	if checkInvertible(&matrixToFindSmallestPowerResult) != nil {
+		// A - kI is singular exactly when k is an eigenvalue, which is then
+		// the nearest one, so a slightly moved shift converges right to it
+		nudge := nearestShiftNudge * max(mat.Norm(A, math.Inf(1)), 1)
+		slog.WarnContext(ctx, "Shift is an eigenvalue of the matrix, nudging it",
+			slog.Float64("scalarToGoNearest", scalarToGoNearest),
+			slog.Float64("nudge", nudge),
+		)
+
+		scalarToGoNearest += nudge
+		for i := 0; i < len(matrix[0]); i++ {
+			matrixToFindSmallestPowerResult.Set(i, i, A.At(i, i)-scalarToGoNearest)
+		}
+		shiftAtEigenvalue = true
+	}
+
 	matrixAsSlice := denseToSliceOfSlices(&matrixToFindSmallestPowerResult)
 
 	result, err := u.InversePower(ctx, matrixAsSlice, initialGuess, epsilon, maxNumberOfIterations)
@@ -249,12 +337,33 @@ func (u *PowerUseCase) NearestEigenvaluePower(
 	)
 
 	return &PowerResult{
-		Eigenvalue:    nearestEigenvalue,
-		Eigenvector:   eigenvector,
-		NumIterations: result.NumIterations,
+		Eigenvalue:        nearestEigenvalue,
+		Eigenvector:       eigenvector,
+		NumIterations:     result.NumIterations,
+		ShiftAtEigenvalue: shiftAtEigenvalue,
+		Converged:         result.Converged,
 	}, nil
 }
 
+// validateShiftedPowerInput checks what the inverse and shifted power methods
+// need before building A - kI: a square matrix and a non-empty initial guess
+// of its dimension.
+func validateShiftedPowerInput(matrix [][]float64, initialGuess []float64) error {
+	if err := validateSquareMatrix(matrix); err != nil {
+		return err
+	}
+
+	if len(initialGuess) == 0 {
+		return ErrEmptyInitialGuess
+	}
+
+	if len(initialGuess) != len(matrix) {
+		return fmt.Errorf("%w: %d != %d", ErrGuessDimensionMismatch, len(initialGuess), len(matrix))
+	}
+
+	return nil
+}
+
 func (u *PowerUseCase) innerRegularPower(ctx context.Context,
 	matrix *mat.Dense,
 	initialGuess *mat.VecDense,
@@ -262,24 +371,30 @@ func (u *PowerUseCase) innerRegularPower(ctx context.Context,
 	maxNumberOfIterations uint64,
 ) (*PowerResult, error) {
 	slog.DebugContext(ctx, "Starting the inner regular power method",
-		slog.Any("matrix", matrix.RawMatrix().Data),
-		slog.Any("initialGuess", initialGuess.RawVector().Data),
+		denseAttr("matrix", matrix),
+		vectorAttr("initialGuess", initialGuess.RawVector().Data),
 		slog.Float64("epsilon", epsilon),
 		slog.Uint64("maxNumberOfIterations", maxNumberOfIterations),
 	)
 
 	slog.DebugContext(ctx, "Normalizing the initial guess vector")
 
-	bestEigenvector := mat.NewVecDense(initialGuess.Len(), nil)
 	// Normalize the initialGuess vector
 	const l2Norm = 2
-	bestEigenvector.ScaleVec(1/initialGuess.Norm(l2Norm), initialGuess)
+	initialNorm := initialGuess.Norm(l2Norm)
+	if initialNorm == 0 {
+		slog.ErrorContext(ctx, "Initial guess has a zero norm, it cannot be normalized")
+		return nil, ErrZeroInitialGuess
+	}
+	bestEigenvector := mat.NewVecDense(initialGuess.Len(), nil)
+	bestEigenvector.ScaleVec(1/initialNorm, initialGuess)
 
 	currentError := math.Inf(1)
 	currentIteration := uint64(0)
 	Y := mat.NewVecDense(initialGuess.Len(), nil)
 
 	var bestEigenvalue float64
+	converged := false
 
 	for currentIteration < maxNumberOfIterations {
 		currentIteration++
@@ -291,7 +406,7 @@ func (u *PowerUseCase) innerRegularPower(ctx context.Context,
 			slog.Float64("bestEigenvalue", bestEigenvalue),
 		)
 
-		Y.MulVec(matrix, bestEigenvector)
+		u.mulVec(Y, matrix, bestEigenvector)
 
 		slog.DebugContext(ctx, "Multiplying matrix A with the calculated Y eigenvector",
 			slog.String("Y", fmt.Sprintf("%v", Y.RawVector().Data)),
@@ -300,13 +415,13 @@ func (u *PowerUseCase) innerRegularPower(ctx context.Context,
 		normY := Y.Norm(l2Norm)
 		if normY == 0 {
 			slog.WarnContext(ctx, "Norm is 0, cannot continue iterating",
-				slog.Any("Y", mat.Formatted(Y)),
+				denseAttr("Y", Y),
 			)
 			break
 		}
 
 		// Takes the largest element in absolute value from Y
-		possibleBestEigenvalue := mat.Dot(Y, bestEigenvector)
+		possibleBestEigenvalue := u.dot(Y, bestEigenvector)
 
 		bestEigenvector.ScaleVec(1/normY, Y)
 
@@ -328,6 +443,7 @@ func (u *PowerUseCase) innerRegularPower(ctx context.Context,
 				slog.Float64("iterationError", iterationError),
 				slog.Float64("epsilon", epsilon),
 			)
+			converged = true
 			break
 		}
 	}
@@ -344,9 +460,28 @@ func (u *PowerUseCase) innerRegularPower(ctx context.Context,
 		Eigenvalue:    bestEigenvalue,
 		Eigenvector:   bestEigenvector.RawVector().Data,
 		NumIterations: currentIteration,
+		Converged:     converged,
 	}, nil
 }
 
+// checkInvertible returns ErrSingularMatrix, with its determinant and
+// condition number, when matrix is too close to singular to be inverted.
+func checkInvertible(matrix *mat.Dense) error {
+	var lu mat.LU
+	lu.Factorize(matrix)
+
+	determinant := lu.Det()
+	condition := lu.Cond()
+	if determinant != 0 && condition <= mat.ConditionTolerance {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: determinant %.3g, condition number %.3g, zero is an eigenvalue, use the nearest eigenvalue power method with a nonzero shift",
+		ErrSingularMatrix, determinant, condition,
+	)
+}
+
 func denseToSliceOfSlices(m *mat.Dense) [][]float64 {
 	r, c := m.Dims()
 
@@ -381,6 +516,20 @@ func constructVector(vector []float64) *mat.VecDense {
 	return mat.NewVecDense(len(vector), vector)
 }
 
+// validateInitialGuess rejects the initial guesses that cannot be
+// normalized: the empty vector and the zero vector.
+func validateInitialGuess(initialGuess []float64) error {
+	if len(initialGuess) == 0 {
+		return ErrEmptyInitialGuess
+	}
+
+	if all(initialGuess, func(value float64) bool { return value == 0 }) {
+		return ErrZeroInitialGuess
+	}
+
+	return nil
+}
+
 func all(values []float64, condition func(float64) bool) bool {
 	for _, item := range values {
 		if !condition(item) {
@@ -392,8 +541,35 @@ func all(values []float64, condition func(float64) bool) bool {
 }
 
 // extractEigenvectorFromMatrix uses Gonum's eigenvalue decomposition to find
-// the eigenvector corresponding to the given eigenvalue from the original matrix
+// the eigenvector corresponding to the given eigenvalue from the original
+// matrix, falling back to shifted inverse iteration when it fails. With
+// ExtractionInverseIteration it skips the decomposition altogether.
 func (u *PowerUseCase) extractEigenvectorFromMatrix(ctx context.Context, matrix *mat.Dense, targetEigenvalue float64) ([]float64, error) {
+	if u.extraction == ExtractionInverseIteration {
+		slog.DebugContext(ctx, "Extracting eigenvector with inverse iteration",
+			slog.Float64("targetEigenvalue", targetEigenvalue),
+		)
+		return u.inverseIterationEigenvector(ctx, matrix, targetEigenvalue)
+	}
+
+	eigenvector, err := u.eigenvectorFromDecomposition(ctx, matrix, targetEigenvalue)
+	if err == nil {
+		return eigenvector, nil
+	}
+
+	slog.WarnContext(ctx, "Falling back to inverse iteration to extract the eigenvector",
+		slog.Any("error", err),
+	)
+
+	eigenvector, fallbackErr := u.inverseIterationEigenvector(ctx, matrix, targetEigenvalue)
+	if fallbackErr != nil {
+		return nil, errors.Join(err, fallbackErr)
+	}
+
+	return eigenvector, nil
+}
+
+func (u *PowerUseCase) eigenvectorFromDecomposition(ctx context.Context, matrix *mat.Dense, targetEigenvalue float64) ([]float64, error) {
 	slog.DebugContext(ctx, "Extracting eigenvector from matrix using eigenvalue decomposition",
 		slog.Float64("targetEigenvalue", targetEigenvalue),
 	)
@@ -401,7 +577,9 @@ func (u *PowerUseCase) extractEigenvectorFromMatrix(ctx context.Context, matrix
 	var eig mat.Eigen
 	ok := eig.Factorize(matrix, mat.EigenRight)
 	if !ok {
-		return nil, errors.New("eigenvalue decomposition failed")
+		rows, cols := matrix.Dims()
+		return nil, fmt.Errorf("%w for %dx%d matrix near eigenvalue %g",
+			ErrEigenDecompositionFailed, rows, cols, targetEigenvalue)
 	}
 
 	eigenvalues := eig.Values(nil)
@@ -438,8 +616,97 @@ func (u *PowerUseCase) extractEigenvectorFromMatrix(ctx context.Context, matrix
 	}
 
 	slog.DebugContext(ctx, "Extracted eigenvector",
-		slog.Any("eigenvector", eigenvector),
+		vectorAttr("eigenvector", eigenvector),
 	)
 
+	// Gonum may succeed while handing back a vector that is not an eigenvector,
+	// e.g. the real part of a complex one or garbage from non-finite entries
+	v := mat.NewVecDense(len(eigenvector), eigenvector)
+	var residual mat.VecDense
+	residual.MulVec(matrix, v)
+	residual.AddScaledVec(&residual, -real(eigenvalues[bestIndex]), v)
+
+	residualNorm := residual.Norm(2)
+	if !(residualNorm <= decompositionResidualTolerance*math.Max(1, mat.Norm(matrix, 2))) {
+		rows, cols := matrix.Dims()
+		return nil, fmt.Errorf("%w for %dx%d matrix near eigenvalue %g: residual %g",
+			ErrEigenDecompositionFailed, rows, cols, targetEigenvalue, residualNorm)
+	}
+
 	return eigenvector, nil
 }
+
+// inverseIterationEigenvector finds the eigenvector for targetEigenvalue by
+// repeatedly solving (A - σI)y = v with σ slightly off the eigenvalue. It
+// only needs A - σI to be invertible, so it also works on defective matrices
+// where the full decomposition is unreliable. The solves go through gonum's
+// mat.LU, only mat.Eigen is avoided.
+func (u *PowerUseCase) inverseIterationEigenvector(ctx context.Context, matrix *mat.Dense, targetEigenvalue float64) ([]float64, error) {
+	rows, cols := matrix.Dims()
+	if rows != cols {
+		return nil, fmt.Errorf("%w: %dx%d", ErrNonSquareMatrix, rows, cols)
+	}
+
+	sigma := targetEigenvalue + inverseIterationShift*math.Max(1, math.Abs(targetEigenvalue))
+
+	shifted := mat.NewDense(rows, cols, nil)
+	shifted.Copy(matrix)
+	for i := range rows {
+		shifted.Set(i, i, shifted.At(i, i)-sigma)
+	}
+
+	var lu mat.LU
+	lu.Factorize(shifted)
+
+	v := mat.NewVecDense(rows, nil)
+	for i := range rows {
+		v.SetVec(i, 1)
+	}
+	v.ScaleVec(1/v.Norm(2), v)
+
+	y := mat.NewVecDense(rows, nil)
+	for iteration := range inverseIterationMaxIterations {
+		if err := lu.SolveVecTo(y, false, v); err != nil {
+			var condition mat.Condition
+			if !errors.As(err, &condition) {
+				return nil, fmt.Errorf("%w for %dx%d matrix near eigenvalue %g: %w",
+					ErrInverseIterationFailed, rows, cols, targetEigenvalue, err)
+			}
+		}
+
+		norm := y.Norm(2)
+		if norm == 0 || math.IsNaN(norm) || math.IsInf(norm, 0) {
+			break
+		}
+		y.ScaleVec(1/norm, y)
+
+		// The sign may flip between iterations, compare both orientations
+		if mat.Dot(y, v) < 0 {
+			y.ScaleVec(-1, y)
+		}
+
+		var diff mat.VecDense
+		diff.SubVec(y, v)
+		v.CopyVec(y)
+
+		if diff.Norm(2) < inverseIterationTolerance {
+			slog.DebugContext(ctx, "Inverse iteration converged",
+				slog.Int("iterations", iteration+1),
+				vectorAttr("eigenvector", v.RawVector().Data),
+			)
+			return v.RawVector().Data, nil
+		}
+	}
+
+	// Accept a vector that is not stationary yet but already satisfies
+	// Av ≈ λv, as happens with very slow convergence on defective matrices
+	var residual mat.VecDense
+	residual.MulVec(matrix, v)
+	residual.AddScaledVec(&residual, -targetEigenvalue, v)
+	if residual.Norm(2) <= math.Sqrt(inverseIterationTolerance)*math.Max(1, math.Abs(targetEigenvalue)) {
+		return v.RawVector().Data, nil
+	}
+
+	return nil, fmt.Errorf("%w for %dx%d matrix near eigenvalue %g",
+		ErrInverseIterationFailed, rows, cols, targetEigenvalue)
+}
@@ -8,7 +8,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 type powerTestCase struct {
@@ -252,6 +255,10 @@ func TestNearestEigenvaluePowerMethod(t *testing.T) {
 }
 
 func matchVectorsWithTolerance(t *testing.T, expected, actual []float64, tolerance float64) {
+	// constructVector panics on empty vectors, so those fail the test instead
+	require.NotEmpty(t, expected)
+	require.Len(t, actual, len(expected))
+
 	actualVec := constructVector(actual)
 	normalizedActualVec := mat.NewVecDense(actualVec.Len(), nil)
 	normalizedActualVec.ScaleVec(1/actualVec.Norm(2), actualVec)
@@ -267,3 +274,437 @@ func matchVectorsWithTolerance(t *testing.T, expected, actual []float64, toleran
 			"Expected normalized value %v but got %v at index %d", expectedValue, actualValue, i)
 	}
 }
+
+func TestInverseIterationEigenvectorOnDefectiveMatrices(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name       string
+		matrix     [][]float64
+		eigenvalue float64
+	}{
+		{
+			name:       "Jordan block",
+			matrix:     [][]float64{{2, 1}, {0, 2}},
+			eigenvalue: 2,
+		},
+		{
+			name:       "3x3 Jordan block",
+			matrix:     [][]float64{{-1, 1, 0}, {0, -1, 1}, {0, 0, -1}},
+			eigenvalue: -1,
+		},
+		{
+			name:       "Defective block next to a simple eigenvalue",
+			matrix:     [][]float64{{3, 1, 0}, {0, 3, 0}, {0, 0, 5}},
+			eigenvalue: 3,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			u := NewPowerUseCase()
+			A := constructMatrix(test.matrix)
+
+			// Act
+			eigenvector, err := u.inverseIterationEigenvector(t.Context(), A, test.eigenvalue)
+
+			// Assert
+			assert.NoError(t, err)
+			v := mat.NewVecDense(len(eigenvector), eigenvector)
+			assert.InDelta(t, 1, v.Norm(2), 1e-12)
+
+			var residual mat.VecDense
+			residual.MulVec(A, v)
+			residual.AddScaledVec(&residual, -test.eigenvalue, v)
+			assert.Less(t, residual.Norm(2), 1e-6)
+		})
+	}
+}
+
+func TestExtractEigenvectorFromDefectiveMatrix(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	u := NewPowerUseCase()
+	A := constructMatrix([][]float64{{2, 1}, {0, 2}})
+
+	// Act
+	eigenvector, err := u.extractEigenvectorFromMatrix(t.Context(), A, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.InDelta(t, 1, math.Abs(eigenvector[0]), 1e-6)
+	assert.InDelta(t, 0, eigenvector[1], 1e-6)
+}
+
+func TestExtractEigenvectorReportsContext(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	u := NewPowerUseCase()
+	A := constructMatrix([][]float64{{math.NaN(), 1}, {0, 2}})
+
+	// Act
+	_, err := u.extractEigenvectorFromMatrix(t.Context(), A, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrEigenDecompositionFailed)
+	assert.ErrorIs(t, err, ErrInverseIterationFailed)
+	assert.ErrorContains(t, err, "2x2 matrix near eigenvalue 2")
+}
+
+func TestInversePowerRejectsSingularMatrices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		matrix [][]float64
+	}{
+		{name: "ZeroRow", matrix: [][]float64{{1, 2}, {0, 0}}},
+		{name: "DependentRows", matrix: [][]float64{{1, 2, 3}, {2, 4, 6}, {1, 0, 1}}},
+		{name: "Zero", matrix: [][]float64{{0, 0}, {0, 0}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			initialGuess := make([]float64, len(tc.matrix))
+			for i := range initialGuess {
+				initialGuess[i] = 1
+			}
+
+			// Act
+			result, err := useCase.InversePower(t.Context(), tc.matrix, initialGuess, 1e-6, 100)
+
+			// Assert
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, ErrSingularMatrix)
+			assert.ErrorContains(t, err, "nearest eigenvalue power method")
+		})
+	}
+}
+
+func TestInversePowerRejectsNonSquareMatrices(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+
+	// Act
+	_, err := useCase.InversePower(t.Context(), [][]float64{{1, 2, 3}, {4, 5, 6}}, []float64{1, 1, 1}, 1e-6, 100)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNonSquareMatrix)
+}
+
+func TestShiftedPowerMethodsRejectMismatchedGuess(t *testing.T) {
+	t.Parallel()
+
+	matrix := [][]float64{{10, 6, 7}, {1, 7, -2}, {2, 2, 2}}
+
+	tt := []struct {
+		name  string
+		solve func(u *PowerUseCase, guess []float64) (*PowerResult, error)
+	}{
+		{
+			name: "Farthest",
+			solve: func(u *PowerUseCase, guess []float64) (*PowerResult, error) {
+				return u.FarthestEigenvaluePower(t.Context(), matrix, guess, 0, 1e-6, 100)
+			},
+		},
+		{
+			name: "Nearest",
+			solve: func(u *PowerUseCase, guess []float64) (*PowerResult, error) {
+				return u.NearestEigenvaluePower(t.Context(), matrix, guess, 5, 1e-6, 100)
+			},
+		},
+	}
+
+	for _, test := range tt {
+		for _, guess := range [][]float64{{1, 1}, {1, 1, 1, 1}} {
+			t.Run(fmt.Sprintf("%s with %d components", test.name, len(guess)), func(t *testing.T) {
+				// Arrange
+				t.Parallel()
+				useCase := NewPowerUseCase()
+
+				// Act
+				result, err := test.solve(useCase, guess)
+
+				// Assert
+				assert.Nil(t, result)
+				assert.ErrorIs(t, err, ErrGuessDimensionMismatch)
+				assert.ErrorContains(t, err, "dimensions do not match")
+			})
+		}
+	}
+}
+
+func TestShiftedPowerMethodsRejectNonSquareMatrices(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+	matrix := [][]float64{{1, 2, 3}, {4, 5, 6}}
+
+	// Act
+	_, farthestErr := useCase.FarthestEigenvaluePower(t.Context(), matrix, []float64{1, 1, 1}, 0, 1e-6, 100)
+	_, nearestErr := useCase.NearestEigenvaluePower(t.Context(), matrix, []float64{1, 1, 1}, 0, 1e-6, 100)
+
+	// Assert
+	assert.ErrorIs(t, farthestErr, ErrNonSquareMatrix)
+	assert.ErrorIs(t, nearestErr, ErrNonSquareMatrix)
+}
+
+func TestNearestEigenvaluePowerWithShiftAtEigenvalue(t *testing.T) {
+	t.Parallel()
+
+	tests := []shiftedPowerTestCase{
+		{
+			matrix:              [][]float64{{2, 0}, {0, 3}},
+			initialGuess:        []float64{1, 1},
+			epsilon:             1e-10,
+			expectedEigenvalue:  2,
+			expectedEigenvector: []float64{1, 0},
+			k:                   2,
+		},
+		{
+			matrix:              [][]float64{{2, 3}, {5, 4}},
+			initialGuess:        []float64{1, 1},
+			epsilon:             1e-10,
+			expectedEigenvalue:  7,
+			expectedEigenvector: []float64{0.6, 1},
+			k:                   7,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%v", tc.matrix), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+
+			// Act
+			result, err := useCase.NearestEigenvaluePower(t.Context(), tc.matrix, tc.initialGuess, tc.k, tc.epsilon, 100)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.True(t, result.ShiftAtEigenvalue)
+			assert.InDelta(t, tc.expectedEigenvalue, result.Eigenvalue, 1e-6)
+			matchVectorsWithTolerance(t, tc.expectedEigenvector, result.Eigenvector, 1e-6)
+		})
+	}
+}
+
+func TestNearestEigenvaluePowerKeepsShiftAwayFromEigenvalues(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+
+	// Act
+	result, err := useCase.NearestEigenvaluePower(t.Context(), [][]float64{{2, 0}, {0, 3}}, []float64{1, 1}, 2.2, 1e-10, 100)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, result.ShiftAtEigenvalue)
+	assert.InDelta(t, 2, result.Eigenvalue, 1e-8)
+}
+
+func TestPowerMethodNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method    PowerMethod
+		name      string
+		label     string
+		usesShift bool
+	}{
+		{method: PowerMethodRegular, name: "regular", label: "Regular Power Method"},
+		{method: PowerMethodInverse, name: "inverse", label: "Inverse Power Method"},
+		{method: PowerMethodFarthest, name: "farthest", label: "Farthest Eigenvalue Power", usesShift: true},
+		{method: PowerMethodNearest, name: "nearest", label: "Nearest Eigenvalue Power", usesShift: true},
+		{method: PowerMethod(42), name: "PowerMethod(42)", label: "PowerMethod(42)"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			parsed, err := ParsePowerMethod(tc.method.String())
+
+			// Assert
+			assert.Equal(t, tc.name, tc.method.String())
+			assert.Equal(t, tc.label, tc.method.Describe())
+			assert.Equal(t, tc.usesShift, tc.method.UsesShift())
+			if tc.method == PowerMethod(42) {
+				assert.ErrorIs(t, err, ErrUnknownPowerMethod)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.method, parsed)
+		})
+	}
+}
+
+func TestPowerMethodsListsEveryMethodOnce(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	seen := make(map[string]bool)
+
+	// Act
+	methods := PowerMethods()
+
+	// Assert
+	assert.Len(t, methods, 4)
+	for _, method := range methods {
+		assert.False(t, seen[method.String()], "%s is listed twice", method)
+		seen[method.String()] = true
+	}
+}
+
+func TestSolveDispatchesEachMethod(t *testing.T) {
+	t.Parallel()
+
+	matrix := [][]float64{{10, 6, 7}, {1, 7, -2}, {2, 2, 2}}
+
+	tests := []struct {
+		method   PowerMethod
+		shift    float64
+		expected float64
+	}{
+		{method: PowerMethodRegular, expected: (13 + math.Sqrt(129)) / 2},
+		{method: PowerMethodInverse, expected: (13 - math.Sqrt(129)) / 2},
+		{method: PowerMethodFarthest, shift: 1, expected: (13 + math.Sqrt(129)) / 2},
+		{method: PowerMethodNearest, shift: 5, expected: 6},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			params := PowerParams{Shift: tc.shift, Epsilon: 1e-10, MaxIterations: 1000}
+
+			// Act
+			result, err := useCase.Solve(t.Context(), tc.method, matrix, params)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.InDelta(t, tc.expected, result.Eigenvalue, 1e-6)
+		})
+	}
+}
+
+func TestSolveRejectsUnknownMethod(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+
+	// Act
+	_, err := useCase.Solve(t.Context(), PowerMethod(-1), [][]float64{{1}}, PowerParams{})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownPowerMethod)
+}
+
+func TestRegularPowerRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		matrix       [][]float64
+		initialGuess []float64
+		expectedErr  error
+	}{
+		{name: "ZeroGuess", matrix: [][]float64{{2, 0}, {0, 1}}, initialGuess: []float64{0, 0}, expectedErr: ErrZeroInitialGuess},
+		{name: "EmptyGuess", matrix: [][]float64{{2, 0}, {0, 1}}, initialGuess: []float64{}, expectedErr: ErrEmptyInitialGuess},
+		{name: "EmptyMatrix", matrix: [][]float64{}, initialGuess: []float64{1}, expectedErr: ErrEmptyMatrix},
+		{name: "GuessDimension", matrix: [][]float64{{2, 0}, {0, 1}}, initialGuess: []float64{1, 1, 1}, expectedErr: ErrGuessDimensionMismatch},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+
+			// Act
+			_, err := useCase.Solve(t.Context(), PowerMethodRegular, test.matrix, PowerParams{
+				InitialGuess:  test.initialGuess,
+				Epsilon:       1e-6,
+				MaxIterations: 100,
+			})
+			wrapped := fmt.Errorf("error solving: %w", err)
+
+			// Assert
+			assert.ErrorIs(t, wrapped, test.expectedErr)
+			assert.ErrorIs(t, wrapped, numeerr.ErrInvalidInput)
+			assert.NotErrorIs(t, wrapped, numeerr.ErrFailed)
+		})
+	}
+}
+
+func TestPowerMethodsRejectEmptyInitialGuess(t *testing.T) {
+	t.Parallel()
+
+	matrix := [][]float64{{2, 1}, {1, 3}}
+
+	for _, method := range PowerMethods() {
+		t.Run(method.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			params := PowerParams{InitialGuess: []float64{}, Shift: 1, Epsilon: 1e-6, MaxIterations: 100}
+
+			// Act
+			var err error
+			assert.NotPanics(t, func() {
+				_, err = useCase.Solve(t.Context(), method, matrix, params)
+			})
+
+			// Assert
+			assert.ErrorIs(t, err, ErrEmptyInitialGuess)
+		})
+	}
+}
+
+func TestRegularPowerRejectsEmptyInitialGuess(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewPowerUseCase()
+
+	// Act
+	var result *PowerResult
+	var err error
+	assert.NotPanics(t, func() {
+		result, err = useCase.RegularPower(t.Context(), [][]float64{{2}}, []float64{}, 1e-6, 100)
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrEmptyInitialGuess)
+	assert.ErrorContains(t, err, "initial guess cannot be empty")
+}
+
+func TestPowerMethodsOnSingleElementMatrices(t *testing.T) {
+	t.Parallel()
+
+	for _, method := range PowerMethods() {
+		t.Run(method.String(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewPowerUseCase()
+			params := PowerParams{InitialGuess: []float64{-3}, Shift: 1, Epsilon: 1e-10, MaxIterations: 100}
+
+			// Act
+			result, err := useCase.Solve(t.Context(), method, [][]float64{{5}}, params)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, 5, result.Eigenvalue, 1e-9)
+			require.Len(t, result.Eigenvector, 1)
+			assert.InDelta(t, 1, math.Abs(result.Eigenvector[0]), 1e-12)
+		})
+	}
+}
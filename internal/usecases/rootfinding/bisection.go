@@ -0,0 +1,94 @@
+package rootfinding
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+// BisectionStrategy halves an interval whose ends have opposite signs,
+// keeping the half that still brackets the root. It always converges, gaining
+// one bit of the root per iteration.
+type BisectionStrategy struct{}
+
+// Description implements RootFindingStrategy.
+func (*BisectionStrategy) Description() string {
+	return "Bisection"
+}
+
+// FindRoot implements RootFindingStrategy.
+func (*BisectionStrategy) FindRoot(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	left float64,
+	right float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+) (*RootResult, error) {
+	if left > right {
+		left, right = right, left
+	}
+
+	fLeft, fRight := simpleExpr(left), simpleExpr(right)
+	if err := checkFinite(left, fLeft); err != nil {
+		return nil, err
+	}
+	if err := checkFinite(right, fRight); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case fLeft == 0:
+		return &RootResult{Root: left, Converged: true}, nil
+	case fRight == 0:
+		return &RootResult{Root: right, Converged: true}, nil
+	case math.Signbit(fLeft) == math.Signbit(fRight):
+		return nil, fmt.Errorf("%w: f(%v) = %v, f(%v) = %v", ErrRootNotBracketed, left, fLeft, right, fRight)
+	}
+
+	result := &RootResult{}
+	for result.Iterations < maxNumberOfIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result.Iterations++
+
+		middle := left + (right-left)/2
+		fMiddle := simpleExpr(middle)
+		if err := checkFinite(middle, fMiddle); err != nil {
+			return nil, err
+		}
+
+		if math.Signbit(fMiddle) == math.Signbit(fLeft) {
+			left, fLeft = middle, fMiddle
+		} else {
+			right = middle
+		}
+
+		result.Root = middle
+		result.Error = (right - left) / 2
+
+		slog.DebugContext(ctx, "Bisection iteration",
+			slog.Uint64("iteration", result.Iterations),
+			slog.Float64("middle", middle),
+			slog.Float64("fMiddle", fMiddle),
+			slog.Float64("error", result.Error),
+		)
+
+		if fMiddle == 0 {
+			result.Error = 0
+			result.Converged = true
+			break
+		}
+		if result.Error < epsilon {
+			result.Root = left + (right-left)/2
+			result.Converged = true
+			break
+		}
+	}
+
+	return result, nil
+}
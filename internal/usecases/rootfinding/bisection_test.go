@@ -0,0 +1,53 @@
+package rootfinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBisectionRejectsUnbracketedRoots(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	strategy := &BisectionStrategy{}
+	square := func(x float64) float64 { return x*x + 1 }
+
+	// Act
+	_, err := strategy.FindRoot(context.Background(), square, -1, 1, 1e-10, 100)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrRootNotBracketed)
+}
+
+func TestBisectionAcceptsReversedAndExactEnds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		left, right  float64
+		expectedRoot float64
+	}{
+		{name: "Reversed", left: 3, right: 0, expectedRoot: 2},
+		{name: "RootAtLeft", left: 2, right: 5, expectedRoot: 2},
+		{name: "RootAtRight", left: 0, right: 2, expectedRoot: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			strategy := &BisectionStrategy{}
+			linear := func(x float64) float64 { return x - 2 }
+
+			// Act
+			result, err := strategy.FindRoot(context.Background(), linear, test.left, test.right, 1e-12, 100)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, result.Converged)
+			assert.InDelta(t, test.expectedRoot, result.Root, 1e-12)
+		})
+	}
+}
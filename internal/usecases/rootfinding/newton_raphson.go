@@ -0,0 +1,94 @@
+package rootfinding
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+// NewtonRaphsonStrategy follows the tangent of the expression from the
+// middle of the interval, its derivative estimated by a finite difference.
+// Near a simple root it doubles the correct digits on every iteration, but
+// it may diverge from a poor start.
+type NewtonRaphsonStrategy struct {
+	difference usecases.DifferenceStrategy
+	delta      float64
+}
+
+// NewNewtonRaphsonStrategy returns a Newton-Raphson strategy differentiating
+// with difference and step delta.
+func NewNewtonRaphsonStrategy(difference usecases.DifferenceStrategy, delta float64) *NewtonRaphsonStrategy {
+	return &NewtonRaphsonStrategy{
+		difference: difference,
+		delta:      delta,
+	}
+}
+
+// Description implements RootFindingStrategy.
+func (*NewtonRaphsonStrategy) Description() string {
+	return "Newton-Raphson"
+}
+
+// FindRoot implements RootFindingStrategy.
+func (s *NewtonRaphsonStrategy) FindRoot(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	left float64,
+	right float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+) (*RootResult, error) {
+	derivative, err := s.difference.Derivative(ctx, simpleExpr, s.delta)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RootResult{Root: left + (right-left)/2, Error: math.Inf(1)}
+	for result.Iterations < maxNumberOfIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		x := result.Root
+		fx := simpleExpr(x)
+		if err := checkFinite(x, fx); err != nil {
+			return nil, err
+		}
+		if fx == 0 {
+			result.Error = 0
+			result.Converged = true
+			break
+		}
+
+		slope := derivative(x)
+		if slope == 0 {
+			return nil, fmt.Errorf("%w: at %v", ErrZeroDerivative, x)
+		}
+
+		result.Iterations++
+		result.Root = x - fx/slope
+		result.Error = math.Abs(result.Root - x)
+
+		slog.DebugContext(ctx, "Newton-Raphson iteration",
+			slog.Uint64("iteration", result.Iterations),
+			slog.Float64("x", result.Root),
+			slog.Float64("slope", slope),
+			slog.Float64("error", result.Error),
+		)
+
+		if result.Error < epsilon {
+			result.Converged = true
+			break
+		}
+	}
+
+	if err := checkFinite(result.Root, 0); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
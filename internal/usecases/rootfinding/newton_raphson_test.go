@@ -0,0 +1,62 @@
+package rootfinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func TestNewtonRaphsonRejectsInvalidDerivatives(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		delta       float64
+		left, right float64
+		expectedErr error
+	}{
+		{name: "FlatStart", delta: 1e-6, left: -1, right: 1, expectedErr: ErrZeroDerivative},
+		{name: "ZeroDelta", delta: 0, left: 1, right: 2, expectedErr: usecases.ErrDeltaIsZero},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			strategy := NewNewtonRaphsonStrategy(&usecases.CentralDifferenceStrategy{}, test.delta)
+			square := func(x float64) float64 { return x*x - 2 }
+
+			// Act
+			_, err := strategy.FindRoot(context.Background(), square, test.left, test.right, 1e-10, 100)
+
+			// Assert
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestNewtonRaphsonWithEveryDifference(t *testing.T) {
+	t.Parallel()
+
+	for _, philosophy := range []string{"forward", "backward", "central"} {
+		t.Run(philosophy, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			difference, err := usecases.ParseDifferenceStrategy(philosophy)
+			assert.NoError(t, err)
+			strategy := NewNewtonRaphsonStrategy(difference, 1e-7)
+			square := func(x float64) float64 { return x*x - 2 }
+
+			// Act
+			result, err := strategy.FindRoot(context.Background(), square, 1, 2, 1e-10, 100)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.True(t, result.Converged)
+			assert.InDelta(t, 1.4142135623730951, result.Root, 1e-9)
+		})
+	}
+}
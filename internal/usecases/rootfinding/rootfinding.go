@@ -0,0 +1,121 @@
+package rootfinding
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrNonPositiveEpsilon = numeerr.New(numeerr.CodeInvalidInput, "epsilon must be positive")
+	ErrNoIterations       = numeerr.New(numeerr.CodeInvalidInput, "at least one iteration is needed to find a root")
+	ErrNonFiniteInterval  = numeerr.New(numeerr.CodeInvalidInput, "interval ends must be finite")
+	ErrRootNotBracketed   = numeerr.New(numeerr.CodeDomain, "function has the same sign at both interval ends")
+	ErrZeroDerivative     = numeerr.New(numeerr.CodeDomain, "derivative is zero, the tangent never crosses the axis")
+	ErrFlatSecant         = numeerr.New(numeerr.CodeDomain, "function has the same value at both secant points")
+	ErrNonFiniteIterate   = numeerr.New(numeerr.CodeDomain, "iterate is not finite")
+)
+
+// RootFindingStrategy looks for a root of an expression starting from the
+// interval [left, right]. Bracketing methods keep the root inside it, open
+// methods only start from it and may leave it.
+type RootFindingStrategy interface {
+	FindRoot(
+		ctx context.Context,
+		simpleExpr expressions.SingleVariableExpr,
+		left float64,
+		right float64,
+		epsilon float64,
+		maxNumberOfIterations uint64,
+	) (*RootResult, error)
+	Description() string // Returns a description of the strategy (e.g., "Bisection")
+}
+
+var (
+	_ RootFindingStrategy = (*BisectionStrategy)(nil)
+	_ RootFindingStrategy = (*NewtonRaphsonStrategy)(nil)
+	_ RootFindingStrategy = (*SecantStrategy)(nil)
+)
+
+// RootResult is the outcome of a root search. Error is the last estimate of
+// the distance to the root, half the bracket for bisection and the last step
+// for the open methods. Converged is false when the iterations ran out
+// before Error fell below epsilon, Root is then the last iterate.
+type RootResult struct {
+	Root       float64
+	Iterations uint64
+	Error      float64
+	Converged  bool
+}
+
+type RootFindingUseCase struct {
+	strategy RootFindingStrategy
+}
+
+func NewRootFindingUseCase(strategy RootFindingStrategy) *RootFindingUseCase {
+	return &RootFindingUseCase{
+		strategy: strategy,
+	}
+}
+
+// FindRoot validates the search parameters and runs the strategy.
+func (u *RootFindingUseCase) FindRoot(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	left float64,
+	right float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+) (*RootResult, error) {
+	slog.DebugContext(ctx, "Starting the root search",
+		slog.String("strategy", u.strategy.Description()),
+		slog.Float64("left", left),
+		slog.Float64("right", right),
+		slog.Float64("epsilon", epsilon),
+		slog.Uint64("maxNumberOfIterations", maxNumberOfIterations),
+	)
+
+	switch {
+	case !(epsilon > 0):
+		return nil, fmt.Errorf("%w: got %v", ErrNonPositiveEpsilon, epsilon)
+	case maxNumberOfIterations == 0:
+		return nil, ErrNoIterations
+	case math.IsNaN(left) || math.IsInf(left, 0) || math.IsNaN(right) || math.IsInf(right, 0):
+		return nil, fmt.Errorf("%w: [%v, %v]", ErrNonFiniteInterval, left, right)
+	}
+
+	result, err := u.strategy.FindRoot(ctx, simpleExpr, left, right, epsilon, maxNumberOfIterations)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to find a root", slog.String("strategy", u.strategy.Description()), slog.Any("error", err))
+		return nil, err
+	}
+
+	if !result.Converged {
+		slog.WarnContext(ctx, "Root search did not converge",
+			slog.Float64("root", result.Root),
+			slog.Float64("error", result.Error),
+		)
+	}
+
+	slog.InfoContext(ctx, "Root search completed",
+		slog.Float64("root", result.Root),
+		slog.Uint64("iterations", result.Iterations),
+		slog.Float64("error", result.Error),
+	)
+
+	return result, nil
+}
+
+// checkFinite returns ErrNonFiniteIterate when x or its image fx is NaN or
+// infinite, which would only propagate through the next iterations.
+func checkFinite(x, fx float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) || math.IsNaN(fx) || math.IsInf(fx, 0) {
+		return fmt.Errorf("%w: f(%v) = %v", ErrNonFiniteIterate, x, fx)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package rootfinding
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+	"github.com/taldoflemis/nume/internal/numeerr"
+	"github.com/taldoflemis/nume/internal/usecases"
+)
+
+func strategies() []RootFindingStrategy {
+	return []RootFindingStrategy{
+		&BisectionStrategy{},
+		NewNewtonRaphsonStrategy(&usecases.CentralDifferenceStrategy{}, 1e-6),
+		&SecantStrategy{},
+	}
+}
+
+func TestFindRootConverges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		simpleExpr   expressions.SingleVariableExpr
+		left, right  float64
+		expectedRoot float64
+	}{
+		{
+			name:         "SquareRootOfTwo",
+			simpleExpr:   func(x float64) float64 { return x*x - 2 },
+			left:         1,
+			right:        2,
+			expectedRoot: math.Sqrt2,
+		},
+		{
+			name:         "Cosine",
+			simpleExpr:   func(x float64) float64 { return math.Cos(x) - x },
+			left:         0,
+			right:        1,
+			expectedRoot: 0.7390851332151607,
+		},
+		{
+			name:         "Cubic",
+			simpleExpr:   func(x float64) float64 { return x*x*x - x - 2 },
+			left:         1,
+			right:        2,
+			expectedRoot: 1.5213797068045676,
+		},
+	}
+
+	for _, strategy := range strategies() {
+		for _, test := range tests {
+			t.Run(strategy.Description()+"/"+test.name, func(t *testing.T) {
+				// Arrange
+				t.Parallel()
+				useCase := NewRootFindingUseCase(strategy)
+
+				// Act
+				result, err := useCase.FindRoot(context.Background(), test.simpleExpr, test.left, test.right, 1e-10, 200)
+
+				// Assert
+				require.NoError(t, err)
+				assert.True(t, result.Converged)
+				assert.InDelta(t, test.expectedRoot, result.Root, 1e-8)
+				assert.Less(t, result.Error, 1e-10)
+				assert.Positive(t, result.Iterations)
+			})
+		}
+	}
+}
+
+func TestFindRootOpenMethodsNeedFewerIterations(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	square := func(x float64) float64 { return x*x - 2 }
+	iterations := map[string]uint64{}
+
+	// Act
+	for _, strategy := range strategies() {
+		result, err := NewRootFindingUseCase(strategy).FindRoot(context.Background(), square, 1, 2, 1e-12, 200)
+		require.NoError(t, err)
+		iterations[strategy.Description()] = result.Iterations
+	}
+
+	// Assert
+	assert.Less(t, iterations["Newton-Raphson"], iterations["Bisection"])
+	assert.Less(t, iterations["Secant"], iterations["Bisection"])
+}
+
+func TestFindRootRunsOutOfIterations(t *testing.T) {
+	t.Parallel()
+
+	for _, strategy := range strategies() {
+		t.Run(strategy.Description(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			square := func(x float64) float64 { return x*x - 2 }
+
+			// Act
+			result, err := NewRootFindingUseCase(strategy).FindRoot(context.Background(), square, 1, 2, 1e-15, 2)
+
+			// Assert
+			require.NoError(t, err)
+			assert.False(t, result.Converged)
+			assert.Equal(t, uint64(2), result.Iterations)
+			assert.Greater(t, result.Error, 1e-15)
+		})
+	}
+}
+
+func TestFindRootRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		left, right   float64
+		epsilon       float64
+		maxIterations uint64
+		expectedErr   error
+	}{
+		{name: "ZeroEpsilon", left: 1, right: 2, epsilon: 0, maxIterations: 10, expectedErr: ErrNonPositiveEpsilon},
+		{name: "NaNEpsilon", left: 1, right: 2, epsilon: math.NaN(), maxIterations: 10, expectedErr: ErrNonPositiveEpsilon},
+		{name: "NoIterations", left: 1, right: 2, epsilon: 1e-6, maxIterations: 0, expectedErr: ErrNoIterations},
+		{name: "InfiniteEnd", left: 1, right: math.Inf(1), epsilon: 1e-6, maxIterations: 10, expectedErr: ErrNonFiniteInterval},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewRootFindingUseCase(&BisectionStrategy{})
+			square := func(x float64) float64 { return x*x - 2 }
+
+			// Act
+			_, err := useCase.FindRoot(context.Background(), square, test.left, test.right, test.epsilon, test.maxIterations)
+
+			// Assert
+			require.ErrorIs(t, err, test.expectedErr)
+			assert.ErrorIs(t, err, numeerr.ErrInvalidInput)
+		})
+	}
+}
+
+func TestFindRootRejectsNonFiniteIterates(t *testing.T) {
+	t.Parallel()
+
+	for _, strategy := range strategies() {
+		t.Run(strategy.Description(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			logarithm := func(x float64) float64 { return math.Log(x) }
+
+			// Act
+			_, err := NewRootFindingUseCase(strategy).FindRoot(context.Background(), logarithm, -3, 2, 1e-10, 100)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrNonFiniteIterate)
+		})
+	}
+}
+
+func TestFindRootStopsOnCancellation(t *testing.T) {
+	t.Parallel()
+
+	for _, strategy := range strategies() {
+		t.Run(strategy.Description(), func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			square := func(x float64) float64 { return x*x - 2 }
+
+			// Act
+			_, err := NewRootFindingUseCase(strategy).FindRoot(ctx, square, 1, 2, 1e-10, 100)
+
+			// Assert
+			assert.ErrorIs(t, err, context.Canceled)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package rootfinding
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/taldoflemis/nume/internal/expressions"
+)
+
+// SecantStrategy is Newton-Raphson with the tangent replaced by the line
+// through the last two iterates, starting from the interval ends. It needs
+// no derivative and converges with order about 1.618 near a simple root.
+type SecantStrategy struct{}
+
+// Description implements RootFindingStrategy.
+func (*SecantStrategy) Description() string {
+	return "Secant"
+}
+
+// FindRoot implements RootFindingStrategy.
+func (*SecantStrategy) FindRoot(
+	ctx context.Context,
+	simpleExpr expressions.SingleVariableExpr,
+	left float64,
+	right float64,
+	epsilon float64,
+	maxNumberOfIterations uint64,
+) (*RootResult, error) {
+	previous, current := left, right
+	fPrevious := simpleExpr(previous)
+	if err := checkFinite(previous, fPrevious); err != nil {
+		return nil, err
+	}
+
+	result := &RootResult{Root: current, Error: math.Abs(current - previous)}
+	for result.Iterations < maxNumberOfIterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		fCurrent := simpleExpr(current)
+		if err := checkFinite(current, fCurrent); err != nil {
+			return nil, err
+		}
+		if fCurrent == 0 {
+			result.Error = 0
+			result.Converged = true
+			break
+		}
+		if fCurrent == fPrevious {
+			return nil, fmt.Errorf("%w: f(%v) = f(%v) = %v", ErrFlatSecant, previous, current, fCurrent)
+		}
+
+		next := current - fCurrent*(current-previous)/(fCurrent-fPrevious)
+		previous, fPrevious, current = current, fCurrent, next
+
+		result.Iterations++
+		result.Root = current
+		result.Error = math.Abs(current - previous)
+
+		slog.DebugContext(ctx, "Secant iteration",
+			slog.Uint64("iteration", result.Iterations),
+			slog.Float64("x", current),
+			slog.Float64("error", result.Error),
+		)
+
+		if result.Error < epsilon {
+			result.Converged = true
+			break
+		}
+	}
+
+	if err := checkFinite(result.Root, 0); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
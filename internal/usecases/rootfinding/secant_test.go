@@ -0,0 +1,21 @@
+package rootfinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecantRejectsFlatSecants(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	strategy := &SecantStrategy{}
+	square := func(x float64) float64 { return x*x - 2 }
+
+	// Act
+	_, err := strategy.FindRoot(context.Background(), square, -1, 1, 1e-10, 100)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrFlatSecant)
+}
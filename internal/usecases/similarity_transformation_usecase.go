@@ -7,6 +7,8 @@ import (
 	"math"
 
 	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
 )
 
 type (
@@ -18,6 +20,18 @@ func NewSimilarityTransformationUseCase() *SimilarityTransformationUseCase {
 	return &SimilarityTransformationUseCase{}
 }
 
+// ShiftStrategy selects the shift applied to each QR iteration, shifts speed up
+// the convergence of the subdiagonal entries.
+type ShiftStrategy string
+
+const (
+	ShiftNone      ShiftStrategy = "none"
+	ShiftRayleigh  ShiftStrategy = "rayleigh"
+	ShiftWilkinson ShiftStrategy = "wilkinson"
+)
+
+var ErrUnknownShiftStrategy = numeerr.New(numeerr.CodeUnsupported, "unknown shift strategy")
+
 type HouseholderMethodResult struct {
 	HouseholderMatrix  *mat.Dense
 	TriangulizedMatrix *mat.Dense
@@ -26,11 +40,14 @@ type HouseholderMethodResult struct {
 type QRMethodResult struct {
 	Eigenvalues  []float64
 	Eigenvectors *mat.Dense
+	// Iterations is the number of QR steps performed, zero when the matrix
+	// structure allowed reading the eigenvalues directly
+	Iterations int
 }
 
 func (u *SimilarityTransformationUseCase) householderSimetricMatrix(ctx context.Context, A *mat.Dense, j int) (*mat.Dense, error) {
 	slog.DebugContext(ctx, "Starting householderSimetricMatrix",
-		slog.Any("matrix", A.RawMatrix().Data),
+		denseAttr("matrix", A),
 		slog.Int("j", j),
 	)
 	
@@ -43,7 +60,7 @@ func (u *SimilarityTransformationUseCase) householderSimetricMatrix(ctx context.
 	}
 
 	slog.DebugContext(ctx, "w vector after initialization",
-		slog.Any("w", w.RawVector().Data),
+		vectorAttr("w", w.RawVector().Data),
 	)
 
 	// Calculate the norm of w
@@ -77,7 +94,7 @@ func (u *SimilarityTransformationUseCase) householderSimetricMatrix(ctx context.
 	v.ScaleVec(1.0/vNorm, v)
 
 	slog.DebugContext(ctx, "Normalized v vector",
-		slog.Any("v", v.RawVector().Data),
+		vectorAttr("v", v.RawVector().Data),
 	)
 
 	// Create Householder matrix H = I - 2*v*v^T
@@ -89,7 +106,7 @@ func (u *SimilarityTransformationUseCase) householderSimetricMatrix(ctx context.
 	householderMatrix.Sub(householderMatrix, vvT)
 
 	slog.InfoContext(ctx, "Finished householderSimetricMatrix for step j",
-		slog.Any("householderMatrix", householderMatrix.RawMatrix().Data),
+		denseAttr("householderMatrix", householderMatrix),
 		slog.Int("j", j),
 	)
 
@@ -98,7 +115,7 @@ func (u *SimilarityTransformationUseCase) householderSimetricMatrix(ctx context.
 
 func (u *SimilarityTransformationUseCase) HouseholderMethod(ctx context.Context, matrix [][]float64) (*HouseholderMethodResult, error) {
 	slog.DebugContext(ctx, "Starting HouseholderMethod",
-		slog.Any("matrix", matrix),
+		matrixAttr("matrix", matrix),
 	)
 
 	n := len(matrix)
@@ -111,8 +128,8 @@ func (u *SimilarityTransformationUseCase) HouseholderMethod(ctx context.Context,
 	// We create and iterate through the Householder matrices
 	for i := 0; i < n-2; i++ {
 		slog.DebugContext(ctx, "Iteration in householderMethod", slog.Int("i", i),
-			slog.Any("aMinus1", aMinus1.RawMatrix().Data),
-			slog.Any("householderMatrix", householderMatrix.RawMatrix().Data),
+			denseAttr("aMinus1", aMinus1),
+			denseAttr("householderMatrix", householderMatrix),
 		)
 
 		householderMatrixI, err := u.householderSimetricMatrix(ctx, aMinus1, i)
@@ -136,8 +153,8 @@ func (u *SimilarityTransformationUseCase) HouseholderMethod(ctx context.Context,
 	}
 
 	slog.InfoContext(ctx, "Finished householderMethod",
-		slog.Any("householderMatrix", householderMatrix.RawMatrix().Data),
-		slog.Any("TriangulizedMatrix", aMinus1.RawMatrix().Data),
+		denseAttr("householderMatrix", householderMatrix),
+		denseAttr("TriangulizedMatrix", aMinus1),
 	)
 
 	return &HouseholderMethodResult{
@@ -146,11 +163,31 @@ func (u *SimilarityTransformationUseCase) HouseholderMethod(ctx context.Context,
 	}, nil
 }
 
-func (u *SimilarityTransformationUseCase) QRMethod(ctx context.Context, tridiagonalMatrix *mat.Dense, householderMatrix *mat.Dense, maxIterations int, tolerance float64) (*QRMethodResult, error) {
+// QRMethod iterates QR decompositions on the tridiagonal matrix until it is
+// diagonal. An empty shiftStrategy defaults to the Wilkinson shift.
+func (u *SimilarityTransformationUseCase) QRMethod(ctx context.Context, tridiagonalMatrix *mat.Dense, householderMatrix *mat.Dense, maxIterations int, tolerance float64, shiftStrategy ShiftStrategy) (*QRMethodResult, error) {
 	slog.DebugContext(ctx, "Starting QR Method",
-		slog.Any("tridiagonalMatrix", tridiagonalMatrix.RawMatrix().Data),
+		denseAttr("tridiagonalMatrix", tridiagonalMatrix),
+		slog.String("shiftStrategy", string(shiftStrategy)),
 	)
 
+	if shiftStrategy == "" {
+		shiftStrategy = ShiftWilkinson
+	}
+
+	var computeShift func(A *mat.Dense, m int) float64
+	switch shiftStrategy {
+	case ShiftNone:
+		computeShift = func(*mat.Dense, int) float64 { return 0 }
+	case ShiftRayleigh:
+		computeShift = rayleighShift
+	case ShiftWilkinson:
+		computeShift = wilkinsonShift
+	default:
+		slog.ErrorContext(ctx, "Unknown shift strategy", slog.String("shiftStrategy", string(shiftStrategy)))
+		return nil, fmt.Errorf("%w: %q", ErrUnknownShiftStrategy, shiftStrategy)
+	}
+
 	n := tridiagonalMatrix.RawMatrix().Rows
 	A := mat.NewDense(n, n, nil)
 	A.Copy(tridiagonalMatrix)
@@ -159,14 +196,16 @@ func (u *SimilarityTransformationUseCase) QRMethod(ctx context.Context, tridiago
 	V := mat.NewDense(n, n, nil)
 	V.Copy(householderMatrix)
 
+	iterations := 0
 	for iter := 0; iter < maxIterations; iter++ {
 		// Check for convergence
 		if isConverged(A, tolerance) {
 			break
 		}
 
-		// Wilkinson shift for better convergence
-		shift := wilkinsonShift(A)
+		// Shift towards the trailing block that hasn't converged yet, so the
+		// shift keeps improving once the last eigenvalue is found
+		shift := computeShift(A, activeBlockEnd(A, tolerance))
 		
 		// Shift the matrix
 		for i := 0; i < n; i++ {
@@ -186,6 +225,7 @@ func (u *SimilarityTransformationUseCase) QRMethod(ctx context.Context, tridiago
 		var temp mat.Dense
 		temp.Mul(V, Q)
 		V.Copy(&temp)
+		iterations++
 
 		slog.DebugContext(ctx, "QR iteration", 
 			slog.Int("iteration", iter),
@@ -200,12 +240,14 @@ func (u *SimilarityTransformationUseCase) QRMethod(ctx context.Context, tridiago
 	}
 
 	slog.InfoContext(ctx, "Finished QR Method",
-		slog.Any("eigenvalues", eigenvalues),
+		vectorAttr("eigenvalues", eigenvalues),
+		slog.Int("iterations", iterations),
 	)
 
 	return &QRMethodResult{
 		Eigenvalues:  eigenvalues,
 		Eigenvectors: V,
+		Iterations:   iterations,
 	}, nil
 }
 
@@ -213,11 +255,20 @@ func (u *SimilarityTransformationUseCase) QRMethod(ctx context.Context, tridiago
 // It combines Householder tridiagonalization and QR iteration to find all eigenvalues and eigenvectors
 func (u *SimilarityTransformationUseCase) CompleteEigenDecomposition(ctx context.Context, matrix [][]float64, maxIterations int, tolerance float64) (*QRMethodResult, error) {
 	slog.InfoContext(ctx, "Starting complete eigenvalue decomposition",
-		slog.Any("matrix", matrix),
+		matrixAttr("matrix", matrix),
 		slog.Int("maxIterations", maxIterations),
 		slog.Float64("tolerance", tolerance),
 	)
 
+	// Diagonal and triangular matrices already carry their eigenvalues on the
+	// diagonal, so the iterative methods can be skipped
+	if result, ok := triangularEigenDecomposition(matrix, tolerance); ok {
+		slog.InfoContext(ctx, "Matrix is triangular, skipping QR iterations",
+			vectorAttr("eigenvalues", result.Eigenvalues),
+		)
+		return result, nil
+	}
+
 	// Step 1: Apply Householder method to reduce to tridiagonal form
 	householderResult, err := u.HouseholderMethod(ctx, matrix)
 	if err != nil {
@@ -228,14 +279,14 @@ func (u *SimilarityTransformationUseCase) CompleteEigenDecomposition(ctx context
 	slog.InfoContext(ctx, "Householder method completed successfully")
 
 	// Step 2: Apply QR method to find eigenvalues and eigenvectors
-	qrResult, err := u.QRMethod(ctx, householderResult.TriangulizedMatrix, householderResult.HouseholderMatrix, maxIterations, tolerance)
+	qrResult, err := u.QRMethod(ctx, householderResult.TriangulizedMatrix, householderResult.HouseholderMatrix, maxIterations, tolerance, ShiftWilkinson)
 	if err != nil {
 		slog.ErrorContext(ctx, "Error in QR method", slog.Any("error", err))
 		return nil, fmt.Errorf("QR method failed: %w", err)
 	}
 
 	slog.InfoContext(ctx, "Complete eigenvalue decomposition finished successfully",
-		slog.Any("eigenvalues", qrResult.Eigenvalues),
+		vectorAttr("eigenvalues", qrResult.Eigenvalues),
 	)
 
 	return qrResult, nil
@@ -326,17 +377,34 @@ func isConverged(A *mat.Dense, tolerance float64) bool {
 	return true
 }
 
-func wilkinsonShift(A *mat.Dense) float64 {
+// activeBlockEnd returns the last row whose subdiagonal entry hasn't converged.
+func activeBlockEnd(A *mat.Dense, tolerance float64) int {
 	n := A.RawMatrix().Rows
-	if n < 2 {
+	for m := n - 1; m > 0; m-- {
+		if math.Abs(A.At(m, m-1)) > tolerance {
+			return m
+		}
+	}
+	return n - 1
+}
+
+// rayleighShift uses the diagonal entry at row m, the Rayleigh quotient of the
+// m-th basis vector.
+func rayleighShift(A *mat.Dense, m int) float64 {
+	return A.At(m, m)
+}
+
+// wilkinsonShift uses the eigenvalue of the 2x2 block ending at row m closest
+// to its bottom-right entry.
+func wilkinsonShift(A *mat.Dense, m int) float64 {
+	if m < 1 {
 		return 0
 	}
 	
-	// Use the bottom-right 2x2 submatrix for Wilkinson shift
-	a := A.At(n-2, n-2)
-	b := A.At(n-2, n-1)
-	c := A.At(n-1, n-2)
-	d := A.At(n-1, n-1)
+	a := A.At(m-1, m-1)
+	b := A.At(m-1, m)
+	c := A.At(m, m-1)
+	d := A.At(m, m)
 	
 	trace := a + d
 	det := a*d - b*c
@@ -357,6 +425,101 @@ func wilkinsonShift(A *mat.Dense) float64 {
 	return lambda2
 }
 
+// triangularEigenDecomposition reads the eigenvalues of a diagonal or triangular
+// matrix off its diagonal and solves (A - λI)v = 0 by substitution for each
+// eigenvector. It reports false when the matrix has no such structure or is
+// defective, in which case the full computation should be used.
+func triangularEigenDecomposition(matrix [][]float64, tolerance float64) (*QRMethodResult, bool) {
+	n := len(matrix)
+	if n == 0 {
+		return nil, false
+	}
+	for _, row := range matrix {
+		if len(row) != n {
+			return nil, false
+		}
+	}
+
+	upper, lower := true, true
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if math.Abs(matrix[i][j]) <= tolerance {
+				continue
+			}
+			if i > j {
+				upper = false
+			}
+			if i < j {
+				lower = false
+			}
+		}
+	}
+
+	if !upper && !lower {
+		return nil, false
+	}
+
+	eigenvalues := make([]float64, n)
+	for i := 0; i < n; i++ {
+		eigenvalues[i] = matrix[i][i]
+	}
+
+	if upper && lower {
+		return &QRMethodResult{
+			Eigenvalues:  eigenvalues,
+			Eigenvectors: generateIdentityMatrix(n),
+		}, true
+	}
+
+	eigenvectors := mat.NewDense(n, n, nil)
+	for k, lambda := range eigenvalues {
+		v := make([]float64, n)
+		v[k] = 1
+
+		// Upper triangular systems are solved bottom-up from row k, lower
+		// triangular ones top-down
+		rows := make([]int, 0, n)
+		if upper {
+			for i := k - 1; i >= 0; i-- {
+				rows = append(rows, i)
+			}
+		} else {
+			for i := k + 1; i < n; i++ {
+				rows = append(rows, i)
+			}
+		}
+
+		for _, i := range rows {
+			sum := 0.0
+			for j := 0; j < n; j++ {
+				if j != i {
+					sum += matrix[i][j] * v[j]
+				}
+			}
+
+			denominator := matrix[i][i] - lambda
+			if math.Abs(denominator) <= tolerance {
+				// Repeated eigenvalue, the component is free unless the
+				// system is inconsistent, meaning there's no eigenvector basis
+				if math.Abs(sum) > tolerance {
+					return nil, false
+				}
+				continue
+			}
+			v[i] = -sum / denominator
+		}
+
+		vec := mat.NewVecDense(n, v)
+		vec.ScaleVec(1/vec.Norm(2), vec)
+		eigenvectors.SetCol(k, vec.RawVector().Data)
+	}
+
+	return &QRMethodResult{
+		Eigenvalues:  eigenvalues,
+		Eigenvectors: eigenvectors,
+	}, true
+}
+
 func generateIdentityMatrix(size int) *mat.Dense {
 	identity := mat.NewDense(size, size, nil)
 	for i := 0; i < size; i++ {
@@ -264,7 +264,7 @@ func TestQRMethod(t *testing.T) {
 
 			// Act
 			ctx := context.Background()
-			result, err := useCase.QRMethod(ctx, tridiagMatrix, householderMatrix, tc.maxIterations, tc.tolerance)
+			result, err := useCase.QRMethod(ctx, tridiagMatrix, householderMatrix, tc.maxIterations, tc.tolerance, ShiftWilkinson)
 
 			// Assert
 			assert.NoError(t, err)
@@ -339,7 +339,7 @@ func TestHouseholderWithQRIntegration(t *testing.T) {
 
 			// Step 2: Apply QR method
 			qrResult, err := useCase.QRMethod(ctx, householderResult.TriangulizedMatrix, 
-				householderResult.HouseholderMatrix, 1000, 1e-10)
+				householderResult.HouseholderMatrix, 1000, 1e-10, ShiftWilkinson)
 			assert.NoError(t, err)
 			assert.NotNil(t, qrResult)
 
@@ -662,3 +662,175 @@ func compareMatricesWithTolerance(t *testing.T, expectedMatrix [][]float64, actu
 		}
 	}
 }
+
+func TestCompleteEigenDecompositionTriangularFastPath(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name   string
+		matrix [][]float64
+	}{
+		{
+			name: "Diagonal matrix",
+			matrix: [][]float64{
+				{5, 0, 0},
+				{0, 3, 0},
+				{0, 0, 1},
+			},
+		},
+		{
+			name: "Upper triangular matrix",
+			matrix: [][]float64{
+				{2, 1, 3},
+				{0, 5, -1},
+				{0, 0, -4},
+			},
+		},
+		{
+			name: "Lower triangular matrix",
+			matrix: [][]float64{
+				{1, 0, 0, 0},
+				{2, 3, 0, 0},
+				{-1, 4, 6, 0},
+				{0.5, 1, 2, -2},
+			},
+		},
+		{
+			name: "Upper triangular matrix with repeated eigenvalue",
+			matrix: [][]float64{
+				{2, 0, 1},
+				{0, 2, 3},
+				{0, 0, 7},
+			},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			useCase := NewSimilarityTransformationUseCase()
+			n := len(test.matrix)
+			A := constructMatrix(test.matrix)
+
+			var reference mat.Eigen
+			assert.True(t, reference.Factorize(A, mat.EigenNone))
+			expected := make([]float64, n)
+			for i, value := range reference.Values(nil) {
+				expected[i] = real(value)
+			}
+			sortFloat64Slice(expected)
+
+			// Act
+			result, err := useCase.CompleteEigenDecomposition(context.Background(), test.matrix, 1000, 1e-12)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Zero(t, result.Iterations)
+
+			eigenvalues := make([]float64, n)
+			copy(eigenvalues, result.Eigenvalues)
+			sortFloat64Slice(eigenvalues)
+			assert.InDeltaSlice(t, expected, eigenvalues, 1e-10)
+
+			for i := 0; i < n; i++ {
+				v := mat.VecDenseCopyOf(result.Eigenvectors.ColView(i))
+				assert.InDelta(t, 1, v.Norm(2), 1e-10)
+
+				var av, lambdav mat.VecDense
+				av.MulVec(A, v)
+				lambdav.ScaleVec(result.Eigenvalues[i], v)
+				assert.InDeltaSlice(t, lambdav.RawVector().Data, av.RawVector().Data, 1e-10)
+			}
+		})
+	}
+}
+
+func TestCompleteEigenDecompositionDiagonalMatchesQRMethod(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewSimilarityTransformationUseCase()
+	ctx := context.Background()
+	matrix := [][]float64{
+		{4, 0, 0},
+		{0, -1, 0},
+		{0, 0, 2.5},
+	}
+
+	householderResult, err := useCase.HouseholderMethod(ctx, matrix)
+	assert.NoError(t, err)
+	full, err := useCase.QRMethod(ctx, householderResult.TriangulizedMatrix, householderResult.HouseholderMatrix, 1000, 1e-12, ShiftWilkinson)
+	assert.NoError(t, err)
+
+	// Act
+	fast, err := useCase.CompleteEigenDecomposition(ctx, matrix, 1000, 1e-12)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, full.Eigenvalues, fast.Eigenvalues, 1e-10)
+	assert.InDeltaSlice(t, full.Eigenvectors.RawMatrix().Data, fast.Eigenvectors.RawMatrix().Data, 1e-10)
+}
+
+func TestCompleteEigenDecompositionDenseMatrixIterates(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewSimilarityTransformationUseCase()
+
+	// Act
+	result, err := useCase.CompleteEigenDecomposition(context.Background(), [][]float64{
+		{4, 1, -2},
+		{1, 2, 0},
+		{-2, 0, 3},
+	}, 1000, 1e-10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Positive(t, result.Iterations)
+}
+
+func TestQRMethodShiftStrategies(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewSimilarityTransformationUseCase()
+	ctx := context.Background()
+	matrix := [][]float64{
+		{5, 2, 0, 1},
+		{2, 3, 1, 0},
+		{0, 1, 4, 2},
+		{1, 0, 2, 1},
+	}
+	expected := []float64{6.708849797114361, 4.673850142641444, 2, -0.382699939755808}
+
+	householderResult, err := useCase.HouseholderMethod(ctx, matrix)
+	assert.NoError(t, err)
+
+	iterations := make(map[ShiftStrategy]int)
+	for _, strategy := range []ShiftStrategy{ShiftNone, ShiftRayleigh, ShiftWilkinson, ""} {
+		// Act
+		result, err := useCase.QRMethod(ctx, householderResult.TriangulizedMatrix, householderResult.HouseholderMatrix, 1000, 1e-10, strategy)
+
+		// Assert
+		assert.NoError(t, err)
+		eigenvalues := make([]float64, len(result.Eigenvalues))
+		copy(eigenvalues, result.Eigenvalues)
+		sortFloat64Slice(eigenvalues)
+		assert.InDeltaSlice(t, expected, eigenvalues, 1e-8, "strategy %q", strategy)
+		iterations[strategy] = result.Iterations
+	}
+
+	assert.Less(t, iterations[ShiftWilkinson], iterations[ShiftRayleigh])
+	assert.Less(t, iterations[ShiftRayleigh], iterations[ShiftNone])
+	assert.Equal(t, iterations[ShiftWilkinson], iterations[""], "Wilkinson should be the default")
+}
+
+func TestQRMethodUnknownShiftStrategy(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewSimilarityTransformationUseCase()
+
+	// Act
+	_, err := useCase.QRMethod(context.Background(), generateIdentityMatrix(2), generateIdentityMatrix(2), 10, 1e-10, "francis")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnknownShiftStrategy)
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"math"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var ErrNoSamples = numeerr.New(numeerr.CodeInvalidInput, "cannot summarize zero samples")
+
+// SampleAccumulator keeps the running mean and spread of samples with
+// Welford's algorithm, which stays accurate when the samples are large and
+// close together, where the naive sum of squares cancels catastrophically.
+// The zero value is ready to use.
+type SampleAccumulator struct {
+	count int
+	mean  float64
+	// m2 is the sum of squared distances to the current mean
+	m2  float64
+	min float64
+	max float64
+}
+
+// Add includes value in the summary.
+func (a *SampleAccumulator) Add(value float64) {
+	a.count++
+
+	if a.count == 1 {
+		a.min, a.max = value, value
+	} else {
+		a.min = math.Min(a.min, value)
+		a.max = math.Max(a.max, value)
+	}
+
+	delta := value - a.mean
+	a.mean += delta / float64(a.count)
+	a.m2 += delta * (value - a.mean)
+}
+
+// Summary returns the statistics of the samples added so far.
+func (a *SampleAccumulator) Summary() (SampleSummary, error) {
+	if a.count == 0 {
+		return SampleSummary{}, ErrNoSamples
+	}
+
+	summary := SampleSummary{
+		Count: a.count,
+		Mean:  a.mean,
+		Min:   a.min,
+		Max:   a.max,
+	}
+
+	// A single sample has no spread
+	if a.count > 1 {
+		summary.StdDev = math.Sqrt(a.m2 / float64(a.count-1))
+	}
+
+	return summary, nil
+}
+
+// SampleSummary describes a set of results, such as a parameter sweep.
+// StdDev is the sample standard deviation, with n - 1 degrees of freedom.
+type SampleSummary struct {
+	Count  int
+	Mean   float64
+	StdDev float64
+	Min    float64
+	Max    float64
+}
+
+// Summarize returns the statistics of samples in a single pass.
+func Summarize(samples []float64) (SampleSummary, error) {
+	var accumulator SampleAccumulator
+	for _, sample := range samples {
+		accumulator.Add(sample)
+	}
+
+	return accumulator.Summary()
+}
@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		samples  []float64
+		expected SampleSummary
+	}{
+		{
+			name:     "Single sample",
+			samples:  []float64{4.2},
+			expected: SampleSummary{Count: 1, Mean: 4.2, StdDev: 0, Min: 4.2, Max: 4.2},
+		},
+		{
+			name:     "Known sample",
+			samples:  []float64{2, 4, 4, 4, 5, 5, 7, 9},
+			expected: SampleSummary{Count: 8, Mean: 5, StdDev: math.Sqrt(32.0 / 7), Min: 2, Max: 9},
+		},
+		{
+			name:     "Constant",
+			samples:  []float64{3, 3, 3, 3},
+			expected: SampleSummary{Count: 4, Mean: 3, StdDev: 0, Min: 3, Max: 3},
+		},
+		{
+			name:     "Negative values",
+			samples:  []float64{-1, -3, -5},
+			expected: SampleSummary{Count: 3, Mean: -3, StdDev: 2, Min: -5, Max: -1},
+		},
+		{
+			// The naive E[x²] - E[x]² loses every digit of the spread here
+			name:     "Large offset",
+			samples:  []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16},
+			expected: SampleSummary{Count: 4, Mean: 1e9 + 10, StdDev: math.Sqrt(30), Min: 1e9 + 4, Max: 1e9 + 16},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			summary, err := Summarize(test.samples)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expected.Count, summary.Count)
+			assert.InDelta(t, test.expected.Mean, summary.Mean, 1e-9)
+			assert.InDelta(t, test.expected.StdDev, summary.StdDev, 1e-9)
+			assert.Equal(t, test.expected.Min, summary.Min)
+			assert.Equal(t, test.expected.Max, summary.Max)
+		})
+	}
+}
+
+func TestSummarizeUniformDistribution(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	samples := make([]float64, 10001)
+	for i := range samples {
+		samples[i] = float64(i) / 10000
+	}
+
+	// Act
+	summary, err := Summarize(samples)
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, summary.Mean, 1e-12)
+	// A uniform distribution on [0, 1] has a standard deviation of 1/√12
+	assert.InDelta(t, 1/math.Sqrt(12), summary.StdDev, 1e-4)
+	assert.Equal(t, 0.0, summary.Min)
+	assert.Equal(t, 1.0, summary.Max)
+}
+
+func TestSummarizeWithoutSamples(t *testing.T) {
+	// Arrange
+	t.Parallel()
+
+	// Act
+	_, err := Summarize(nil)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNoSamples)
+}
+
+func TestSampleAccumulatorMatchesSummarize(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	samples := []float64{0.1, 0.7, 0.3, 0.9, 0.5}
+	var accumulator SampleAccumulator
+
+	// Act
+	for _, sample := range samples {
+		accumulator.Add(sample)
+	}
+	incremental, err := accumulator.Summary()
+
+	// Assert
+	require.NoError(t, err)
+	expected, err := Summarize(samples)
+	require.NoError(t, err)
+	assert.Equal(t, expected, incremental)
+}
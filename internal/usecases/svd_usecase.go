@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/taldoflemis/nume/internal/numeerr"
+)
+
+var (
+	ErrRaggedMatrix = numeerr.New(numeerr.CodeInvalidInput, "matrix rows have different lengths")
+	ErrSVDFailed    = numeerr.New(numeerr.CodeFailed, "singular value decomposition failed")
+)
+
+// PseudoInverse computes the Moore–Penrose inverse A⁺ = V Σ⁺ Uᵀ of any
+// matrix, inverting only the singular values above the default rank
+// tolerance so rank-deficient matrices stay well defined.
+func (u *MatrixUseCase) PseudoInverse(ctx context.Context, matrix [][]float64) ([][]float64, error) {
+	slog.DebugContext(ctx, "Starting the pseudo-inverse computation",
+		matrixAttr("matrix", matrix),
+	)
+
+	svd, err := factorizeSVD(matrix)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid matrix for the pseudo-inverse", slog.Any("error", err))
+		return nil, err
+	}
+
+	var left, right mat.Dense
+	svd.UTo(&left)
+	svd.VTo(&right)
+
+	values := svd.Values(nil)
+	tolerance := defaultRankTolerance(matrix, values)
+
+	// V Σ⁺ scales the columns of V by the inverted singular values
+	for j, value := range values {
+		scale := 0.0
+		if value > tolerance {
+			scale = 1 / value
+		}
+		for i := range right.RawMatrix().Rows {
+			right.Set(i, j, right.At(i, j)*scale)
+		}
+	}
+
+	var pseudoInverse mat.Dense
+	pseudoInverse.Mul(&right, left.T())
+
+	slog.InfoContext(ctx, "Finished the pseudo-inverse computation",
+		vectorAttr("singularValues", values),
+	)
+
+	return denseToSliceOfSlices(&pseudoInverse), nil
+}
+
+// Rank counts the singular values above tolerance. A non-positive tolerance
+// picks the usual max(m, n)·σ₁·ε.
+func (u *MatrixUseCase) Rank(ctx context.Context, matrix [][]float64, tolerance float64) (int, error) {
+	svd, err := factorizeSVD(matrix)
+	if err != nil {
+		slog.ErrorContext(ctx, "Invalid matrix for the rank computation", slog.Any("error", err))
+		return 0, err
+	}
+
+	values := svd.Values(nil)
+	if tolerance <= 0 {
+		tolerance = defaultRankTolerance(matrix, values)
+	}
+
+	rank := 0
+	for _, value := range values {
+		if value > tolerance {
+			rank++
+		}
+	}
+
+	slog.InfoContext(ctx, "Finished the rank computation",
+		vectorAttr("singularValues", values),
+		slog.Float64("tolerance", tolerance),
+		slog.Int("rank", rank),
+	)
+
+	return rank, nil
+}
+
+// factorizeSVD computes the thin singular value decomposition of a non-empty
+// rectangular matrix.
+func factorizeSVD(matrix [][]float64) (*mat.SVD, error) {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return nil, ErrEmptyMatrix
+	}
+
+	for i, row := range matrix {
+		if len(row) != len(matrix[0]) {
+			return nil, fmt.Errorf("%w: row %d has %d columns, expected %d", ErrRaggedMatrix, i, len(row), len(matrix[0]))
+		}
+	}
+
+	var svd mat.SVD
+	if ok := svd.Factorize(constructMatrix(matrix), mat.SVDThin); !ok {
+		return nil, ErrSVDFailed
+	}
+
+	return &svd, nil
+}
+
+// defaultRankTolerance is the threshold below which singular values are
+// indistinguishable from round-off, values are sorted in decreasing order.
+func defaultRankTolerance(matrix [][]float64, values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	dimension := float64(max(len(matrix), len(matrix[0])))
+	return dimension * values[0] * machineEpsilon
+}
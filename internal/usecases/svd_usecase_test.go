@@ -0,0 +1,184 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestRank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		matrix       [][]float64
+		tolerance    float64
+		expectedRank int
+	}{
+		{
+			name:         "Full rank square",
+			matrix:       [][]float64{{4, 7}, {2, 6}},
+			expectedRank: 2,
+		},
+		{
+			name:         "Rank deficient square",
+			matrix:       [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+			expectedRank: 2,
+		},
+		{
+			name:         "Wide rank one",
+			matrix:       [][]float64{{1, 2, 3, 4}, {2, 4, 6, 8}},
+			expectedRank: 1,
+		},
+		{
+			name:         "Tall full column rank",
+			matrix:       [][]float64{{1, 0}, {0, 1}, {1, 1}},
+			expectedRank: 2,
+		},
+		{
+			name:         "Nearly singular under a loose tolerance",
+			matrix:       [][]float64{{1, 0}, {0, 1e-9}},
+			tolerance:    1e-6,
+			expectedRank: 1,
+		},
+		{
+			name:         "Zero matrix",
+			matrix:       [][]float64{{0, 0}, {0, 0}},
+			expectedRank: 0,
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			rank, err := useCase.Rank(t.Context(), test.matrix, test.tolerance)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRank, rank)
+		})
+	}
+}
+
+func TestPseudoInverseSatisfiesPenroseConditions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		matrix [][]float64
+	}{
+		{
+			name:   "Rank deficient square",
+			matrix: [][]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+		},
+		{
+			name:   "Wide rank one",
+			matrix: [][]float64{{1, 2, 3, 4}, {2, 4, 6, 8}},
+		},
+		{
+			name:   "Tall full column rank",
+			matrix: [][]float64{{1, 0}, {0, 1}, {1, 1}},
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			a := constructMatrix(test.matrix)
+
+			// Act
+			pseudoInverse, err := useCase.PseudoInverse(t.Context(), test.matrix)
+
+			// Assert
+			require.NoError(t, err)
+			aPlus := constructMatrix(pseudoInverse)
+			rows, cols := a.Dims()
+			plusRows, plusCols := aPlus.Dims()
+			require.Equal(t, cols, plusRows)
+			require.Equal(t, rows, plusCols)
+
+			var aAPlus, aPlusA, product mat.Dense
+			aAPlus.Mul(a, aPlus)
+			aPlusA.Mul(aPlus, a)
+
+			// A A⁺ A = A
+			product.Mul(&aAPlus, a)
+			assert.True(t, mat.EqualApprox(&product, a, 1e-10), "A A⁺ A = A")
+
+			// A⁺ A A⁺ = A⁺
+			product.Reset()
+			product.Mul(&aPlusA, aPlus)
+			assert.True(t, mat.EqualApprox(&product, aPlus, 1e-10), "A⁺ A A⁺ = A⁺")
+
+			// A A⁺ and A⁺ A are symmetric
+			assert.True(t, mat.EqualApprox(&aAPlus, aAPlus.T(), 1e-10), "(A A⁺)ᵀ = A A⁺")
+			assert.True(t, mat.EqualApprox(&aPlusA, aPlusA.T(), 1e-10), "(A⁺ A)ᵀ = A⁺ A")
+		})
+	}
+}
+
+func TestPseudoInverseOfInvertibleMatrixIsInverse(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	useCase := NewMatrixUseCase()
+	matrix := [][]float64{{4, 7}, {2, 6}}
+
+	// Act
+	pseudoInverse, err := useCase.PseudoInverse(t.Context(), matrix)
+
+	// Assert
+	require.NoError(t, err)
+	inverse, err := useCase.MatrixInverse(t.Context(), matrix)
+	require.NoError(t, err)
+	for i := range inverse {
+		assert.InDeltaSlice(t, inverse[i], pseudoInverse[i], 1e-12)
+	}
+}
+
+func TestSVDRejectsInvalidMatrices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		matrix      [][]float64
+		expectedErr error
+	}{
+		{
+			name:        "Empty",
+			matrix:      [][]float64{},
+			expectedErr: ErrEmptyMatrix,
+		},
+		{
+			name:        "Ragged",
+			matrix:      [][]float64{{1, 2}, {3}},
+			expectedErr: ErrRaggedMatrix,
+		},
+	}
+
+	useCase := NewMatrixUseCase()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			// Act
+			_, pseudoInverseErr := useCase.PseudoInverse(t.Context(), test.matrix)
+			_, rankErr := useCase.Rank(t.Context(), test.matrix, 0)
+
+			// Assert
+			assert.ErrorIs(t, pseudoInverseErr, test.expectedErr)
+			assert.ErrorIs(t, rankErr, test.expectedErr)
+		})
+	}
+}